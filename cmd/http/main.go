@@ -2,24 +2,39 @@ package main
 
 import (
 	"context"
-	"fmt"
+	"errors"
+	"flag"
 	"log/slog"
+	"net"
 	"os"
+	"os/signal"
+	"strconv"
+	"syscall"
+	"time"
 
 	"github.com/TienMinh25/go-hexagonal-architecture/config"
 	_ "github.com/TienMinh25/go-hexagonal-architecture/docs"
 	storagepostgres "github.com/TienMinh25/go-hexagonal-architecture/infrastructure/storage/postgres"
+	"github.com/TienMinh25/go-hexagonal-architecture/internal/adapter/auth/jwt"
 	"github.com/TienMinh25/go-hexagonal-architecture/internal/adapter/auth/paseto"
 	"github.com/TienMinh25/go-hexagonal-architecture/internal/adapter/handler/http"
 	"github.com/TienMinh25/go-hexagonal-architecture/internal/adapter/logger"
+	"github.com/TienMinh25/go-hexagonal-architecture/internal/adapter/mailer/file"
+	"github.com/TienMinh25/go-hexagonal-architecture/internal/adapter/mailer/smtp"
+	"github.com/TienMinh25/go-hexagonal-architecture/internal/adapter/metrics"
 	"github.com/TienMinh25/go-hexagonal-architecture/internal/adapter/redis"
 	"github.com/TienMinh25/go-hexagonal-architecture/internal/adapter/repository"
+	"github.com/TienMinh25/go-hexagonal-architecture/internal/adapter/tracing"
+	domainauth "github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain/auth"
+	domainloyalty "github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain/loyalty"
+	domainuser "github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain/user"
+	"github.com/TienMinh25/go-hexagonal-architecture/internal/application/port"
 	"github.com/TienMinh25/go-hexagonal-architecture/internal/application/usecase"
 )
 
 // @title						Go Sale API demo for hexagonal architecture
 // @version					1.0
-// @description				This is a simple RESTful Point of Sale (POS) Service API written in Go using Gin web framework, PostgreSQL database, and Redis cache.
+// @description				This is a simple RESTful Point of Sale (POS) Service API written in Go using Gin web framework, PostgreSQL database, and Redis cache. Errors are sent as problem details (RFC 7807) to clients accepting application/problem+json. Error messages are in English, Vietnamese or Indonesian, following the locale of the user or the Accept-Language header.
 //
 // @contact.name				Tien Minh
 // @contact.url				https://github.com/TienMinh25/go-hexagonal-architecture
@@ -36,27 +51,60 @@ import (
 // @in							header
 // @name						Authorization
 // @description				Type "Bearer" followed by a space and the access token.
+//
+// @securityDefinitions.apikey	APIKeyAuth
+// @in							header
+// @name						X-API-Key
+// @description				API key of a machine client, accepted on catalog endpoints.
 func main() {
-	// Load environment variables
-	cfg, err := config.New()
+	configFile := flag.String("config", os.Getenv("CONFIG_FILE"), "path of the YAML config file, its settings are overridden by the environment")
+	printConfig := flag.Bool("print-config", false, "print the configuration with the secrets redacted and exit")
+	flag.Parse()
+
+	// Load configuration
+	cfg, err := config.New(*configFile)
 	if err != nil {
-		slog.Error("Error loading environment variables", "error", err)
+		var configErr *config.Error
+		if errors.As(err, &configErr) {
+			for _, problem := range configErr.Problems {
+				slog.Error("Invalid configuration", "problem", problem)
+			}
+		} else {
+			slog.Error("Error loading configuration", "error", err)
+		}
 		os.Exit(1)
 	}
 
+	if *printConfig {
+		if err := cfg.Print(os.Stdout); err != nil {
+			slog.Error("Error printing configuration", "error", err)
+			os.Exit(1)
+		}
+		return
+	}
+
 	// Set logger
 	logger.Set(cfg.App)
 
 	slog.Info("Starting the application", "app", cfg.App.Name, "env", cfg.App.Env)
+	slog.Info("Loaded configuration", "config", cfg)
 
-	// Init database
+	// Init tracing
 	ctx := context.Background()
+	tracerProvider, err := tracing.New(ctx, cfg.App, cfg.Tracing)
+	if err != nil {
+		slog.Error("Error initializing tracing", "error", err)
+		os.Exit(1)
+	}
+
+	slog.Info("Successfully initialized tracing", "exporter", cfg.Tracing.Exporter, "sample_ratio", cfg.Tracing.SampleRatio)
+
+	// Init database
 	db, err := storagepostgres.New(ctx, cfg.DB)
 	if err != nil {
 		slog.Error("Error initializing database connection", "error", err)
 		os.Exit(1)
 	}
-	defer db.Close()
 
 	slog.Info("Successfully connected to the database", "db", cfg.DB.Connection)
 
@@ -69,63 +117,188 @@ func main() {
 
 	slog.Info("Successfully migrated the database")
 
+	// Init metrics
+	appMetrics, err := metrics.New(db.Pool)
+	if err != nil {
+		slog.Error("Error initializing metrics", "error", err)
+		os.Exit(1)
+	}
+
 	// Init cache service
-	cache, err := redis.NewRedis(ctx, cfg.Redis)
+	cache, err := redis.NewRedis(ctx, cfg.Redis, appMetrics)
 	if err != nil {
 		slog.Error("Error initializing cache connection", "error", err)
 		os.Exit(1)
 	}
-	defer cache.Close()
 
 	slog.Info("Successfully connected to the cache server")
 
 	// Init token service
-	token, err := paseto.New(cfg.Token)
+	var token port.TokenService
+	if cfg.Token.Type == "jwt" {
+		token, err = jwt.New(cfg.Token, cache)
+	} else {
+		token, err = paseto.New(cfg.Token, cache)
+	}
 	if err != nil {
 		slog.Error("Error initializing token service", "error", err)
 		os.Exit(1)
 	}
 
+	// Init mailer
+	var mailer port.Mailer
+	if cfg.Mail.Driver == "smtp" {
+		mailer = smtp.New(cfg.Mail)
+	} else {
+		mailer = file.New(cfg.Mail.File)
+	}
+
 	// Dependency injection
+	// Health
+	healthRepo := repository.NewHealthRepository(db)
+	healthService := usecase.NewHealthUsecase(healthRepo, cache)
+	healthHandler := http.NewHealthHandler(healthService)
+	metricsHandler := http.NewMetricsHandler(appMetrics.Handler(), cfg.Metrics.Token)
+
+	// Audit
+	auditRepo := repository.NewAuditRepository(db)
+	auditService := tracing.NewAuditService(usecase.NewAuditUsecase(auditRepo))
+	auditHandler := http.NewAuditHandler(auditService)
+
 	// User
+	registrationPolicy := domainuser.RegistrationPolicy{
+		Mode:               domainuser.RegistrationMode(cfg.Registration.Policy),
+		InvitationDuration: cfg.Registration.InvitationDuration,
+		InvitationURL:      cfg.Registration.InvitationURL,
+	}
 	userRepo := repository.NewUserRepository(db)
-	userService := usecase.NewUserUsecase(userRepo, cache)
+	invitationRepo := repository.NewInvitationRepository(db)
+	userService := tracing.NewUserService(usecase.NewUserUsecase(userRepo, invitationRepo, cache, registrationPolicy, auditService))
 	userHandler := http.NewUserHandler(userService)
+	invitationService := tracing.NewInvitationService(usecase.NewInvitationUsecase(invitationRepo, userRepo, mailer, registrationPolicy, auditService))
+	invitationHandler := http.NewInvitationHandler(invitationService)
+
+	// Role
+	roleRepo := repository.NewRoleRepository(db)
+	roleService := tracing.NewRoleService(usecase.NewRoleUsecase(roleRepo, auditService))
+	roleHandler := http.NewRoleHandler(roleService)
+
+	// Terminal
+	terminalRepo := repository.NewTerminalRepository(db)
+	terminalService := tracing.NewTerminalService(usecase.NewTerminalUsecase(terminalRepo, auditService))
+	terminalHandler := http.NewTerminalHandler(terminalService)
+
+	// API key
+	apiKeyRepo := repository.NewAPIKeyRepository(db)
+	apiKeyService := tracing.NewAPIKeyService(usecase.NewAPIKeyUsecase(apiKeyRepo, userRepo, roleRepo, auditService))
+	apiKeyHandler := http.NewAPIKeyHandler(apiKeyService)
 
 	// Auth
-	authService := usecase.NewAuthUsecase(userRepo, token)
+	refreshTokenRepo := repository.NewRefreshTokenRepository(db)
+	lockoutPolicy := domainauth.LockoutPolicy{
+		MaxAttempts:     cfg.Login.MaxAttempts,
+		IPMaxAttempts:   cfg.Login.IPMaxAttempts,
+		BaseDelay:       cfg.Login.BackoffBase,
+		MaxDelay:        cfg.Login.BackoffMax,
+		LockoutDuration: cfg.Login.LockoutDuration,
+	}
+	twoFactorRepo := repository.NewTwoFactorRepository(db)
+	twoFactorPolicy := domainauth.TwoFactorPolicy{
+		Issuer:       cfg.App.Name,
+		RequireAdmin: cfg.Login.RequireAdmin2FA,
+	}
+	authService := tracing.NewAuthService(usecase.NewAuthUsecase(
+		userRepo,
+		refreshTokenRepo,
+		roleRepo,
+		terminalRepo,
+		twoFactorRepo,
+		token,
+		cache,
+		cfg.Token.RefreshDuration,
+		lockoutPolicy,
+		twoFactorPolicy,
+		auditService,
+	))
 	authHandler := http.NewAuthHandler(authService)
+	keyHandler := http.NewKeyHandler(token)
+
+	// Password
+	passwordResetRepo := repository.NewPasswordResetRepository(db)
+	passwordResetPolicy := domainauth.PasswordResetPolicy{
+		Duration:      cfg.Reset.Duration,
+		URL:           cfg.Reset.URL,
+		MaxRequests:   cfg.Reset.MaxRequests,
+		IPMaxRequests: cfg.Reset.IPMaxRequests,
+		Window:        cfg.Reset.Window,
+	}
+	passwordService := tracing.NewPasswordService(usecase.NewPasswordUsecase(userRepo, passwordResetRepo, refreshTokenRepo, mailer, cache, passwordResetPolicy, auditService))
+	passwordHandler := http.NewPasswordHandler(passwordService)
 
 	// Payment
 	paymentRepo := repository.NewPaymentRepository(db)
-	paymentService := usecase.NewPaymentUsecase(paymentRepo, cache)
+	paymentService := tracing.NewPaymentService(usecase.NewPaymentUsecase(paymentRepo, cache, auditService))
 	paymentHandler := http.NewPaymentHandler(paymentService)
 
 	// Category
 	categoryRepo := repository.NewCategoryRepository(db)
-	categoryService := usecase.NewCategoryUsecase(categoryRepo, cache)
+	categoryService := tracing.NewCategoryService(usecase.NewCategoryUsecase(categoryRepo, cache, auditService))
 	categoryHandler := http.NewCategoryHandler(categoryService)
 
 	// Product
 	productRepo := repository.NewProductRepository(db)
-	productService := usecase.NewProductUsecase(productRepo, categoryRepo, cache)
+	productService := tracing.NewProductService(usecase.NewProductUsecase(productRepo, categoryRepo, cache, auditService))
 	productHandler := http.NewProductHandler(productService)
 
+	// Customer
+	customerRepo := repository.NewCustomerRepository(db)
+	customerService := tracing.NewCustomerService(usecase.NewCustomerUsecase(customerRepo, cache, auditService))
+
+	// Loyalty
+	loyaltyPolicy := domainloyalty.Policy{
+		EarnRate:          cfg.Loyalty.EarnRate,
+		CategoryEarnRates: cfg.Loyalty.CategoryEarnRates,
+		PointValue:        cfg.Loyalty.PointValue,
+	}
+	loyaltyRepo := repository.NewLoyaltyRepository(db)
+	loyaltyService := tracing.NewLoyaltyService(usecase.NewLoyaltyUsecase(loyaltyRepo, customerRepo, loyaltyPolicy))
+	loyaltyHandler := http.NewLoyaltyHandler(loyaltyService)
+
+	// Gift card
+	giftCardRepo := repository.NewGiftCardRepository(db)
+	giftCardService := tracing.NewGiftCardService(usecase.NewGiftCardUsecase(giftCardRepo, paymentRepo, customerRepo, cache, auditService))
+	giftCardHandler := http.NewGiftCardHandler(giftCardService)
+
 	// Order
 	orderRepo := repository.NewOrderRepository(db)
-	orderService := usecase.NewOrderUsecase(orderRepo, productRepo, categoryRepo, userRepo, paymentRepo, cache)
+	orderService := tracing.NewOrderService(usecase.NewOrderUsecase(orderRepo, productRepo, categoryRepo, userRepo, paymentRepo, customerRepo, giftCardRepo, loyaltyRepo, cache, loyaltyPolicy, appMetrics, auditService))
 	orderHandler := http.NewOrderHandler(orderService)
+	customerHandler := http.NewCustomerHandler(customerService, orderService)
 
 	// Init router
 	router, err := http.NewRouter(
 		cfg.HTTP,
 		token,
+		apiKeyService,
+		appMetrics,
+		*healthHandler,
+		*metricsHandler,
 		*userHandler,
 		*authHandler,
+		*passwordHandler,
+		*invitationHandler,
+		*keyHandler,
+		*roleHandler,
+		*terminalHandler,
+		*apiKeyHandler,
+		*auditHandler,
 		*paymentHandler,
 		*categoryHandler,
 		*productHandler,
 		*orderHandler,
+		*customerHandler,
+		*loyaltyHandler,
+		*giftCardHandler,
 	)
 	if err != nil {
 		slog.Error("Error initializing router", "error", err)
@@ -133,11 +306,68 @@ func main() {
 	}
 
 	// Start server
-	listenAddr := fmt.Sprintf("%s:%s", cfg.HTTP.URL, cfg.HTTP.Port)
+	signalCtx, stop := signal.NotifyContext(ctx, os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	listenAddr := net.JoinHostPort(cfg.HTTP.URL, strconv.Itoa(cfg.HTTP.Port))
 	slog.Info("Starting the HTTP server", "listen_address", listenAddr)
-	err = router.Serve(listenAddr)
+
+	serveErr := make(chan error, 1)
+	go func() {
+		serveErr <- router.Serve(listenAddr)
+	}()
+
+	exitCode := 0
+	select {
+	case err = <-serveErr:
+		if err != nil {
+			slog.Error("Error starting the HTTP server", "error", err)
+			exitCode = 1
+		}
+	case <-signalCtx.Done():
+		// A second signal kills the process right away instead of waiting for the drain
+		stop()
+		slog.Info("Shutting down the application", "drain_delay", cfg.HTTP.ShutdownDelay, "drain_timeout", cfg.HTTP.ShutdownTimeout)
+
+		// Keep serving while reported not ready until the load balancers stop routing new requests here
+		healthService.Drain()
+		time.Sleep(cfg.HTTP.ShutdownDelay)
+	}
+
+	// Shut down in order, each step only after everything using it is done:
+	// the HTTP server drains in-flight requests first, background workers stop next,
+	// and the cache and database connections are closed last
+	shutdownCtx, cancel := context.WithTimeout(ctx, cfg.HTTP.ShutdownTimeout)
+	defer cancel()
+
+	err = router.Shutdown(shutdownCtx)
 	if err != nil {
-		slog.Error("Error starting the HTTP server", "error", err)
-		os.Exit(1)
+		slog.Error("Error shutting down the HTTP server", "error", err)
+		exitCode = 1
 	}
+
+	// Background workers stop next, the drained requests may have left reset mails sending
+	err = passwordService.Close(shutdownCtx)
+	if err != nil {
+		slog.Error("Error waiting for the password reset mails", "error", err)
+		exitCode = 1
+	}
+
+	// The spans of the drained requests are still batched in memory
+	err = tracerProvider.Shutdown(shutdownCtx)
+	if err != nil {
+		slog.Error("Error flushing the traces", "error", err)
+		exitCode = 1
+	}
+
+	err = cache.Close()
+	if err != nil {
+		slog.Error("Error closing the cache connection", "error", err)
+		exitCode = 1
+	}
+
+	db.Close()
+
+	slog.Info("Application stopped")
+	os.Exit(exitCode)
 }
@@ -1,96 +1,216 @@
 package config
 
 import (
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
 	"os"
+	"strings"
+	"time"
 
 	"github.com/joho/godotenv"
+	"gopkg.in/yaml.v3"
 )
 
-// Container contains environment variables for the application, database, cache, token, and http server
+// Container contains the configuration of the application, database, cache, token, and http server.
+// Every setting has a default, is read from the YAML file when one is given and is overridden by its environment variable
 type (
 	Container struct {
-		App   *App
-		Token *Token
-		Redis *Redis
-		DB    *DB
-		HTTP  *HTTP
+		App          *App           `yaml:"app"`
+		Token        *Token         `yaml:"token"`
+		Redis        *Redis         `yaml:"redis"`
+		DB           *DB            `yaml:"db"`
+		HTTP         *HTTP          `yaml:"http"`
+		Loyalty      *Loyalty       `yaml:"loyalty"`
+		Login        *Login         `yaml:"login"`
+		Mail         *Mail          `yaml:"mail"`
+		Reset        *PasswordReset `yaml:"password_reset"`
+		Registration *Registration  `yaml:"registration"`
+		Metrics      *Metrics       `yaml:"metrics"`
+		Tracing      *Tracing       `yaml:"tracing"`
 	}
-	// App contains all the environment variables for the application
+	// App contains all the settings for the application
 	App struct {
-		Name string
-		Env  string
+		Name string `yaml:"name" env:"APP_NAME" default:"hexagonal-demo" validate:"required"`
+		Env  string `yaml:"env" env:"APP_ENV" default:"development" validate:"required"`
 	}
-	// Token contains all the environment variables for the token service
+	// Token contains all the settings for the token service
 	Token struct {
-		Duration string
+		Type             string        `yaml:"type" env:"TOKEN_TYPE" default:"local" validate:"oneof=local public jwt"`
+		Duration         time.Duration `yaml:"duration" env:"TOKEN_DURATION" default:"15m" validate:"gt=0"`
+		RefreshDuration  time.Duration `yaml:"refresh_duration" env:"TOKEN_REFRESH_DURATION" default:"168h" validate:"gt=0"`
+		TerminalDuration time.Duration `yaml:"terminal_duration" env:"TOKEN_TERMINAL_DURATION" default:"10m" validate:"gt=0"`
+		Keys             []string      `yaml:"keys" env:"TOKEN_KEYS" secret:"true" validate:"required_without=KeyFile"`
+		KeyFile          string        `yaml:"key_file" env:"TOKEN_KEY_FILE"`
+		ActiveKeyID      string        `yaml:"active_key_id" env:"TOKEN_ACTIVE_KEY_ID"`
 	}
-	// Redis contains all the environment variables for the cache service
+	// Redis contains all the settings for the cache service
 	Redis struct {
-		Addr     string
-		Password string
+		Addr     string `yaml:"addr" env:"REDIS_ADDR" default:"localhost:6379" validate:"required"`
+		Password string `yaml:"password" env:"REDIS_PASSWORD" secret:"true"`
 	}
-	// Database contains all the environment variables for the database
+	// Database contains all the settings for the database
 	DB struct {
-		Connection string
-		Host       string
-		Port       string
-		User       string
-		Password   string
-		Name       string
-	}
-	// HTTP contains all the environment variables for the http server
+		Connection string `yaml:"connection" env:"DB_CONNECTION" default:"postgres" validate:"required"`
+		Host       string `yaml:"host" env:"DB_HOST" default:"127.0.0.1" validate:"required"`
+		Port       int    `yaml:"port" env:"DB_PORT" default:"5432" validate:"min=1,max=65535"`
+		User       string `yaml:"user" env:"DB_USER" validate:"required"`
+		Password   string `yaml:"password" env:"DB_PASSWORD" secret:"true"`
+		Name       string `yaml:"name" env:"DB_NAME" validate:"required"`
+	}
+	// HTTP contains all the settings for the http server, Env is the one of the application
 	HTTP struct {
-		Env            string
-		URL            string
-		Port           string
-		AllowedOrigins string
+		Env               string        `yaml:"-"`
+		URL               string        `yaml:"url" env:"HTTP_URL" default:"127.0.0.1"`
+		Port              int           `yaml:"port" env:"HTTP_PORT" default:"8080" validate:"min=1,max=65535"`
+		AllowedOrigins    []string      `yaml:"allowed_origins" env:"HTTP_ALLOWED_ORIGINS" validate:"min=1,dive,required"`
+		TrustedProxies    []string      `yaml:"trusted_proxies" env:"HTTP_TRUSTED_PROXIES" validate:"dive,required"`
+		ReadTimeout       time.Duration `yaml:"read_timeout" env:"HTTP_READ_TIMEOUT" default:"15s" validate:"gt=0"`
+		ReadHeaderTimeout time.Duration `yaml:"read_header_timeout" env:"HTTP_READ_HEADER_TIMEOUT" default:"5s" validate:"gt=0"`
+		WriteTimeout      time.Duration `yaml:"write_timeout" env:"HTTP_WRITE_TIMEOUT" default:"30s" validate:"gt=0"`
+		IdleTimeout       time.Duration `yaml:"idle_timeout" env:"HTTP_IDLE_TIMEOUT" default:"60s" validate:"gt=0"`
+		ShutdownDelay     time.Duration `yaml:"shutdown_delay" env:"HTTP_SHUTDOWN_DELAY" default:"5s" validate:"gt=0"`
+		ShutdownTimeout   time.Duration `yaml:"shutdown_timeout" env:"HTTP_SHUTDOWN_TIMEOUT" default:"30s" validate:"gt=0"`
+	}
+	// Login contains all the settings for throttling failed logins
+	Login struct {
+		MaxAttempts     int64         `yaml:"max_attempts" env:"LOGIN_MAX_ATTEMPTS" default:"5" validate:"gt=0"`
+		IPMaxAttempts   int64         `yaml:"ip_max_attempts" env:"LOGIN_IP_MAX_ATTEMPTS" default:"20" validate:"gt=0"`
+		BackoffBase     time.Duration `yaml:"backoff_base" env:"LOGIN_BACKOFF_BASE" default:"1s" validate:"gt=0"`
+		BackoffMax      time.Duration `yaml:"backoff_max" env:"LOGIN_BACKOFF_MAX" default:"5m" validate:"gt=0"`
+		LockoutDuration time.Duration `yaml:"lockout_duration" env:"LOGIN_LOCKOUT_DURATION" default:"15m" validate:"gt=0"`
+		RequireAdmin2FA bool          `yaml:"require_admin_2fa" env:"LOGIN_REQUIRE_ADMIN_2FA"`
+	}
+	// Mail contains all the settings for sending emails
+	Mail struct {
+		Driver       string `yaml:"driver" env:"MAIL_DRIVER" default:"file" validate:"oneof=file smtp"`
+		From         string `yaml:"from" env:"MAIL_FROM" validate:"required_if=Driver smtp"`
+		SMTPHost     string `yaml:"smtp_host" env:"MAIL_SMTP_HOST" validate:"required_if=Driver smtp"`
+		SMTPPort     int    `yaml:"smtp_port" env:"MAIL_SMTP_PORT" default:"587" validate:"min=1,max=65535"`
+		SMTPUser     string `yaml:"smtp_user" env:"MAIL_SMTP_USER"`
+		SMTPPassword string `yaml:"smtp_password" env:"MAIL_SMTP_PASSWORD" secret:"true"`
+		File         string `yaml:"file" env:"MAIL_FILE"`
+	}
+	// PasswordReset contains all the settings for password reset links
+	PasswordReset struct {
+		Duration      time.Duration `yaml:"duration" env:"PASSWORD_RESET_DURATION" default:"1h" validate:"gt=0"`
+		URL           string        `yaml:"url" env:"PASSWORD_RESET_URL" validate:"omitempty,url"`
+		MaxRequests   int64         `yaml:"max_requests" env:"PASSWORD_RESET_MAX_REQUESTS" default:"3" validate:"gt=0"`
+		IPMaxRequests int64         `yaml:"ip_max_requests" env:"PASSWORD_RESET_IP_MAX_REQUESTS" default:"20" validate:"gt=0"`
+		Window        time.Duration `yaml:"window" env:"PASSWORD_RESET_WINDOW" default:"1h" validate:"gt=0"`
+	}
+	// Registration contains all the settings for registering new accounts
+	Registration struct {
+		Policy             string        `yaml:"policy" env:"REGISTRATION_POLICY" default:"invite" validate:"oneof=closed invite open"`
+		InvitationDuration time.Duration `yaml:"invitation_duration" env:"INVITATION_DURATION" default:"72h" validate:"gt=0"`
+		InvitationURL      string        `yaml:"invitation_url" env:"INVITATION_URL" validate:"omitempty,url"`
+	}
+	// Metrics contains all the settings for exposing the metrics
+	Metrics struct {
+		Token string `yaml:"token" env:"METRICS_TOKEN" secret:"true"`
+	}
+	// Tracing contains all the settings for exporting traces
+	Tracing struct {
+		Exporter    string  `yaml:"exporter" env:"TRACING_EXPORTER" default:"none" validate:"oneof=none otlp"`
+		SampleRatio float64 `yaml:"sample_ratio" env:"TRACING_SAMPLE_RATIO" default:"1" validate:"gte=0,lte=1"`
+	}
+	// Loyalty contains all the settings for the loyalty points program
+	Loyalty struct {
+		EarnRate          float64            `yaml:"earn_rate" env:"LOYALTY_EARN_RATE" validate:"gte=0"`
+		CategoryEarnRates map[uint64]float64 `yaml:"category_earn_rates" env:"LOYALTY_CATEGORY_EARN_RATES" validate:"dive,gte=0"`
+		PointValue        float64            `yaml:"point_value" env:"LOYALTY_POINT_VALUE" validate:"gte=0"`
 	}
 )
 
-// New creates a new container instance
-func New() (*Container, error) {
+// New creates a new container instance from the defaults, the YAML file when the path is not empty and the environment.
+// The .env file is only read outside production and only when it exists.
+// All the problems of the configuration are reported at once in an *Error
+func New(file string) (*Container, error) {
 	if os.Getenv("APP_ENV") != "production" {
 		err := godotenv.Load()
-		if err != nil {
+		if err != nil && !errors.Is(err, fs.ErrNotExist) {
 			return nil, err
 		}
 	}
 
-	app := &App{
-		Name: os.Getenv("APP_NAME"),
-		Env:  os.Getenv("APP_ENV"),
+	container := &Container{
+		App:          &App{},
+		Token:        &Token{},
+		Redis:        &Redis{},
+		DB:           &DB{},
+		HTTP:         &HTTP{},
+		Loyalty:      &Loyalty{},
+		Login:        &Login{},
+		Mail:         &Mail{},
+		Reset:        &PasswordReset{},
+		Registration: &Registration{},
+		Metrics:      &Metrics{},
+		Tracing:      &Tracing{},
+	}
+
+	var problems []string
+
+	for _, s := range container.settings() {
+		if def := s.field.Tag.Get("default"); def != "" {
+			if err := parseValue(s.value, def); err != nil {
+				problems = append(problems, fmt.Sprintf("default of %s %s", s.env(), err))
+			}
+		}
+	}
+
+	if file != "" {
+		if err := readFile(file, container); err != nil {
+			return nil, err
+		}
 	}
 
-	token := &Token{
-		Duration: os.Getenv("TOKEN_DURATION"),
+	for _, s := range container.settings() {
+		value := os.Getenv(s.env())
+		if value == "" {
+			continue
+		}
+
+		if err := parseValue(s.value, value); err != nil {
+			problems = append(problems, fmt.Sprintf("%s %s", s.env(), err))
+		}
 	}
 
-	redis := &Redis{
-		Addr:     os.Getenv("REDIS_ADDR"),
-		Password: os.Getenv("REDIS_PASSWORD"),
+	container.HTTP.Env = container.App.Env
+
+	problems = append(problems, validate(container)...)
+	if len(problems) > 0 {
+		return nil, &Error{problems}
 	}
 
-	db := &DB{
-		Connection: os.Getenv("DB_CONNECTION"),
-		Host:       os.Getenv("DB_HOST"),
-		Port:       os.Getenv("DB_PORT"),
-		User:       os.Getenv("DB_USER"),
-		Password:   os.Getenv("DB_PASSWORD"),
-		Name:       os.Getenv("DB_NAME"),
+	return container, nil
+}
+
+// Error lists all the problems of the configuration
+type Error struct {
+	Problems []string
+}
+
+// Error returns the problems of the configuration, one per line
+func (e *Error) Error() string {
+	return "invalid configuration:\n  " + strings.Join(e.Problems, "\n  ")
+}
+
+// readFile reads the settings of the YAML file over the defaults, unknown keys are rejected to catch typos
+func readFile(file string, container *Container) error {
+	f, err := os.Open(file)
+	if err != nil {
+		return err
 	}
+	defer f.Close()
+
+	decoder := yaml.NewDecoder(f)
+	decoder.KnownFields(true)
 
-	http := &HTTP{
-		Env:            os.Getenv("APP_ENV"),
-		URL:            os.Getenv("HTTP_URL"),
-		Port:           os.Getenv("HTTP_PORT"),
-		AllowedOrigins: os.Getenv("HTTP_ALLOWED_ORIGINS"),
+	err = decoder.Decode(container)
+	if err != nil && !errors.Is(err, io.EOF) {
+		return fmt.Errorf("config file %s: %w", file, err)
 	}
 
-	return &Container{
-		app,
-		token,
-		redis,
-		db,
-		http,
-	}, nil
-}
\ No newline at end of file
+	return nil
+}
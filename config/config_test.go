@@ -0,0 +1,167 @@
+package config
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type newTestedInput struct {
+	file string
+	env  map[string]string
+}
+
+type newExpectedOutput struct {
+	tokenDuration      time.Duration
+	httpPort           int
+	allowedOrigins     []string
+	registrationPolicy string
+	problems           []string
+	err                bool
+}
+
+func TestNew(t *testing.T) {
+	dir := t.TempDir()
+
+	file := filepath.Join(dir, "config.yaml")
+	_ = os.WriteFile(file, []byte(`
+token:
+  duration: 30m
+  keys:
+    - dev:secret
+db:
+  user: postgres
+  name: gopos
+http:
+  port: 9090
+  allowed_origins:
+    - http://127.0.0.1:3000
+`), 0o600)
+
+	typoFile := filepath.Join(dir, "typo.yaml")
+	_ = os.WriteFile(typoFile, []byte("http:\n  prot: 9090\n"), 0o600)
+
+	testCases := []struct {
+		desc     string
+		input    newTestedInput
+		expected newExpectedOutput
+	}{
+		{
+			desc: "Success_DefaultsFileAndEnv",
+			input: newTestedInput{
+				file: file,
+				env: map[string]string{
+					"HTTP_PORT": "9091",
+				},
+			},
+			expected: newExpectedOutput{
+				tokenDuration:      30 * time.Minute,
+				httpPort:           9091,
+				allowedOrigins:     []string{"http://127.0.0.1:3000"},
+				registrationPolicy: "invite",
+			},
+		},
+		{
+			desc: "Success_EnvOnly",
+			input: newTestedInput{
+				env: map[string]string{
+					"TOKEN_KEYS":           "dev:secret",
+					"DB_USER":              "postgres",
+					"DB_NAME":              "gopos",
+					"HTTP_ALLOWED_ORIGINS": "http://127.0.0.1:3000, http://127.0.0.1:5173",
+				},
+			},
+			expected: newExpectedOutput{
+				tokenDuration:      15 * time.Minute,
+				httpPort:           8080,
+				allowedOrigins:     []string{"http://127.0.0.1:3000", "http://127.0.0.1:5173"},
+				registrationPolicy: "invite",
+			},
+		},
+		{
+			desc: "Fail_AllProblems",
+			input: newTestedInput{
+				env: map[string]string{
+					"TOKEN_DURATION":       "15",
+					"TOKEN_TYPE":           "opaque",
+					"HTTP_PORT":            "0",
+					"MAIL_DRIVER":          "smtp",
+					"TRACING_SAMPLE_RATIO": "2",
+				},
+			},
+			expected: newExpectedOutput{
+				problems: []string{
+					"TOKEN_DURATION must be a duration such as 30s or 15m",
+					"TOKEN_TYPE must be one of local, public, jwt",
+					"TOKEN_KEYS is required when TOKEN_KEY_FILE is not set",
+					"DB_USER is required",
+					"DB_NAME is required",
+					"HTTP_PORT must be at least 1",
+					"HTTP_ALLOWED_ORIGINS must list at least 1 value(s)",
+					"MAIL_FROM is required when MAIL_DRIVER is smtp",
+					"MAIL_SMTP_HOST is required when MAIL_DRIVER is smtp",
+					"TRACING_SAMPLE_RATIO must be at most 1",
+				},
+				err: true,
+			},
+		},
+		{
+			desc: "Fail_UnknownFileKey",
+			input: newTestedInput{
+				file: typoFile,
+			},
+			expected: newExpectedOutput{
+				err: true,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			for key, value := range tc.input.env {
+				t.Setenv(key, value)
+			}
+
+			cfg, err := New(tc.input.file)
+
+			var configErr *Error
+			if errors.As(err, &configErr) {
+				assert.Equal(t, tc.expected.problems, configErr.Problems, "Problems mismatch")
+			}
+			assert.Equal(t, tc.expected.err, err != nil, "Error mismatch")
+
+			if cfg != nil {
+				assert.Equal(t, tc.expected.tokenDuration, cfg.Token.Duration, "Token duration mismatch")
+				assert.Equal(t, tc.expected.httpPort, cfg.HTTP.Port, "HTTP port mismatch")
+				assert.Equal(t, tc.expected.allowedOrigins, cfg.HTTP.AllowedOrigins, "Allowed origins mismatch")
+				assert.Equal(t, tc.expected.registrationPolicy, cfg.Registration.Policy, "Registration policy mismatch")
+				assert.Equal(t, cfg.App.Env, cfg.HTTP.Env, "HTTP env mismatch")
+			}
+		})
+	}
+}
+
+func TestContainer_Print(t *testing.T) {
+	t.Setenv("TOKEN_KEYS", "dev:secret")
+	t.Setenv("DB_USER", "postgres")
+	t.Setenv("DB_NAME", "gopos")
+	t.Setenv("DB_PASSWORD", "hunter2")
+	t.Setenv("HTTP_ALLOWED_ORIGINS", "http://127.0.0.1:3000")
+
+	cfg, err := New("")
+	assert.NoError(t, err, "Error mismatch")
+
+	var out bytes.Buffer
+	assert.NoError(t, cfg.Print(&out), "Print mismatch")
+
+	assert.NotContains(t, out.String(), "dev:secret", "Token keys not redacted")
+	assert.NotContains(t, out.String(), "hunter2", "Database password not redacted")
+	assert.Contains(t, out.String(), redactedValue, "Redacted value missing")
+	assert.Equal(t, []string{"dev:secret"}, cfg.Token.Keys, "Container modified")
+	assert.Equal(t, "hunter2", cfg.DB.Password, "Container modified")
+}
@@ -0,0 +1,210 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+)
+
+// setting is a single setting of a section along with the field declaring it
+type setting struct {
+	value reflect.Value
+	field reflect.StructField
+}
+
+// env returns the environment variable overriding the setting
+func (s setting) env() string {
+	return s.field.Tag.Get("env")
+}
+
+// settings lists the settings of all sections in the order they are declared,
+// sections left empty by the YAML file are created again
+func (c *Container) settings() []setting {
+	var settings []setting
+
+	sections := reflect.ValueOf(c).Elem()
+	for i := range sections.NumField() {
+		section := sections.Field(i)
+		if section.IsNil() {
+			section.Set(reflect.New(section.Type().Elem()))
+		}
+
+		fields := section.Elem()
+		for j := range fields.NumField() {
+			field := fields.Type().Field(j)
+			if field.Tag.Get("env") == "" {
+				continue
+			}
+
+			settings = append(settings, setting{fields.Field(j), field})
+		}
+	}
+
+	return settings
+}
+
+// durationType is the type of the duration settings, they are parsed apart from the other int64 settings
+var durationType = reflect.TypeOf(time.Duration(0))
+
+// parseValue parses the text of a default or an environment variable into the setting.
+// Lists are separated by commas and the category rates are category_id:rate pairs
+func parseValue(value reflect.Value, text string) error {
+	if value.Type() == durationType {
+		d, err := time.ParseDuration(text)
+		if err != nil {
+			return errors.New("must be a duration such as 30s or 15m")
+		}
+		value.SetInt(int64(d))
+		return nil
+	}
+
+	switch value.Kind() {
+	case reflect.String:
+		value.SetString(text)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(text)
+		if err != nil {
+			return errors.New("must be true or false")
+		}
+		value.SetBool(b)
+	case reflect.Int, reflect.Int64:
+		i, err := strconv.ParseInt(text, 10, 64)
+		if err != nil {
+			return errors.New("must be an integer")
+		}
+		value.SetInt(i)
+	case reflect.Float64:
+		f, err := strconv.ParseFloat(text, 64)
+		if err != nil {
+			return errors.New("must be a number")
+		}
+		value.SetFloat(f)
+	case reflect.Slice:
+		value.Set(reflect.ValueOf(parseList(text)))
+	case reflect.Map:
+		rates, err := parseCategoryRates(text)
+		if err != nil {
+			return err
+		}
+		value.Set(reflect.ValueOf(rates))
+	default:
+		return fmt.Errorf("has an unsupported type %s", value.Type())
+	}
+
+	return nil
+}
+
+// parseList parses a comma separated list, leaving out empty entries
+func parseList(text string) []string {
+	var list []string
+	for _, entry := range strings.Split(text, ",") {
+		if entry = strings.TrimSpace(entry); entry != "" {
+			list = append(list, entry)
+		}
+	}
+
+	return list
+}
+
+// parseCategoryRates parses a comma separated list of category_id:rate pairs
+func parseCategoryRates(text string) (map[uint64]float64, error) {
+	rates := make(map[uint64]float64)
+
+	for _, pair := range parseList(text) {
+		id, rate, ok := strings.Cut(pair, ":")
+		if !ok {
+			return nil, errors.New("must be a list of category_id:rate pairs")
+		}
+
+		categoryID, err := strconv.ParseUint(id, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("has an invalid category id %q", id)
+		}
+
+		r, err := strconv.ParseFloat(rate, 64)
+		if err != nil {
+			return nil, fmt.Errorf("has an invalid rate %q", rate)
+		}
+
+		rates[categoryID] = r
+	}
+
+	return rates, nil
+}
+
+// validate checks the settings against their rules and describes every failure by the environment variable of the setting
+func validate(c *Container) []string {
+	v := validator.New(validator.WithRequiredStructEnabled())
+	v.RegisterTagNameFunc(func(field reflect.StructField) string {
+		return field.Tag.Get("env")
+	})
+
+	var validationErrs validator.ValidationErrors
+	if err := v.Struct(c); !errors.As(err, &validationErrs) {
+		return nil
+	}
+
+	problems := make([]string, 0, len(validationErrs))
+	for _, fieldErr := range validationErrs {
+		problems = append(problems, validationProblem(fieldErr))
+	}
+
+	return problems
+}
+
+// validationProblem describes the rule a setting failed
+func validationProblem(fieldErr validator.FieldError) string {
+	name := fieldErr.Field()
+	param := fieldErr.Param()
+
+	switch fieldErr.Tag() {
+	case "required":
+		return fmt.Sprintf("%s is required", name)
+	case "required_if":
+		field, value, _ := strings.Cut(param, " ")
+		return fmt.Sprintf("%s is required when %s is %s", name, siblingEnv(fieldErr, field), value)
+	case "required_without":
+		return fmt.Sprintf("%s is required when %s is not set", name, siblingEnv(fieldErr, param))
+	case "oneof":
+		return fmt.Sprintf("%s must be one of %s", name, strings.ReplaceAll(param, " ", ", "))
+	case "gt":
+		return fmt.Sprintf("%s must be greater than %s", name, param)
+	case "gte", "min":
+		if fieldErr.Kind() == reflect.Slice {
+			return fmt.Sprintf("%s must list at least %s value(s)", name, param)
+		}
+		return fmt.Sprintf("%s must be at least %s", name, param)
+	case "lte", "max":
+		return fmt.Sprintf("%s must be at most %s", name, param)
+	case "url":
+		return fmt.Sprintf("%s must be a URL", name)
+	default:
+		return fmt.Sprintf("%s is invalid", name)
+	}
+}
+
+// siblingEnv returns the environment variable of a setting of the same section a rule refers to by its field name
+func siblingEnv(fieldErr validator.FieldError, fieldName string) string {
+	// the struct namespace is made of the container, the section and the setting, such as Container.Mail.From
+	parts := strings.Split(fieldErr.StructNamespace(), ".")
+	if len(parts) < 3 {
+		return fieldName
+	}
+
+	section, ok := reflect.TypeOf(Container{}).FieldByName(parts[1])
+	if !ok {
+		return fieldName
+	}
+
+	field, ok := section.Type.Elem().FieldByName(fieldName)
+	if !ok {
+		return fieldName
+	}
+
+	return field.Tag.Get("env")
+}
@@ -0,0 +1,91 @@
+package config
+
+import (
+	"io"
+	"log/slog"
+	"reflect"
+
+	"gopkg.in/yaml.v3"
+)
+
+// redactedValue replaces the value of the secrets that are set
+const redactedValue = "[REDACTED]"
+
+// redacted returns a copy of the container where the secrets that are set are replaced,
+// the container itself is left untouched
+func (c *Container) redacted() *Container {
+	copied := &Container{}
+
+	sections := reflect.ValueOf(c).Elem()
+	copiedSections := reflect.ValueOf(copied).Elem()
+	for i := range sections.NumField() {
+		section := sections.Field(i)
+		if section.IsNil() {
+			continue
+		}
+
+		copiedSection := reflect.New(section.Type().Elem())
+		copiedSection.Elem().Set(section.Elem())
+
+		fields := copiedSection.Elem()
+		for j := range fields.NumField() {
+			value := fields.Field(j)
+			if fields.Type().Field(j).Tag.Get("secret") != "true" || value.IsZero() {
+				continue
+			}
+
+			switch value.Kind() {
+			case reflect.String:
+				value.SetString(redactedValue)
+			case reflect.Slice:
+				value.Set(reflect.ValueOf([]string{redactedValue}))
+			}
+		}
+
+		copiedSections.Field(i).Set(copiedSection)
+	}
+
+	return copied
+}
+
+// Print writes the configuration as YAML with the secrets redacted, it can be used as a config file once the secrets are filled in
+func (c *Container) Print(w io.Writer) error {
+	encoder := yaml.NewEncoder(w)
+	encoder.SetIndent(2)
+
+	if err := encoder.Encode(c.redacted()); err != nil {
+		return err
+	}
+
+	return encoder.Close()
+}
+
+// LogValue implements slog.LogValuer interface, the configuration is logged by section with the secrets redacted
+func (c *Container) LogValue() slog.Value {
+	var sections []slog.Attr
+
+	redacted := reflect.ValueOf(c.redacted()).Elem()
+	for i := range redacted.NumField() {
+		section := redacted.Field(i)
+		if section.IsNil() {
+			continue
+		}
+
+		var attrs []slog.Attr
+		fields := section.Elem()
+		for j := range fields.NumField() {
+			name := fields.Type().Field(j).Tag.Get("yaml")
+			if name == "-" {
+				continue
+			}
+			attrs = append(attrs, slog.Any(name, fields.Field(j).Interface()))
+		}
+
+		sections = append(sections, slog.Attr{
+			Key:   redacted.Type().Field(i).Tag.Get("yaml"),
+			Value: slog.GroupValue(attrs...),
+		})
+	}
+
+	return slog.GroupValue(sections...)
+}
@@ -23,14 +23,34 @@ const docTemplate = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
-        "/categories": {
+        "/.well-known/jwks.json": {
+            "get": {
+                "description": "Get the current and previous Ed25519 public keys as a JSON Web Key Set so other services can verify tokens without a shared secret. Tokens name their key in the kid footer or header. The set is empty when tokens are symmetrically encrypted.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Auth"
+                ],
+                "summary": "Get the token verification keys",
+                "responses": {
+                    "200": {
+                        "description": "Key set retrieved",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.KeySetResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api-keys": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "List categories with pagination",
+                "description": "List active, expired and revoked API keys with pagination",
                 "consumes": [
                     "application/json"
                 ],
@@ -38,12 +58,13 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Categories"
+                    "API Keys"
                 ],
-                "summary": "List categories",
+                "summary": "List API keys",
                 "parameters": [
                     {
                         "type": "integer",
+                        "format": "int64",
                         "description": "Skip",
                         "name": "skip",
                         "in": "query",
@@ -51,6 +72,7 @@ const docTemplate = `{
                     },
                     {
                         "type": "integer",
+                        "format": "int64",
                         "description": "Limit",
                         "name": "limit",
                         "in": "query",
@@ -59,7 +81,7 @@ const docTemplate = `{
                 ],
                 "responses": {
                     "200": {
-                        "description": "Categories displayed",
+                        "description": "API keys displayed",
                         "schema": {
                             "$ref": "#/definitions/modelv1.Meta"
                         }
@@ -70,6 +92,18 @@ const docTemplate = `{
                             "$ref": "#/definitions/modelv1.ErrorResponse"
                         }
                     },
+                    "401": {
+                        "description": "Unauthorized error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal server error",
                         "schema": {
@@ -84,7 +118,7 @@ const docTemplate = `{
                         "BearerAuth": []
                     }
                 ],
-                "description": "create a new category with name",
+                "description": "Create an API key for a machine client, scoped to permissions the creator holds. The key is sent in the X-API-Key header and is only shown once.",
                 "consumes": [
                     "application/json"
                 ],
@@ -92,25 +126,25 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Categories"
+                    "API Keys"
                 ],
-                "summary": "Create a new category",
+                "summary": "Create a new API key",
                 "parameters": [
                     {
-                        "description": "Create category request",
-                        "name": "createCategoryRequest",
+                        "description": "Create API key request",
+                        "name": "createAPIKeyRequest",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/modelv1.CreateCategoryRequest"
+                            "$ref": "#/definitions/modelv1.CreateAPIKeyRequest"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Category created",
+                        "description": "API key created",
                         "schema": {
-                            "$ref": "#/definitions/modelv1.CategoryResponse"
+                            "$ref": "#/definitions/modelv1.CreateAPIKeyResponse"
                         }
                     },
                     "400": {
@@ -131,18 +165,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/modelv1.ErrorResponse"
                         }
                     },
-                    "404": {
-                        "description": "Data not found error",
-                        "schema": {
-                            "$ref": "#/definitions/modelv1.ErrorResponse"
-                        }
-                    },
-                    "409": {
-                        "description": "Data conflict error",
-                        "schema": {
-                            "$ref": "#/definitions/modelv1.ErrorResponse"
-                        }
-                    },
                     "500": {
                         "description": "Internal server error",
                         "schema": {
@@ -152,14 +174,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/categories/{id}": {
-            "get": {
+        "/api-keys/{id}": {
+            "delete": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "get a category by id",
+                "description": "Revoke an API key so that requests sending it are rejected",
                 "consumes": [
                     "application/json"
                 ],
@@ -167,13 +189,14 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Categories"
+                    "API Keys"
                 ],
-                "summary": "Get a category",
+                "summary": "Revoke an API key",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Category ID",
+                        "format": "int64",
+                        "description": "API key ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -181,9 +204,9 @@ const docTemplate = `{
                 ],
                 "responses": {
                     "200": {
-                        "description": "Category retrieved",
+                        "description": "API key revoked",
                         "schema": {
-                            "$ref": "#/definitions/modelv1.CategoryResponse"
+                            "$ref": "#/definitions/modelv1.APIKeyResponse"
                         }
                     },
                     "400": {
@@ -192,12 +215,30 @@ const docTemplate = `{
                             "$ref": "#/definitions/modelv1.ErrorResponse"
                         }
                     },
+                    "401": {
+                        "description": "Unauthorized error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    },
                     "404": {
                         "description": "Data not found error",
                         "schema": {
                             "$ref": "#/definitions/modelv1.ErrorResponse"
                         }
                     },
+                    "409": {
+                        "description": "Data conflict error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal server error",
                         "schema": {
@@ -205,14 +246,16 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "put": {
+            }
+        },
+        "/audit-logs": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "update a category's name by id",
+                "description": "List the changes made through the API with who made them, newest first, filtered by actor, action, entity and time",
                 "consumes": [
                     "application/json"
                 ],
@@ -220,32 +263,99 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Categories"
+                    "Audit Logs"
                 ],
-                "summary": "Update a category",
+                "summary": "List audit logs",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Category ID",
-                        "name": "id",
-                        "in": "path",
+                        "format": "int64",
+                        "description": "Actor user ID",
+                        "name": "actor_id",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "create",
+                            "update",
+                            "delete",
+                            "restore",
+                            "revoke",
+                            "refund",
+                            "top_up",
+                            "unlock",
+                            "set_pin",
+                            "set_permissions",
+                            "change_password",
+                            "reset_password"
+                        ],
+                        "type": "string",
+                        "description": "Action",
+                        "name": "action",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "user",
+                            "invitation",
+                            "role",
+                            "terminal",
+                            "api_key",
+                            "payment",
+                            "category",
+                            "product",
+                            "customer",
+                            "order",
+                            "gift_card"
+                        ],
+                        "type": "string",
+                        "description": "Entity",
+                        "name": "entity",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "format": "int64",
+                        "description": "Entity ID",
+                        "name": "entity_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "format": "date-time",
+                        "description": "From time, inclusive",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "format": "date-time",
+                        "description": "To time, exclusive",
+                        "name": "to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "format": "int64",
+                        "description": "Skip",
+                        "name": "skip",
+                        "in": "query",
                         "required": true
                     },
                     {
-                        "description": "Update category request",
-                        "name": "updateCategoryRequest",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/modelv1.UpdateCategoryRequest"
-                        }
+                        "type": "integer",
+                        "format": "int64",
+                        "description": "Limit",
+                        "name": "limit",
+                        "in": "query",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Category updated",
+                        "description": "Audit logs displayed",
                         "schema": {
-                            "$ref": "#/definitions/modelv1.CategoryResponse"
+                            "$ref": "#/definitions/modelv1.Meta"
                         }
                     },
                     "400": {
@@ -266,18 +376,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/modelv1.ErrorResponse"
                         }
                     },
-                    "404": {
-                        "description": "Data not found error",
-                        "schema": {
-                            "$ref": "#/definitions/modelv1.ErrorResponse"
-                        }
-                    },
-                    "409": {
-                        "description": "Data conflict error",
-                        "schema": {
-                            "$ref": "#/definitions/modelv1.ErrorResponse"
-                        }
-                    },
                     "500": {
                         "description": "Internal server error",
                         "schema": {
@@ -285,14 +383,16 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
+            }
+        },
+        "/audit-logs/verify": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Delete a category by id",
+                "description": "Check the hash chain of the audit log to detect logs that have been changed or removed",
                 "consumes": [
                     "application/json"
                 ],
@@ -300,29 +400,14 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Categories"
-                ],
-                "summary": "Delete a category",
-                "parameters": [
-                    {
-                        "type": "integer",
-                        "description": "Category ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    }
+                    "Audit Logs"
                 ],
+                "summary": "Verify the audit log",
                 "responses": {
                     "200": {
-                        "description": "Category deleted",
+                        "description": "Audit log verified",
                         "schema": {
-                            "$ref": "#/definitions/modelv1.Response"
-                        }
-                    },
-                    "400": {
-                        "description": "Validation error",
-                        "schema": {
-                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                            "$ref": "#/definitions/modelv1.AuditVerificationResponse"
                         }
                     },
                     "401": {
@@ -337,12 +422,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/modelv1.ErrorResponse"
                         }
                     },
-                    "404": {
-                        "description": "Data not found error",
-                        "schema": {
-                            "$ref": "#/definitions/modelv1.ErrorResponse"
-                        }
-                    },
                     "500": {
                         "description": "Internal server error",
                         "schema": {
@@ -352,14 +431,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/orders": {
-            "get": {
+        "/auth/2fa/confirm": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "List orders and return an array of order data with purchase details",
+                "description": "Enables two-factor authentication of the logged in user with a TOTP code and returns recovery codes, which are only shown once",
                 "consumes": [
                     "application/json"
                 ],
@@ -367,30 +446,25 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Orders"
+                    "Auth"
                 ],
-                "summary": "List orders",
+                "summary": "Confirm two-factor enrollment",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "Skip records",
-                        "name": "skip",
-                        "in": "query",
-                        "required": true
-                    },
-                    {
-                        "type": "integer",
-                        "description": "Limit records",
-                        "name": "limit",
-                        "in": "query",
-                        "required": true
+                        "description": "Two-factor code request body",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.TwoFactorCodeRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Orders displayed",
+                        "description": "Two-factor authentication enabled",
                         "schema": {
-                            "$ref": "#/definitions/modelv1.Meta"
+                            "$ref": "#/definitions/modelv1.RecoveryCodesResponse"
                         }
                     },
                     "400": {
@@ -405,6 +479,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/modelv1.ErrorResponse"
                         }
                     },
+                    "409": {
+                        "description": "Data conflict error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal server error",
                         "schema": {
@@ -412,14 +492,16 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
+            }
+        },
+        "/auth/2fa/disable": {
             "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Create a new order and return the order data with purchase details",
+                "description": "Disables two-factor authentication of the logged in user with a TOTP code or an unused recovery code. Roles that require two-factor authentication cannot disable it.",
                 "consumes": [
                     "application/json"
                 ],
@@ -427,25 +509,25 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Orders"
+                    "Auth"
                 ],
-                "summary": "Create a new order",
+                "summary": "Disable two-factor authentication",
                 "parameters": [
                     {
-                        "description": "Create order request",
-                        "name": "createOrderRequest",
+                        "description": "Two-factor code request body",
+                        "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/modelv1.CreateOrderRequest"
+                            "$ref": "#/definitions/modelv1.TwoFactorCodeRequest"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Order created",
+                        "description": "Two-factor authentication disabled",
                         "schema": {
-                            "$ref": "#/definitions/modelv1.OrderResponse"
+                            "$ref": "#/definitions/modelv1.Response"
                         }
                     },
                     "400": {
@@ -454,8 +536,14 @@ const docTemplate = `{
                             "$ref": "#/definitions/modelv1.ErrorResponse"
                         }
                     },
-                    "404": {
-                        "description": "Data not found error",
+                    "401": {
+                        "description": "Unauthorized error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden error",
                         "schema": {
                             "$ref": "#/definitions/modelv1.ErrorResponse"
                         }
@@ -475,14 +563,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/orders/{id}": {
-            "get": {
+        "/auth/2fa/enroll": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Get an order by id and return the order data with purchase details",
+                "description": "Generates a TOTP secret for the logged in user and returns its provisioning uri to show as a QR code. Two-factor authentication is enabled once confirmed with a code.",
                 "consumes": [
                     "application/json"
                 ],
@@ -490,33 +578,24 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Orders"
-                ],
-                "summary": "Get an order",
-                "parameters": [
-                    {
-                        "type": "integer",
-                        "description": "Order ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    }
+                    "Auth"
                 ],
+                "summary": "Start two-factor enrollment",
                 "responses": {
                     "200": {
-                        "description": "Order displayed",
+                        "description": "Enrollment started",
                         "schema": {
-                            "$ref": "#/definitions/modelv1.OrderResponse"
+                            "$ref": "#/definitions/modelv1.TOTPEnrollmentResponse"
                         }
                     },
-                    "400": {
-                        "description": "Validation error",
+                    "401": {
+                        "description": "Unauthorized error",
                         "schema": {
                             "$ref": "#/definitions/modelv1.ErrorResponse"
                         }
                     },
-                    "404": {
-                        "description": "Data not found error",
+                    "409": {
+                        "description": "Data conflict error",
                         "schema": {
                             "$ref": "#/definitions/modelv1.ErrorResponse"
                         }
@@ -530,14 +609,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/payments": {
-            "get": {
-                "security": [
-                    {
-                        "BearerAuth": []
-                    }
-                ],
-                "description": "List payments with pagination",
+        "/auth/2fa/verify": {
+            "post": {
+                "description": "Completes the challenge of a password login with a TOTP code or an unused recovery code and returns an access token and a refresh token. Failed codes share the backoff and lockout of failed passwords.",
                 "consumes": [
                     "application/json"
                 ],
@@ -545,30 +619,25 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Payments"
+                    "Auth"
                 ],
-                "summary": "List payments",
+                "summary": "Complete a login with a second factor",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "Skip",
-                        "name": "skip",
-                        "in": "query",
-                        "required": true
-                    },
-                    {
-                        "type": "integer",
-                        "description": "Limit",
-                        "name": "limit",
-                        "in": "query",
-                        "required": true
+                        "description": "Verify two-factor request body",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.VerifyTwoFactorRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Payments displayed",
+                        "description": "Succesfully logged in",
                         "schema": {
-                            "$ref": "#/definitions/modelv1.Meta"
+                            "$ref": "#/definitions/modelv1.AuthResponse"
                         }
                     },
                     "400": {
@@ -577,6 +646,24 @@ const docTemplate = `{
                             "$ref": "#/definitions/modelv1.ErrorResponse"
                         }
                     },
+                    "401": {
+                        "description": "Unauthorized error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    },
+                    "423": {
+                        "description": "Account locked error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    },
+                    "429": {
+                        "description": "Too many attempts error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal server error",
                         "schema": {
@@ -584,14 +671,16 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
+            }
+        },
+        "/auth/logout": {
             "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "create a new payment with name, type, and logo",
+                "description": "Revokes the access token of the request and, when given, the refresh token along with every token rotated from the same login.",
                 "consumes": [
                     "application/json"
                 ],
@@ -599,25 +688,24 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Payments"
+                    "Auth"
                 ],
-                "summary": "Create a new payment",
+                "summary": "Logout",
                 "parameters": [
                     {
-                        "description": "Create payment request",
-                        "name": "createPaymentRequest",
+                        "description": "Logout request body",
+                        "name": "request",
                         "in": "body",
-                        "required": true,
                         "schema": {
-                            "$ref": "#/definitions/modelv1.CreatePaymentRequest"
+                            "$ref": "#/definitions/modelv1.LogoutRequest"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Payment created",
+                        "description": "Succesfully logged out",
                         "schema": {
-                            "$ref": "#/definitions/modelv1.PaymentResponse"
+                            "$ref": "#/definitions/modelv1.Response"
                         }
                     },
                     "400": {
@@ -632,26 +720,54 @@ const docTemplate = `{
                             "$ref": "#/definitions/modelv1.ErrorResponse"
                         }
                     },
-                    "403": {
-                        "description": "Forbidden error",
+                    "500": {
+                        "description": "Internal server error",
                         "schema": {
                             "$ref": "#/definitions/modelv1.ErrorResponse"
                         }
-                    },
-                    "404": {
-                        "description": "Data not found error",
+                    }
+                }
+            }
+        },
+        "/auth/password/forgot": {
+            "post": {
+                "description": "Mails a single-use password reset link to the user with the email. The response is the same whether or not the email belongs to a user, the mail is sent after it. Requests are throttled per email and client ip.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Auth"
+                ],
+                "summary": "Request a password reset",
+                "parameters": [
+                    {
+                        "description": "Forgot password request",
+                        "name": "forgotPasswordRequest",
+                        "in": "body",
+                        "required": true,
                         "schema": {
-                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                            "$ref": "#/definitions/modelv1.ForgotPasswordRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Reset link sent if the user exists",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.Response"
                         }
                     },
-                    "409": {
-                        "description": "Data conflict error",
+                    "400": {
+                        "description": "Validation error",
                         "schema": {
                             "$ref": "#/definitions/modelv1.ErrorResponse"
                         }
                     },
-                    "500": {
-                        "description": "Internal server error",
+                    "429": {
+                        "description": "Too many requests error",
                         "schema": {
                             "$ref": "#/definitions/modelv1.ErrorResponse"
                         }
@@ -659,14 +775,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/payments/{id}": {
-            "get": {
-                "security": [
-                    {
-                        "BearerAuth": []
-                    }
-                ],
-                "description": "get a payment by id",
+        "/auth/password/reset": {
+            "post": {
+                "description": "Sets a new password with the token from a password reset link and revokes the user's refresh tokens. A token can be used once.",
                 "consumes": [
                     "application/json"
                 ],
@@ -674,23 +785,25 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Payments"
+                    "Auth"
                 ],
-                "summary": "Get a payment",
+                "summary": "Reset a password",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "Payment ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "Reset password request",
+                        "name": "resetPasswordRequest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ResetPasswordRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Payment retrieved",
+                        "description": "Password reset",
                         "schema": {
-                            "$ref": "#/definitions/modelv1.PaymentResponse"
+                            "$ref": "#/definitions/modelv1.Response"
                         }
                     },
                     "400": {
@@ -699,8 +812,8 @@ const docTemplate = `{
                             "$ref": "#/definitions/modelv1.ErrorResponse"
                         }
                     },
-                    "404": {
-                        "description": "Data not found error",
+                    "401": {
+                        "description": "Invalid reset token error",
                         "schema": {
                             "$ref": "#/definitions/modelv1.ErrorResponse"
                         }
@@ -712,14 +825,11 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "put": {
-                "security": [
-                    {
-                        "BearerAuth": []
-                    }
-                ],
-                "description": "update a payment's name, type, or logo by id",
+            }
+        },
+        "/auth/pin": {
+            "post": {
+                "description": "Logs in a user by PIN on a registered terminal and returns a short-lived access token carrying the terminal id, without a refresh token. Failed PINs share the backoff and lockout of failed passwords.",
                 "consumes": [
                     "application/json"
                 ],
@@ -727,32 +837,25 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Payments"
+                    "Users"
                 ],
-                "summary": "Update a payment",
+                "summary": "Login by PIN on a terminal",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "Payment ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "Update payment request",
-                        "name": "updatePaymentRequest",
+                        "description": "PIN login request body",
+                        "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/modelv1.UpdatePaymentRequest"
+                            "$ref": "#/definitions/modelv1.PINLoginRequest"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Payment updated",
+                        "description": "Succesfully logged in",
                         "schema": {
-                            "$ref": "#/definitions/modelv1.PaymentResponse"
+                            "$ref": "#/definitions/modelv1.PINLoginResponse"
                         }
                     },
                     "400": {
@@ -767,20 +870,14 @@ const docTemplate = `{
                             "$ref": "#/definitions/modelv1.ErrorResponse"
                         }
                     },
-                    "403": {
-                        "description": "Forbidden error",
-                        "schema": {
-                            "$ref": "#/definitions/modelv1.ErrorResponse"
-                        }
-                    },
-                    "404": {
-                        "description": "Data not found error",
+                    "423": {
+                        "description": "Account locked error",
                         "schema": {
                             "$ref": "#/definitions/modelv1.ErrorResponse"
                         }
                     },
-                    "409": {
-                        "description": "Data conflict error",
+                    "429": {
+                        "description": "Too many attempts error",
                         "schema": {
                             "$ref": "#/definitions/modelv1.ErrorResponse"
                         }
@@ -792,14 +889,11 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
-                "security": [
-                    {
-                        "BearerAuth": []
-                    }
-                ],
-                "description": "Delete a payment by id",
+            }
+        },
+        "/auth/refresh": {
+            "post": {
+                "description": "Exchanges a refresh token for a new access token and refresh token. A refresh token can be used once, using it again revokes every token rotated from the same login.",
                 "consumes": [
                     "application/json"
                 ],
@@ -807,23 +901,25 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Payments"
+                    "Auth"
                 ],
-                "summary": "Delete a payment",
+                "summary": "Refresh the access token",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "Payment ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "Refresh request body",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.RefreshRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Payment deleted",
+                        "description": "Succesfully refreshed",
                         "schema": {
-                            "$ref": "#/definitions/modelv1.Response"
+                            "$ref": "#/definitions/modelv1.AuthResponse"
                         }
                     },
                     "400": {
@@ -838,18 +934,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/modelv1.ErrorResponse"
                         }
                     },
-                    "403": {
-                        "description": "Forbidden error",
-                        "schema": {
-                            "$ref": "#/definitions/modelv1.ErrorResponse"
-                        }
-                    },
-                    "404": {
-                        "description": "Data not found error",
-                        "schema": {
-                            "$ref": "#/definitions/modelv1.ErrorResponse"
-                        }
-                    },
                     "500": {
                         "description": "Internal server error",
                         "schema": {
@@ -859,14 +943,17 @@ const docTemplate = `{
                 }
             }
         },
-        "/products": {
+        "/categories": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
+                    },
+                    {
+                        "APIKeyAuth": []
                     }
                 ],
-                "description": "List products with pagination",
+                "description": "List categories with pagination",
                 "consumes": [
                     "application/json"
                 ],
@@ -874,24 +961,13 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Products"
+                    "Categories"
                 ],
-                "summary": "List products",
+                "summary": "List categories",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Category ID",
-                        "name": "category_id",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Query",
-                        "name": "q",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
+                        "format": "int64",
                         "description": "Skip",
                         "name": "skip",
                         "in": "query",
@@ -899,15 +975,22 @@ const docTemplate = `{
                     },
                     {
                         "type": "integer",
+                        "format": "int64",
                         "description": "Limit",
                         "name": "limit",
                         "in": "query",
                         "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Include archived records",
+                        "name": "include_archived",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Products retrieved",
+                        "description": "Categories displayed",
                         "schema": {
                             "$ref": "#/definitions/modelv1.Meta"
                         }
@@ -930,9 +1013,12 @@ const docTemplate = `{
                 "security": [
                     {
                         "BearerAuth": []
+                    },
+                    {
+                        "APIKeyAuth": []
                     }
                 ],
-                "description": "create a new product with name, image, price, and stock",
+                "description": "create a new category with name",
                 "consumes": [
                     "application/json"
                 ],
@@ -940,25 +1026,31 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Products"
+                    "Categories"
                 ],
-                "summary": "Create a new product",
+                "summary": "Create a new category",
                 "parameters": [
                     {
-                        "description": "Create product request",
-                        "name": "createProductRequest",
+                        "description": "Create category request",
+                        "name": "createCategoryRequest",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/modelv1.CreateProductRequest"
+                            "$ref": "#/definitions/modelv1.CreateCategoryRequest"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Product created",
+                        "description": "Category created",
                         "schema": {
-                            "$ref": "#/definitions/modelv1.ProductResponse"
+                            "$ref": "#/definitions/modelv1.CategoryResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Version of the category"
+                            }
                         }
                     },
                     "400": {
@@ -1000,14 +1092,17 @@ const docTemplate = `{
                 }
             }
         },
-        "/products/{id}": {
+        "/categories/{id}": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
+                    },
+                    {
+                        "APIKeyAuth": []
                     }
                 ],
-                "description": "get a product by id with its category",
+                "description": "get a category by id",
                 "consumes": [
                     "application/json"
                 ],
@@ -1015,13 +1110,14 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Products"
+                    "Categories"
                 ],
-                "summary": "Get a product",
+                "summary": "Get a category",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Product ID",
+                        "format": "int64",
+                        "description": "Category ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -1029,9 +1125,15 @@ const docTemplate = `{
                 ],
                 "responses": {
                     "200": {
-                        "description": "Product retrieved",
+                        "description": "Category retrieved",
                         "schema": {
-                            "$ref": "#/definitions/modelv1.ProductResponse"
+                            "$ref": "#/definitions/modelv1.CategoryResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Version of the category"
+                            }
                         }
                     },
                     "400": {
@@ -1058,9 +1160,12 @@ const docTemplate = `{
                 "security": [
                     {
                         "BearerAuth": []
+                    },
+                    {
+                        "APIKeyAuth": []
                     }
                 ],
-                "description": "update a product's name, image, price, or stock by id",
+                "description": "update a category's name by id",
                 "consumes": [
                     "application/json"
                 ],
@@ -1068,32 +1173,46 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Products"
+                    "Categories"
                 ],
-                "summary": "Update a product",
+                "summary": "Update a category",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Product ID",
+                        "format": "int64",
+                        "description": "Category ID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Update product request",
-                        "name": "updateProductRequest",
+                        "type": "string",
+                        "description": "ETag of the category version being modified",
+                        "name": "If-Match",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "Update category request",
+                        "name": "updateCategoryRequest",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/modelv1.UpdateProductRequest"
+                            "$ref": "#/definitions/modelv1.UpdateCategoryRequest"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Product updated",
+                        "description": "Category updated",
                         "schema": {
-                            "$ref": "#/definitions/modelv1.ProductResponse"
+                            "$ref": "#/definitions/modelv1.CategoryResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Version of the category"
+                            }
                         }
                     },
                     "400": {
@@ -1126,6 +1245,18 @@ const docTemplate = `{
                             "$ref": "#/definitions/modelv1.ErrorResponse"
                         }
                     },
+                    "412": {
+                        "description": "Version mismatch error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    },
+                    "428": {
+                        "description": "Version required error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal server error",
                         "schema": {
@@ -1138,9 +1269,12 @@ const docTemplate = `{
                 "security": [
                     {
                         "BearerAuth": []
+                    },
+                    {
+                        "APIKeyAuth": []
                     }
                 ],
-                "description": "Delete a product by id",
+                "description": "Archive a category by id, keeping it resolvable from past orders",
                 "consumes": [
                     "application/json"
                 ],
@@ -1148,21 +1282,29 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Products"
+                    "Categories"
                 ],
-                "summary": "Delete a product",
+                "summary": "Delete a category",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Product ID",
+                        "format": "int64",
+                        "description": "Category ID",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of the category version being modified",
+                        "name": "If-Match",
+                        "in": "header",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Product deleted",
+                        "description": "Category deleted",
                         "schema": {
                             "$ref": "#/definitions/modelv1.Response"
                         }
@@ -1191,6 +1333,18 @@ const docTemplate = `{
                             "$ref": "#/definitions/modelv1.ErrorResponse"
                         }
                     },
+                    "412": {
+                        "description": "Version mismatch error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    },
+                    "428": {
+                        "description": "Version required error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal server error",
                         "schema": {
@@ -1200,14 +1354,17 @@ const docTemplate = `{
                 }
             }
         },
-        "/users": {
-            "get": {
+        "/categories/{id}/restore": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
+                    },
+                    {
+                        "APIKeyAuth": []
                     }
                 ],
-                "description": "List users with pagination",
+                "description": "Restore an archived category by id",
                 "consumes": [
                     "application/json"
                 ],
@@ -1215,30 +1372,30 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Users"
+                    "Categories"
                 ],
-                "summary": "List users",
+                "summary": "Restore a category",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Skip",
-                        "name": "skip",
-                        "in": "query",
-                        "required": true
-                    },
-                    {
-                        "type": "integer",
-                        "description": "Limit",
-                        "name": "limit",
-                        "in": "query",
+                        "format": "int64",
+                        "description": "Category ID",
+                        "name": "id",
+                        "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Users displayed",
+                        "description": "Category restored",
                         "schema": {
-                            "$ref": "#/definitions/modelv1.Meta"
+                            "$ref": "#/definitions/modelv1.CategoryResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Version of the category"
+                            }
                         }
                     },
                     "400": {
@@ -1247,6 +1404,30 @@ const docTemplate = `{
                             "$ref": "#/definitions/modelv1.ErrorResponse"
                         }
                     },
+                    "401": {
+                        "description": "Unauthorized error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Data not found error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Data not archived error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal server error",
                         "schema": {
@@ -1254,9 +1435,16 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "post": {
-                "description": "create a new user account with default role \"cashier\"",
+            }
+        },
+        "/customers": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "List customers with pagination, searching by name, phone, or email",
                 "consumes": [
                     "application/json"
                 ],
@@ -1264,25 +1452,44 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Users"
+                    "Customers"
                 ],
-                "summary": "Register a new user",
+                "summary": "List customers",
                 "parameters": [
                     {
-                        "description": "Register request",
-                        "name": "registerRequest",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/modelv1.RegisterRequest"
-                        }
+                        "type": "string",
+                        "description": "Query",
+                        "name": "q",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "format": "int64",
+                        "description": "Skip",
+                        "name": "skip",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "format": "int64",
+                        "description": "Limit",
+                        "name": "limit",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Include archived records",
+                        "name": "include_archived",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "User created",
+                        "description": "Customers displayed",
                         "schema": {
-                            "$ref": "#/definitions/modelv1.UserResponse"
+                            "$ref": "#/definitions/modelv1.Meta"
                         }
                     },
                     "400": {
@@ -1297,18 +1504,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/modelv1.ErrorResponse"
                         }
                     },
-                    "404": {
-                        "description": "Data not found error",
-                        "schema": {
-                            "$ref": "#/definitions/modelv1.ErrorResponse"
-                        }
-                    },
-                    "409": {
-                        "description": "Data conflict error",
-                        "schema": {
-                            "$ref": "#/definitions/modelv1.ErrorResponse"
-                        }
-                    },
                     "500": {
                         "description": "Internal server error",
                         "schema": {
@@ -1316,11 +1511,14 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/users/login": {
+            },
             "post": {
-                "description": "Logs in a registered user and returns an access token if the credentials are valid.",
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "create a new customer with name, phone, email, and notes",
                 "consumes": [
                     "application/json"
                 ],
@@ -1328,25 +1526,31 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Users"
+                    "Customers"
                 ],
-                "summary": "Login and get an access token",
+                "summary": "Create a new customer",
                 "parameters": [
                     {
-                        "description": "Login request body",
-                        "name": "request",
+                        "description": "Create customer request",
+                        "name": "createCustomerRequest",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/modelv1.LoginRequest"
+                            "$ref": "#/definitions/modelv1.CreateCustomerRequest"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Succesfully logged in",
+                        "description": "Customer created",
                         "schema": {
-                            "$ref": "#/definitions/modelv1.AuthResponse"
+                            "$ref": "#/definitions/modelv1.CustomerResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Version of the customer"
+                            }
                         }
                     },
                     "400": {
@@ -1361,6 +1565,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/modelv1.ErrorResponse"
                         }
                     },
+                    "409": {
+                        "description": "Data conflict error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal server error",
                         "schema": {
@@ -1370,14 +1580,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/users/{id}": {
+        "/customers/{id}": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Get a user by id",
+                "description": "get a customer by id",
                 "consumes": [
                     "application/json"
                 ],
@@ -1385,13 +1595,14 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Users"
+                    "Customers"
                 ],
-                "summary": "Get a user",
+                "summary": "Get a customer",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "User ID",
+                        "format": "int64",
+                        "description": "Customer ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -1399,9 +1610,15 @@ const docTemplate = `{
                 ],
                 "responses": {
                     "200": {
-                        "description": "User displayed",
+                        "description": "Customer retrieved",
                         "schema": {
-                            "$ref": "#/definitions/modelv1.UserResponse"
+                            "$ref": "#/definitions/modelv1.CustomerResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Version of the customer"
+                            }
                         }
                     },
                     "400": {
@@ -1410,6 +1627,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/modelv1.ErrorResponse"
                         }
                     },
+                    "401": {
+                        "description": "Unauthorized error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    },
                     "404": {
                         "description": "Data not found error",
                         "schema": {
@@ -1430,7 +1653,7 @@ const docTemplate = `{
                         "BearerAuth": []
                     }
                 ],
-                "description": "Update a user's name, email, password, or role by id",
+                "description": "update a customer's name, phone, email, or notes by id",
                 "consumes": [
                     "application/json"
                 ],
@@ -1438,32 +1661,46 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Users"
+                    "Customers"
                 ],
-                "summary": "Update a user",
+                "summary": "Update a customer",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "User ID",
+                        "format": "int64",
+                        "description": "Customer ID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Update user request",
-                        "name": "updateUserRequest",
+                        "type": "string",
+                        "description": "ETag of the customer version being modified",
+                        "name": "If-Match",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "Update customer request",
+                        "name": "updateCustomerRequest",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/modelv1.UpdateUserRequest"
+                            "$ref": "#/definitions/modelv1.UpdateCustomerRequest"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "User updated",
+                        "description": "Customer updated",
                         "schema": {
-                            "$ref": "#/definitions/modelv1.UserResponse"
+                            "$ref": "#/definitions/modelv1.CustomerResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Version of the customer"
+                            }
                         }
                     },
                     "400": {
@@ -1478,14 +1715,26 @@ const docTemplate = `{
                             "$ref": "#/definitions/modelv1.ErrorResponse"
                         }
                     },
-                    "403": {
-                        "description": "Forbidden error",
+                    "404": {
+                        "description": "Data not found error",
                         "schema": {
                             "$ref": "#/definitions/modelv1.ErrorResponse"
                         }
                     },
-                    "404": {
-                        "description": "Data not found error",
+                    "409": {
+                        "description": "Data conflict error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    },
+                    "412": {
+                        "description": "Version mismatch error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    },
+                    "428": {
+                        "description": "Version required error",
                         "schema": {
                             "$ref": "#/definitions/modelv1.ErrorResponse"
                         }
@@ -1504,7 +1753,7 @@ const docTemplate = `{
                         "BearerAuth": []
                     }
                 ],
-                "description": "Delete a user by id",
+                "description": "Archive a customer by id, keeping it resolvable from past orders",
                 "consumes": [
                     "application/json"
                 ],
@@ -1512,21 +1761,29 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Users"
+                    "Customers"
                 ],
-                "summary": "Delete a user",
+                "summary": "Delete a customer",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "User ID",
+                        "format": "int64",
+                        "description": "Customer ID",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of the customer version being modified",
+                        "name": "If-Match",
+                        "in": "header",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "User deleted",
+                        "description": "Customer deleted",
                         "schema": {
                             "$ref": "#/definitions/modelv1.Response"
                         }
@@ -1555,6 +1812,18 @@ const docTemplate = `{
                             "$ref": "#/definitions/modelv1.ErrorResponse"
                         }
                     },
+                    "412": {
+                        "description": "Version mismatch error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    },
+                    "428": {
+                        "description": "Version required error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal server error",
                         "schema": {
@@ -1563,104 +1832,4550 @@ const docTemplate = `{
                     }
                 }
             }
-        }
-    },
-    "definitions": {
-        "domainpayment.PaymentType": {
-            "type": "string",
-            "enum": [
-                "CASH",
-                "E-WALLET",
-                "EDC"
-            ],
-            "x-enum-varnames": [
-                "Cash",
-                "EWallet",
-                "EDC"
-            ]
         },
-        "domainuser.UserRole": {
-            "type": "string",
-            "enum": [
-                "admin",
-                "cashier"
-            ],
+        "/customers/{id}/orders": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "List the orders of a customer with pagination, along with lifetime spend and visit count",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Customers"
+                ],
+                "summary": "List a customer's orders",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "format": "int64",
+                        "description": "Customer ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "format": "int64",
+                        "description": "Skip records",
+                        "name": "skip",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "format": "int64",
+                        "description": "Limit records",
+                        "name": "limit",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Customer orders displayed",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.CustomerOrdersResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Data not found error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/customers/{id}/points": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get the loyalty points balance of a customer and its value when redeemed",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Customers"
+                ],
+                "summary": "Get a customer's points balance",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "format": "int64",
+                        "description": "Customer ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Points balance retrieved",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.LoyaltyBalanceResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Data not found error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/customers/{id}/restore": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Restore an archived customer by id",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Customers"
+                ],
+                "summary": "Restore a customer",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "format": "int64",
+                        "description": "Customer ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Customer restored",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.CustomerResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Version of the customer"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Data not found error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Data not archived error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/gift-cards": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Sell a new gift card with an initial balance and an optional expiry through an order paid with a payment, the code is generated",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "GiftCards"
+                ],
+                "summary": "Sell a new gift card",
+                "parameters": [
+                    {
+                        "description": "Issue gift card request",
+                        "name": "issueGiftCardRequest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.IssueGiftCardRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Gift card sold",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.GiftCardSaleResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Data not found error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Data conflict error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/gift-cards/{code}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get a gift card by code to check its balance and expiry",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "GiftCards"
+                ],
+                "summary": "Get a gift card",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Gift card code",
+                        "name": "code",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Gift card retrieved",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.GiftCardResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Data not found error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/gift-cards/{code}/top-up": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Sell an amount added to the balance of an unexpired gift card through an order paid with a payment",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "GiftCards"
+                ],
+                "summary": "Top up a gift card",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Gift card code",
+                        "name": "code",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Top up gift card request",
+                        "name": "topUpGiftCardRequest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.TopUpGiftCardRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Gift card topped up",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.GiftCardSaleResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Data not found error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/healthz": {
+            "get": {
+                "description": "Answer as long as the process serves requests, without checking any dependency. A failing liveness probe means the process should be restarted.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Health"
+                ],
+                "summary": "Check the process is alive",
+                "responses": {
+                    "200": {
+                        "description": "Process is alive",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.LivenessResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/invitations": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "List pending, accepted and revoked invitations with pagination, newest first",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Invitations"
+                ],
+                "summary": "List invitations",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "format": "int64",
+                        "description": "Skip",
+                        "name": "skip",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "format": "int64",
+                        "description": "Limit",
+                        "name": "limit",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Invitations displayed",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.Meta"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Invite an email to register with a role. The invitation link is mailed to the email and its token is only shown once.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Invitations"
+                ],
+                "summary": "Invite a user",
+                "parameters": [
+                    {
+                        "description": "Create invitation request",
+                        "name": "createInvitationRequest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.CreateInvitationRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Invitation created",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.CreateInvitationResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Data conflict error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/invitations/{id}": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Revoke a pending invitation so that it can no longer be used to register",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Invitations"
+                ],
+                "summary": "Revoke an invitation",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "format": "int64",
+                        "description": "Invitation ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Invitation revoked",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.InvitationResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Data not found error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Data conflict error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/metrics": {
+            "get": {
+                "description": "Get the HTTP, database pool, cache and business metrics in the Prometheus text format. When a metrics token is configured, it must be sent as a bearer token.",
+                "produces": [
+                    "text/plain"
+                ],
+                "tags": [
+                    "Health"
+                ],
+                "summary": "Scrape the metrics",
+                "responses": {
+                    "200": {
+                        "description": "Metrics scraped",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/orders": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "List orders and return an array of order data with purchase details",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Orders"
+                ],
+                "summary": "List orders",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "format": "int64",
+                        "description": "Customer ID",
+                        "name": "customer_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "format": "int64",
+                        "description": "Skip records",
+                        "name": "skip",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "format": "int64",
+                        "description": "Limit records",
+                        "name": "limit",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Orders displayed",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.Meta"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Create a new order and return the order data with purchase details",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Orders"
+                ],
+                "summary": "Create a new order",
+                "parameters": [
+                    {
+                        "description": "Create order request",
+                        "name": "createOrderRequest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.CreateOrderRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Order created",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.OrderResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Data not found error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Data conflict error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/orders/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get an order by id and return the order data with purchase details",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Orders"
+                ],
+                "summary": "Get an order",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "format": "int64",
+                        "description": "Order ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Order displayed",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.OrderResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Data not found error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/orders/{id}/refund": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Refund an order by id, restocking its products and reversing the loyalty points it earned or redeemed",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Orders"
+                ],
+                "summary": "Refund an order",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "format": "int64",
+                        "description": "Order ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Order refunded",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.OrderResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Data not found error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Order already refunded error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/payments": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "List payments with pagination",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Payments"
+                ],
+                "summary": "List payments",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "format": "int64",
+                        "description": "Skip",
+                        "name": "skip",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "format": "int64",
+                        "description": "Limit",
+                        "name": "limit",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Include archived records",
+                        "name": "include_archived",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Payments displayed",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.Meta"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "create a new payment with name, type, and logo",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Payments"
+                ],
+                "summary": "Create a new payment",
+                "parameters": [
+                    {
+                        "description": "Create payment request",
+                        "name": "createPaymentRequest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.CreatePaymentRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Payment created",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.PaymentResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Version of the payment"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Data not found error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Data conflict error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/payments/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "get a payment by id",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Payments"
+                ],
+                "summary": "Get a payment",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Payment ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Payment retrieved",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.PaymentResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Version of the payment"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Data not found error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "update a payment's name, type, or logo by id",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Payments"
+                ],
+                "summary": "Update a payment",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Payment ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of the payment version being modified",
+                        "name": "If-Match",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "Update payment request",
+                        "name": "updatePaymentRequest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.UpdatePaymentRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Payment updated",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.PaymentResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Version of the payment"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Data not found error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Data conflict error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    },
+                    "412": {
+                        "description": "Version mismatch error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    },
+                    "428": {
+                        "description": "Version required error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Archive a payment by id, keeping it resolvable from past orders",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Payments"
+                ],
+                "summary": "Delete a payment",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "format": "int64",
+                        "description": "Payment ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of the payment version being modified",
+                        "name": "If-Match",
+                        "in": "header",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Payment deleted",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Data not found error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    },
+                    "412": {
+                        "description": "Version mismatch error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    },
+                    "428": {
+                        "description": "Version required error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/payments/{id}/restore": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Restore an archived payment by id",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Payments"
+                ],
+                "summary": "Restore a payment",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "format": "int64",
+                        "description": "Payment ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Payment restored",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.PaymentResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Version of the payment"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Data not found error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Data not archived error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/permissions": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "List the permissions that can be granted to roles",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Roles"
+                ],
+                "summary": "List permissions",
+                "responses": {
+                    "200": {
+                        "description": "Permissions displayed",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/modelv1.PermissionResponse"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/products": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "APIKeyAuth": []
+                    }
+                ],
+                "description": "List products with pagination",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Products"
+                ],
+                "summary": "List products",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "format": "int64",
+                        "description": "Category ID",
+                        "name": "category_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Query",
+                        "name": "q",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "format": "int64",
+                        "description": "Skip",
+                        "name": "skip",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "format": "int64",
+                        "description": "Limit",
+                        "name": "limit",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Include archived records",
+                        "name": "include_archived",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Products retrieved",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.Meta"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "APIKeyAuth": []
+                    }
+                ],
+                "description": "create a new product with name, image, price, and stock",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Products"
+                ],
+                "summary": "Create a new product",
+                "parameters": [
+                    {
+                        "description": "Create product request",
+                        "name": "createProductRequest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.CreateProductRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Product created",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ProductResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Version of the product"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Data not found error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Data conflict error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/products/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "APIKeyAuth": []
+                    }
+                ],
+                "description": "get a product by id with its category",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Products"
+                ],
+                "summary": "Get a product",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "format": "int64",
+                        "description": "Product ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Product retrieved",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ProductResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Version of the product"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Data not found error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "APIKeyAuth": []
+                    }
+                ],
+                "description": "update a product's name, image, price, or stock by id",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Products"
+                ],
+                "summary": "Update a product",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "format": "int64",
+                        "description": "Product ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of the product version being modified",
+                        "name": "If-Match",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "Update product request",
+                        "name": "updateProductRequest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.UpdateProductRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Product updated",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ProductResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Version of the product"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Data not found error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Data conflict error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    },
+                    "412": {
+                        "description": "Version mismatch error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    },
+                    "428": {
+                        "description": "Version required error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "APIKeyAuth": []
+                    }
+                ],
+                "description": "Archive a product by id, keeping it resolvable from past orders",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Products"
+                ],
+                "summary": "Delete a product",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "format": "int64",
+                        "description": "Product ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of the product version being modified",
+                        "name": "If-Match",
+                        "in": "header",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Product deleted",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Data not found error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    },
+                    "412": {
+                        "description": "Version mismatch error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    },
+                    "428": {
+                        "description": "Version required error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/products/{id}/restore": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "APIKeyAuth": []
+                    }
+                ],
+                "description": "Restore an archived product by id",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Products"
+                ],
+                "summary": "Restore a product",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "format": "int64",
+                        "description": "Product ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Product restored",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ProductResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Version of the product"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Data not found error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Data not archived error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/readyz": {
+            "get": {
+                "description": "Check the database connection, the database schema migration version and the cache connection, reporting the status and latency of each. The application is not ready while it drains requests during shutdown.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Health"
+                ],
+                "summary": "Check the application is ready to serve requests",
+                "responses": {
+                    "200": {
+                        "description": "Application is ready",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ReadinessResponse"
+                        }
+                    },
+                    "503": {
+                        "description": "Application is not ready",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ReadinessResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/roles": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "List the built-in and custom roles with their permissions",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Roles"
+                ],
+                "summary": "List roles",
+                "responses": {
+                    "200": {
+                        "description": "Roles displayed",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/modelv1.RoleResponse"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Create a new custom role granted the given permissions",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Roles"
+                ],
+                "summary": "Create a new role",
+                "parameters": [
+                    {
+                        "description": "Create role request",
+                        "name": "createRoleRequest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.CreateRoleRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Role created",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.RoleResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Data conflict error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/roles/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get a role with its permissions by id",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Roles"
+                ],
+                "summary": "Get a role",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "format": "int64",
+                        "description": "Role ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Role retrieved",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.RoleResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Data not found error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Delete a custom role that is no longer assigned to any user",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Roles"
+                ],
+                "summary": "Delete a role",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "format": "int64",
+                        "description": "Role ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Role deleted",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Data not found error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Data conflict error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/roles/{id}/permissions": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Replace the permissions granted to a role. Users of the role get the new permissions with their next access token. The admin role always keeps every permission.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Roles"
+                ],
+                "summary": "Set the permissions of a role",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "format": "int64",
+                        "description": "Role ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Set role permissions request",
+                        "name": "setRolePermissionsRequest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.SetRolePermissionsRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Role permissions set",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.RoleResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Data not found error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Data conflict error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/terminals": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "List registered and revoked terminals with pagination",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Terminals"
+                ],
+                "summary": "List terminals",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "format": "int64",
+                        "description": "Skip",
+                        "name": "skip",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "format": "int64",
+                        "description": "Limit",
+                        "name": "limit",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Terminals displayed",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.Meta"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Register a new POS terminal and get its device secret, which is only shown once",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Terminals"
+                ],
+                "summary": "Register a new terminal",
+                "parameters": [
+                    {
+                        "description": "Register terminal request",
+                        "name": "registerTerminalRequest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.RegisterTerminalRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Terminal registered",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.RegisterTerminalResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/terminals/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get a terminal by id",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Terminals"
+                ],
+                "summary": "Get a terminal",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "format": "int64",
+                        "description": "Terminal ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Terminal retrieved",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.TerminalResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Data not found error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Revoke a terminal so that no more PIN logins are accepted on it",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Terminals"
+                ],
+                "summary": "Revoke a terminal",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "format": "int64",
+                        "description": "Terminal ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Terminal revoked",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.TerminalResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Data not found error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Data conflict error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "List users with pagination",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "List users",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "format": "int64",
+                        "description": "Skip",
+                        "name": "skip",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "format": "int64",
+                        "description": "Limit",
+                        "name": "limit",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Include archived records",
+                        "name": "include_archived",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Users displayed",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.Meta"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "create a new user account as allowed by the registration policy, with role \"cashier\" or the role of the invitation",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "Register a new user",
+                "parameters": [
+                    {
+                        "description": "Register request",
+                        "name": "registerRequest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.RegisterRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "User created",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.UserResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Version of the user"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Registration closed or invalid invitation error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Data not found error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Data conflict error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/login": {
+            "post": {
+                "description": "Logs in a registered user and returns an access token and a refresh token if the credentials are valid. Users with two-factor authentication get a challenge to complete at /auth/2fa/verify instead. Failed logins back off exponentially per email and per client address, and lock the account after too many failures.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "Login and get an access token",
+                "parameters": [
+                    {
+                        "description": "Login request body",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.LoginRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Succesfully logged in",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.LoginResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    },
+                    "423": {
+                        "description": "Account locked error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    },
+                    "429": {
+                        "description": "Too many attempts error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/me": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get the profile of the logged in user",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "Get own profile",
+                "responses": {
+                    "200": {
+                        "description": "User displayed",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.UserResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Version of the user"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Data not found error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Update the name, email or message locale (en, vi or id) of the logged in user, the password is changed at /users/me/password. Changing the email requires the current password.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "Update own profile",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ETag of the user version being modified",
+                        "name": "If-Match",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "Update profile request",
+                        "name": "updateMeRequest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.UpdateMeRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "User updated",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.UserResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Version of the user"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Incorrect password error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Data not found error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Data conflict error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    },
+                    "412": {
+                        "description": "Version mismatch error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    },
+                    "428": {
+                        "description": "Version required error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/me/password": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Changes the password of the logged in user given the current one and revokes the user's refresh tokens",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "Change own password",
+                "parameters": [
+                    {
+                        "description": "Change password request",
+                        "name": "changePasswordRequest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ChangePasswordRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Password changed",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Incorrect password error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get a user by id",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "Get a user",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "format": "int64",
+                        "description": "User ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "User displayed",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.UserResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Version of the user"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Data not found error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Update a user's name, email, password, or role by id",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "Update a user",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "format": "int64",
+                        "description": "User ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of the user version being modified",
+                        "name": "If-Match",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "Update user request",
+                        "name": "updateUserRequest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.UpdateUserRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "User updated",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.UserResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Version of the user"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Data not found error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    },
+                    "412": {
+                        "description": "Version mismatch error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    },
+                    "428": {
+                        "description": "Version required error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Archive a user by id, keeping it resolvable from past orders",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "Delete a user",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "format": "int64",
+                        "description": "User ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of the user version being modified",
+                        "name": "If-Match",
+                        "in": "header",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "User deleted",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Data not found error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    },
+                    "412": {
+                        "description": "Version mismatch error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    },
+                    "428": {
+                        "description": "Version required error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/{id}/pin": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Sets or replaces the PIN a user logs in with on registered terminals",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "Set the PIN of a user",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "format": "int64",
+                        "description": "User ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Set PIN request",
+                        "name": "setPINRequest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.SetPINRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "PIN set",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Data not found error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/{id}/restore": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Restore an archived user by id",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "Restore a user",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "format": "int64",
+                        "description": "User ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "User restored",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.UserResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Version of the user"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Data not found error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Data not archived error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/{id}/unlock": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Clears the lockout and failed login attempts of a user locked out after too many failed logins",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "Unlock a user",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "format": "int64",
+                        "description": "User ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "User unlocked",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Data not found error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/modelv1.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "domainpayment.PaymentType": {
+            "type": "string",
+            "enum": [
+                "CASH",
+                "E-WALLET",
+                "EDC",
+                "GIFT_CARD"
+            ],
+            "x-enum-varnames": [
+                "Cash",
+                "EWallet",
+                "EDC",
+                "GiftCard"
+            ]
+        },
+        "domainuser.UserRole": {
+            "type": "string",
+            "enum": [
+                "admin",
+                "manager",
+                "cashier"
+            ],
             "x-enum-varnames": [
                 "Admin",
+                "Manager",
                 "Cashier"
             ]
         },
-        "modelv1.AuthResponse": {
+        "modelv1.APIKeyResponse": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string",
+                    "example": "1970-01-01T00:00:00Z"
+                },
+                "created_by": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "expires_at": {
+                    "type": "string",
+                    "example": "1970-01-01T00:00:00Z"
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "last_used_at": {
+                    "type": "string",
+                    "example": "1970-01-01T00:00:00Z"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Storefront sync"
+                },
+                "prefix": {
+                    "type": "string",
+                    "example": "pk_q0GDCb0"
+                },
+                "revoked_at": {
+                    "type": "string",
+                    "example": "1970-01-01T00:00:00Z"
+                },
+                "scopes": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "products:write"
+                    ]
+                }
+            }
+        },
+        "modelv1.AuditVerificationResponse": {
+            "type": "object",
+            "properties": {
+                "broken_at": {
+                    "type": "integer",
+                    "example": 0
+                },
+                "checked": {
+                    "type": "integer",
+                    "example": 42
+                },
+                "valid": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "modelv1.AuthResponse": {
+            "type": "object",
+            "properties": {
+                "refresh_token": {
+                    "type": "string",
+                    "example": "q0GDCb0wXJk5oK3Iu2p0n0v4Y0s8JqJ7Q6Xv3K2ZJ5A"
+                },
+                "token": {
+                    "type": "string",
+                    "example": "v4.local.Gdh5kiOTyyaQ3_bNykYDeYHO21Jg2..."
+                }
+            }
+        },
+        "modelv1.CategoryResponse": {
+            "type": "object",
+            "properties": {
+                "deleted_at": {
+                    "type": "string",
+                    "example": "1970-01-01T00:00:00Z"
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Foods"
+                },
+                "version": {
+                    "type": "integer",
+                    "example": 1
+                }
+            }
+        },
+        "modelv1.ChangePasswordRequest": {
+            "type": "object",
+            "required": [
+                "current_password",
+                "new_password"
+            ],
+            "properties": {
+                "current_password": {
+                    "type": "string",
+                    "example": "12345678"
+                },
+                "new_password": {
+                    "type": "string",
+                    "minLength": 8,
+                    "example": "87654321"
+                }
+            }
+        },
+        "modelv1.ComponentResponse": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string",
+                    "example": "check timed out"
+                },
+                "latency_ms": {
+                    "type": "number",
+                    "example": 1.25
+                },
+                "name": {
+                    "type": "string",
+                    "example": "database"
+                },
+                "status": {
+                    "type": "string",
+                    "example": "up"
+                }
+            }
+        },
+        "modelv1.CreateAPIKeyRequest": {
+            "type": "object",
+            "required": [
+                "name",
+                "scopes"
+            ],
+            "properties": {
+                "expires_at": {
+                    "type": "string",
+                    "example": "2030-01-01T00:00:00Z"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Storefront sync"
+                },
+                "scopes": {
+                    "type": "array",
+                    "minItems": 1,
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "products:write"
+                    ]
+                }
+            }
+        },
+        "modelv1.CreateAPIKeyResponse": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string",
+                    "example": "1970-01-01T00:00:00Z"
+                },
+                "created_by": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "expires_at": {
+                    "type": "string",
+                    "example": "1970-01-01T00:00:00Z"
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "key": {
+                    "type": "string",
+                    "example": "pk_q0GDCb0wXJk5oK3Iu2p0n0v4Y0s8JqJ7Q6Xv3K2ZJ5A"
+                },
+                "last_used_at": {
+                    "type": "string",
+                    "example": "1970-01-01T00:00:00Z"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Storefront sync"
+                },
+                "prefix": {
+                    "type": "string",
+                    "example": "pk_q0GDCb0"
+                },
+                "revoked_at": {
+                    "type": "string",
+                    "example": "1970-01-01T00:00:00Z"
+                },
+                "scopes": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "products:write"
+                    ]
+                }
+            }
+        },
+        "modelv1.CreateCategoryRequest": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "name": {
+                    "type": "string",
+                    "example": "Foods"
+                }
+            }
+        },
+        "modelv1.CreateCustomerRequest": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "email": {
+                    "type": "string",
+                    "example": "john@example.com"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "John Doe"
+                },
+                "notes": {
+                    "type": "string",
+                    "example": "Prefers paper receipts"
+                },
+                "phone": {
+                    "type": "string",
+                    "example": "+6281234567890"
+                }
+            }
+        },
+        "modelv1.CreateInvitationRequest": {
+            "type": "object",
+            "required": [
+                "email",
+                "role"
+            ],
+            "properties": {
+                "email": {
+                    "type": "string",
+                    "example": "test@example.com"
+                },
+                "role": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/domainuser.UserRole"
+                        }
+                    ],
+                    "example": "cashier"
+                }
+            }
+        },
+        "modelv1.CreateInvitationResponse": {
+            "type": "object",
+            "properties": {
+                "accepted_at": {
+                    "type": "string",
+                    "example": "1970-01-01T00:00:00Z"
+                },
+                "created_at": {
+                    "type": "string",
+                    "example": "1970-01-01T00:00:00Z"
+                },
+                "email": {
+                    "type": "string",
+                    "example": "test@example.com"
+                },
+                "expires_at": {
+                    "type": "string",
+                    "example": "1970-01-01T00:00:00Z"
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "invited_by": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "revoked_at": {
+                    "type": "string",
+                    "example": "1970-01-01T00:00:00Z"
+                },
+                "role": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/domainuser.UserRole"
+                        }
+                    ],
+                    "example": "cashier"
+                },
+                "token": {
+                    "type": "string",
+                    "example": "q0GDCb0wXJk5oK3Iu2p0n0v4Y0s8JqJ7Q6Xv3K2ZJ5A"
+                }
+            }
+        },
+        "modelv1.CreateOrderRequest": {
+            "type": "object",
+            "required": [
+                "payment_id",
+                "products"
+            ],
+            "properties": {
+                "customer_id": {
+                    "type": "integer",
+                    "minimum": 1,
+                    "example": 1
+                },
+                "customer_name": {
+                    "type": "string",
+                    "example": "John Doe"
+                },
+                "gift_card_code": {
+                    "type": "string",
+                    "example": "ABCD-EFGH-JKLM-NPQR"
+                },
+                "payment_id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "products": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/modelv1.OrderProductRequest"
+                    }
+                },
+                "redeem_points": {
+                    "type": "integer",
+                    "minimum": 1,
+                    "example": 0
+                },
+                "total_paid": {
+                    "type": "integer",
+                    "minimum": 0,
+                    "example": 100000
+                }
+            }
+        },
+        "modelv1.CreatePaymentRequest": {
+            "type": "object",
+            "required": [
+                "logo",
+                "name",
+                "type"
+            ],
+            "properties": {
+                "logo": {
+                    "type": "string",
+                    "example": "https://example.com/cash.png"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Tunai"
+                },
+                "type": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/domainpayment.PaymentType"
+                        }
+                    ],
+                    "example": "CASH"
+                }
+            }
+        },
+        "modelv1.CreateProductRequest": {
+            "type": "object",
+            "required": [
+                "category_id",
+                "image",
+                "name",
+                "price",
+                "stock"
+            ],
+            "properties": {
+                "category_id": {
+                    "type": "integer",
+                    "minimum": 1,
+                    "example": 1
+                },
+                "image": {
+                    "type": "string",
+                    "example": "https://example.com/chiki-ball.png"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Chiki Ball"
+                },
+                "price": {
+                    "type": "number",
+                    "minimum": 0,
+                    "example": 5000
+                },
+                "stock": {
+                    "type": "integer",
+                    "minimum": 0,
+                    "example": 100
+                }
+            }
+        },
+        "modelv1.CreateRoleRequest": {
+            "type": "object",
+            "required": [
+                "name",
+                "permissions"
+            ],
+            "properties": {
+                "description": {
+                    "type": "string",
+                    "example": "Runs the floor during a shift"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "supervisor"
+                },
+                "permissions": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "orders:refund",
+                        "reports:read"
+                    ]
+                }
+            }
+        },
+        "modelv1.CustomerOrdersResponse": {
+            "type": "object",
+            "properties": {
+                "meta": {
+                    "$ref": "#/definitions/modelv1.Meta"
+                },
+                "orders": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/modelv1.OrderResponse"
+                    }
+                },
+                "summary": {
+                    "$ref": "#/definitions/modelv1.PurchaseSummaryResponse"
+                }
+            }
+        },
+        "modelv1.CustomerResponse": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string",
+                    "example": "1970-01-01T00:00:00Z"
+                },
+                "deleted_at": {
+                    "type": "string",
+                    "example": "1970-01-01T00:00:00Z"
+                },
+                "email": {
+                    "type": "string",
+                    "example": "john@example.com"
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "name": {
+                    "type": "string",
+                    "example": "John Doe"
+                },
+                "notes": {
+                    "type": "string",
+                    "example": "Prefers paper receipts"
+                },
+                "phone": {
+                    "type": "string",
+                    "example": "+6281234567890"
+                },
+                "updated_at": {
+                    "type": "string",
+                    "example": "1970-01-01T00:00:00Z"
+                },
+                "version": {
+                    "type": "integer",
+                    "example": 1
+                }
+            }
+        },
+        "modelv1.ErrorResponse": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "string",
+                    "example": "data_not_found"
+                },
+                "messages": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "Error message 1",
+                        " Error message 2"
+                    ]
+                },
+                "request_id": {
+                    "type": "string",
+                    "example": "5f1b7c1e-6d0a-4b5e-9a5e-0c8f1d2e3a4b"
+                },
+                "success": {
+                    "type": "boolean",
+                    "example": false
+                }
+            }
+        },
+        "modelv1.ForgotPasswordRequest": {
+            "type": "object",
+            "required": [
+                "email"
+            ],
+            "properties": {
+                "email": {
+                    "type": "string",
+                    "example": "test@example.com"
+                }
+            }
+        },
+        "modelv1.GiftCardResponse": {
+            "type": "object",
+            "properties": {
+                "balance": {
+                    "type": "number",
+                    "example": 125000
+                },
+                "code": {
+                    "type": "string",
+                    "example": "ABCD-EFGH-JKLM-NPQR"
+                },
+                "created_at": {
+                    "type": "string",
+                    "example": "1970-01-01T00:00:00Z"
+                },
+                "expires_at": {
+                    "type": "string",
+                    "example": "1970-01-01T00:00:00Z"
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "initial_balance": {
+                    "type": "number",
+                    "example": 500000
+                },
+                "updated_at": {
+                    "type": "string",
+                    "example": "1970-01-01T00:00:00Z"
+                }
+            }
+        },
+        "modelv1.GiftCardSaleResponse": {
+            "type": "object",
+            "properties": {
+                "gift_card": {
+                    "$ref": "#/definitions/modelv1.GiftCardResponse"
+                },
+                "order_id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "receipt_id": {
+                    "type": "string",
+                    "example": "4979cf6e-d215-4ff8-9d0d-b3e99bcc7750"
+                },
+                "total_paid": {
+                    "type": "number",
+                    "example": 500000
+                },
+                "total_price": {
+                    "type": "number",
+                    "example": 500000
+                },
+                "total_return": {
+                    "type": "number",
+                    "example": 0
+                }
+            }
+        },
+        "modelv1.InvitationResponse": {
+            "type": "object",
+            "properties": {
+                "accepted_at": {
+                    "type": "string",
+                    "example": "1970-01-01T00:00:00Z"
+                },
+                "created_at": {
+                    "type": "string",
+                    "example": "1970-01-01T00:00:00Z"
+                },
+                "email": {
+                    "type": "string",
+                    "example": "test@example.com"
+                },
+                "expires_at": {
+                    "type": "string",
+                    "example": "1970-01-01T00:00:00Z"
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "invited_by": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "revoked_at": {
+                    "type": "string",
+                    "example": "1970-01-01T00:00:00Z"
+                },
+                "role": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/domainuser.UserRole"
+                        }
+                    ],
+                    "example": "cashier"
+                }
+            }
+        },
+        "modelv1.IssueGiftCardRequest": {
+            "type": "object",
+            "required": [
+                "initial_balance",
+                "payment_id",
+                "total_paid"
+            ],
+            "properties": {
+                "customer_id": {
+                    "type": "integer",
+                    "minimum": 1,
+                    "example": 1
+                },
+                "customer_name": {
+                    "type": "string",
+                    "example": "John Doe"
+                },
+                "expires_at": {
+                    "type": "string",
+                    "example": "2030-01-01T00:00:00Z"
+                },
+                "initial_balance": {
+                    "type": "number",
+                    "example": 500000
+                },
+                "payment_id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "total_paid": {
+                    "type": "number",
+                    "example": 500000
+                }
+            }
+        },
+        "modelv1.KeyResponse": {
             "type": "object",
             "properties": {
+                "alg": {
+                    "type": "string",
+                    "example": "v4.public"
+                },
+                "crv": {
+                    "type": "string",
+                    "example": "Ed25519"
+                },
+                "kid": {
+                    "type": "string",
+                    "example": "2024-01"
+                },
+                "kty": {
+                    "type": "string",
+                    "example": "OKP"
+                },
+                "use": {
+                    "type": "string",
+                    "example": "sig"
+                },
+                "x": {
+                    "type": "string",
+                    "example": "11qYAYKxCrfVS_7TyWQHOg7hcvPapiMlrwIaaPcHURo"
+                }
+            }
+        },
+        "modelv1.KeySetResponse": {
+            "type": "object",
+            "properties": {
+                "keys": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/modelv1.KeyResponse"
+                    }
+                }
+            }
+        },
+        "modelv1.LivenessResponse": {
+            "type": "object",
+            "properties": {
+                "status": {
+                    "type": "string",
+                    "example": "up"
+                }
+            }
+        },
+        "modelv1.LoginRequest": {
+            "type": "object",
+            "required": [
+                "email",
+                "password"
+            ],
+            "properties": {
+                "email": {
+                    "type": "string",
+                    "example": "test@example.com"
+                },
+                "password": {
+                    "type": "string",
+                    "minLength": 8,
+                    "example": "12345678"
+                }
+            }
+        },
+        "modelv1.LoginResponse": {
+            "type": "object",
+            "properties": {
+                "challenge": {
+                    "type": "string",
+                    "example": "x7Jm2c0k5dI9q3rV1sT8uW6yZ4aB0eF2gH5jK7lN9oP"
+                },
+                "refresh_token": {
+                    "type": "string",
+                    "example": "q0GDCb0wXJk5oK3Iu2p0n0v4Y0s8JqJ7Q6Xv3K2ZJ5A"
+                },
                 "token": {
                     "type": "string",
-                    "example": "v2.local.Gdh5kiOTyyaQ3_bNykYDeYHO21Jg2..."
+                    "example": "v4.local.Gdh5kiOTyyaQ3_bNykYDeYHO21Jg2..."
+                },
+                "two_factor_required": {
+                    "type": "boolean",
+                    "example": false
                 }
             }
         },
-        "modelv1.CategoryResponse": {
+        "modelv1.LogoutRequest": {
+            "type": "object",
+            "properties": {
+                "refresh_token": {
+                    "type": "string",
+                    "example": "q0GDCb0wXJk5oK3Iu2p0n0v4Y0s8JqJ7Q6Xv3K2ZJ5A"
+                }
+            }
+        },
+        "modelv1.LoyaltyBalanceResponse": {
+            "type": "object",
+            "properties": {
+                "customer_id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "points": {
+                    "type": "integer",
+                    "example": 250
+                },
+                "value": {
+                    "type": "number",
+                    "example": 2500
+                }
+            }
+        },
+        "modelv1.Meta": {
+            "type": "object",
+            "properties": {
+                "limit": {
+                    "type": "integer",
+                    "example": 10
+                },
+                "skip": {
+                    "type": "integer",
+                    "example": 0
+                },
+                "total": {
+                    "type": "integer",
+                    "example": 100
+                }
+            }
+        },
+        "modelv1.OrderProductRequest": {
+            "type": "object",
+            "required": [
+                "product_id",
+                "qty"
+            ],
+            "properties": {
+                "product_id": {
+                    "type": "integer",
+                    "minimum": 1,
+                    "example": 1
+                },
+                "qty": {
+                    "type": "integer",
+                    "example": 1
+                }
+            }
+        },
+        "modelv1.OrderProductResponse": {
             "type": "object",
             "properties": {
+                "created_at": {
+                    "type": "string",
+                    "example": "1970-01-01T00:00:00Z"
+                },
                 "id": {
                     "type": "integer",
                     "example": 1
                 },
-                "name": {
+                "order_id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "price": {
+                    "type": "number",
+                    "example": 100000
+                },
+                "product": {
+                    "$ref": "#/definitions/modelv1.ProductResponse"
+                },
+                "product_id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "qty": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "total_final_price": {
+                    "type": "number",
+                    "example": 100000
+                },
+                "total_normal_price": {
+                    "type": "number",
+                    "example": 100000
+                },
+                "updated_at": {
                     "type": "string",
-                    "example": "Foods"
+                    "example": "1970-01-01T00:00:00Z"
                 }
             }
         },
-        "modelv1.CreateCategoryRequest": {
+        "modelv1.OrderResponse": {
             "type": "object",
-            "required": [
-                "name"
-            ],
             "properties": {
-                "name": {
+                "created_at": {
                     "type": "string",
-                    "example": "Foods"
-                }
-            }
-        },
-        "modelv1.CreateOrderRequest": {
-            "type": "object",
-            "required": [
-                "customer_name",
-                "payment_id",
-                "products",
-                "total_paid"
-            ],
-            "properties": {
+                    "example": "1970-01-01T00:00:00Z"
+                },
+                "customer_id": {
+                    "type": "integer",
+                    "example": 1
+                },
                 "customer_name": {
                     "type": "string",
                     "example": "John Doe"
                 },
-                "payment_id": {
+                "gift_card_amount": {
+                    "type": "number",
+                    "example": 0
+                },
+                "gift_card_id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "payment_type": {
+                    "$ref": "#/definitions/modelv1.PaymentResponse"
+                },
+                "payment_type_id": {
                     "type": "integer",
                     "example": 1
                 },
+                "points_earned": {
+                    "type": "integer",
+                    "example": 100
+                },
+                "points_redeemed": {
+                    "type": "integer",
+                    "example": 0
+                },
                 "products": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/modelv1.OrderProductRequest"
+                        "$ref": "#/definitions/modelv1.OrderProductResponse"
                     }
                 },
-                "total_paid": {
+                "receipt_id": {
+                    "type": "string",
+                    "example": "4979cf6e-d215-4ff8-9d0d-b3e99bcc7750"
+                },
+                "refunded_at": {
+                    "type": "string",
+                    "example": "1970-01-01T00:00:00Z"
+                },
+                "sold_gift_card_id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "terminal_id": {
                     "type": "integer",
+                    "example": 1
+                },
+                "total_paid": {
+                    "type": "number",
+                    "example": 100000
+                },
+                "total_price": {
+                    "type": "number",
                     "example": 100000
+                },
+                "total_return": {
+                    "type": "number",
+                    "example": 0
+                },
+                "updated_at": {
+                    "type": "string",
+                    "example": "1970-01-01T00:00:00Z"
+                },
+                "user_id": {
+                    "type": "integer",
+                    "example": 1
                 }
             }
         },
-        "modelv1.CreatePaymentRequest": {
+        "modelv1.PINLoginRequest": {
             "type": "object",
             "required": [
-                "logo",
-                "name",
-                "type"
+                "pin",
+                "terminal_id",
+                "terminal_secret",
+                "user_id"
             ],
             "properties": {
+                "pin": {
+                    "type": "string",
+                    "maxLength": 8,
+                    "minLength": 4,
+                    "example": "1234"
+                },
+                "terminal_id": {
+                    "type": "integer",
+                    "minimum": 1,
+                    "example": 1
+                },
+                "terminal_secret": {
+                    "type": "string",
+                    "example": "q0GDCb0wXJk5oK3Iu2p0n0v4Y0s8JqJ7Q6Xv3K2ZJ5A"
+                },
+                "user_id": {
+                    "type": "integer",
+                    "minimum": 1,
+                    "example": 1
+                }
+            }
+        },
+        "modelv1.PINLoginResponse": {
+            "type": "object",
+            "properties": {
+                "token": {
+                    "type": "string",
+                    "example": "v4.local.Gdh5kiOTyyaQ3_bNykYDeYHO21Jg2..."
+                }
+            }
+        },
+        "modelv1.PaymentResponse": {
+            "type": "object",
+            "properties": {
+                "deleted_at": {
+                    "type": "string",
+                    "example": "1970-01-01T00:00:00Z"
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 1
+                },
                 "logo": {
                     "type": "string",
                     "example": "https://example.com/cash.png"
@@ -1676,22 +6391,46 @@ const docTemplate = `{
                         }
                     ],
                     "example": "CASH"
+                },
+                "version": {
+                    "type": "integer",
+                    "example": 1
                 }
             }
         },
-        "modelv1.CreateProductRequest": {
+        "modelv1.PermissionResponse": {
             "type": "object",
-            "required": [
-                "category_id",
-                "image",
-                "name",
-                "price",
-                "stock"
-            ],
             "properties": {
-                "category_id": {
+                "description": {
+                    "type": "string",
+                    "example": "Refund orders"
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "name": {
+                    "type": "string",
+                    "example": "orders:refund"
+                }
+            }
+        },
+        "modelv1.ProductResponse": {
+            "type": "object",
+            "properties": {
+                "category": {
+                    "$ref": "#/definitions/modelv1.CategoryResponse"
+                },
+                "created_at": {
+                    "type": "string",
+                    "example": "1970-01-01T00:00:00Z"
+                },
+                "deleted_at": {
+                    "type": "string",
+                    "example": "1970-01-01T00:00:00Z"
+                },
+                "id": {
                     "type": "integer",
-                    "minimum": 1,
                     "example": 1
                 },
                 "image": {
@@ -1704,39 +6443,102 @@ const docTemplate = `{
                 },
                 "price": {
                     "type": "number",
-                    "minimum": 0,
                     "example": 5000
                 },
+                "sku": {
+                    "type": "string",
+                    "example": "9a4c25d3-9786-492c-b084-85cb75c1ee3e"
+                },
                 "stock": {
                     "type": "integer",
-                    "minimum": 0,
                     "example": 100
+                },
+                "updated_at": {
+                    "type": "string",
+                    "example": "1970-01-01T00:00:00Z"
+                },
+                "version": {
+                    "type": "integer",
+                    "example": 1
                 }
             }
         },
-        "modelv1.ErrorResponse": {
+        "modelv1.PurchaseSummaryResponse": {
             "type": "object",
             "properties": {
-                "messages": {
+                "customer_id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "first_visit_at": {
+                    "type": "string",
+                    "example": "1970-01-01T00:00:00Z"
+                },
+                "last_visit_at": {
+                    "type": "string",
+                    "example": "1970-01-01T00:00:00Z"
+                },
+                "lifetime_spend": {
+                    "type": "number",
+                    "example": 1250000
+                },
+                "visits": {
+                    "type": "integer",
+                    "example": 12
+                }
+            }
+        },
+        "modelv1.ReadinessResponse": {
+            "type": "object",
+            "properties": {
+                "components": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/modelv1.ComponentResponse"
+                    }
+                },
+                "draining": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "status": {
+                    "type": "string",
+                    "example": "up"
+                }
+            }
+        },
+        "modelv1.RecoveryCodesResponse": {
+            "type": "object",
+            "properties": {
+                "recovery_codes": {
                     "type": "array",
                     "items": {
                         "type": "string"
                     },
                     "example": [
-                        "Error message 1",
-                        " Error message 2"
+                        "ABCDE-FGHJK",
+                        "LMNPQ-RSTUV"
                     ]
-                },
-                "success": {
-                    "type": "boolean",
-                    "example": false
                 }
             }
         },
-        "modelv1.LoginRequest": {
+        "modelv1.RefreshRequest": {
+            "type": "object",
+            "required": [
+                "refresh_token"
+            ],
+            "properties": {
+                "refresh_token": {
+                    "type": "string",
+                    "example": "q0GDCb0wXJk5oK3Iu2p0n0v4Y0s8JqJ7Q6Xv3K2ZJ5A"
+                }
+            }
+        },
+        "modelv1.RegisterRequest": {
             "type": "object",
             "required": [
                 "email",
+                "name",
                 "password"
             ],
             "properties": {
@@ -1744,6 +6546,14 @@ const docTemplate = `{
                     "type": "string",
                     "example": "test@example.com"
                 },
+                "invitation_token": {
+                    "type": "string",
+                    "example": "q0GDCb0wXJk5oK3Iu2p0n0v4Y0s8JqJ7Q6Xv3K2ZJ5A"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "John Doe"
+                },
                 "password": {
                     "type": "string",
                     "minLength": 8,
@@ -1751,42 +6561,19 @@ const docTemplate = `{
                 }
             }
         },
-        "modelv1.Meta": {
-            "type": "object",
-            "properties": {
-                "limit": {
-                    "type": "integer",
-                    "example": 10
-                },
-                "skip": {
-                    "type": "integer",
-                    "example": 0
-                },
-                "total": {
-                    "type": "integer",
-                    "example": 100
-                }
-            }
-        },
-        "modelv1.OrderProductRequest": {
+        "modelv1.RegisterTerminalRequest": {
             "type": "object",
             "required": [
-                "product_id",
-                "qty"
+                "name"
             ],
             "properties": {
-                "product_id": {
-                    "type": "integer",
-                    "minimum": 1,
-                    "example": 1
-                },
-                "qty": {
-                    "type": "integer",
-                    "example": 1
+                "name": {
+                    "type": "string",
+                    "example": "Front counter till"
                 }
             }
         },
-        "modelv1.OrderProductResponse": {
+        "modelv1.RegisterTerminalResponse": {
             "type": "object",
             "properties": {
                 "created_at": {
@@ -1797,124 +6584,139 @@ const docTemplate = `{
                     "type": "integer",
                     "example": 1
                 },
-                "order_id": {
-                    "type": "integer",
-                    "example": 1
-                },
-                "price": {
-                    "type": "number",
-                    "example": 100000
-                },
-                "product": {
-                    "$ref": "#/definitions/modelv1.ProductResponse"
-                },
-                "product_id": {
-                    "type": "integer",
-                    "example": 1
-                },
-                "qty": {
-                    "type": "integer",
-                    "example": 1
+                "name": {
+                    "type": "string",
+                    "example": "Front counter till"
                 },
-                "total_final_price": {
-                    "type": "number",
-                    "example": 100000
+                "revoked_at": {
+                    "type": "string",
+                    "example": "1970-01-01T00:00:00Z"
                 },
-                "total_normal_price": {
-                    "type": "number",
-                    "example": 100000
+                "secret": {
+                    "type": "string",
+                    "example": "q0GDCb0wXJk5oK3Iu2p0n0v4Y0s8JqJ7Q6Xv3K2ZJ5A"
+                }
+            }
+        },
+        "modelv1.ResetPasswordRequest": {
+            "type": "object",
+            "required": [
+                "password",
+                "token"
+            ],
+            "properties": {
+                "password": {
+                    "type": "string",
+                    "minLength": 8,
+                    "example": "87654321"
                 },
-                "updated_at": {
+                "token": {
                     "type": "string",
-                    "example": "1970-01-01T00:00:00Z"
+                    "example": "q0GDCb0wXJk5oK3Iu2p0n0v4Y0s8JqJ7Q6Xv3K2ZJ5A"
                 }
             }
         },
-        "modelv1.OrderResponse": {
+        "modelv1.Response": {
+            "type": "object",
+            "properties": {
+                "data": {},
+                "message": {
+                    "type": "string",
+                    "example": "Success"
+                },
+                "success": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "modelv1.RoleResponse": {
             "type": "object",
             "properties": {
                 "created_at": {
                     "type": "string",
                     "example": "1970-01-01T00:00:00Z"
                 },
-                "customer_name": {
+                "description": {
                     "type": "string",
-                    "example": "John Doe"
+                    "example": "Runs the floor during a shift"
                 },
                 "id": {
                     "type": "integer",
                     "example": 1
                 },
-                "payment_type": {
-                    "$ref": "#/definitions/modelv1.PaymentResponse"
+                "is_system": {
+                    "type": "boolean",
+                    "example": false
                 },
-                "payment_type_id": {
-                    "type": "integer",
-                    "example": 1
+                "name": {
+                    "type": "string",
+                    "example": "supervisor"
                 },
-                "products": {
+                "permissions": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/modelv1.OrderProductResponse"
-                    }
-                },
-                "receipt_id": {
-                    "type": "string",
-                    "example": "4979cf6e-d215-4ff8-9d0d-b3e99bcc7750"
-                },
-                "total_paid": {
-                    "type": "number",
-                    "example": 100000
-                },
-                "total_price": {
-                    "type": "number",
-                    "example": 100000
-                },
-                "total_return": {
-                    "type": "number",
-                    "example": 0
+                        "type": "string"
+                    },
+                    "example": [
+                        "orders:refund",
+                        "reports:read"
+                    ]
                 },
                 "updated_at": {
                     "type": "string",
                     "example": "1970-01-01T00:00:00Z"
-                },
-                "user_id": {
-                    "type": "integer",
-                    "example": 1
                 }
             }
         },
-        "modelv1.PaymentResponse": {
+        "modelv1.SetPINRequest": {
             "type": "object",
+            "required": [
+                "pin"
+            ],
             "properties": {
-                "id": {
-                    "type": "integer",
-                    "example": 1
-                },
-                "logo": {
+                "pin": {
                     "type": "string",
-                    "example": "https://example.com/cash.png"
-                },
-                "name": {
+                    "maxLength": 8,
+                    "minLength": 4,
+                    "example": "1234"
+                }
+            }
+        },
+        "modelv1.SetRolePermissionsRequest": {
+            "type": "object",
+            "required": [
+                "permissions"
+            ],
+            "properties": {
+                "permissions": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "orders:refund",
+                        "reports:read"
+                    ]
+                }
+            }
+        },
+        "modelv1.TOTPEnrollmentResponse": {
+            "type": "object",
+            "properties": {
+                "provisioning_uri": {
                     "type": "string",
-                    "example": "Tunai"
+                    "example": "otpauth://totp/hexagonal-demo:test@example.com?algorithm=SHA1\u0026digits=6\u0026issuer=hexagonal-demo\u0026period=30\u0026secret=JBSWY3DPEHPK3PXPJBSWY3DPEHPK3PXP"
                 },
-                "type": {
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/domainpayment.PaymentType"
-                        }
-                    ],
-                    "example": "CASH"
+                "secret": {
+                    "type": "string",
+                    "example": "JBSWY3DPEHPK3PXPJBSWY3DPEHPK3PXP"
                 }
             }
         },
-        "modelv1.ProductResponse": {
+        "modelv1.TerminalResponse": {
             "type": "object",
             "properties": {
-                "category": {
-                    "$ref": "#/definitions/modelv1.CategoryResponse"
-                },
                 "created_at": {
                     "type": "string",
                     "example": "1970-01-01T00:00:00Z"
@@ -1923,78 +6725,118 @@ const docTemplate = `{
                     "type": "integer",
                     "example": 1
                 },
-                "image": {
-                    "type": "string",
-                    "example": "https://example.com/chiki-ball.png"
-                },
                 "name": {
                     "type": "string",
-                    "example": "Chiki Ball"
+                    "example": "Front counter till"
                 },
-                "price": {
+                "revoked_at": {
+                    "type": "string",
+                    "example": "1970-01-01T00:00:00Z"
+                }
+            }
+        },
+        "modelv1.TopUpGiftCardRequest": {
+            "type": "object",
+            "required": [
+                "amount",
+                "payment_id",
+                "total_paid"
+            ],
+            "properties": {
+                "amount": {
                     "type": "number",
-                    "example": 5000
+                    "example": 100000
                 },
-                "sku": {
+                "customer_id": {
+                    "type": "integer",
+                    "minimum": 1,
+                    "example": 1
+                },
+                "customer_name": {
                     "type": "string",
-                    "example": "9a4c25d3-9786-492c-b084-85cb75c1ee3e"
+                    "example": "John Doe"
                 },
-                "stock": {
+                "payment_id": {
                     "type": "integer",
-                    "example": 100
+                    "example": 1
                 },
-                "updated_at": {
+                "total_paid": {
+                    "type": "number",
+                    "example": 100000
+                }
+            }
+        },
+        "modelv1.TwoFactorCodeRequest": {
+            "type": "object",
+            "properties": {
+                "code": {
                     "type": "string",
-                    "example": "1970-01-01T00:00:00Z"
+                    "example": "123456"
                 }
             }
         },
-        "modelv1.RegisterRequest": {
+        "modelv1.UpdateCategoryRequest": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "name": {
+                    "type": "string",
+                    "example": "Beverages"
+                }
+            }
+        },
+        "modelv1.UpdateCustomerRequest": {
             "type": "object",
             "required": [
                 "email",
                 "name",
-                "password"
+                "notes",
+                "phone"
             ],
             "properties": {
                 "email": {
                     "type": "string",
-                    "example": "test@example.com"
+                    "example": "john@example.com"
                 },
                 "name": {
                     "type": "string",
                     "example": "John Doe"
                 },
-                "password": {
-                    "type": "string",
-                    "minLength": 8,
-                    "example": "12345678"
-                }
-            }
-        },
-        "modelv1.Response": {
-            "type": "object",
-            "properties": {
-                "data": {},
-                "message": {
+                "notes": {
                     "type": "string",
-                    "example": "Success"
+                    "example": "Prefers paper receipts"
                 },
-                "success": {
-                    "type": "boolean",
-                    "example": true
+                "phone": {
+                    "type": "string",
+                    "example": "+6281234567890"
                 }
             }
         },
-        "modelv1.UpdateCategoryRequest": {
+        "modelv1.UpdateMeRequest": {
             "type": "object",
             "required": [
+                "current_password",
+                "email",
                 "name"
             ],
             "properties": {
+                "current_password": {
+                    "type": "string",
+                    "example": "12345678"
+                },
+                "email": {
+                    "type": "string",
+                    "example": "test@example.com"
+                },
+                "locale": {
+                    "type": "string",
+                    "example": "vi"
+                },
                 "name": {
                     "type": "string",
-                    "example": "Beverages"
+                    "example": "John Doe"
                 }
             }
         },
@@ -2098,6 +6940,10 @@ const docTemplate = `{
                     "type": "string",
                     "example": "1970-01-01T00:00:00Z"
                 },
+                "deleted_at": {
+                    "type": "string",
+                    "example": "1970-01-01T00:00:00Z"
+                },
                 "email": {
                     "type": "string",
                     "example": "test@example.com"
@@ -2106,6 +6952,10 @@ const docTemplate = `{
                     "type": "integer",
                     "example": 1
                 },
+                "locale": {
+                    "type": "string",
+                    "example": "vi"
+                },
                 "name": {
                     "type": "string",
                     "example": "John Doe"
@@ -2113,11 +6963,38 @@ const docTemplate = `{
                 "updated_at": {
                     "type": "string",
                     "example": "1970-01-01T00:00:00Z"
+                },
+                "version": {
+                    "type": "integer",
+                    "example": 1
+                }
+            }
+        },
+        "modelv1.VerifyTwoFactorRequest": {
+            "type": "object",
+            "required": [
+                "challenge",
+                "code"
+            ],
+            "properties": {
+                "challenge": {
+                    "type": "string",
+                    "example": "x7Jm2c0k5dI9q3rV1sT8uW6yZ4aB0eF2gH5jK7lN9oP"
+                },
+                "code": {
+                    "type": "string",
+                    "example": "123456"
                 }
             }
         }
     },
     "securityDefinitions": {
+        "APIKeyAuth": {
+            "description": "API key of a machine client, accepted on catalog endpoints.",
+            "type": "apiKey",
+            "name": "X-API-Key",
+            "in": "header"
+        },
         "BearerAuth": {
             "description": "Type \"Bearer\" followed by a space and the access token.",
             "type": "apiKey",
@@ -2134,7 +7011,7 @@ var SwaggerInfo = &swag.Spec{
 	BasePath:         "/v1",
 	Schemes:          []string{"http", "https"},
 	Title:            "Go Sale API demo for hexagonal architecture",
-	Description:      "This is a simple RESTful Point of Sale (POS) Service API written in Go using Gin web framework, PostgreSQL database, and Redis cache.",
+	Description:      "This is a simple RESTful Point of Sale (POS) Service API written in Go using Gin web framework, PostgreSQL database, and Redis cache. Errors are sent as problem details (RFC 7807) to clients accepting application/problem+json. Error messages are in English, Vietnamese or Indonesian, following the locale of the user or the Accept-Language header.",
 	InfoInstanceName: "swagger",
 	SwaggerTemplate:  docTemplate,
 	LeftDelim:        "{{",
@@ -3,7 +3,9 @@ package storagepostgres
 import (
 	"context"
 	"embed"
+	"errors"
 	"fmt"
+	"io/fs"
 
 	"github.com/Masterminds/squirrel"
 	"github.com/TienMinh25/go-hexagonal-architecture/config"
@@ -33,7 +35,7 @@ type DB struct {
 
 // New creates a new PostgreSQL database instance
 func New(ctx context.Context, config *config.DB) (*DB, error) {
-	url := fmt.Sprintf("%s://%s:%s@%s:%s/%s?sslmode=disable",
+	url := fmt.Sprintf("%s://%s:%s@%s:%d/%s?sslmode=disable",
 		config.Connection,
 		config.User,
 		config.Password,
@@ -42,7 +44,15 @@ func New(ctx context.Context, config *config.DB) (*DB, error) {
 		config.Name,
 	)
 
-	db, err := pgxpool.New(ctx, url)
+	poolConfig, err := pgxpool.ParseConfig(url)
+	if err != nil {
+		return nil, err
+	}
+	poolConfig.ConnConfig.Tracer = &queryTracer{
+		database: config.Name,
+	}
+
+	db, err := pgxpool.NewWithConfig(ctx, poolConfig)
 	if err != nil {
 		return nil, err
 	}
@@ -81,6 +91,32 @@ func (db *DB) Migrate() error {
 	return nil
 }
 
+// LatestMigration returns the version of the last migration embedded in the application
+func (db *DB) LatestMigration() (uint, error) {
+	driver, err := iofs.New(migrationsFS, "migrations")
+	if err != nil {
+		return 0, err
+	}
+	defer driver.Close()
+
+	version, err := driver.First()
+	if err != nil {
+		return 0, err
+	}
+
+	for {
+		next, err := driver.Next(version)
+		if errors.Is(err, fs.ErrNotExist) {
+			return version, nil
+		}
+		if err != nil {
+			return 0, err
+		}
+
+		version = next
+	}
+}
+
 // ErrorCode returns the error code of the given error
 func (db *DB) ErrorCode(err error) string {
 	pgErr := err.(*pgconn.PgError)
@@ -0,0 +1,15 @@
+DROP INDEX IF EXISTS "products_deleted_at";
+
+DROP INDEX IF EXISTS "categories_deleted_at";
+
+DROP INDEX IF EXISTS "payments_deleted_at";
+
+DROP INDEX IF EXISTS "users_deleted_at";
+
+ALTER TABLE "products" DROP COLUMN IF EXISTS "deleted_at";
+
+ALTER TABLE "categories" DROP COLUMN IF EXISTS "deleted_at";
+
+ALTER TABLE "payments" DROP COLUMN IF EXISTS "deleted_at";
+
+ALTER TABLE "users" DROP COLUMN IF EXISTS "deleted_at";
\ No newline at end of file
@@ -0,0 +1,15 @@
+ALTER TABLE "users" ADD COLUMN "deleted_at" timestamptz;
+
+ALTER TABLE "payments" ADD COLUMN "deleted_at" timestamptz;
+
+ALTER TABLE "categories" ADD COLUMN "deleted_at" timestamptz;
+
+ALTER TABLE "products" ADD COLUMN "deleted_at" timestamptz;
+
+CREATE INDEX "users_deleted_at" ON "users" ("deleted_at");
+
+CREATE INDEX "payments_deleted_at" ON "payments" ("deleted_at");
+
+CREATE INDEX "categories_deleted_at" ON "categories" ("deleted_at");
+
+CREATE INDEX "products_deleted_at" ON "products" ("deleted_at");
\ No newline at end of file
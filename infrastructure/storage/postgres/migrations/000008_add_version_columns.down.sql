@@ -0,0 +1,7 @@
+ALTER TABLE "products" DROP COLUMN IF EXISTS "version";
+
+ALTER TABLE "categories" DROP COLUMN IF EXISTS "version";
+
+ALTER TABLE "payments" DROP COLUMN IF EXISTS "version";
+
+ALTER TABLE "users" DROP COLUMN IF EXISTS "version";
\ No newline at end of file
@@ -0,0 +1,8 @@
+ALTER TABLE
+    "orders" DROP CONSTRAINT "fk_customers_orders";
+
+DROP INDEX IF EXISTS "orders_customer_id";
+
+ALTER TABLE "orders" DROP COLUMN IF EXISTS "customer_id";
+
+DROP TABLE IF EXISTS "customers";
\ No newline at end of file
@@ -0,0 +1,28 @@
+CREATE TABLE "customers" (
+    "id" BIGSERIAL PRIMARY KEY,
+    "name" varchar NOT NULL,
+    "phone" varchar NOT NULL DEFAULT '',
+    "email" varchar NOT NULL DEFAULT '',
+    "notes" text NOT NULL DEFAULT '',
+    "created_at" timestamptz NOT NULL DEFAULT (now()),
+    "updated_at" timestamptz NOT NULL DEFAULT (now()),
+    "deleted_at" timestamptz,
+    "version" bigint NOT NULL DEFAULT 1
+);
+
+CREATE INDEX "customers_name" ON "customers" ("name");
+
+CREATE UNIQUE INDEX "customers_phone" ON "customers" ("phone") WHERE "phone" <> '';
+
+CREATE UNIQUE INDEX "customers_email" ON "customers" ("email") WHERE "email" <> '';
+
+CREATE INDEX "customers_deleted_at" ON "customers" ("deleted_at");
+
+ALTER TABLE "orders" ADD COLUMN "customer_id" bigint;
+
+CREATE INDEX "orders_customer_id" ON "orders" ("customer_id");
+
+ALTER TABLE
+    "orders"
+ADD
+    CONSTRAINT "fk_customers_orders" FOREIGN KEY ("customer_id") REFERENCES "customers" ("id") ON DELETE NO ACTION ON UPDATE NO ACTION;
\ No newline at end of file
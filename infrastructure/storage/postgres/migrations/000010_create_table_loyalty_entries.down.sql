@@ -0,0 +1,9 @@
+ALTER TABLE "orders" DROP COLUMN IF EXISTS "refunded_at";
+
+ALTER TABLE "orders" DROP COLUMN IF EXISTS "points_earned";
+
+ALTER TABLE "orders" DROP COLUMN IF EXISTS "points_redeemed";
+
+DROP TABLE IF EXISTS "loyalty_entries";
+
+DROP TYPE IF EXISTS "loyalty_entries_type_enum";
\ No newline at end of file
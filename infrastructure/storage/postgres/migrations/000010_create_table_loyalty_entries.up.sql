@@ -0,0 +1,30 @@
+CREATE TYPE "loyalty_entries_type_enum" AS ENUM ('EARN', 'REDEEM', 'REVERSAL');
+
+CREATE TABLE "loyalty_entries" (
+    "id" BIGSERIAL PRIMARY KEY,
+    "customer_id" bigint NOT NULL,
+    "order_id" bigint,
+    "type" loyalty_entries_type_enum NOT NULL,
+    "points" bigint NOT NULL,
+    "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE INDEX "loyalty_entries_customer_id" ON "loyalty_entries" ("customer_id");
+
+CREATE INDEX "loyalty_entries_order_id" ON "loyalty_entries" ("order_id");
+
+ALTER TABLE
+    "loyalty_entries"
+ADD
+    CONSTRAINT "fk_customers_loyalty_entries" FOREIGN KEY ("customer_id") REFERENCES "customers" ("id") ON DELETE NO ACTION ON UPDATE NO ACTION;
+
+ALTER TABLE
+    "loyalty_entries"
+ADD
+    CONSTRAINT "fk_orders_loyalty_entries" FOREIGN KEY ("order_id") REFERENCES "orders" ("id") ON DELETE NO ACTION ON UPDATE NO ACTION;
+
+ALTER TABLE "orders" ADD COLUMN "points_redeemed" bigint NOT NULL DEFAULT 0;
+
+ALTER TABLE "orders" ADD COLUMN "points_earned" bigint NOT NULL DEFAULT 0;
+
+ALTER TABLE "orders" ADD COLUMN "refunded_at" timestamptz;
\ No newline at end of file
@@ -0,0 +1,27 @@
+ALTER TABLE
+    "orders" DROP CONSTRAINT "fk_gift_cards_sold_orders";
+
+ALTER TABLE "orders" DROP COLUMN IF EXISTS "sold_gift_card_id";
+
+ALTER TABLE
+    "orders" DROP CONSTRAINT "fk_gift_cards_orders";
+
+ALTER TABLE "orders" DROP COLUMN IF EXISTS "gift_card_amount";
+
+ALTER TABLE "orders" DROP COLUMN IF EXISTS "gift_card_id";
+
+DROP TABLE IF EXISTS "gift_card_transactions";
+
+DROP TYPE IF EXISTS "gift_card_transactions_type_enum";
+
+DROP TABLE IF EXISTS "gift_cards";
+
+DELETE FROM "payments" WHERE "type" = 'GIFT_CARD';
+
+ALTER TYPE "payments_type_enum" RENAME TO "payments_type_enum_old";
+
+CREATE TYPE "payments_type_enum" AS ENUM ('CASH', 'E-WALLET', 'EDC');
+
+ALTER TABLE "payments" ALTER COLUMN "type" TYPE payments_type_enum USING "type"::text::payments_type_enum;
+
+DROP TYPE "payments_type_enum_old";
\ No newline at end of file
@@ -0,0 +1,52 @@
+ALTER TYPE "payments_type_enum" ADD VALUE 'GIFT_CARD';
+
+CREATE TYPE "gift_card_transactions_type_enum" AS ENUM ('ISSUE', 'TOP_UP', 'REDEEM', 'REFUND');
+
+CREATE TABLE "gift_cards" (
+    "id" BIGSERIAL PRIMARY KEY,
+    "code" varchar NOT NULL,
+    "initial_balance" decimal(18, 2) NOT NULL,
+    "balance" decimal(18, 2) NOT NULL CHECK ("balance" >= 0),
+    "expires_at" timestamptz,
+    "created_at" timestamptz NOT NULL DEFAULT (now()),
+    "updated_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE UNIQUE INDEX "gift_cards_code" ON "gift_cards" ("code");
+
+CREATE TABLE "gift_card_transactions" (
+    "id" BIGSERIAL PRIMARY KEY,
+    "gift_card_id" bigint NOT NULL,
+    "order_id" bigint,
+    "type" gift_card_transactions_type_enum NOT NULL,
+    "amount" decimal(18, 2) NOT NULL,
+    "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE INDEX "gift_card_transactions_gift_card_id" ON "gift_card_transactions" ("gift_card_id");
+
+ALTER TABLE
+    "gift_card_transactions"
+ADD
+    CONSTRAINT "fk_gift_cards_gift_card_transactions" FOREIGN KEY ("gift_card_id") REFERENCES "gift_cards" ("id") ON DELETE NO ACTION ON UPDATE NO ACTION;
+
+ALTER TABLE
+    "gift_card_transactions"
+ADD
+    CONSTRAINT "fk_orders_gift_card_transactions" FOREIGN KEY ("order_id") REFERENCES "orders" ("id") ON DELETE NO ACTION ON UPDATE NO ACTION;
+
+ALTER TABLE "orders" ADD COLUMN "gift_card_id" bigint;
+
+ALTER TABLE "orders" ADD COLUMN "gift_card_amount" decimal(18, 2) NOT NULL DEFAULT 0;
+
+ALTER TABLE
+    "orders"
+ADD
+    CONSTRAINT "fk_gift_cards_orders" FOREIGN KEY ("gift_card_id") REFERENCES "gift_cards" ("id") ON DELETE NO ACTION ON UPDATE NO ACTION;
+
+ALTER TABLE "orders" ADD COLUMN "sold_gift_card_id" bigint;
+
+ALTER TABLE
+    "orders"
+ADD
+    CONSTRAINT "fk_gift_cards_sold_orders" FOREIGN KEY ("sold_gift_card_id") REFERENCES "gift_cards" ("id") ON DELETE NO ACTION ON UPDATE NO ACTION;
\ No newline at end of file
@@ -0,0 +1,81 @@
+CREATE TABLE "roles" (
+    "id" BIGSERIAL PRIMARY KEY,
+    "name" varchar NOT NULL,
+    "description" varchar NOT NULL DEFAULT '',
+    "is_system" boolean NOT NULL DEFAULT false,
+    "created_at" timestamptz NOT NULL DEFAULT (now()),
+    "updated_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE UNIQUE INDEX "roles_name" ON "roles" ("name");
+
+CREATE TABLE "permissions" (
+    "id" BIGSERIAL PRIMARY KEY,
+    "name" varchar NOT NULL,
+    "description" varchar NOT NULL DEFAULT '',
+    "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE UNIQUE INDEX "permissions_name" ON "permissions" ("name");
+
+CREATE TABLE "role_permissions" (
+    "role_id" bigint NOT NULL,
+    "permission_id" bigint NOT NULL,
+    PRIMARY KEY ("role_id", "permission_id")
+);
+
+ALTER TABLE
+    "role_permissions"
+ADD
+    CONSTRAINT "fk_roles_role_permissions" FOREIGN KEY ("role_id") REFERENCES "roles" ("id") ON DELETE CASCADE ON UPDATE NO ACTION;
+
+ALTER TABLE
+    "role_permissions"
+ADD
+    CONSTRAINT "fk_permissions_role_permissions" FOREIGN KEY ("permission_id") REFERENCES "permissions" ("id") ON DELETE CASCADE ON UPDATE NO ACTION;
+
+INSERT INTO "roles" ("name", "description", "is_system") VALUES
+    ('admin', 'Full access to every resource', true),
+    ('manager', 'Manages the catalog, payments, refunds and reports', true),
+    ('cashier', 'Takes orders and serves customers', true);
+
+INSERT INTO "permissions" ("name", "description") VALUES
+    ('users:write', 'Update, archive and restore users'),
+    ('roles:manage', 'Create roles and assign permissions to them'),
+    ('payments:write', 'Create, update, archive and restore payment types'),
+    ('categories:write', 'Create, update, archive and restore categories'),
+    ('products:write', 'Create, update, archive and restore products'),
+    ('customers:delete', 'Archive and restore customers'),
+    ('orders:refund', 'Refund orders'),
+    ('reports:read', 'Read sales reports'),
+    ('gift_cards:write', 'Sell and top up gift cards');
+
+INSERT INTO "role_permissions" ("role_id", "permission_id")
+SELECT "roles"."id", "permissions"."id" FROM "roles" CROSS JOIN "permissions" WHERE "roles"."name" = 'admin';
+
+INSERT INTO "role_permissions" ("role_id", "permission_id")
+SELECT "roles"."id", "permissions"."id" FROM "roles" CROSS JOIN "permissions"
+WHERE "roles"."name" = 'manager'
+    AND "permissions"."name" IN ('payments:write', 'categories:write', 'products:write', 'customers:delete', 'orders:refund', 'reports:read', 'gift_cards:write');
+
+INSERT INTO "role_permissions" ("role_id", "permission_id")
+SELECT "roles"."id", "permissions"."id" FROM "roles" CROSS JOIN "permissions"
+WHERE "roles"."name" = 'cashier'
+    AND "permissions"."name" IN ('gift_cards:write');
+
+ALTER TABLE "users" ALTER COLUMN "role" DROP DEFAULT;
+
+ALTER TABLE "users" ALTER COLUMN "role" TYPE varchar USING "role"::varchar;
+
+ALTER TABLE "users" ALTER COLUMN "role" SET DEFAULT 'cashier';
+
+UPDATE "users" SET "role" = 'cashier' WHERE "role" IS NULL;
+
+ALTER TABLE "users" ALTER COLUMN "role" SET NOT NULL;
+
+ALTER TABLE
+    "users"
+ADD
+    CONSTRAINT "fk_roles_users" FOREIGN KEY ("role") REFERENCES "roles" ("name") ON DELETE RESTRICT ON UPDATE CASCADE;
+
+DROP TYPE IF EXISTS "users_role_enum";
\ No newline at end of file
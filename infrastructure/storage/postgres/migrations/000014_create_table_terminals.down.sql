@@ -0,0 +1,5 @@
+DELETE FROM "permissions" WHERE "name" = 'terminals:manage';
+
+DROP TABLE IF EXISTS "user_pins";
+
+DROP TABLE IF EXISTS "terminals";
\ No newline at end of file
@@ -0,0 +1,26 @@
+CREATE TABLE "terminals" (
+    "id" BIGSERIAL PRIMARY KEY,
+    "name" varchar NOT NULL,
+    "secret_hash" varchar NOT NULL,
+    "created_at" timestamptz NOT NULL DEFAULT (now()),
+    "revoked_at" timestamptz
+);
+
+CREATE TABLE "user_pins" (
+    "user_id" bigint PRIMARY KEY,
+    "pin_hash" varchar NOT NULL,
+    "updated_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+ALTER TABLE
+    "user_pins"
+ADD
+    CONSTRAINT "fk_users_user_pins" FOREIGN KEY ("user_id") REFERENCES "users" ("id") ON DELETE CASCADE ON UPDATE NO ACTION;
+
+INSERT INTO "permissions" ("name", "description") VALUES
+    ('terminals:manage', 'Register and revoke POS terminals');
+
+INSERT INTO "role_permissions" ("role_id", "permission_id")
+SELECT "roles"."id", "permissions"."id" FROM "roles" CROSS JOIN "permissions"
+WHERE "roles"."name" = 'admin'
+    AND "permissions"."name" = 'terminals:manage';
\ No newline at end of file
@@ -0,0 +1,26 @@
+CREATE TABLE "user_totp" (
+    "user_id" bigint PRIMARY KEY,
+    "secret" varchar NOT NULL,
+    "confirmed_at" timestamptz,
+    "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+ALTER TABLE
+    "user_totp"
+ADD
+    CONSTRAINT "fk_users_user_totp" FOREIGN KEY ("user_id") REFERENCES "users" ("id") ON DELETE CASCADE ON UPDATE NO ACTION;
+
+CREATE TABLE "recovery_codes" (
+    "id" BIGSERIAL PRIMARY KEY,
+    "user_id" bigint NOT NULL,
+    "code_hash" varchar NOT NULL,
+    "used_at" timestamptz,
+    "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE UNIQUE INDEX "recovery_codes_user_id_code_hash" ON "recovery_codes" ("user_id", "code_hash");
+
+ALTER TABLE
+    "recovery_codes"
+ADD
+    CONSTRAINT "fk_user_totp_recovery_codes" FOREIGN KEY ("user_id") REFERENCES "user_totp" ("user_id") ON DELETE CASCADE ON UPDATE NO ACTION;
\ No newline at end of file
@@ -0,0 +1,17 @@
+CREATE TABLE "password_reset_tokens" (
+    "id" BIGSERIAL PRIMARY KEY,
+    "user_id" bigint NOT NULL,
+    "token_hash" varchar NOT NULL,
+    "expires_at" timestamptz NOT NULL,
+    "used_at" timestamptz,
+    "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE UNIQUE INDEX "password_reset_tokens_token_hash" ON "password_reset_tokens" ("token_hash");
+
+CREATE INDEX "password_reset_tokens_user_id" ON "password_reset_tokens" ("user_id");
+
+ALTER TABLE
+    "password_reset_tokens"
+ADD
+    CONSTRAINT "fk_users_password_reset_tokens" FOREIGN KEY ("user_id") REFERENCES "users" ("id") ON DELETE CASCADE ON UPDATE NO ACTION;
\ No newline at end of file
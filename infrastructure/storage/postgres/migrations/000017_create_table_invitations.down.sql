@@ -0,0 +1 @@
+DROP TABLE IF EXISTS "invitations";
\ No newline at end of file
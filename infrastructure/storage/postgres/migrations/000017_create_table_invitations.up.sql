@@ -0,0 +1,25 @@
+CREATE TABLE "invitations" (
+    "id" BIGSERIAL PRIMARY KEY,
+    "email" varchar NOT NULL,
+    "role" varchar NOT NULL,
+    "token_hash" varchar NOT NULL,
+    "invited_by" bigint NOT NULL,
+    "expires_at" timestamptz NOT NULL,
+    "accepted_at" timestamptz,
+    "revoked_at" timestamptz,
+    "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE UNIQUE INDEX "invitations_token_hash" ON "invitations" ("token_hash");
+
+CREATE INDEX "invitations_email" ON "invitations" ("email");
+
+ALTER TABLE
+    "invitations"
+ADD
+    CONSTRAINT "fk_roles_invitations" FOREIGN KEY ("role") REFERENCES "roles" ("name") ON DELETE CASCADE ON UPDATE CASCADE;
+
+ALTER TABLE
+    "invitations"
+ADD
+    CONSTRAINT "fk_users_invitations" FOREIGN KEY ("invited_by") REFERENCES "users" ("id") ON DELETE CASCADE ON UPDATE NO ACTION;
\ No newline at end of file
@@ -0,0 +1,27 @@
+CREATE TABLE "api_keys" (
+    "id" BIGSERIAL PRIMARY KEY,
+    "name" varchar NOT NULL,
+    "prefix" varchar NOT NULL,
+    "key_hash" varchar NOT NULL,
+    "scopes" varchar[] NOT NULL DEFAULT '{}',
+    "created_by" bigint NOT NULL,
+    "expires_at" timestamptz,
+    "last_used_at" timestamptz,
+    "revoked_at" timestamptz,
+    "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE UNIQUE INDEX "api_keys_key_hash" ON "api_keys" ("key_hash");
+
+ALTER TABLE
+    "api_keys"
+ADD
+    CONSTRAINT "fk_users_api_keys" FOREIGN KEY ("created_by") REFERENCES "users" ("id") ON DELETE CASCADE ON UPDATE NO ACTION;
+
+INSERT INTO "permissions" ("name", "description") VALUES
+    ('api_keys:manage', 'Create and revoke API keys for integrations');
+
+INSERT INTO "role_permissions" ("role_id", "permission_id")
+SELECT "roles"."id", "permissions"."id" FROM "roles" CROSS JOIN "permissions"
+WHERE "roles"."name" = 'admin'
+    AND "permissions"."name" = 'api_keys:manage';
\ No newline at end of file
@@ -0,0 +1,42 @@
+CREATE TABLE "audit_logs" (
+    "id" BIGSERIAL PRIMARY KEY,
+    "actor_id" bigint NOT NULL DEFAULT 0,
+    "api_key_id" bigint NOT NULL DEFAULT 0,
+    "action" varchar NOT NULL,
+    "entity" varchar NOT NULL,
+    "entity_id" bigint NOT NULL,
+    "before" json,
+    "after" json,
+    "changes" json NOT NULL,
+    "ip" varchar NOT NULL DEFAULT '',
+    "request_id" varchar NOT NULL DEFAULT '',
+    "prev_hash" varchar NOT NULL,
+    "hash" varchar NOT NULL,
+    "created_at" timestamptz NOT NULL
+);
+
+CREATE INDEX "audit_logs_actor_id" ON "audit_logs" ("actor_id");
+
+CREATE INDEX "audit_logs_entity" ON "audit_logs" ("entity", "entity_id");
+
+CREATE INDEX "audit_logs_created_at" ON "audit_logs" ("created_at");
+
+CREATE FUNCTION "audit_logs_append_only"() RETURNS trigger AS $$
+BEGIN
+    RAISE EXCEPTION 'audit_logs is append-only';
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER "audit_logs_no_update_delete" BEFORE UPDATE OR DELETE ON "audit_logs"
+FOR EACH ROW EXECUTE FUNCTION "audit_logs_append_only"();
+
+CREATE TRIGGER "audit_logs_no_truncate" BEFORE TRUNCATE ON "audit_logs"
+FOR EACH STATEMENT EXECUTE FUNCTION "audit_logs_append_only"();
+
+INSERT INTO "permissions" ("name", "description") VALUES
+    ('audit_logs:read', 'Read the audit log of changes');
+
+INSERT INTO "role_permissions" ("role_id", "permission_id")
+SELECT "roles"."id", "permissions"."id" FROM "roles" CROSS JOIN "permissions"
+WHERE "roles"."name" = 'admin'
+    AND "permissions"."name" = 'audit_logs:read';
\ No newline at end of file
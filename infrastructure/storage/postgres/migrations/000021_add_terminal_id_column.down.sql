@@ -0,0 +1,5 @@
+ALTER TABLE "orders" DROP CONSTRAINT IF EXISTS "fk_terminals_orders";
+
+DROP INDEX IF EXISTS "orders_terminal_id";
+
+ALTER TABLE "orders" DROP COLUMN IF EXISTS "terminal_id";
\ No newline at end of file
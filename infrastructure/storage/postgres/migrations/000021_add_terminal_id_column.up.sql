@@ -0,0 +1,8 @@
+ALTER TABLE "orders" ADD COLUMN "terminal_id" bigint;
+
+CREATE INDEX "orders_terminal_id" ON "orders" ("terminal_id");
+
+ALTER TABLE
+    "orders"
+ADD
+    CONSTRAINT "fk_terminals_orders" FOREIGN KEY ("terminal_id") REFERENCES "terminals" ("id") ON DELETE NO ACTION ON UPDATE NO ACTION;
\ No newline at end of file
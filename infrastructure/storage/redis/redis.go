@@ -14,6 +14,7 @@ func NewRedis(ctx context.Context, config *config.Redis) (*redis.Client, error)
 		Password: config.Password,
 		DB:       0,
 	})
+	client.AddHook(tracingHook{})
 
 	_, err := client.Ping(ctx).Result()
 	if err != nil {
@@ -0,0 +1,73 @@
+package storageredis
+
+import (
+	"context"
+	"errors"
+	"net"
+
+	"github.com/redis/go-redis/v9"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.34.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// tracerName names the tracer of the redis command spans
+const tracerName = "github.com/TienMinh25/go-hexagonal-architecture/infrastructure/storage/redis"
+
+/**
+ * tracingHook implements redis.Hook interface
+ * and records a span for every command and pipeline sent to redis.
+ * Only the command names are recorded, keys and values can hold tokens
+ */
+type tracingHook struct{}
+
+// DialHook leaves dialing untraced, it is part of the first command on a connection
+func (tracingHook) DialHook(next redis.DialHook) redis.DialHook {
+	return func(ctx context.Context, network, addr string) (net.Conn, error) {
+		return next(ctx, network, addr)
+	}
+}
+
+// ProcessHook records a span around a command
+func (tracingHook) ProcessHook(next redis.ProcessHook) redis.ProcessHook {
+	return func(ctx context.Context, cmd redis.Cmder) error {
+		ctx, span := startSpan(ctx, cmd.Name())
+		err := next(ctx, cmd)
+		endSpan(span, err)
+
+		return err
+	}
+}
+
+// ProcessPipelineHook records a span around a pipeline of commands
+func (tracingHook) ProcessPipelineHook(next redis.ProcessPipelineHook) redis.ProcessPipelineHook {
+	return func(ctx context.Context, cmds []redis.Cmder) error {
+		ctx, span := startSpan(ctx, "pipeline")
+		err := next(ctx, cmds)
+		endSpan(span, err)
+
+		return err
+	}
+}
+
+// startSpan starts the span of a redis operation
+func startSpan(ctx context.Context, operation string) (context.Context, trace.Span) {
+	return otel.Tracer(tracerName).Start(ctx, operation,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			semconv.DBSystemNameRedis,
+			semconv.DBOperationName(operation),
+		),
+	)
+}
+
+// endSpan ends the span of a redis operation, a key that does not exist is not a failure
+func endSpan(span trace.Span, err error) {
+	if err != nil && !errors.Is(err, redis.Nil) {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	span.End()
+}
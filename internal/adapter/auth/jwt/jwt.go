@@ -0,0 +1,169 @@
+package jwt
+
+import (
+	"context"
+	"crypto/ed25519"
+	"errors"
+	"time"
+
+	"github.com/TienMinh25/go-hexagonal-architecture/config"
+	"github.com/TienMinh25/go-hexagonal-architecture/internal/adapter/auth/keyring"
+	"github.com/TienMinh25/go-hexagonal-architecture/internal/adapter/auth/revocation"
+	"github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain"
+	domainauth "github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain/auth"
+	domainuser "github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain/user"
+	"github.com/TienMinh25/go-hexagonal-architecture/internal/application/port"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+)
+
+/**
+ * jwtToken implements port.TokenService interface
+ * and provides an access to the jwt library,
+ * signing tokens with Ed25519 keys for services that only speak JWT
+ */
+type jwtToken struct {
+	keys             *keyring.Ring[ed25519.PrivateKey]
+	parser           *jwt.Parser
+	duration         time.Duration
+	terminalDuration time.Duration
+	revoked          *revocation.List
+}
+
+// claims are the claims of the token, the payload is kept under the same claim as in paseto tokens
+type claims struct {
+	Payload *domainauth.TokenPayload `json:"payload"`
+	jwt.RegisteredClaims
+}
+
+// New creates a new jwt instance, keeping revoked token ids in the cache
+func New(config *config.Token, cache port.CacheRepository) (port.TokenService, error) {
+	duration := config.Duration
+	if duration <= 0 {
+		return nil, domain.ErrTokenDuration
+	}
+
+	keys, err := keyring.New(config, keyring.ParseEd25519)
+	if err != nil {
+		return nil, err
+	}
+
+	parser := jwt.NewParser(
+		jwt.WithValidMethods([]string{jwt.SigningMethodEdDSA.Alg()}),
+		jwt.WithExpirationRequired(),
+	)
+
+	return &jwtToken{
+		keys,
+		parser,
+		duration,
+		config.TerminalDuration,
+		revocation.New(cache),
+	}, nil
+}
+
+// CreateToken creates a new jwt token naming its key in the kid header
+func (jt *jwtToken) CreateToken(user *domainuser.User, permissions []string) (string, error) {
+	return jt.createToken(user, permissions, 0, jt.duration)
+}
+
+// CreateTerminalToken creates a new short-lived jwt token carrying the terminal id
+func (jt *jwtToken) CreateTerminalToken(user *domainuser.User, permissions []string, terminalID uint64) (string, error) {
+	return jt.createToken(user, permissions, terminalID, jt.terminalDuration)
+}
+
+// createToken creates a jwt token of the given lifetime, signed with the active key
+func (jt *jwtToken) createToken(user *domainuser.User, permissions []string, terminalID uint64, duration time.Duration) (string, error) {
+	id, err := uuid.NewRandom()
+	if err != nil {
+		return "", domain.ErrTokenCreation
+	}
+
+	issuedAt := time.Now()
+	expiredAt := issuedAt.Add(duration)
+
+	payload := &domainauth.TokenPayload{
+		ID:          id,
+		UserID:      user.ID,
+		Role:        user.Role,
+		Locale:      user.Locale,
+		Permissions: permissions,
+		TerminalID:  terminalID,
+		ExpiredAt:   expiredAt,
+	}
+
+	token := jwt.NewWithClaims(jwt.SigningMethodEdDSA, claims{
+		Payload: payload,
+		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        id.String(),
+			IssuedAt:  jwt.NewNumericDate(issuedAt),
+			NotBefore: jwt.NewNumericDate(issuedAt),
+			ExpiresAt: jwt.NewNumericDate(expiredAt),
+		},
+	})
+
+	keyID, key := jt.keys.Active()
+	token.Header["kid"] = keyID
+
+	signed, err := token.SignedString(key)
+	if err != nil {
+		return "", domain.ErrTokenCreation
+	}
+
+	return signed, nil
+}
+
+// VerifyToken verifies the jwt token with the key named in its header and checks it is not revoked
+func (jt *jwtToken) VerifyToken(ctx context.Context, token string) (*domainauth.TokenPayload, error) {
+	var tokenClaims claims
+
+	_, err := jt.parser.ParseWithClaims(token, &tokenClaims, func(t *jwt.Token) (any, error) {
+		keyID, _ := t.Header["kid"].(string)
+		key, ok := jt.keys.Get(keyID)
+		if !ok {
+			return nil, domain.ErrInvalidToken
+		}
+		return key.Public(), nil
+	})
+	if err != nil {
+		if errors.Is(err, jwt.ErrTokenExpired) {
+			return nil, domain.ErrExpiredToken
+		}
+		return nil, domain.ErrInvalidToken
+	}
+
+	if tokenClaims.Payload == nil {
+		return nil, domain.ErrInvalidToken
+	}
+
+	revoked, err := jt.revoked.IsRevoked(ctx, tokenClaims.Payload)
+	if err != nil {
+		return nil, domain.ErrInternal.Wrap(err)
+	}
+
+	if revoked {
+		return nil, domain.ErrRevokedToken
+	}
+
+	return tokenClaims.Payload, nil
+}
+
+// RevokeToken adds the token id to the revocation list until the token expires
+func (jt *jwtToken) RevokeToken(ctx context.Context, payload *domainauth.TokenPayload) error {
+	return jt.revoked.Revoke(ctx, payload)
+}
+
+// PublicKeys returns the active and retired keys
+func (jt *jwtToken) PublicKeys() []domainauth.PublicKey {
+	var keys []domainauth.PublicKey
+	for _, id := range jt.keys.IDs() {
+		key, _ := jt.keys.Get(id)
+		keys = append(keys, domainauth.PublicKey{
+			ID:        id,
+			Algorithm: jwt.SigningMethodEdDSA.Alg(),
+			Key:       key.Public().(ed25519.PublicKey),
+		})
+	}
+
+	return keys
+}
@@ -0,0 +1,280 @@
+package jwt
+
+import (
+	"context"
+	"crypto/ed25519"
+	"encoding/hex"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/TienMinh25/go-hexagonal-architecture/config"
+	"github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain"
+	domainauth "github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain/auth"
+	domainuser "github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain/user"
+	"github.com/TienMinh25/go-hexagonal-architecture/internal/application/mock"
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+type verifyTokenTestedInput struct {
+	token func(t *testing.T) string
+}
+
+type verifyTokenExpectedOutput struct {
+	userID uint64
+	err    error
+}
+
+func TestJWTToken_VerifyToken(t *testing.T) {
+	ctx := context.Background()
+	oldSeed := strings.Repeat("a", 64)
+	newSeed := strings.Repeat("b", 64)
+	oldKey := newPrivateKey(oldSeed)
+	newKey := newPrivateKey(newSeed)
+
+	user := &domainuser.User{
+		ID:   gofakeit.Uint64(),
+		Role: "admin",
+	}
+
+	verifierConfig := &config.Token{
+		Duration:    time.Minute,
+		Keys:        []string{"2024:" + oldSeed, "2025:" + newSeed},
+		ActiveKeyID: "2025",
+	}
+
+	// signedToken signs the claims of the user with the given method, key id and key
+	signedToken := func(t *testing.T, method jwt.SigningMethod, keyID string, key any, payload *domainauth.TokenPayload, expiredAt time.Time) string {
+		token := jwt.NewWithClaims(method, claims{
+			Payload: payload,
+			RegisteredClaims: jwt.RegisteredClaims{
+				ExpiresAt: jwt.NewNumericDate(expiredAt),
+			},
+		})
+		token.Header["kid"] = keyID
+
+		signed, err := token.SignedString(key)
+		assert.NoError(t, err, "Signing mismatch")
+
+		return signed
+	}
+	payload := &domainauth.TokenPayload{
+		ID:     uuid.New(),
+		UserID: user.ID,
+	}
+
+	testCases := []struct {
+		desc     string
+		mocks    func(cache *mock.MockCacheRepository)
+		input    verifyTokenTestedInput
+		expected verifyTokenExpectedOutput
+	}{
+		{
+			desc: "Success_ActiveKey",
+			mocks: func(cache *mock.MockCacheRepository) {
+				cache.EXPECT().
+					Get(gomock.Any(), gomock.Any()).
+					Return(nil, domain.ErrDataNotFound)
+			},
+			input: verifyTokenTestedInput{
+				token: func(t *testing.T) string {
+					signer, err := New(verifierConfig, nil)
+					assert.NoError(t, err, "Signer mismatch")
+
+					token, err := signer.CreateToken(user, nil)
+					assert.NoError(t, err, "Token mismatch")
+
+					parsed, _, err := jwt.NewParser().ParseUnverified(token, &claims{})
+					assert.NoError(t, err, "Token mismatch")
+					assert.Equal(t, "2025", parsed.Header["kid"], "Key id mismatch")
+
+					return token
+				},
+			},
+			expected: verifyTokenExpectedOutput{
+				userID: user.ID,
+				err:    nil,
+			},
+		},
+		{
+			desc: "Success_RetiredKey",
+			mocks: func(cache *mock.MockCacheRepository) {
+				cache.EXPECT().
+					Get(gomock.Any(), gomock.Any()).
+					Return(nil, domain.ErrDataNotFound)
+			},
+			input: verifyTokenTestedInput{
+				token: func(t *testing.T) string {
+					return signedToken(t, jwt.SigningMethodEdDSA, "2024", oldKey, payload, time.Now().Add(time.Minute))
+				},
+			},
+			expected: verifyTokenExpectedOutput{
+				userID: user.ID,
+				err:    nil,
+			},
+		},
+		{
+			desc:  "Fail_Expired",
+			mocks: func(cache *mock.MockCacheRepository) {},
+			input: verifyTokenTestedInput{
+				token: func(t *testing.T) string {
+					return signedToken(t, jwt.SigningMethodEdDSA, "2025", newKey, payload, time.Now().Add(-time.Minute))
+				},
+			},
+			expected: verifyTokenExpectedOutput{
+				err: domain.ErrExpiredToken,
+			},
+		},
+		{
+			desc:  "Fail_WrongKey",
+			mocks: func(cache *mock.MockCacheRepository) {},
+			input: verifyTokenTestedInput{
+				token: func(t *testing.T) string {
+					return signedToken(t, jwt.SigningMethodEdDSA, "2025", oldKey, payload, time.Now().Add(time.Minute))
+				},
+			},
+			expected: verifyTokenExpectedOutput{
+				err: domain.ErrInvalidToken,
+			},
+		},
+		{
+			desc:  "Fail_UnknownKeyID",
+			mocks: func(cache *mock.MockCacheRepository) {},
+			input: verifyTokenTestedInput{
+				token: func(t *testing.T) string {
+					return signedToken(t, jwt.SigningMethodEdDSA, "2023", newKey, payload, time.Now().Add(time.Minute))
+				},
+			},
+			expected: verifyTokenExpectedOutput{
+				err: domain.ErrInvalidToken,
+			},
+		},
+		{
+			desc:  "Fail_AlgNone",
+			mocks: func(cache *mock.MockCacheRepository) {},
+			input: verifyTokenTestedInput{
+				token: func(t *testing.T) string {
+					return signedToken(t, jwt.SigningMethodNone, "2025", jwt.UnsafeAllowNoneSignatureType, payload, time.Now().Add(time.Minute))
+				},
+			},
+			expected: verifyTokenExpectedOutput{
+				err: domain.ErrInvalidToken,
+			},
+		},
+		{
+			desc:  "Fail_AlgHMACWithPublicKey",
+			mocks: func(cache *mock.MockCacheRepository) {},
+			input: verifyTokenTestedInput{
+				token: func(t *testing.T) string {
+					publicKey := []byte(newKey.Public().(ed25519.PublicKey))
+					return signedToken(t, jwt.SigningMethodHS256, "2025", publicKey, payload, time.Now().Add(time.Minute))
+				},
+			},
+			expected: verifyTokenExpectedOutput{
+				err: domain.ErrInvalidToken,
+			},
+		},
+		{
+			desc:  "Fail_MissingPayload",
+			mocks: func(cache *mock.MockCacheRepository) {},
+			input: verifyTokenTestedInput{
+				token: func(t *testing.T) string {
+					return signedToken(t, jwt.SigningMethodEdDSA, "2025", newKey, nil, time.Now().Add(time.Minute))
+				},
+			},
+			expected: verifyTokenExpectedOutput{
+				err: domain.ErrInvalidToken,
+			},
+		},
+		{
+			desc: "Fail_Revoked",
+			mocks: func(cache *mock.MockCacheRepository) {
+				cache.EXPECT().
+					Get(gomock.Any(), gomock.Any()).
+					Return([]byte{1}, nil)
+			},
+			input: verifyTokenTestedInput{
+				token: func(t *testing.T) string {
+					return signedToken(t, jwt.SigningMethodEdDSA, "2025", newKey, payload, time.Now().Add(time.Minute))
+				},
+			},
+			expected: verifyTokenExpectedOutput{
+				err: domain.ErrRevokedToken,
+			},
+		},
+		{
+			desc: "Fail_CacheUnavailable",
+			mocks: func(cache *mock.MockCacheRepository) {
+				cache.EXPECT().
+					Get(gomock.Any(), gomock.Any()).
+					Return(nil, domain.ErrInternal)
+			},
+			input: verifyTokenTestedInput{
+				token: func(t *testing.T) string {
+					return signedToken(t, jwt.SigningMethodEdDSA, "2025", newKey, payload, time.Now().Add(time.Minute))
+				},
+			},
+			expected: verifyTokenExpectedOutput{
+				err: domain.ErrInternal,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.desc, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			cache := mock.NewMockCacheRepository(ctrl)
+
+			tc.mocks(cache)
+
+			tokenService, err := New(verifierConfig, cache)
+			assert.NoError(t, err, "Token service mismatch")
+
+			verifiedPayload, err := tokenService.VerifyToken(ctx, tc.input.token(t))
+			assert.ErrorIs(t, err, tc.expected.err, "Error mismatch")
+
+			if tc.expected.err == nil {
+				assert.Equal(t, tc.expected.userID, verifiedPayload.UserID, "User id mismatch")
+			}
+		})
+	}
+}
+
+func TestJWTToken_PublicKeys(t *testing.T) {
+	oldSeed := strings.Repeat("a", 64)
+	newSeed := strings.Repeat("b", 64)
+
+	tokenService, err := New(&config.Token{
+		Duration:    time.Minute,
+		Keys:        []string{"2024:" + oldSeed, "2025:" + newSeed},
+		ActiveKeyID: "2025",
+	}, nil)
+	assert.NoError(t, err, "Token service mismatch")
+
+	keys := tokenService.PublicKeys()
+	assert.Len(t, keys, 2, "Keys mismatch")
+
+	for i, seed := range []string{newSeed, oldSeed} {
+		privateKey := newPrivateKey(seed)
+
+		assert.Equal(t, []string{"2025", "2024"}[i], keys[i].ID, "Key id mismatch")
+		assert.Equal(t, "EdDSA", keys[i].Algorithm, "Algorithm mismatch")
+		assert.Len(t, keys[i].Key, ed25519.PublicKeySize, "Key size mismatch")
+		assert.Equal(t, privateKey.Public(), keys[i].Key, "Public key mismatch")
+		assert.NotContains(t, hex.EncodeToString(keys[i].Key), seed, "Private key material published")
+	}
+}
+
+// newPrivateKey creates the Ed25519 key of a hex encoded seed
+func newPrivateKey(seed string) ed25519.PrivateKey {
+	b, _ := hex.DecodeString(seed)
+	return ed25519.NewKeyFromSeed(b)
+}
@@ -0,0 +1,42 @@
+package keyring
+
+import (
+	"crypto/ed25519"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"slices"
+)
+
+// Decode decodes a hex or base64 encoded key of one of the given sizes in bytes
+func Decode(encoded string, sizes ...int) ([]byte, error) {
+	for _, size := range sizes {
+		if len(encoded) == hex.EncodedLen(size) {
+			if b, err := hex.DecodeString(encoded); err == nil {
+				return b, nil
+			}
+		}
+	}
+
+	for _, encoding := range []*base64.Encoding{base64.StdEncoding, base64.RawStdEncoding, base64.URLEncoding, base64.RawURLEncoding} {
+		if b, err := encoding.DecodeString(encoded); err == nil && slices.Contains(sizes, len(b)) {
+			return b, nil
+		}
+	}
+
+	return nil, fmt.Errorf("must be %v bytes encoded as hex or base64", sizes)
+}
+
+// ParseEd25519 decodes an Ed25519 private key given either as its 32 byte seed or as the 64 byte key
+func ParseEd25519(encoded string) (ed25519.PrivateKey, error) {
+	b, err := Decode(encoded, ed25519.SeedSize, ed25519.PrivateKeySize)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(b) == ed25519.SeedSize {
+		return ed25519.NewKeyFromSeed(b), nil
+	}
+
+	return ed25519.PrivateKey(b), nil
+}
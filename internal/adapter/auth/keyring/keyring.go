@@ -0,0 +1,127 @@
+package keyring
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/TienMinh25/go-hexagonal-architecture/config"
+	"github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain"
+)
+
+// Ring holds the signing keys by key id, only the active key creates tokens
+// while retired keys keep verifying the tokens they created until those expire
+type Ring[K any] struct {
+	keys     map[string]K
+	activeID string
+}
+
+// New loads the keys from the TOKEN_KEYS list and the key file,
+// each entry being a key id and an encoded key separated by a colon
+func New[K any](config *config.Token, parse func(encoded string) (K, error)) (*Ring[K], error) {
+	var entries []string
+
+	entries = append(entries, config.Keys...)
+
+	if config.KeyFile != "" {
+		fileEntries, err := readKeyFile(config.KeyFile)
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, fileEntries...)
+	}
+
+	ring := &Ring[K]{
+		keys:     make(map[string]K),
+		activeID: config.ActiveKeyID,
+	}
+
+	for _, entry := range entries {
+		id, encoded, ok := strings.Cut(strings.TrimSpace(entry), ":")
+		if !ok || id == "" {
+			return nil, fmt.Errorf("%w: entries must be formatted as key_id:key", domain.ErrTokenKey)
+		}
+
+		if _, exists := ring.keys[id]; exists {
+			return nil, fmt.Errorf("%w: key id %q is used more than once", domain.ErrTokenKey, id)
+		}
+
+		key, err := parse(strings.TrimSpace(encoded))
+		if err != nil {
+			return nil, fmt.Errorf("%w: key %q: %v", domain.ErrTokenKey, id, err)
+		}
+
+		ring.keys[id] = key
+	}
+
+	if len(ring.keys) == 0 {
+		return nil, fmt.Errorf("%w: no keys are configured", domain.ErrTokenKey)
+	}
+
+	if ring.activeID == "" {
+		if len(ring.keys) > 1 {
+			return nil, fmt.Errorf("%w: the active key id must be set when several keys are configured", domain.ErrTokenKey)
+		}
+		for id := range ring.keys {
+			ring.activeID = id
+		}
+	}
+
+	if _, ok := ring.keys[ring.activeID]; !ok {
+		return nil, fmt.Errorf("%w: active key %q is not configured", domain.ErrTokenKey, ring.activeID)
+	}
+
+	return ring, nil
+}
+
+// Active returns the id and the key that new tokens are created with
+func (r *Ring[K]) Active() (string, K) {
+	return r.activeID, r.keys[r.activeID]
+}
+
+// Get returns the key of the given id
+func (r *Ring[K]) Get(id string) (K, bool) {
+	key, ok := r.keys[id]
+	return key, ok
+}
+
+// IDs returns the ids of all keys, the active key first
+func (r *Ring[K]) IDs() []string {
+	ids := make([]string, 0, len(r.keys))
+	for id := range r.keys {
+		if id != r.activeID {
+			ids = append(ids, id)
+		}
+	}
+	sort.Strings(ids)
+
+	return append([]string{r.activeID}, ids...)
+}
+
+// readKeyFile reads the key entries of a key file, one per line, ignoring blank lines and # comments
+func readKeyFile(path string) ([]string, error) {
+	var entries []string
+
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", domain.ErrTokenKey, err)
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		entries = append(entries, line)
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("%w: %v", domain.ErrTokenKey, err)
+	}
+
+	return entries, nil
+}
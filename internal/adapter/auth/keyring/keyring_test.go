@@ -0,0 +1,246 @@
+package keyring
+
+import (
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/TienMinh25/go-hexagonal-architecture/config"
+	"github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+type newTestedInput struct {
+	config *config.Token
+}
+
+type newExpectedOutput struct {
+	activeID string
+	ids      []string
+	err      error
+}
+
+func TestNew(t *testing.T) {
+	first := strings.Repeat("a", 64)
+	second := strings.Repeat("b", 64)
+
+	dir := t.TempDir()
+
+	keyFile := filepath.Join(dir, "keys")
+	_ = os.WriteFile(keyFile, []byte("# retired\n\n2024:"+second+"\n"), 0o600)
+
+	testCases := []struct {
+		desc     string
+		input    newTestedInput
+		expected newExpectedOutput
+	}{
+		{
+			desc: "Success_SingleKeyIsActive",
+			input: newTestedInput{
+				config: &config.Token{
+					Keys: []string{"2025:" + first},
+				},
+			},
+			expected: newExpectedOutput{
+				activeID: "2025",
+				ids:      []string{"2025"},
+			},
+		},
+		{
+			desc: "Success_ActiveAndRetiredKeys",
+			input: newTestedInput{
+				config: &config.Token{
+					Keys:        []string{"2024:" + first, "2025:" + second},
+					ActiveKeyID: "2025",
+				},
+			},
+			expected: newExpectedOutput{
+				activeID: "2025",
+				ids:      []string{"2025", "2024"},
+			},
+		},
+		{
+			desc: "Success_KeyFile",
+			input: newTestedInput{
+				config: &config.Token{
+					Keys:        []string{"2025:" + first},
+					KeyFile:     keyFile,
+					ActiveKeyID: "2025",
+				},
+			},
+			expected: newExpectedOutput{
+				activeID: "2025",
+				ids:      []string{"2025", "2024"},
+			},
+		},
+		{
+			desc: "Fail_NoKeys",
+			input: newTestedInput{
+				config: &config.Token{},
+			},
+			expected: newExpectedOutput{
+				err: domain.ErrTokenKey,
+			},
+		},
+		{
+			desc: "Fail_MissingKeyID",
+			input: newTestedInput{
+				config: &config.Token{
+					Keys: []string{first},
+				},
+			},
+			expected: newExpectedOutput{
+				err: domain.ErrTokenKey,
+			},
+		},
+		{
+			desc: "Fail_MalformedKey",
+			input: newTestedInput{
+				config: &config.Token{
+					Keys: []string{"2025:not-a-key"},
+				},
+			},
+			expected: newExpectedOutput{
+				err: domain.ErrTokenKey,
+			},
+		},
+		{
+			desc: "Fail_DuplicateKeyID",
+			input: newTestedInput{
+				config: &config.Token{
+					Keys:        []string{"2025:" + first, "2025:" + second},
+					ActiveKeyID: "2025",
+				},
+			},
+			expected: newExpectedOutput{
+				err: domain.ErrTokenKey,
+			},
+		},
+		{
+			desc: "Fail_ActiveKeyIDMissing",
+			input: newTestedInput{
+				config: &config.Token{
+					Keys: []string{"2024:" + first, "2025:" + second},
+				},
+			},
+			expected: newExpectedOutput{
+				err: domain.ErrTokenKey,
+			},
+		},
+		{
+			desc: "Fail_ActiveKeyNotConfigured",
+			input: newTestedInput{
+				config: &config.Token{
+					Keys:        []string{"2024:" + first},
+					ActiveKeyID: "2025",
+				},
+			},
+			expected: newExpectedOutput{
+				err: domain.ErrTokenKey,
+			},
+		},
+		{
+			desc: "Fail_KeyFileNotFound",
+			input: newTestedInput{
+				config: &config.Token{
+					KeyFile: filepath.Join(dir, "missing"),
+				},
+			},
+			expected: newExpectedOutput{
+				err: domain.ErrTokenKey,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.desc, func(t *testing.T) {
+			t.Parallel()
+
+			ring, err := New(tc.input.config, func(encoded string) ([]byte, error) {
+				return Decode(encoded, 32)
+			})
+			assert.ErrorIs(t, err, tc.expected.err, "Error mismatch")
+
+			if tc.expected.err == nil {
+				activeID, _ := ring.Active()
+				assert.Equal(t, tc.expected.activeID, activeID, "Active key id mismatch")
+				assert.Equal(t, tc.expected.ids, ring.IDs(), "Key ids mismatch")
+			}
+		})
+	}
+}
+
+type decodeTestedInput struct {
+	encoded string
+	sizes   []int
+}
+
+type decodeExpectedOutput struct {
+	key []byte
+	err bool
+}
+
+func TestDecode(t *testing.T) {
+	key := []byte(strings.Repeat("k", 32))
+
+	testCases := []struct {
+		desc     string
+		input    decodeTestedInput
+		expected decodeExpectedOutput
+	}{
+		{
+			desc: "Success_Hex",
+			input: decodeTestedInput{
+				encoded: hex.EncodeToString(key),
+				sizes:   []int{32},
+			},
+			expected: decodeExpectedOutput{
+				key: key,
+			},
+		},
+		{
+			desc: "Success_Base64",
+			input: decodeTestedInput{
+				encoded: "a2tra2tra2tra2tra2tra2tra2tra2tra2tra2tra2s=",
+				sizes:   []int{32},
+			},
+			expected: decodeExpectedOutput{
+				key: key,
+			},
+		},
+		{
+			desc: "Fail_WrongSize",
+			input: decodeTestedInput{
+				encoded: hex.EncodeToString(key[:16]),
+				sizes:   []int{32},
+			},
+			expected: decodeExpectedOutput{
+				err: true,
+			},
+		},
+		{
+			desc: "Fail_NotEncoded",
+			input: decodeTestedInput{
+				encoded: strings.Repeat("!", 64),
+				sizes:   []int{32},
+			},
+			expected: decodeExpectedOutput{
+				err: true,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.desc, func(t *testing.T) {
+			t.Parallel()
+
+			key, err := Decode(tc.input.encoded, tc.input.sizes...)
+			assert.Equal(t, tc.expected.err, err != nil, "Error mismatch")
+			assert.Equal(t, tc.expected.key, key, "Key mismatch")
+		})
+	}
+}
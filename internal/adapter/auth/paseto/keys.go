@@ -0,0 +1,26 @@
+package paseto
+
+import (
+	"aidanwoods.dev/go-paseto"
+	"github.com/TienMinh25/go-hexagonal-architecture/internal/adapter/auth/keyring"
+)
+
+// parseSymmetricKey decodes a hex or base64 encoded 32 byte v4.local key
+func parseSymmetricKey(encoded string) (paseto.V4SymmetricKey, error) {
+	b, err := keyring.Decode(encoded, 32)
+	if err != nil {
+		return paseto.V4SymmetricKey{}, err
+	}
+
+	return paseto.V4SymmetricKeyFromBytes(b)
+}
+
+// parseSecretKey decodes an Ed25519 v4.public secret key
+func parseSecretKey(encoded string) (paseto.V4AsymmetricSecretKey, error) {
+	privateKey, err := keyring.ParseEd25519(encoded)
+	if err != nil {
+		return paseto.V4AsymmetricSecretKey{}, err
+	}
+
+	return paseto.NewV4AsymmetricSecretKeyFromEd25519(privateKey)
+}
@@ -1,10 +1,15 @@
 package paseto
 
 import (
+	"context"
+	"crypto/ed25519"
+	"encoding/json"
 	"time"
 
 	"aidanwoods.dev/go-paseto"
 	"github.com/TienMinh25/go-hexagonal-architecture/config"
+	"github.com/TienMinh25/go-hexagonal-architecture/internal/adapter/auth/keyring"
+	"github.com/TienMinh25/go-hexagonal-architecture/internal/adapter/auth/revocation"
 	"github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain"
 	domainauth "github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain/auth"
 	domainuser "github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain/user"
@@ -13,71 +18,139 @@ import (
 )
 
 /**
- * pasetoToken implements portout.TokenService interface
- * and provides an access to the paseto library
+ * pasetoToken implements port.TokenService interface
+ * and provides an access to the paseto library,
+ * creating either v4.local or v4.public tokens
  */
 type pasetoToken struct {
-	token    *paseto.Token
-	key      *paseto.V4SymmetricKey
-	parser   *paseto.Parser
-	duration time.Duration
+	localKeys        *keyring.Ring[paseto.V4SymmetricKey]
+	publicKeys       *keyring.Ring[paseto.V4AsymmetricSecretKey]
+	parser           *paseto.Parser
+	duration         time.Duration
+	terminalDuration time.Duration
+	revoked          *revocation.List
 }
 
-// New creates a new paseto instance
-func New(config *config.Token) (port.TokenService, error) {
-	durationStr := config.Duration
-	duration, err := time.ParseDuration(durationStr)
-	if err != nil {
+// footer is the unencrypted part of the token telling which key it was created with
+type footer struct {
+	KeyID string `json:"kid"`
+}
+
+// New creates a new paseto instance, keeping revoked token ids in the cache.
+// Tokens are signed with Ed25519 keys when the token type is public and encrypted otherwise
+func New(config *config.Token, cache port.CacheRepository) (port.TokenService, error) {
+	duration := config.Duration
+	if duration <= 0 {
 		return nil, domain.ErrTokenDuration
 	}
 
-	token := paseto.NewToken()
-	key := paseto.NewV4SymmetricKey()
 	parser := paseto.NewParser()
 
-	return &pasetoToken{
-		&token,
-		&key,
-		&parser,
-		duration,
-	}, nil
+	pt := &pasetoToken{
+		parser:           &parser,
+		duration:         duration,
+		terminalDuration: config.TerminalDuration,
+		revoked:          revocation.New(cache),
+	}
+
+	var err error
+	if config.Type == "public" {
+		pt.publicKeys, err = keyring.New(config, parseSecretKey)
+	} else {
+		pt.localKeys, err = keyring.New(config, parseSymmetricKey)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return pt, nil
 }
 
 // CreateToken creates a new paseto token
-func (pt *pasetoToken) CreateToken(user *domainuser.User) (string, error) {
+func (pt *pasetoToken) CreateToken(user *domainuser.User, permissions []string) (string, error) {
+	return pt.createToken(user, permissions, 0, pt.duration)
+}
+
+// CreateTerminalToken creates a new short-lived paseto token carrying the terminal id
+func (pt *pasetoToken) CreateTerminalToken(user *domainuser.User, permissions []string, terminalID uint64) (string, error) {
+	return pt.createToken(user, permissions, terminalID, pt.terminalDuration)
+}
+
+// createToken creates a paseto token of the given lifetime, signed or encrypted with the active key
+func (pt *pasetoToken) createToken(user *domainuser.User, permissions []string, terminalID uint64, duration time.Duration) (string, error) {
 	id, err := uuid.NewRandom()
 	if err != nil {
 		return "", domain.ErrTokenCreation
 	}
 
+	issuedAt := time.Now()
+	expiredAt := issuedAt.Add(duration)
+
 	payload := &domainauth.TokenPayload{
-		ID:     id,
-		UserID: user.ID,
-		Role:   user.Role,
+		ID:          id,
+		UserID:      user.ID,
+		Role:        user.Role,
+		Locale:      user.Locale,
+		Permissions: permissions,
+		TerminalID:  terminalID,
+		ExpiredAt:   expiredAt,
 	}
 
-	err = pt.token.Set("payload", payload)
+	token := paseto.NewToken()
+
+	err = token.Set("payload", payload)
 	if err != nil {
 		return "", domain.ErrTokenCreation
 	}
 
-	issuedAt := time.Now()
-	expiredAt := issuedAt.Add(pt.duration)
+	token.SetIssuedAt(issuedAt)
+	token.SetNotBefore(issuedAt)
+	token.SetExpiration(expiredAt)
 
-	pt.token.SetIssuedAt(issuedAt)
-	pt.token.SetNotBefore(issuedAt)
-	pt.token.SetExpiration(expiredAt)
+	if pt.publicKeys != nil {
+		keyID, key := pt.publicKeys.Active()
+		if err := setFooter(&token, keyID); err != nil {
+			return "", err
+		}
+		return token.V4Sign(key, nil), nil
+	}
 
-	token := pt.token.V4Encrypt(*pt.key, nil)
+	keyID, key := pt.localKeys.Active()
+	if err := setFooter(&token, keyID); err != nil {
+		return "", err
+	}
 
-	return token, nil
+	return token.V4Encrypt(key, nil), nil
 }
 
-// VerifyToken verifies the paseto token
-func (pt *pasetoToken) VerifyToken(token string) (*domainauth.TokenPayload, error) {
+// VerifyToken verifies the paseto token with the key named in its footer and checks it is not revoked
+func (pt *pasetoToken) VerifyToken(ctx context.Context, token string) (*domainauth.TokenPayload, error) {
 	var payload *domainauth.TokenPayload
+	var parsedToken *paseto.Token
 
-	parsedToken, err := pt.parser.ParseV4Local(*pt.key, token, nil)
+	protocol := paseto.V4Local
+	if pt.publicKeys != nil {
+		protocol = paseto.V4Public
+	}
+
+	keyID, err := pt.parseKeyID(protocol, token)
+	if err != nil {
+		return nil, err
+	}
+
+	if pt.publicKeys != nil {
+		key, ok := pt.publicKeys.Get(keyID)
+		if !ok {
+			return nil, domain.ErrInvalidToken
+		}
+		parsedToken, err = pt.parser.ParseV4Public(key.Public(), token, nil)
+	} else {
+		key, ok := pt.localKeys.Get(keyID)
+		if !ok {
+			return nil, domain.ErrInvalidToken
+		}
+		parsedToken, err = pt.parser.ParseV4Local(key, token, nil)
+	}
 	if err != nil {
 		if err.Error() == "this token has expired" {
 			return nil, domain.ErrExpiredToken
@@ -90,5 +163,67 @@ func (pt *pasetoToken) VerifyToken(token string) (*domainauth.TokenPayload, erro
 		return nil, domain.ErrInvalidToken
 	}
 
+	revoked, err := pt.revoked.IsRevoked(ctx, payload)
+	if err != nil {
+		return nil, domain.ErrInternal.Wrap(err)
+	}
+
+	if revoked {
+		return nil, domain.ErrRevokedToken
+	}
+
 	return payload, nil
 }
+
+// RevokeToken adds the token id to the revocation list until the token expires
+func (pt *pasetoToken) RevokeToken(ctx context.Context, payload *domainauth.TokenPayload) error {
+	return pt.revoked.Revoke(ctx, payload)
+}
+
+// PublicKeys returns the active and retired v4.public keys, none for v4.local tokens
+func (pt *pasetoToken) PublicKeys() []domainauth.PublicKey {
+	if pt.publicKeys == nil {
+		return nil
+	}
+
+	var keys []domainauth.PublicKey
+	for _, id := range pt.publicKeys.IDs() {
+		key, _ := pt.publicKeys.Get(id)
+		keys = append(keys, domainauth.PublicKey{
+			ID:        id,
+			Algorithm: "v4.public",
+			Key:       ed25519.PublicKey(key.Public().ExportBytes()),
+		})
+	}
+
+	return keys
+}
+
+// parseKeyID reads the id of the key the token was created with from its footer
+func (pt *pasetoToken) parseKeyID(protocol paseto.Protocol, token string) (string, error) {
+	var tokenFooter footer
+
+	rawFooter, err := pt.parser.UnsafeParseFooter(protocol, token)
+	if err != nil {
+		return "", domain.ErrInvalidToken
+	}
+
+	err = json.Unmarshal(rawFooter, &tokenFooter)
+	if err != nil {
+		return "", domain.ErrInvalidToken
+	}
+
+	return tokenFooter.KeyID, nil
+}
+
+// setFooter names the key the token is created with in its footer
+func setFooter(token *paseto.Token, keyID string) error {
+	tokenFooter, err := json.Marshal(footer{KeyID: keyID})
+	if err != nil {
+		return domain.ErrTokenCreation
+	}
+
+	token.SetFooter(tokenFooter)
+
+	return nil
+}
@@ -0,0 +1,314 @@
+package paseto
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"aidanwoods.dev/go-paseto"
+	"github.com/TienMinh25/go-hexagonal-architecture/config"
+	"github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain"
+	domainuser "github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain/user"
+	"github.com/TienMinh25/go-hexagonal-architecture/internal/application/mock"
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+type newTestedInput struct {
+	config *config.Token
+}
+
+type newExpectedOutput struct {
+	err error
+}
+
+func TestNew(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		input    newTestedInput
+		expected newExpectedOutput
+	}{
+		{
+			desc: "Success_Local",
+			input: newTestedInput{
+				config: &config.Token{
+					Duration: time.Minute,
+					Keys:     []string{"2025:" + strings.Repeat("a", 64)},
+				},
+			},
+			expected: newExpectedOutput{
+				err: nil,
+			},
+		},
+		{
+			desc: "Success_Public",
+			input: newTestedInput{
+				config: &config.Token{
+					Type:     "public",
+					Duration: time.Minute,
+					Keys:     []string{"2025:" + strings.Repeat("a", 64)},
+				},
+			},
+			expected: newExpectedOutput{
+				err: nil,
+			},
+		},
+		{
+			desc: "Fail_LocalMalformedKey",
+			input: newTestedInput{
+				config: &config.Token{
+					Duration: time.Minute,
+					Keys:     []string{"2025:" + strings.Repeat("a", 32)},
+				},
+			},
+			expected: newExpectedOutput{
+				err: domain.ErrTokenKey,
+			},
+		},
+		{
+			desc: "Fail_PublicMalformedKey",
+			input: newTestedInput{
+				config: &config.Token{
+					Type:     "public",
+					Duration: time.Minute,
+					Keys:     []string{"2025:not-a-key"},
+				},
+			},
+			expected: newExpectedOutput{
+				err: domain.ErrTokenKey,
+			},
+		},
+		{
+			desc: "Fail_DuplicateKeyID",
+			input: newTestedInput{
+				config: &config.Token{
+					Duration:    time.Minute,
+					Keys:        []string{"2025:" + strings.Repeat("a", 64), "2025:" + strings.Repeat("b", 64)},
+					ActiveKeyID: "2025",
+				},
+			},
+			expected: newExpectedOutput{
+				err: domain.ErrTokenKey,
+			},
+		},
+		{
+			desc: "Fail_ActiveKeyIDMissing",
+			input: newTestedInput{
+				config: &config.Token{
+					Duration: time.Minute,
+					Keys:     []string{"2024:" + strings.Repeat("a", 64), "2025:" + strings.Repeat("b", 64)},
+				},
+			},
+			expected: newExpectedOutput{
+				err: domain.ErrTokenKey,
+			},
+		},
+		{
+			desc: "Fail_InvalidDuration",
+			input: newTestedInput{
+				config: &config.Token{
+					Keys: []string{"2025:" + strings.Repeat("a", 64)},
+				},
+			},
+			expected: newExpectedOutput{
+				err: domain.ErrTokenDuration,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.desc, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := New(tc.input.config, nil)
+			assert.ErrorIs(t, err, tc.expected.err, "Error mismatch")
+		})
+	}
+}
+
+type verifyTokenTestedInput struct {
+	signer   *config.Token
+	verifier *config.Token
+}
+
+type verifyTokenExpectedOutput struct {
+	keyID string
+	err   error
+}
+
+func TestPasetoToken_VerifyToken(t *testing.T) {
+	ctx := context.Background()
+	oldKey := "2024:" + strings.Repeat("a", 64)
+	newKey := "2025:" + strings.Repeat("b", 64)
+
+	user := &domainuser.User{
+		ID:   gofakeit.Uint64(),
+		Role: "admin",
+	}
+
+	testCases := []struct {
+		desc     string
+		input    verifyTokenTestedInput
+		expected verifyTokenExpectedOutput
+	}{
+		{
+			desc: "Success_LocalActiveKey",
+			input: verifyTokenTestedInput{
+				signer: &config.Token{
+					Keys: []string{newKey},
+				},
+				verifier: &config.Token{
+					Keys: []string{newKey},
+				},
+			},
+			expected: verifyTokenExpectedOutput{
+				keyID: "2025",
+				err:   nil,
+			},
+		},
+		{
+			desc: "Success_LocalRetiredKey",
+			input: verifyTokenTestedInput{
+				signer: &config.Token{
+					Keys: []string{oldKey},
+				},
+				verifier: &config.Token{
+					Keys:        []string{oldKey, newKey},
+					ActiveKeyID: "2025",
+				},
+			},
+			expected: verifyTokenExpectedOutput{
+				keyID: "2024",
+				err:   nil,
+			},
+		},
+		{
+			desc: "Success_PublicActiveKey",
+			input: verifyTokenTestedInput{
+				signer: &config.Token{
+					Type: "public",
+					Keys: []string{newKey},
+				},
+				verifier: &config.Token{
+					Type: "public",
+					Keys: []string{newKey},
+				},
+			},
+			expected: verifyTokenExpectedOutput{
+				keyID: "2025",
+				err:   nil,
+			},
+		},
+		{
+			desc: "Success_PublicRetiredKey",
+			input: verifyTokenTestedInput{
+				signer: &config.Token{
+					Type: "public",
+					Keys: []string{oldKey},
+				},
+				verifier: &config.Token{
+					Type:        "public",
+					Keys:        []string{oldKey, newKey},
+					ActiveKeyID: "2025",
+				},
+			},
+			expected: verifyTokenExpectedOutput{
+				keyID: "2024",
+				err:   nil,
+			},
+		},
+		{
+			desc: "Fail_LocalUnknownKeyID",
+			input: verifyTokenTestedInput{
+				signer: &config.Token{
+					Keys: []string{oldKey},
+				},
+				verifier: &config.Token{
+					Keys: []string{newKey},
+				},
+			},
+			expected: verifyTokenExpectedOutput{
+				keyID: "2024",
+				err:   domain.ErrInvalidToken,
+			},
+		},
+		{
+			desc: "Fail_PublicUnknownKeyID",
+			input: verifyTokenTestedInput{
+				signer: &config.Token{
+					Type: "public",
+					Keys: []string{oldKey},
+				},
+				verifier: &config.Token{
+					Type: "public",
+					Keys: []string{newKey},
+				},
+			},
+			expected: verifyTokenExpectedOutput{
+				keyID: "2024",
+				err:   domain.ErrInvalidToken,
+			},
+		},
+		{
+			desc: "Fail_PublicKeyReplacedUnderSameID",
+			input: verifyTokenTestedInput{
+				signer: &config.Token{
+					Type: "public",
+					Keys: []string{"2025:" + strings.Repeat("a", 64)},
+				},
+				verifier: &config.Token{
+					Type: "public",
+					Keys: []string{newKey},
+				},
+			},
+			expected: verifyTokenExpectedOutput{
+				keyID: "2025",
+				err:   domain.ErrInvalidToken,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.desc, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			cache := mock.NewMockCacheRepository(ctrl)
+			cache.EXPECT().
+				Get(gomock.Any(), gomock.Any()).
+				Return(nil, domain.ErrDataNotFound).
+				AnyTimes()
+
+			tc.input.signer.Duration = time.Minute
+			tc.input.verifier.Duration = time.Minute
+
+			signer, err := New(tc.input.signer, cache)
+			assert.NoError(t, err, "Signer mismatch")
+			verifier, err := New(tc.input.verifier, cache)
+			assert.NoError(t, err, "Verifier mismatch")
+
+			token, err := signer.CreateToken(user, nil)
+			assert.NoError(t, err, "Token mismatch")
+
+			protocol := paseto.V4Local
+			if tc.input.signer.Type == "public" {
+				protocol = paseto.V4Public
+			}
+			keyID, err := signer.(*pasetoToken).parseKeyID(protocol, token)
+			assert.NoError(t, err, "Footer mismatch")
+			assert.Equal(t, tc.expected.keyID, keyID, "Key id mismatch")
+
+			payload, err := verifier.VerifyToken(ctx, token)
+			assert.ErrorIs(t, err, tc.expected.err, "Error mismatch")
+
+			if tc.expected.err == nil {
+				assert.Equal(t, user.ID, payload.UserID, "User id mismatch")
+			}
+		})
+	}
+}
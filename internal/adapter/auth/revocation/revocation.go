@@ -0,0 +1,55 @@
+package revocation
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain"
+	domainauth "github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain/auth"
+	"github.com/TienMinh25/go-hexagonal-architecture/internal/application/port"
+	"github.com/TienMinh25/go-hexagonal-architecture/internal/application/util"
+)
+
+// List keeps the ids of revoked tokens in the cache until the tokens expire,
+// so that every token service checks revocation the same way
+type List struct {
+	cache port.CacheRepository
+}
+
+// New creates a new revocation list backed by the cache
+func New(cache port.CacheRepository) *List {
+	return &List{
+		cache,
+	}
+}
+
+// Revoke adds the token id to the list until the token expires
+func (l *List) Revoke(ctx context.Context, payload *domainauth.TokenPayload) error {
+	ttl := time.Until(payload.ExpiredAt)
+	if ttl <= 0 {
+		return nil
+	}
+
+	return l.cache.Set(ctx, key(payload), []byte{1}, ttl)
+}
+
+// IsRevoked reports whether the token of the payload has been revoked.
+// Only a cache miss means the token is not revoked, an unreachable cache fails closed
+func (l *List) IsRevoked(ctx context.Context, payload *domainauth.TokenPayload) (bool, error) {
+	_, err := l.cache.Get(ctx, key(payload))
+	if err == nil {
+		return true, nil
+	}
+
+	if errors.Is(err, domain.ErrDataNotFound) {
+		return false, nil
+	}
+
+	return true, err
+}
+
+// key returns the cache key marking the token of the payload as revoked
+func key(payload *domainauth.TokenPayload) string {
+	return util.GenerateCacheKey("revoked_token", payload.ID)
+}
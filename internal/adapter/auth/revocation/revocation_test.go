@@ -0,0 +1,189 @@
+package revocation
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain"
+	domainauth "github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain/auth"
+	"github.com/TienMinh25/go-hexagonal-architecture/internal/application/mock"
+	"github.com/TienMinh25/go-hexagonal-architecture/internal/application/util"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+type isRevokedTestedInput struct {
+	payload *domainauth.TokenPayload
+}
+
+type isRevokedExpectedOutput struct {
+	revoked bool
+	err     error
+}
+
+func TestList_IsRevoked(t *testing.T) {
+	ctx := context.Background()
+	payload := &domainauth.TokenPayload{
+		ID:        uuid.New(),
+		ExpiredAt: time.Now().Add(time.Minute),
+	}
+	cacheKey := util.GenerateCacheKey("revoked_token", payload.ID)
+
+	testCases := []struct {
+		desc     string
+		mocks    func(cache *mock.MockCacheRepository)
+		input    isRevokedTestedInput
+		expected isRevokedExpectedOutput
+	}{
+		{
+			desc: "Success_NotRevoked",
+			mocks: func(cache *mock.MockCacheRepository) {
+				cache.EXPECT().
+					Get(gomock.Any(), gomock.Eq(cacheKey)).
+					Return(nil, domain.ErrDataNotFound)
+			},
+			input: isRevokedTestedInput{
+				payload: payload,
+			},
+			expected: isRevokedExpectedOutput{
+				revoked: false,
+				err:     nil,
+			},
+		},
+		{
+			desc: "Success_Revoked",
+			mocks: func(cache *mock.MockCacheRepository) {
+				cache.EXPECT().
+					Get(gomock.Any(), gomock.Eq(cacheKey)).
+					Return([]byte{1}, nil)
+			},
+			input: isRevokedTestedInput{
+				payload: payload,
+			},
+			expected: isRevokedExpectedOutput{
+				revoked: true,
+				err:     nil,
+			},
+		},
+		{
+			desc: "Fail_CacheUnavailable",
+			mocks: func(cache *mock.MockCacheRepository) {
+				cache.EXPECT().
+					Get(gomock.Any(), gomock.Eq(cacheKey)).
+					Return(nil, domain.ErrInternal)
+			},
+			input: isRevokedTestedInput{
+				payload: payload,
+			},
+			expected: isRevokedExpectedOutput{
+				revoked: true,
+				err:     domain.ErrInternal,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.desc, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			cache := mock.NewMockCacheRepository(ctrl)
+
+			tc.mocks(cache)
+
+			list := New(cache)
+
+			revoked, err := list.IsRevoked(ctx, tc.input.payload)
+			assert.ErrorIs(t, err, tc.expected.err, "Error mismatch")
+			assert.Equal(t, tc.expected.revoked, revoked, "Revoked mismatch")
+		})
+	}
+}
+
+type revokeTestedInput struct {
+	payload *domainauth.TokenPayload
+}
+
+type revokeExpectedOutput struct {
+	err error
+}
+
+func TestList_Revoke(t *testing.T) {
+	ctx := context.Background()
+	payload := &domainauth.TokenPayload{
+		ID:        uuid.New(),
+		ExpiredAt: time.Now().Add(time.Minute),
+	}
+	expiredPayload := &domainauth.TokenPayload{
+		ID:        uuid.New(),
+		ExpiredAt: time.Now().Add(-time.Minute),
+	}
+
+	testCases := []struct {
+		desc     string
+		mocks    func(cache *mock.MockCacheRepository)
+		input    revokeTestedInput
+		expected revokeExpectedOutput
+	}{
+		{
+			desc: "Success",
+			mocks: func(cache *mock.MockCacheRepository) {
+				cache.EXPECT().
+					Set(gomock.Any(), gomock.Eq(util.GenerateCacheKey("revoked_token", payload.ID)), gomock.Any(), gomock.Any()).
+					Return(nil)
+			},
+			input: revokeTestedInput{
+				payload: payload,
+			},
+			expected: revokeExpectedOutput{
+				err: nil,
+			},
+		},
+		{
+			desc:  "Success_AlreadyExpired",
+			mocks: func(cache *mock.MockCacheRepository) {},
+			input: revokeTestedInput{
+				payload: expiredPayload,
+			},
+			expected: revokeExpectedOutput{
+				err: nil,
+			},
+		},
+		{
+			desc: "Fail_CacheUnavailable",
+			mocks: func(cache *mock.MockCacheRepository) {
+				cache.EXPECT().
+					Set(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(domain.ErrInternal)
+			},
+			input: revokeTestedInput{
+				payload: payload,
+			},
+			expected: revokeExpectedOutput{
+				err: domain.ErrInternal,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.desc, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			cache := mock.NewMockCacheRepository(ctrl)
+
+			tc.mocks(cache)
+
+			list := New(cache)
+
+			err := list.Revoke(ctx, tc.input.payload)
+			assert.ErrorIs(t, err, tc.expected.err, "Error mismatch")
+		})
+	}
+}
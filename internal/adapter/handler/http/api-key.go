@@ -0,0 +1,142 @@
+package http
+
+import (
+	domainauth "github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain/auth"
+	"github.com/TienMinh25/go-hexagonal-architecture/internal/application/port"
+	modelv1 "github.com/TienMinh25/go-hexagonal-architecture/pkg/model/v1"
+	"github.com/gin-gonic/gin"
+)
+
+// APIKeyHandler represents the HTTP handler for API key-related requests
+type APIKeyHandler struct {
+	svc port.APIKeyService
+}
+
+// NewAPIKeyHandler creates a new APIKeyHandler instance
+func NewAPIKeyHandler(svc port.APIKeyService) *APIKeyHandler {
+	return &APIKeyHandler{
+		svc,
+	}
+}
+
+// CreateAPIKey godoc
+//
+//	@Summary		Create a new API key
+//	@Description	Create an API key for a machine client, scoped to permissions the creator holds. The key is sent in the X-API-Key header and is only shown once.
+//	@Tags			API Keys
+//	@Accept			json
+//	@Produce		json
+//	@Param			createAPIKeyRequest	body		modelv1.CreateAPIKeyRequest		true	"Create API key request"
+//	@Success		200					{object}	modelv1.CreateAPIKeyResponse	"API key created"
+//	@Failure		400					{object}	modelv1.ErrorResponse			"Validation error"
+//	@Failure		401					{object}	modelv1.ErrorResponse			"Unauthorized error"
+//	@Failure		403					{object}	modelv1.ErrorResponse			"Forbidden error"
+//	@Failure		500					{object}	modelv1.ErrorResponse			"Internal server error"
+//	@Router			/api-keys [post]
+//	@Security		BearerAuth
+func (ah *APIKeyHandler) CreateAPIKey(ctx *gin.Context) {
+	var req modelv1.CreateAPIKeyRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		validationError(ctx, err)
+		return
+	}
+
+	authPayload := getAuthPayload(ctx, authorizationPayloadKey)
+
+	key := domainauth.APIKey{
+		Name:      req.Name,
+		Scopes:    req.Scopes,
+		CreatedBy: authPayload.UserID,
+		ExpiresAt: req.ExpiresAt,
+	}
+
+	createdKey, value, err := ah.svc.CreateAPIKey(ctx, &key, authPayload.Permissions)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	rsp := modelv1.CreateAPIKeyResponse{
+		APIKeyResponse: newAPIKeyResponse(createdKey),
+		Key:            value,
+	}
+
+	handleSuccess(ctx, rsp)
+}
+
+// ListAPIKeys godoc
+//
+//	@Summary		List API keys
+//	@Description	List active, expired and revoked API keys with pagination
+//	@Tags			API Keys
+//	@Accept			json
+//	@Produce		json
+//	@Param			skip	query		uint64					true	"Skip"
+//	@Param			limit	query		uint64					true	"Limit"
+//	@Success		200		{object}	modelv1.Meta			"API keys displayed"
+//	@Failure		400		{object}	modelv1.ErrorResponse	"Validation error"
+//	@Failure		401		{object}	modelv1.ErrorResponse	"Unauthorized error"
+//	@Failure		403		{object}	modelv1.ErrorResponse	"Forbidden error"
+//	@Failure		500		{object}	modelv1.ErrorResponse	"Internal server error"
+//	@Router			/api-keys [get]
+//	@Security		BearerAuth
+func (ah *APIKeyHandler) ListAPIKeys(ctx *gin.Context) {
+	var req modelv1.ListAPIKeysRequest
+	var keysList []modelv1.APIKeyResponse
+
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		validationError(ctx, err)
+		return
+	}
+
+	keys, err := ah.svc.ListAPIKeys(ctx, req.Skip, req.Limit)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	for _, key := range keys {
+		keysList = append(keysList, newAPIKeyResponse(&key))
+	}
+
+	total := uint64(len(keysList))
+	meta := newMeta(total, req.Limit, req.Skip)
+	rsp := toMap(meta, keysList, "api_keys")
+
+	handleSuccess(ctx, rsp)
+}
+
+// RevokeAPIKey godoc
+//
+//	@Summary		Revoke an API key
+//	@Description	Revoke an API key so that requests sending it are rejected
+//	@Tags			API Keys
+//	@Accept			json
+//	@Produce		json
+//	@Param			id	path		uint64					true	"API key ID"
+//	@Success		200	{object}	modelv1.APIKeyResponse	"API key revoked"
+//	@Failure		400	{object}	modelv1.ErrorResponse	"Validation error"
+//	@Failure		401	{object}	modelv1.ErrorResponse	"Unauthorized error"
+//	@Failure		403	{object}	modelv1.ErrorResponse	"Forbidden error"
+//	@Failure		404	{object}	modelv1.ErrorResponse	"Data not found error"
+//	@Failure		409	{object}	modelv1.ErrorResponse	"Data conflict error"
+//	@Failure		500	{object}	modelv1.ErrorResponse	"Internal server error"
+//	@Router			/api-keys/{id} [delete]
+//	@Security		BearerAuth
+func (ah *APIKeyHandler) RevokeAPIKey(ctx *gin.Context) {
+	var req modelv1.RevokeAPIKeyRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		validationError(ctx, err)
+		return
+	}
+
+	key, err := ah.svc.RevokeAPIKey(ctx, req.ID)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	rsp := newAPIKeyResponse(key)
+
+	handleSuccess(ctx, rsp)
+}
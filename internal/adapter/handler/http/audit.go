@@ -0,0 +1,102 @@
+package http
+
+import (
+	domainaudit "github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain/audit"
+	"github.com/TienMinh25/go-hexagonal-architecture/internal/application/port"
+	modelv1 "github.com/TienMinh25/go-hexagonal-architecture/pkg/model/v1"
+	"github.com/gin-gonic/gin"
+)
+
+// AuditHandler represents the HTTP handler for audit log-related requests
+type AuditHandler struct {
+	svc port.AuditService
+}
+
+// NewAuditHandler creates a new AuditHandler instance
+func NewAuditHandler(svc port.AuditService) *AuditHandler {
+	return &AuditHandler{
+		svc,
+	}
+}
+
+// ListAuditLogs godoc
+//
+//	@Summary		List audit logs
+//	@Description	List the changes made through the API with who made them, newest first, filtered by actor, action, entity and time
+//	@Tags			Audit Logs
+//	@Accept			json
+//	@Produce		json
+//	@Param			actor_id	query		uint64					false	"Actor user ID"
+//	@Param			action		query		string					false	"Action"	Enums(create, update, delete, restore, revoke, refund, top_up, unlock, set_pin, set_permissions, change_password, reset_password)
+//	@Param			entity		query		string					false	"Entity"	Enums(user, invitation, role, terminal, api_key, payment, category, product, customer, order, gift_card)
+//	@Param			entity_id	query		uint64					false	"Entity ID"
+//	@Param			from		query		string					false	"From time, inclusive"	Format(date-time)
+//	@Param			to			query		string					false	"To time, exclusive"	Format(date-time)
+//	@Param			skip		query		uint64					true	"Skip"
+//	@Param			limit		query		uint64					true	"Limit"
+//	@Success		200			{object}	modelv1.Meta			"Audit logs displayed"
+//	@Failure		400			{object}	modelv1.ErrorResponse	"Validation error"
+//	@Failure		401			{object}	modelv1.ErrorResponse	"Unauthorized error"
+//	@Failure		403			{object}	modelv1.ErrorResponse	"Forbidden error"
+//	@Failure		500			{object}	modelv1.ErrorResponse	"Internal server error"
+//	@Router			/audit-logs [get]
+//	@Security		BearerAuth
+func (ah *AuditHandler) ListAuditLogs(ctx *gin.Context) {
+	var req modelv1.ListAuditLogsRequest
+	var logsList []modelv1.AuditLogResponse
+
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		validationError(ctx, err)
+		return
+	}
+
+	filter := domainaudit.Filter{
+		ActorID:  req.ActorID,
+		Action:   domainaudit.Action(req.Action),
+		Entity:   req.Entity,
+		EntityID: req.EntityID,
+		From:     req.From,
+		To:       req.To,
+	}
+
+	logs, err := ah.svc.ListAuditLogs(ctx, filter, req.Skip, req.Limit)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	for _, log := range logs {
+		logsList = append(logsList, newAuditLogResponse(&log))
+	}
+
+	total := uint64(len(logsList))
+	meta := newMeta(total, req.Limit, req.Skip)
+	rsp := toMap(meta, logsList, "audit_logs")
+
+	handleSuccess(ctx, rsp)
+}
+
+// VerifyAuditLogs godoc
+//
+//	@Summary		Verify the audit log
+//	@Description	Check the hash chain of the audit log to detect logs that have been changed or removed
+//	@Tags			Audit Logs
+//	@Accept			json
+//	@Produce		json
+//	@Success		200	{object}	modelv1.AuditVerificationResponse	"Audit log verified"
+//	@Failure		401	{object}	modelv1.ErrorResponse				"Unauthorized error"
+//	@Failure		403	{object}	modelv1.ErrorResponse				"Forbidden error"
+//	@Failure		500	{object}	modelv1.ErrorResponse				"Internal server error"
+//	@Router			/audit-logs/verify [get]
+//	@Security		BearerAuth
+func (ah *AuditHandler) VerifyAuditLogs(ctx *gin.Context) {
+	verification, err := ah.svc.VerifyAuditLogs(ctx)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	rsp := newAuditVerificationResponse(verification)
+
+	handleSuccess(ctx, rsp)
+}
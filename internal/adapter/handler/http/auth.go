@@ -21,14 +21,16 @@ func NewAuthHandler(svc port.AuthService) *AuthHandler {
 // Login godoc
 //
 //	@Summary		Login and get an access token
-//	@Description	Logs in a registered user and returns an access token if the credentials are valid.
+//	@Description	Logs in a registered user and returns an access token and a refresh token if the credentials are valid. Users with two-factor authentication get a challenge to complete at /auth/2fa/verify instead. Failed logins back off exponentially per email and per client address, and lock the account after too many failures.
 //	@Tags			Users
 //	@Accept			json
 //	@Produce		json
 //	@Param			request	body		modelv1.LoginRequest	true	"Login request body"
-//	@Success		200		{object}	modelv1.AuthResponse	"Succesfully logged in"
+//	@Success		200		{object}	modelv1.LoginResponse	"Succesfully logged in"
 //	@Failure		400		{object}	modelv1.ErrorResponse	"Validation error"
 //	@Failure		401		{object}	modelv1.ErrorResponse	"Unauthorized error"
+//	@Failure		423		{object}	modelv1.ErrorResponse	"Account locked error"
+//	@Failure		429		{object}	modelv1.ErrorResponse	"Too many attempts error"
 //	@Failure		500		{object}	modelv1.ErrorResponse	"Internal server error"
 //	@Router			/users/login [post]
 func (ah *AuthHandler) Login(ctx *gin.Context) {
@@ -38,14 +40,319 @@ func (ah *AuthHandler) Login(ctx *gin.Context) {
 		return
 	}
 
-	token, err := ah.svc.Login(ctx, req.Email, req.Password)
+	result, err := ah.svc.Login(ctx, req.Email, req.Password, ctx.ClientIP())
 
 	if err != nil {
 		handleError(ctx, err)
 		return
 	}
 
-	rsp := newAuthResponse(token)
+	rsp := newLoginResponse(result)
 
 	handleSuccess(ctx, rsp)
 }
+
+// Refresh godoc
+//
+//	@Summary		Refresh the access token
+//	@Description	Exchanges a refresh token for a new access token and refresh token. A refresh token can be used once, using it again revokes every token rotated from the same login.
+//	@Tags			Auth
+//	@Accept			json
+//	@Produce		json
+//	@Param			request	body		modelv1.RefreshRequest	true	"Refresh request body"
+//	@Success		200		{object}	modelv1.AuthResponse	"Succesfully refreshed"
+//	@Failure		400		{object}	modelv1.ErrorResponse	"Validation error"
+//	@Failure		401		{object}	modelv1.ErrorResponse	"Unauthorized error"
+//	@Failure		500		{object}	modelv1.ErrorResponse	"Internal server error"
+//	@Router			/auth/refresh [post]
+func (ah *AuthHandler) Refresh(ctx *gin.Context) {
+	var req modelv1.RefreshRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		validationError(ctx, err)
+		return
+	}
+
+	tokens, err := ah.svc.Refresh(ctx, req.RefreshToken)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	rsp := newAuthResponse(tokens)
+
+	handleSuccess(ctx, rsp)
+}
+
+// Logout godoc
+//
+//	@Summary		Logout
+//	@Description	Revokes the access token of the request and, when given, the refresh token along with every token rotated from the same login.
+//	@Tags			Auth
+//	@Accept			json
+//	@Produce		json
+//	@Param			request	body		modelv1.LogoutRequest	false	"Logout request body"
+//	@Success		200		{object}	modelv1.Response		"Succesfully logged out"
+//	@Failure		400		{object}	modelv1.ErrorResponse	"Validation error"
+//	@Failure		401		{object}	modelv1.ErrorResponse	"Unauthorized error"
+//	@Failure		500		{object}	modelv1.ErrorResponse	"Internal server error"
+//	@Router			/auth/logout [post]
+//	@Security		BearerAuth
+func (ah *AuthHandler) Logout(ctx *gin.Context) {
+	var req modelv1.LogoutRequest
+	if ctx.Request.ContentLength != 0 {
+		if err := ctx.ShouldBindJSON(&req); err != nil {
+			validationError(ctx, err)
+			return
+		}
+	}
+
+	authPayload := getAuthPayload(ctx, authorizationPayloadKey)
+
+	err := ah.svc.Logout(ctx, authPayload, req.RefreshToken)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	handleSuccess(ctx, nil)
+}
+
+// UnlockUser godoc
+//
+//	@Summary		Unlock a user
+//	@Description	Clears the lockout and failed login attempts of a user locked out after too many failed logins
+//	@Tags			Users
+//	@Accept			json
+//	@Produce		json
+//	@Param			id	path		uint64					true	"User ID"
+//	@Success		200	{object}	modelv1.Response		"User unlocked"
+//	@Failure		400	{object}	modelv1.ErrorResponse	"Validation error"
+//	@Failure		401	{object}	modelv1.ErrorResponse	"Unauthorized error"
+//	@Failure		403	{object}	modelv1.ErrorResponse	"Forbidden error"
+//	@Failure		404	{object}	modelv1.ErrorResponse	"Data not found error"
+//	@Failure		500	{object}	modelv1.ErrorResponse	"Internal server error"
+//	@Router			/users/{id}/unlock [post]
+//	@Security		BearerAuth
+func (ah *AuthHandler) UnlockUser(ctx *gin.Context) {
+	var req modelv1.UnlockUserRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		validationError(ctx, err)
+		return
+	}
+
+	err := ah.svc.UnlockUser(ctx, req.ID)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	handleSuccess(ctx, nil)
+}
+
+// PINLogin godoc
+//
+//	@Summary		Login by PIN on a terminal
+//	@Description	Logs in a user by PIN on a registered terminal and returns a short-lived access token carrying the terminal id, without a refresh token. Failed PINs share the backoff and lockout of failed passwords.
+//	@Tags			Users
+//	@Accept			json
+//	@Produce		json
+//	@Param			request	body		modelv1.PINLoginRequest		true	"PIN login request body"
+//	@Success		200		{object}	modelv1.PINLoginResponse	"Succesfully logged in"
+//	@Failure		400		{object}	modelv1.ErrorResponse		"Validation error"
+//	@Failure		401		{object}	modelv1.ErrorResponse		"Unauthorized error"
+//	@Failure		423		{object}	modelv1.ErrorResponse		"Account locked error"
+//	@Failure		429		{object}	modelv1.ErrorResponse		"Too many attempts error"
+//	@Failure		500		{object}	modelv1.ErrorResponse		"Internal server error"
+//	@Router			/auth/pin [post]
+func (ah *AuthHandler) PINLogin(ctx *gin.Context) {
+	var req modelv1.PINLoginRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		validationError(ctx, err)
+		return
+	}
+
+	token, err := ah.svc.PINLogin(ctx, req.TerminalID, req.TerminalSecret, req.UserID, req.PIN, ctx.ClientIP())
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	rsp := modelv1.PINLoginResponse{
+		AccessToken: token,
+	}
+
+	handleSuccess(ctx, rsp)
+}
+
+// SetPIN godoc
+//
+//	@Summary		Set the PIN of a user
+//	@Description	Sets or replaces the PIN a user logs in with on registered terminals
+//	@Tags			Users
+//	@Accept			json
+//	@Produce		json
+//	@Param			id				path		uint64					true	"User ID"
+//	@Param			setPINRequest	body		modelv1.SetPINRequest	true	"Set PIN request"
+//	@Success		200				{object}	modelv1.Response		"PIN set"
+//	@Failure		400				{object}	modelv1.ErrorResponse	"Validation error"
+//	@Failure		401				{object}	modelv1.ErrorResponse	"Unauthorized error"
+//	@Failure		403				{object}	modelv1.ErrorResponse	"Forbidden error"
+//	@Failure		404				{object}	modelv1.ErrorResponse	"Data not found error"
+//	@Failure		500				{object}	modelv1.ErrorResponse	"Internal server error"
+//	@Router			/users/{id}/pin [put]
+//	@Security		BearerAuth
+func (ah *AuthHandler) SetPIN(ctx *gin.Context) {
+	var uri modelv1.GetUserRequest
+	if err := ctx.ShouldBindUri(&uri); err != nil {
+		validationError(ctx, err)
+		return
+	}
+
+	var req modelv1.SetPINRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		validationError(ctx, err)
+		return
+	}
+
+	err := ah.svc.SetPIN(ctx, uri.ID, req.PIN)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	handleSuccess(ctx, nil)
+}
+
+// VerifyTwoFactor godoc
+//
+//	@Summary		Complete a login with a second factor
+//	@Description	Completes the challenge of a password login with a TOTP code or an unused recovery code and returns an access token and a refresh token. Failed codes share the backoff and lockout of failed passwords.
+//	@Tags			Auth
+//	@Accept			json
+//	@Produce		json
+//	@Param			request	body		modelv1.VerifyTwoFactorRequest	true	"Verify two-factor request body"
+//	@Success		200		{object}	modelv1.AuthResponse			"Succesfully logged in"
+//	@Failure		400		{object}	modelv1.ErrorResponse			"Validation error"
+//	@Failure		401		{object}	modelv1.ErrorResponse			"Unauthorized error"
+//	@Failure		423		{object}	modelv1.ErrorResponse			"Account locked error"
+//	@Failure		429		{object}	modelv1.ErrorResponse			"Too many attempts error"
+//	@Failure		500		{object}	modelv1.ErrorResponse			"Internal server error"
+//	@Router			/auth/2fa/verify [post]
+func (ah *AuthHandler) VerifyTwoFactor(ctx *gin.Context) {
+	var req modelv1.VerifyTwoFactorRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		validationError(ctx, err)
+		return
+	}
+
+	tokens, err := ah.svc.VerifyTwoFactor(ctx, req.Challenge, req.Code, ctx.ClientIP())
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	rsp := newAuthResponse(tokens)
+
+	handleSuccess(ctx, rsp)
+}
+
+// EnrollTwoFactor godoc
+//
+//	@Summary		Start two-factor enrollment
+//	@Description	Generates a TOTP secret for the logged in user and returns its provisioning uri to show as a QR code. Two-factor authentication is enabled once confirmed with a code.
+//	@Tags			Auth
+//	@Accept			json
+//	@Produce		json
+//	@Success		200	{object}	modelv1.TOTPEnrollmentResponse	"Enrollment started"
+//	@Failure		401	{object}	modelv1.ErrorResponse			"Unauthorized error"
+//	@Failure		409	{object}	modelv1.ErrorResponse			"Data conflict error"
+//	@Failure		500	{object}	modelv1.ErrorResponse			"Internal server error"
+//	@Router			/auth/2fa/enroll [post]
+//	@Security		BearerAuth
+func (ah *AuthHandler) EnrollTwoFactor(ctx *gin.Context) {
+	authPayload := getAuthPayload(ctx, authorizationPayloadKey)
+
+	enrollment, err := ah.svc.EnrollTwoFactor(ctx, authPayload.UserID)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	rsp := modelv1.TOTPEnrollmentResponse{
+		Secret:          enrollment.Secret,
+		ProvisioningURI: enrollment.ProvisioningURI,
+	}
+
+	handleSuccess(ctx, rsp)
+}
+
+// ConfirmTwoFactor godoc
+//
+//	@Summary		Confirm two-factor enrollment
+//	@Description	Enables two-factor authentication of the logged in user with a TOTP code and returns recovery codes, which are only shown once
+//	@Tags			Auth
+//	@Accept			json
+//	@Produce		json
+//	@Param			request	body		modelv1.TwoFactorCodeRequest	true	"Two-factor code request body"
+//	@Success		200		{object}	modelv1.RecoveryCodesResponse	"Two-factor authentication enabled"
+//	@Failure		400		{object}	modelv1.ErrorResponse			"Validation error"
+//	@Failure		401		{object}	modelv1.ErrorResponse			"Unauthorized error"
+//	@Failure		409		{object}	modelv1.ErrorResponse			"Data conflict error"
+//	@Failure		500		{object}	modelv1.ErrorResponse			"Internal server error"
+//	@Router			/auth/2fa/confirm [post]
+//	@Security		BearerAuth
+func (ah *AuthHandler) ConfirmTwoFactor(ctx *gin.Context) {
+	var req modelv1.TwoFactorCodeRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		validationError(ctx, err)
+		return
+	}
+
+	authPayload := getAuthPayload(ctx, authorizationPayloadKey)
+
+	codes, err := ah.svc.ConfirmTwoFactor(ctx, authPayload.UserID, req.Code)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	rsp := modelv1.RecoveryCodesResponse{
+		RecoveryCodes: codes,
+	}
+
+	handleSuccess(ctx, rsp)
+}
+
+// DisableTwoFactor godoc
+//
+//	@Summary		Disable two-factor authentication
+//	@Description	Disables two-factor authentication of the logged in user with a TOTP code or an unused recovery code. Roles that require two-factor authentication cannot disable it.
+//	@Tags			Auth
+//	@Accept			json
+//	@Produce		json
+//	@Param			request	body		modelv1.TwoFactorCodeRequest	true	"Two-factor code request body"
+//	@Success		200		{object}	modelv1.Response				"Two-factor authentication disabled"
+//	@Failure		400		{object}	modelv1.ErrorResponse			"Validation error"
+//	@Failure		401		{object}	modelv1.ErrorResponse			"Unauthorized error"
+//	@Failure		403		{object}	modelv1.ErrorResponse			"Forbidden error"
+//	@Failure		409		{object}	modelv1.ErrorResponse			"Data conflict error"
+//	@Failure		500		{object}	modelv1.ErrorResponse			"Internal server error"
+//	@Router			/auth/2fa/disable [post]
+//	@Security		BearerAuth
+func (ah *AuthHandler) DisableTwoFactor(ctx *gin.Context) {
+	var req modelv1.TwoFactorCodeRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		validationError(ctx, err)
+		return
+	}
+
+	authPayload := getAuthPayload(ctx, authorizationPayloadKey)
+
+	err := ah.svc.DisableTwoFactor(ctx, authPayload.UserID, req.Code)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	handleSuccess(ctx, nil)
+}
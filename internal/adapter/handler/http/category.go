@@ -28,6 +28,7 @@ func NewCategoryHandler(svc port.CategoryService) *CategoryHandler {
 //	@Produce		json
 //	@Param			createCategoryRequest	body		modelv1.CreateCategoryRequest	true	"Create category request"
 //	@Success		200						{object}	modelv1.CategoryResponse		"Category created"
+//	@Header			200						{string}	ETag							"Version of the category"
 //	@Failure		400						{object}	modelv1.ErrorResponse			"Validation error"
 //	@Failure		401						{object}	modelv1.ErrorResponse			"Unauthorized error"
 //	@Failure		403						{object}	modelv1.ErrorResponse			"Forbidden error"
@@ -36,6 +37,7 @@ func NewCategoryHandler(svc port.CategoryService) *CategoryHandler {
 //	@Failure		500						{object}	modelv1.ErrorResponse			"Internal server error"
 //	@Router			/categories [post]
 //	@Security		BearerAuth
+//	@Security		APIKeyAuth
 func (ch *CategoryHandler) CreateCategory(ctx *gin.Context) {
 	var req modelv1.CreateCategoryRequest
 	if err := ctx.ShouldBindJSON(&req); err != nil {
@@ -47,13 +49,14 @@ func (ch *CategoryHandler) CreateCategory(ctx *gin.Context) {
 		Name: req.Name,
 	}
 
-	_, err := ch.svc.CreateCategory(ctx, &category)
+	createdCategory, err := ch.svc.CreateCategory(ctx, &category)
 	if err != nil {
 		handleError(ctx, err)
 		return
 	}
 
-	rsp := newCategoryResponse(&category)
+	rsp := newCategoryResponse(createdCategory)
+	setETag(ctx, createdCategory.Version)
 
 	handleSuccess(ctx, rsp)
 }
@@ -67,11 +70,13 @@ func (ch *CategoryHandler) CreateCategory(ctx *gin.Context) {
 //	@Produce		json
 //	@Param			id	path		uint64						true	"Category ID"
 //	@Success		200	{object}	modelv1.CategoryResponse	"Category retrieved"
+//	@Header			200	{string}	ETag						"Version of the category"
 //	@Failure		400	{object}	modelv1.ErrorResponse		"Validation error"
 //	@Failure		404	{object}	modelv1.ErrorResponse		"Data not found error"
 //	@Failure		500	{object}	modelv1.ErrorResponse		"Internal server error"
 //	@Router			/categories/{id} [get]
 //	@Security		BearerAuth
+//	@Security		APIKeyAuth
 func (ch *CategoryHandler) GetCategory(ctx *gin.Context) {
 	var req modelv1.GetCategoryRequest
 	if err := ctx.ShouldBindUri(&req); err != nil {
@@ -86,6 +91,7 @@ func (ch *CategoryHandler) GetCategory(ctx *gin.Context) {
 	}
 
 	rsp := newCategoryResponse(category)
+	setETag(ctx, category.Version)
 
 	handleSuccess(ctx, rsp)
 }
@@ -97,13 +103,15 @@ func (ch *CategoryHandler) GetCategory(ctx *gin.Context) {
 //	@Tags			Categories
 //	@Accept			json
 //	@Produce		json
-//	@Param			skip	query		uint64					true	"Skip"
-//	@Param			limit	query		uint64					true	"Limit"
-//	@Success		200		{object}	modelv1.Meta			"Categories displayed"
-//	@Failure		400		{object}	modelv1.ErrorResponse	"Validation error"
-//	@Failure		500		{object}	modelv1.ErrorResponse	"Internal server error"
+//	@Param			skip				query		uint64					true	"Skip"
+//	@Param			limit				query		uint64					true	"Limit"
+//	@Param			include_archived	query		bool					false	"Include archived records"
+//	@Success		200					{object}	modelv1.Meta			"Categories displayed"
+//	@Failure		400					{object}	modelv1.ErrorResponse	"Validation error"
+//	@Failure		500					{object}	modelv1.ErrorResponse	"Internal server error"
 //	@Router			/categories [get]
 //	@Security		BearerAuth
+//	@Security		APIKeyAuth
 func (ch *CategoryHandler) ListCategories(ctx *gin.Context) {
 	var req modelv1.ListCategoriesRequest
 	var categoriesList []modelv1.CategoryResponse
@@ -113,7 +121,7 @@ func (ch *CategoryHandler) ListCategories(ctx *gin.Context) {
 		return
 	}
 
-	categories, err := ch.svc.ListCategories(ctx, req.Skip, req.Limit)
+	categories, err := ch.svc.ListCategories(ctx, req.Skip, req.Limit, req.IncludeArchived)
 	if err != nil {
 		handleError(ctx, err)
 		return
@@ -138,16 +146,21 @@ func (ch *CategoryHandler) ListCategories(ctx *gin.Context) {
 //	@Accept			json
 //	@Produce		json
 //	@Param			id						path		uint64							true	"Category ID"
+//	@Param			If-Match				header		string							true	"ETag of the category version being modified"
 //	@Param			updateCategoryRequest	body		modelv1.UpdateCategoryRequest	true	"Update category request"
 //	@Success		200						{object}	modelv1.CategoryResponse		"Category updated"
+//	@Header			200						{string}	ETag							"Version of the category"
 //	@Failure		400						{object}	modelv1.ErrorResponse			"Validation error"
 //	@Failure		401						{object}	modelv1.ErrorResponse			"Unauthorized error"
 //	@Failure		403						{object}	modelv1.ErrorResponse			"Forbidden error"
 //	@Failure		404						{object}	modelv1.ErrorResponse			"Data not found error"
 //	@Failure		409						{object}	modelv1.ErrorResponse			"Data conflict error"
+//	@Failure		412						{object}	modelv1.ErrorResponse			"Version mismatch error"
+//	@Failure		428						{object}	modelv1.ErrorResponse			"Version required error"
 //	@Failure		500						{object}	modelv1.ErrorResponse			"Internal server error"
 //	@Router			/categories/{id} [put]
 //	@Security		BearerAuth
+//	@Security		APIKeyAuth
 func (ch *CategoryHandler) UpdateCategory(ctx *gin.Context) {
 	var req modelv1.UpdateCategoryRequest
 	if err := ctx.ShouldBindJSON(&req); err != nil {
@@ -162,18 +175,26 @@ func (ch *CategoryHandler) UpdateCategory(ctx *gin.Context) {
 		return
 	}
 
+	version, err := getIfMatchVersion(ctx)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
 	category := domaincategory.Category{
-		ID:   id,
-		Name: req.Name,
+		ID:      id,
+		Name:    req.Name,
+		Version: version,
 	}
 
-	_, err = ch.svc.UpdateCategory(ctx, &category)
+	updatedCategory, err := ch.svc.UpdateCategory(ctx, &category)
 	if err != nil {
 		handleError(ctx, err)
 		return
 	}
 
-	rsp := newCategoryResponse(&category)
+	rsp := newCategoryResponse(updatedCategory)
+	setETag(ctx, updatedCategory.Version)
 
 	handleSuccess(ctx, rsp)
 }
@@ -181,19 +202,23 @@ func (ch *CategoryHandler) UpdateCategory(ctx *gin.Context) {
 // DeleteCategory godoc
 //
 //	@Summary		Delete a category
-//	@Description	Delete a category by id
+//	@Description	Archive a category by id, keeping it resolvable from past orders
 //	@Tags			Categories
 //	@Accept			json
 //	@Produce		json
-//	@Param			id	path		uint64					true	"Category ID"
-//	@Success		200	{object}	modelv1.Response		"Category deleted"
-//	@Failure		400	{object}	modelv1.ErrorResponse	"Validation error"
-//	@Failure		401	{object}	modelv1.ErrorResponse	"Unauthorized error"
-//	@Failure		403	{object}	modelv1.ErrorResponse	"Forbidden error"
-//	@Failure		404	{object}	modelv1.ErrorResponse	"Data not found error"
-//	@Failure		500	{object}	modelv1.ErrorResponse	"Internal server error"
+//	@Param			id			path		uint64					true	"Category ID"
+//	@Param			If-Match	header		string					true	"ETag of the category version being modified"
+//	@Success		200			{object}	modelv1.Response		"Category deleted"
+//	@Failure		400			{object}	modelv1.ErrorResponse	"Validation error"
+//	@Failure		401			{object}	modelv1.ErrorResponse	"Unauthorized error"
+//	@Failure		403			{object}	modelv1.ErrorResponse	"Forbidden error"
+//	@Failure		404			{object}	modelv1.ErrorResponse	"Data not found error"
+//	@Failure		412			{object}	modelv1.ErrorResponse	"Version mismatch error"
+//	@Failure		428			{object}	modelv1.ErrorResponse	"Version required error"
+//	@Failure		500			{object}	modelv1.ErrorResponse	"Internal server error"
 //	@Router			/categories/{id} [delete]
 //	@Security		BearerAuth
+//	@Security		APIKeyAuth
 func (ch *CategoryHandler) DeleteCategory(ctx *gin.Context) {
 	var req modelv1.DeleteCategoryRequest
 	if err := ctx.ShouldBindUri(&req); err != nil {
@@ -201,7 +226,13 @@ func (ch *CategoryHandler) DeleteCategory(ctx *gin.Context) {
 		return
 	}
 
-	err := ch.svc.DeleteCategory(ctx, req.ID)
+	version, err := getIfMatchVersion(ctx)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	err = ch.svc.DeleteCategory(ctx, req.ID, version)
 	if err != nil {
 		handleError(ctx, err)
 		return
@@ -209,3 +240,41 @@ func (ch *CategoryHandler) DeleteCategory(ctx *gin.Context) {
 
 	handleSuccess(ctx, nil)
 }
+
+// RestoreCategory godoc
+//
+//	@Summary		Restore a category
+//	@Description	Restore an archived category by id
+//	@Tags			Categories
+//	@Accept			json
+//	@Produce		json
+//	@Param			id	path		uint64						true	"Category ID"
+//	@Success		200	{object}	modelv1.CategoryResponse	"Category restored"
+//	@Header			200	{string}	ETag						"Version of the category"
+//	@Failure		400	{object}	modelv1.ErrorResponse		"Validation error"
+//	@Failure		401	{object}	modelv1.ErrorResponse		"Unauthorized error"
+//	@Failure		403	{object}	modelv1.ErrorResponse		"Forbidden error"
+//	@Failure		404	{object}	modelv1.ErrorResponse		"Data not found error"
+//	@Failure		409	{object}	modelv1.ErrorResponse		"Data not archived error"
+//	@Failure		500	{object}	modelv1.ErrorResponse		"Internal server error"
+//	@Router			/categories/{id}/restore [post]
+//	@Security		BearerAuth
+//	@Security		APIKeyAuth
+func (ch *CategoryHandler) RestoreCategory(ctx *gin.Context) {
+	var req modelv1.RestoreCategoryRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		validationError(ctx, err)
+		return
+	}
+
+	category, err := ch.svc.RestoreCategory(ctx, req.ID)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	rsp := newCategoryResponse(category)
+	setETag(ctx, category.Version)
+
+	handleSuccess(ctx, rsp)
+}
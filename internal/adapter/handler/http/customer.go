@@ -0,0 +1,340 @@
+package http
+
+import (
+	domaincustomer "github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain/customer"
+	"github.com/TienMinh25/go-hexagonal-architecture/internal/application/port"
+	modelv1 "github.com/TienMinh25/go-hexagonal-architecture/pkg/model/v1"
+	"github.com/gin-gonic/gin"
+)
+
+// CustomerHandler represents the HTTP handler for customer-related requests
+type CustomerHandler struct {
+	svc      port.CustomerService
+	orderSvc port.OrderService
+}
+
+// NewCustomerHandler creates a new CustomerHandler instance
+func NewCustomerHandler(svc port.CustomerService, orderSvc port.OrderService) *CustomerHandler {
+	return &CustomerHandler{
+		svc,
+		orderSvc,
+	}
+}
+
+// CreateCustomer godoc
+//
+//	@Summary		Create a new customer
+//	@Description	create a new customer with name, phone, email, and notes
+//	@Tags			Customers
+//	@Accept			json
+//	@Produce		json
+//	@Param			createCustomerRequest	body		modelv1.CreateCustomerRequest	true	"Create customer request"
+//	@Success		200						{object}	modelv1.CustomerResponse		"Customer created"
+//	@Header			200						{string}	ETag							"Version of the customer"
+//	@Failure		400						{object}	modelv1.ErrorResponse			"Validation error"
+//	@Failure		401						{object}	modelv1.ErrorResponse			"Unauthorized error"
+//	@Failure		409						{object}	modelv1.ErrorResponse			"Data conflict error"
+//	@Failure		500						{object}	modelv1.ErrorResponse			"Internal server error"
+//	@Router			/customers [post]
+//	@Security		BearerAuth
+func (ch *CustomerHandler) CreateCustomer(ctx *gin.Context) {
+	var req modelv1.CreateCustomerRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		validationError(ctx, err)
+		return
+	}
+
+	customer := domaincustomer.Customer{
+		Name:  req.Name,
+		Phone: req.Phone,
+		Email: req.Email,
+		Notes: req.Notes,
+	}
+
+	_, err := ch.svc.CreateCustomer(ctx, &customer)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	rsp := newCustomerResponse(&customer)
+	setETag(ctx, customer.Version)
+
+	handleSuccess(ctx, rsp)
+}
+
+// GetCustomer godoc
+//
+//	@Summary		Get a customer
+//	@Description	get a customer by id
+//	@Tags			Customers
+//	@Accept			json
+//	@Produce		json
+//	@Param			id	path		uint64						true	"Customer ID"
+//	@Success		200	{object}	modelv1.CustomerResponse	"Customer retrieved"
+//	@Header			200	{string}	ETag						"Version of the customer"
+//	@Failure		400	{object}	modelv1.ErrorResponse		"Validation error"
+//	@Failure		401	{object}	modelv1.ErrorResponse		"Unauthorized error"
+//	@Failure		404	{object}	modelv1.ErrorResponse		"Data not found error"
+//	@Failure		500	{object}	modelv1.ErrorResponse		"Internal server error"
+//	@Router			/customers/{id} [get]
+//	@Security		BearerAuth
+func (ch *CustomerHandler) GetCustomer(ctx *gin.Context) {
+	var req modelv1.GetCustomerRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		validationError(ctx, err)
+		return
+	}
+
+	customer, err := ch.svc.GetCustomer(ctx, req.ID)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	rsp := newCustomerResponse(customer)
+	setETag(ctx, customer.Version)
+
+	handleSuccess(ctx, rsp)
+}
+
+// ListCustomers godoc
+//
+//	@Summary		List customers
+//	@Description	List customers with pagination, searching by name, phone, or email
+//	@Tags			Customers
+//	@Accept			json
+//	@Produce		json
+//	@Param			q					query		string					false	"Query"
+//	@Param			skip				query		uint64					true	"Skip"
+//	@Param			limit				query		uint64					true	"Limit"
+//	@Param			include_archived	query		bool					false	"Include archived records"
+//	@Success		200					{object}	modelv1.Meta			"Customers displayed"
+//	@Failure		400					{object}	modelv1.ErrorResponse	"Validation error"
+//	@Failure		401					{object}	modelv1.ErrorResponse	"Unauthorized error"
+//	@Failure		500					{object}	modelv1.ErrorResponse	"Internal server error"
+//	@Router			/customers [get]
+//	@Security		BearerAuth
+func (ch *CustomerHandler) ListCustomers(ctx *gin.Context) {
+	var req modelv1.ListCustomersRequest
+	var customersList []modelv1.CustomerResponse
+
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		validationError(ctx, err)
+		return
+	}
+
+	customers, err := ch.svc.ListCustomers(ctx, req.Query, req.Skip, req.Limit, req.IncludeArchived)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	for _, customer := range customers {
+		customersList = append(customersList, newCustomerResponse(&customer))
+	}
+
+	total := uint64(len(customersList))
+	meta := newMeta(total, req.Limit, req.Skip)
+	rsp := toMap(meta, customersList, "customers")
+
+	handleSuccess(ctx, rsp)
+}
+
+// UpdateCustomer godoc
+//
+//	@Summary		Update a customer
+//	@Description	update a customer's name, phone, email, or notes by id
+//	@Tags			Customers
+//	@Accept			json
+//	@Produce		json
+//	@Param			id						path		uint64							true	"Customer ID"
+//	@Param			If-Match				header		string							true	"ETag of the customer version being modified"
+//	@Param			updateCustomerRequest	body		modelv1.UpdateCustomerRequest	true	"Update customer request"
+//	@Success		200						{object}	modelv1.CustomerResponse		"Customer updated"
+//	@Header			200						{string}	ETag							"Version of the customer"
+//	@Failure		400						{object}	modelv1.ErrorResponse			"Validation error"
+//	@Failure		401						{object}	modelv1.ErrorResponse			"Unauthorized error"
+//	@Failure		404						{object}	modelv1.ErrorResponse			"Data not found error"
+//	@Failure		409						{object}	modelv1.ErrorResponse			"Data conflict error"
+//	@Failure		412						{object}	modelv1.ErrorResponse			"Version mismatch error"
+//	@Failure		428						{object}	modelv1.ErrorResponse			"Version required error"
+//	@Failure		500						{object}	modelv1.ErrorResponse			"Internal server error"
+//	@Router			/customers/{id} [put]
+//	@Security		BearerAuth
+func (ch *CustomerHandler) UpdateCustomer(ctx *gin.Context) {
+	var req modelv1.UpdateCustomerRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		validationError(ctx, err)
+		return
+	}
+
+	idStr := ctx.Param("id")
+	id, err := stringToUint64(idStr)
+	if err != nil {
+		validationError(ctx, err)
+		return
+	}
+
+	version, err := getIfMatchVersion(ctx)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	customer := domaincustomer.Customer{
+		ID:      id,
+		Name:    req.Name,
+		Phone:   req.Phone,
+		Email:   req.Email,
+		Notes:   req.Notes,
+		Version: version,
+	}
+
+	_, err = ch.svc.UpdateCustomer(ctx, &customer)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	rsp := newCustomerResponse(&customer)
+	setETag(ctx, customer.Version)
+
+	handleSuccess(ctx, rsp)
+}
+
+// DeleteCustomer godoc
+//
+//	@Summary		Delete a customer
+//	@Description	Archive a customer by id, keeping it resolvable from past orders
+//	@Tags			Customers
+//	@Accept			json
+//	@Produce		json
+//	@Param			id			path		uint64					true	"Customer ID"
+//	@Param			If-Match	header		string					true	"ETag of the customer version being modified"
+//	@Success		200			{object}	modelv1.Response		"Customer deleted"
+//	@Failure		400			{object}	modelv1.ErrorResponse	"Validation error"
+//	@Failure		401			{object}	modelv1.ErrorResponse	"Unauthorized error"
+//	@Failure		403			{object}	modelv1.ErrorResponse	"Forbidden error"
+//	@Failure		404			{object}	modelv1.ErrorResponse	"Data not found error"
+//	@Failure		412			{object}	modelv1.ErrorResponse	"Version mismatch error"
+//	@Failure		428			{object}	modelv1.ErrorResponse	"Version required error"
+//	@Failure		500			{object}	modelv1.ErrorResponse	"Internal server error"
+//	@Router			/customers/{id} [delete]
+//	@Security		BearerAuth
+func (ch *CustomerHandler) DeleteCustomer(ctx *gin.Context) {
+	var req modelv1.DeleteCustomerRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		validationError(ctx, err)
+		return
+	}
+
+	version, err := getIfMatchVersion(ctx)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	err = ch.svc.DeleteCustomer(ctx, req.ID, version)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	handleSuccess(ctx, nil)
+}
+
+// RestoreCustomer godoc
+//
+//	@Summary		Restore a customer
+//	@Description	Restore an archived customer by id
+//	@Tags			Customers
+//	@Accept			json
+//	@Produce		json
+//	@Param			id	path		uint64						true	"Customer ID"
+//	@Success		200	{object}	modelv1.CustomerResponse	"Customer restored"
+//	@Header			200	{string}	ETag						"Version of the customer"
+//	@Failure		400	{object}	modelv1.ErrorResponse		"Validation error"
+//	@Failure		401	{object}	modelv1.ErrorResponse		"Unauthorized error"
+//	@Failure		403	{object}	modelv1.ErrorResponse		"Forbidden error"
+//	@Failure		404	{object}	modelv1.ErrorResponse		"Data not found error"
+//	@Failure		409	{object}	modelv1.ErrorResponse		"Data not archived error"
+//	@Failure		500	{object}	modelv1.ErrorResponse		"Internal server error"
+//	@Router			/customers/{id}/restore [post]
+//	@Security		BearerAuth
+func (ch *CustomerHandler) RestoreCustomer(ctx *gin.Context) {
+	var req modelv1.RestoreCustomerRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		validationError(ctx, err)
+		return
+	}
+
+	customer, err := ch.svc.RestoreCustomer(ctx, req.ID)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	rsp := newCustomerResponse(customer)
+	setETag(ctx, customer.Version)
+
+	handleSuccess(ctx, rsp)
+}
+
+// ListCustomerOrders godoc
+//
+//	@Summary		List a customer's orders
+//	@Description	List the orders of a customer with pagination, along with lifetime spend and visit count
+//	@Tags			Customers
+//	@Accept			json
+//	@Produce		json
+//	@Param			id		path		uint64							true	"Customer ID"
+//	@Param			skip	query		uint64							true	"Skip records"
+//	@Param			limit	query		uint64							true	"Limit records"
+//	@Success		200		{object}	modelv1.CustomerOrdersResponse	"Customer orders displayed"
+//	@Failure		400		{object}	modelv1.ErrorResponse			"Validation error"
+//	@Failure		401		{object}	modelv1.ErrorResponse			"Unauthorized error"
+//	@Failure		404		{object}	modelv1.ErrorResponse			"Data not found error"
+//	@Failure		500		{object}	modelv1.ErrorResponse			"Internal server error"
+//	@Router			/customers/{id}/orders [get]
+//	@Security		BearerAuth
+func (ch *CustomerHandler) ListCustomerOrders(ctx *gin.Context) {
+	var uri modelv1.GetCustomerRequest
+	var req modelv1.ListCustomerOrdersRequest
+	var ordersList []modelv1.OrderResponse
+
+	if err := ctx.ShouldBindUri(&uri); err != nil {
+		validationError(ctx, err)
+		return
+	}
+
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		validationError(ctx, err)
+		return
+	}
+
+	summary, err := ch.svc.GetPurchaseSummary(ctx, uri.ID)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	orders, err := ch.orderSvc.ListOrders(ctx, uri.ID, req.Skip, req.Limit)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	for _, order := range orders {
+		ordersList = append(ordersList, newOrderResponse(&order))
+	}
+
+	total := uint64(len(ordersList))
+	rsp := modelv1.CustomerOrdersResponse{
+		Meta:    newMeta(total, req.Limit, req.Skip),
+		Summary: newPurchaseSummaryResponse(summary),
+		Orders:  ordersList,
+	}
+
+	handleSuccess(ctx, rsp)
+}
@@ -0,0 +1,168 @@
+package http
+
+import (
+	domaingiftcard "github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain/giftcard"
+	domainorder "github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain/order"
+	"github.com/TienMinh25/go-hexagonal-architecture/internal/application/port"
+	modelv1 "github.com/TienMinh25/go-hexagonal-architecture/pkg/model/v1"
+	"github.com/gin-gonic/gin"
+)
+
+// GiftCardHandler represents the HTTP handler for gift card-related requests
+type GiftCardHandler struct {
+	svc port.GiftCardService
+}
+
+// NewGiftCardHandler creates a new GiftCardHandler instance
+func NewGiftCardHandler(svc port.GiftCardService) *GiftCardHandler {
+	return &GiftCardHandler{
+		svc,
+	}
+}
+
+// IssueGiftCard godoc
+//
+//	@Summary		Sell a new gift card
+//	@Description	Sell a new gift card with an initial balance and an optional expiry through an order paid with a payment, the code is generated
+//	@Tags			GiftCards
+//	@Accept			json
+//	@Produce		json
+//	@Param			issueGiftCardRequest	body		modelv1.IssueGiftCardRequest	true	"Issue gift card request"
+//	@Success		200						{object}	modelv1.GiftCardSaleResponse	"Gift card sold"
+//	@Failure		400						{object}	modelv1.ErrorResponse			"Validation error"
+//	@Failure		401						{object}	modelv1.ErrorResponse			"Unauthorized error"
+//	@Failure		403						{object}	modelv1.ErrorResponse			"Forbidden error"
+//	@Failure		404						{object}	modelv1.ErrorResponse			"Data not found error"
+//	@Failure		409						{object}	modelv1.ErrorResponse			"Data conflict error"
+//	@Failure		500						{object}	modelv1.ErrorResponse			"Internal server error"
+//	@Router			/gift-cards [post]
+//	@Security		BearerAuth
+func (gh *GiftCardHandler) IssueGiftCard(ctx *gin.Context) {
+	var req modelv1.IssueGiftCardRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		validationError(ctx, err)
+		return
+	}
+
+	authPayload := getAuthPayload(ctx, authorizationPayloadKey)
+
+	giftCard := domaingiftcard.GiftCard{
+		InitialBalance: req.InitialBalance,
+		ExpiresAt:      req.ExpiresAt,
+	}
+	order := domainorder.Order{
+		UserID:       authPayload.UserID,
+		PaymentID:    req.PaymentID,
+		CustomerName: req.CustomerName,
+		TotalPaid:    req.TotalPaid,
+	}
+
+	if req.CustomerID != 0 {
+		order.CustomerID = &req.CustomerID
+	}
+
+	if authPayload.TerminalID != 0 {
+		order.TerminalID = &authPayload.TerminalID
+	}
+
+	_, err := gh.svc.IssueGiftCard(ctx, &giftCard, &order)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	rsp := newGiftCardSaleResponse(&giftCard, &order)
+
+	handleSuccess(ctx, rsp)
+}
+
+// GetGiftCard godoc
+//
+//	@Summary		Get a gift card
+//	@Description	Get a gift card by code to check its balance and expiry
+//	@Tags			GiftCards
+//	@Accept			json
+//	@Produce		json
+//	@Param			code	path		string						true	"Gift card code"
+//	@Success		200		{object}	modelv1.GiftCardResponse	"Gift card retrieved"
+//	@Failure		400		{object}	modelv1.ErrorResponse		"Validation error"
+//	@Failure		401		{object}	modelv1.ErrorResponse		"Unauthorized error"
+//	@Failure		404		{object}	modelv1.ErrorResponse		"Data not found error"
+//	@Failure		500		{object}	modelv1.ErrorResponse		"Internal server error"
+//	@Router			/gift-cards/{code} [get]
+//	@Security		BearerAuth
+func (gh *GiftCardHandler) GetGiftCard(ctx *gin.Context) {
+	var req modelv1.GetGiftCardRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		validationError(ctx, err)
+		return
+	}
+
+	giftCard, err := gh.svc.GetGiftCard(ctx, req.Code)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	rsp := newGiftCardResponse(giftCard)
+
+	handleSuccess(ctx, rsp)
+}
+
+// TopUpGiftCard godoc
+//
+//	@Summary		Top up a gift card
+//	@Description	Sell an amount added to the balance of an unexpired gift card through an order paid with a payment
+//	@Tags			GiftCards
+//	@Accept			json
+//	@Produce		json
+//	@Param			code					path		string							true	"Gift card code"
+//	@Param			topUpGiftCardRequest	body		modelv1.TopUpGiftCardRequest	true	"Top up gift card request"
+//	@Success		200						{object}	modelv1.GiftCardSaleResponse	"Gift card topped up"
+//	@Failure		400						{object}	modelv1.ErrorResponse			"Validation error"
+//	@Failure		401						{object}	modelv1.ErrorResponse			"Unauthorized error"
+//	@Failure		403						{object}	modelv1.ErrorResponse			"Forbidden error"
+//	@Failure		404						{object}	modelv1.ErrorResponse			"Data not found error"
+//	@Failure		500						{object}	modelv1.ErrorResponse			"Internal server error"
+//	@Router			/gift-cards/{code}/top-up [post]
+//	@Security		BearerAuth
+func (gh *GiftCardHandler) TopUpGiftCard(ctx *gin.Context) {
+	var uri modelv1.GetGiftCardRequest
+	if err := ctx.ShouldBindUri(&uri); err != nil {
+		validationError(ctx, err)
+		return
+	}
+
+	var req modelv1.TopUpGiftCardRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		validationError(ctx, err)
+		return
+	}
+
+	authPayload := getAuthPayload(ctx, authorizationPayloadKey)
+
+	order := domainorder.Order{
+		UserID:       authPayload.UserID,
+		PaymentID:    req.PaymentID,
+		CustomerName: req.CustomerName,
+		TotalPaid:    req.TotalPaid,
+	}
+
+	if req.CustomerID != 0 {
+		order.CustomerID = &req.CustomerID
+	}
+
+	if authPayload.TerminalID != 0 {
+		order.TerminalID = &authPayload.TerminalID
+	}
+
+	giftCard, err := gh.svc.TopUpGiftCard(ctx, uri.Code, req.Amount, &order)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	rsp := newGiftCardSaleResponse(giftCard, &order)
+
+	handleSuccess(ctx, rsp)
+}
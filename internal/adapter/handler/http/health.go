@@ -0,0 +1,58 @@
+package http
+
+import (
+	"net/http"
+
+	domainhealth "github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain/health"
+	"github.com/TienMinh25/go-hexagonal-architecture/internal/application/port"
+	modelv1 "github.com/TienMinh25/go-hexagonal-architecture/pkg/model/v1"
+	"github.com/gin-gonic/gin"
+)
+
+// HealthHandler represents the HTTP handler for the liveness and readiness probes
+type HealthHandler struct {
+	svc port.HealthService
+}
+
+// NewHealthHandler creates a new HealthHandler instance
+func NewHealthHandler(svc port.HealthService) *HealthHandler {
+	return &HealthHandler{
+		svc,
+	}
+}
+
+// Liveness godoc
+//
+//	@Summary		Check the process is alive
+//	@Description	Answer as long as the process serves requests, without checking any dependency. A failing liveness probe means the process should be restarted.
+//	@Tags			Health
+//	@Produce		json
+//	@Success		200	{object}	modelv1.LivenessResponse	"Process is alive"
+//	@Router			/healthz [get]
+func (hh *HealthHandler) Liveness(ctx *gin.Context) {
+	ctx.Header("Cache-Control", "no-store")
+	ctx.JSON(http.StatusOK, modelv1.LivenessResponse{
+		Status: string(domainhealth.StatusUp),
+	})
+}
+
+// Readiness godoc
+//
+//	@Summary		Check the application is ready to serve requests
+//	@Description	Check the database connection, the database schema migration version and the cache connection, reporting the status and latency of each. The application is not ready while it drains requests during shutdown.
+//	@Tags			Health
+//	@Produce		json
+//	@Success		200	{object}	modelv1.ReadinessResponse	"Application is ready"
+//	@Failure		503	{object}	modelv1.ReadinessResponse	"Application is not ready"
+//	@Router			/readyz [get]
+func (hh *HealthHandler) Readiness(ctx *gin.Context) {
+	report := hh.svc.Readiness(ctx)
+
+	status := http.StatusOK
+	if report.Status != domainhealth.StatusUp {
+		status = http.StatusServiceUnavailable
+	}
+
+	ctx.Header("Cache-Control", "no-store")
+	ctx.JSON(status, newReadinessResponse(report))
+}
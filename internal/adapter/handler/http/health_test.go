@@ -0,0 +1,93 @@
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/TienMinh25/go-hexagonal-architecture/internal/application/mock"
+	"github.com/TienMinh25/go-hexagonal-architecture/internal/application/usecase"
+	modelv1 "github.com/TienMinh25/go-hexagonal-architecture/pkg/model/v1"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+type probeExpectedOutput struct {
+	liveness  int
+	readiness int
+	draining  bool
+}
+
+func TestHealthHandler_Drain(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	healthRepo := mock.NewMockHealthRepository(ctrl)
+	cache := mock.NewMockCacheRepository(ctrl)
+	healthRepo.EXPECT().Ping(gomock.Any()).Return(nil).AnyTimes()
+	healthRepo.EXPECT().CheckMigrations(gomock.Any()).Return(nil).AnyTimes()
+	cache.EXPECT().Ping(gomock.Any()).Return(nil).AnyTimes()
+
+	healthService := usecase.NewHealthUsecase(healthRepo, cache)
+	healthHandler := NewHealthHandler(healthService)
+
+	router := gin.New()
+	router.GET("/healthz", healthHandler.Liveness)
+	router.GET("/readyz", healthHandler.Readiness)
+
+	// The shutdown first drains, then keeps serving while the load balancers stop routing here
+	steps := []struct {
+		desc     string
+		action   func()
+		expected probeExpectedOutput
+	}{
+		{
+			desc:   "Ready",
+			action: func() {},
+			expected: probeExpectedOutput{
+				liveness:  http.StatusOK,
+				readiness: http.StatusOK,
+				draining:  false,
+			},
+		},
+		{
+			desc:   "Draining",
+			action: healthService.Drain,
+			expected: probeExpectedOutput{
+				liveness:  http.StatusOK,
+				readiness: http.StatusServiceUnavailable,
+				draining:  true,
+			},
+		},
+		{
+			desc:   "StillDraining",
+			action: func() {},
+			expected: probeExpectedOutput{
+				liveness:  http.StatusOK,
+				readiness: http.StatusServiceUnavailable,
+				draining:  true,
+			},
+		},
+	}
+
+	for _, step := range steps {
+		step.action()
+
+		rec := httptest.NewRecorder()
+		router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/healthz", nil))
+		assert.Equal(t, step.expected.liveness, rec.Code, "%s: Liveness status mismatch", step.desc)
+
+		rec = httptest.NewRecorder()
+		router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+		assert.Equal(t, step.expected.readiness, rec.Code, "%s: Readiness status mismatch", step.desc)
+
+		var rsp modelv1.ReadinessResponse
+		assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &rsp), "%s: Body mismatch", step.desc)
+		assert.Equal(t, step.expected.draining, rsp.Draining, "%s: Draining mismatch", step.desc)
+		assert.Len(t, rsp.Components, 3, "%s: Components mismatch", step.desc)
+	}
+}
@@ -2,7 +2,9 @@ package http
 
 import (
 	"strconv"
+	"strings"
 
+	"github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain"
 	domainauth "github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain/auth"
 	modelv1 "github.com/TienMinh25/go-hexagonal-architecture/pkg/model/v1"
 	"github.com/gin-gonic/gin"
@@ -27,3 +29,29 @@ func toMap(m modelv1.Meta, data any, key string) map[string]any {
 		key:    data,
 	}
 }
+
+// setETag is a helper function to expose the version of a resource as a strong entity tag
+func setETag(ctx *gin.Context, version uint64) {
+	ctx.Header("ETag", strconv.Quote(strconv.FormatUint(version, 10)))
+}
+
+// getIfMatchVersion is a helper function to get the resource version from the If-Match header
+func getIfMatchVersion(ctx *gin.Context) (uint64, error) {
+	ifMatch := strings.TrimSpace(ctx.GetHeader("If-Match"))
+	if ifMatch == "" {
+		return 0, domain.ErrVersionRequired
+	}
+
+	// If-Match uses strong comparison, so weak or malformed tags never match
+	tag, err := strconv.Unquote(ifMatch)
+	if err != nil {
+		return 0, domain.ErrVersionMismatch
+	}
+
+	version, err := stringToUint64(tag)
+	if err != nil {
+		return 0, domain.ErrVersionMismatch
+	}
+
+	return version, nil
+}
@@ -0,0 +1,142 @@
+package http
+
+import (
+	domainuser "github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain/user"
+	"github.com/TienMinh25/go-hexagonal-architecture/internal/application/port"
+	modelv1 "github.com/TienMinh25/go-hexagonal-architecture/pkg/model/v1"
+	"github.com/gin-gonic/gin"
+)
+
+// InvitationHandler represents the HTTP handler for invitation-related requests
+type InvitationHandler struct {
+	svc port.InvitationService
+}
+
+// NewInvitationHandler creates a new InvitationHandler instance
+func NewInvitationHandler(svc port.InvitationService) *InvitationHandler {
+	return &InvitationHandler{
+		svc,
+	}
+}
+
+// CreateInvitation godoc
+//
+//	@Summary		Invite a user
+//	@Description	Invite an email to register with a role. The invitation link is mailed to the email and its token is only shown once.
+//	@Tags			Invitations
+//	@Accept			json
+//	@Produce		json
+//	@Param			createInvitationRequest	body		modelv1.CreateInvitationRequest		true	"Create invitation request"
+//	@Success		200						{object}	modelv1.CreateInvitationResponse	"Invitation created"
+//	@Failure		400						{object}	modelv1.ErrorResponse				"Validation error"
+//	@Failure		401						{object}	modelv1.ErrorResponse				"Unauthorized error"
+//	@Failure		403						{object}	modelv1.ErrorResponse				"Forbidden error"
+//	@Failure		409						{object}	modelv1.ErrorResponse				"Data conflict error"
+//	@Failure		500						{object}	modelv1.ErrorResponse				"Internal server error"
+//	@Router			/invitations [post]
+//	@Security		BearerAuth
+func (ih *InvitationHandler) CreateInvitation(ctx *gin.Context) {
+	var req modelv1.CreateInvitationRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		validationError(ctx, err)
+		return
+	}
+
+	authPayload := getAuthPayload(ctx, authorizationPayloadKey)
+
+	invitation := domainuser.Invitation{
+		Email:     req.Email,
+		Role:      req.Role,
+		InvitedBy: authPayload.UserID,
+	}
+
+	createdInvitation, token, err := ih.svc.CreateInvitation(ctx, &invitation)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	rsp := modelv1.CreateInvitationResponse{
+		InvitationResponse: newInvitationResponse(createdInvitation),
+		Token:              token,
+	}
+
+	handleSuccess(ctx, rsp)
+}
+
+// ListInvitations godoc
+//
+//	@Summary		List invitations
+//	@Description	List pending, accepted and revoked invitations with pagination, newest first
+//	@Tags			Invitations
+//	@Accept			json
+//	@Produce		json
+//	@Param			skip	query		uint64					true	"Skip"
+//	@Param			limit	query		uint64					true	"Limit"
+//	@Success		200		{object}	modelv1.Meta			"Invitations displayed"
+//	@Failure		400		{object}	modelv1.ErrorResponse	"Validation error"
+//	@Failure		401		{object}	modelv1.ErrorResponse	"Unauthorized error"
+//	@Failure		403		{object}	modelv1.ErrorResponse	"Forbidden error"
+//	@Failure		500		{object}	modelv1.ErrorResponse	"Internal server error"
+//	@Router			/invitations [get]
+//	@Security		BearerAuth
+func (ih *InvitationHandler) ListInvitations(ctx *gin.Context) {
+	var req modelv1.ListInvitationsRequest
+	var invitationsList []modelv1.InvitationResponse
+
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		validationError(ctx, err)
+		return
+	}
+
+	invitations, err := ih.svc.ListInvitations(ctx, req.Skip, req.Limit)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	for _, invitation := range invitations {
+		invitationsList = append(invitationsList, newInvitationResponse(&invitation))
+	}
+
+	total := uint64(len(invitationsList))
+	meta := newMeta(total, req.Limit, req.Skip)
+	rsp := toMap(meta, invitationsList, "invitations")
+
+	handleSuccess(ctx, rsp)
+}
+
+// RevokeInvitation godoc
+//
+//	@Summary		Revoke an invitation
+//	@Description	Revoke a pending invitation so that it can no longer be used to register
+//	@Tags			Invitations
+//	@Accept			json
+//	@Produce		json
+//	@Param			id	path		uint64						true	"Invitation ID"
+//	@Success		200	{object}	modelv1.InvitationResponse	"Invitation revoked"
+//	@Failure		400	{object}	modelv1.ErrorResponse		"Validation error"
+//	@Failure		401	{object}	modelv1.ErrorResponse		"Unauthorized error"
+//	@Failure		403	{object}	modelv1.ErrorResponse		"Forbidden error"
+//	@Failure		404	{object}	modelv1.ErrorResponse		"Data not found error"
+//	@Failure		409	{object}	modelv1.ErrorResponse		"Data conflict error"
+//	@Failure		500	{object}	modelv1.ErrorResponse		"Internal server error"
+//	@Router			/invitations/{id} [delete]
+//	@Security		BearerAuth
+func (ih *InvitationHandler) RevokeInvitation(ctx *gin.Context) {
+	var req modelv1.RevokeInvitationRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		validationError(ctx, err)
+		return
+	}
+
+	invitation, err := ih.svc.RevokeInvitation(ctx, req.ID)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	rsp := newInvitationResponse(invitation)
+
+	handleSuccess(ctx, rsp)
+}
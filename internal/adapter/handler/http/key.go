@@ -0,0 +1,35 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/TienMinh25/go-hexagonal-architecture/internal/application/port"
+	"github.com/gin-gonic/gin"
+)
+
+// KeyHandler represents the HTTP handler for publishing the token verification keys
+type KeyHandler struct {
+	token port.TokenService
+}
+
+// NewKeyHandler creates a new KeyHandler instance
+func NewKeyHandler(token port.TokenService) *KeyHandler {
+	return &KeyHandler{
+		token,
+	}
+}
+
+// GetKeySet godoc
+//
+//	@Summary		Get the token verification keys
+//	@Description	Get the current and previous Ed25519 public keys as a JSON Web Key Set so other services can verify tokens without a shared secret. Tokens name their key in the kid footer or header. The set is empty when tokens are symmetrically encrypted.
+//	@Tags			Auth
+//	@Produce		json
+//	@Success		200	{object}	modelv1.KeySetResponse	"Key set retrieved"
+//	@Router			/.well-known/jwks.json [get]
+func (kh *KeyHandler) GetKeySet(ctx *gin.Context) {
+	rsp := newKeySetResponse(kh.token.PublicKeys())
+
+	ctx.Header("Cache-Control", "public, max-age=300")
+	ctx.JSON(http.StatusOK, rsp)
+}
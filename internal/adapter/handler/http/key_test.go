@@ -0,0 +1,115 @@
+package http
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/TienMinh25/go-hexagonal-architecture/config"
+	"github.com/TienMinh25/go-hexagonal-architecture/internal/adapter/auth/jwt"
+	"github.com/TienMinh25/go-hexagonal-architecture/internal/adapter/auth/paseto"
+	"github.com/TienMinh25/go-hexagonal-architecture/internal/application/port"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+type getKeySetTestedInput struct {
+	token func(config *config.Token, cache port.CacheRepository) (port.TokenService, error)
+	typ   string
+}
+
+type getKeySetExpectedOutput struct {
+	keyIDs []string
+}
+
+func TestKeyHandler_GetKeySet(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	oldSeed := strings.Repeat("a", 64)
+	newSeed := strings.Repeat("b", 64)
+
+	testCases := []struct {
+		desc     string
+		input    getKeySetTestedInput
+		expected getKeySetExpectedOutput
+	}{
+		{
+			desc: "Success_JWT",
+			input: getKeySetTestedInput{
+				token: jwt.New,
+			},
+			expected: getKeySetExpectedOutput{
+				keyIDs: []string{"2025", "2024"},
+			},
+		},
+		{
+			desc: "Success_PasetoPublic",
+			input: getKeySetTestedInput{
+				token: paseto.New,
+				typ:   "public",
+			},
+			expected: getKeySetExpectedOutput{
+				keyIDs: []string{"2025", "2024"},
+			},
+		},
+		{
+			desc: "Success_PasetoLocal",
+			input: getKeySetTestedInput{
+				token: paseto.New,
+				typ:   "local",
+			},
+			expected: getKeySetExpectedOutput{
+				keyIDs: []string{},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.desc, func(t *testing.T) {
+			t.Parallel()
+
+			tokenService, err := tc.input.token(&config.Token{
+				Type:        tc.input.typ,
+				Duration:    time.Minute,
+				Keys:        []string{"2024:" + oldSeed, "2025:" + newSeed},
+				ActiveKeyID: "2025",
+			}, nil)
+			assert.NoError(t, err, "Token service mismatch")
+
+			router := gin.New()
+			router.GET("/.well-known/jwks.json", NewKeyHandler(tokenService).GetKeySet)
+
+			rec := httptest.NewRecorder()
+			router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/.well-known/jwks.json", nil))
+			assert.Equal(t, http.StatusOK, rec.Code, "Status mismatch")
+
+			var keySet struct {
+				Keys []map[string]string `json:"keys"`
+			}
+			assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &keySet), "Body mismatch")
+
+			keyIDs := []string{}
+			for _, key := range keySet.Keys {
+				keyIDs = append(keyIDs, key["kid"])
+
+				members := make([]string, 0, len(key))
+				for member := range key {
+					members = append(members, member)
+				}
+				assert.ElementsMatch(t, []string{"kty", "crv", "kid", "alg", "use", "x"}, members, "Members mismatch")
+
+				x, err := base64.RawURLEncoding.DecodeString(key["x"])
+				assert.NoError(t, err, "Key encoding mismatch")
+				assert.Len(t, x, 32, "Key size mismatch")
+				assert.NotContains(t, rec.Body.String(), oldSeed, "Private key material published")
+				assert.NotContains(t, rec.Body.String(), newSeed, "Private key material published")
+			}
+			assert.Equal(t, tc.expected.keyIDs, keyIDs, "Key ids mismatch")
+		})
+	}
+}
@@ -0,0 +1,52 @@
+package http
+
+import (
+	"github.com/TienMinh25/go-hexagonal-architecture/internal/application/port"
+	modelv1 "github.com/TienMinh25/go-hexagonal-architecture/pkg/model/v1"
+	"github.com/gin-gonic/gin"
+)
+
+// LoyaltyHandler represents the HTTP handler for loyalty points-related requests
+type LoyaltyHandler struct {
+	svc port.LoyaltyService
+}
+
+// NewLoyaltyHandler creates a new LoyaltyHandler instance
+func NewLoyaltyHandler(svc port.LoyaltyService) *LoyaltyHandler {
+	return &LoyaltyHandler{
+		svc,
+	}
+}
+
+// GetBalance godoc
+//
+//	@Summary		Get a customer's points balance
+//	@Description	Get the loyalty points balance of a customer and its value when redeemed
+//	@Tags			Customers
+//	@Accept			json
+//	@Produce		json
+//	@Param			id	path		uint64							true	"Customer ID"
+//	@Success		200	{object}	modelv1.LoyaltyBalanceResponse	"Points balance retrieved"
+//	@Failure		400	{object}	modelv1.ErrorResponse			"Validation error"
+//	@Failure		401	{object}	modelv1.ErrorResponse			"Unauthorized error"
+//	@Failure		404	{object}	modelv1.ErrorResponse			"Data not found error"
+//	@Failure		500	{object}	modelv1.ErrorResponse			"Internal server error"
+//	@Router			/customers/{id}/points [get]
+//	@Security		BearerAuth
+func (lh *LoyaltyHandler) GetBalance(ctx *gin.Context) {
+	var req modelv1.GetLoyaltyBalanceRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		validationError(ctx, err)
+		return
+	}
+
+	balance, err := lh.svc.GetBalance(ctx, req.ID)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	rsp := newLoyaltyBalanceResponse(balance)
+
+	handleSuccess(ctx, rsp)
+}
@@ -0,0 +1,46 @@
+package http
+
+import (
+	"crypto/subtle"
+	"net/http"
+	"strings"
+
+	"github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain"
+	"github.com/gin-gonic/gin"
+)
+
+// MetricsHandler represents the HTTP handler for scraping the metrics
+type MetricsHandler struct {
+	handler http.Handler
+	token   string
+}
+
+// NewMetricsHandler creates a new MetricsHandler instance, the metrics are public when the token is empty
+func NewMetricsHandler(handler http.Handler, token string) *MetricsHandler {
+	return &MetricsHandler{
+		handler,
+		token,
+	}
+}
+
+// Scrape godoc
+//
+//	@Summary		Scrape the metrics
+//	@Description	Get the HTTP, database pool, cache and business metrics in the Prometheus text format. When a metrics token is configured, it must be sent as a bearer token.
+//	@Tags			Health
+//	@Produce		plain
+//	@Success		200	{string}	string					"Metrics scraped"
+//	@Failure		401	{object}	modelv1.ErrorResponse	"Unauthorized error"
+//	@Router			/metrics [get]
+func (mh *MetricsHandler) Scrape(ctx *gin.Context) {
+	if mh.token != "" {
+		fields := strings.Fields(ctx.GetHeader(authorizationHeaderKey))
+		if len(fields) != 2 || strings.ToLower(fields[0]) != authorizationType ||
+			subtle.ConstantTimeCompare([]byte(fields[1]), []byte(mh.token)) != 1 {
+			handleAbort(ctx, domain.ErrUnauthorized)
+			return
+		}
+	}
+
+	mh.handler.ServeHTTP(ctx.Writer, ctx.Request)
+}
@@ -1,12 +1,27 @@
 package http
 
 import (
+	"io"
+	"log/slog"
+	"net/http"
+	"runtime/debug"
+	"slices"
 	"strings"
+	"time"
 
+	"github.com/TienMinh25/go-hexagonal-architecture/internal/adapter/i18n"
+	"github.com/TienMinh25/go-hexagonal-architecture/internal/adapter/logger"
 	"github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain"
-	domainuser "github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain/user"
+	domainaudit "github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain/audit"
+	domainauth "github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain/auth"
 	"github.com/TienMinh25/go-hexagonal-architecture/internal/application/port"
 	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	semconv "go.opentelemetry.io/otel/semconv/v1.34.0"
+	"go.opentelemetry.io/otel/trace"
 )
 
 const (
@@ -16,8 +31,148 @@ const (
 	authorizationType = "bearer"
 	// authorizationPayloadKey is the key for authorization payload in the context
 	authorizationPayloadKey = "authorization_payload"
+	// apiKeyHeaderKey is the key for the API key header machine clients authenticate with
+	apiKeyHeaderKey = "X-API-Key"
+	// requestIDHeaderKey is the key for the request id header, taken from the client or generated
+	requestIDHeaderKey = "X-Request-ID"
+	// requestIDContextKey is the key for the request id in the context
+	requestIDContextKey = "request_id"
+	// translatorContextKey is the key for the translator of the messages in the context
+	translatorContextKey = "translator"
+	// tracerName names the tracer of the request spans
+	tracerName = "github.com/TienMinh25/go-hexagonal-architecture/internal/adapter/handler/http"
 )
 
+// requestIDMiddleware is a middleware to identify every request by the id the client sends or a generated one.
+// The id is echoed in the response and logged along with the route by every line logged with the request context
+func requestIDMiddleware() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		requestID := ctx.GetHeader(requestIDHeaderKey)
+		if !isValidRequestID(requestID) {
+			requestID = uuid.NewString()
+		}
+		ctx.Header(requestIDHeaderKey, requestID)
+		ctx.Set(requestIDContextKey, requestID)
+
+		route := ctx.FullPath()
+		if route == "" {
+			route = unmatchedRoute
+		}
+
+		ctx.Request = ctx.Request.WithContext(logger.WithAttrs(ctx.Request.Context(),
+			slog.String("request_id", requestID),
+			slog.String("route", route),
+		))
+
+		ctx.Next()
+	}
+}
+
+// localeMiddleware is a middleware to make the translator available to the error responses,
+// the locale is only negotiated when a message is sent as the preference of the user is known after authentication
+func localeMiddleware(translator *i18n.Translator) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		ctx.Set(translatorContextKey, translator)
+		ctx.Next()
+	}
+}
+
+// maxRequestIDLength bounds the length of the request id taken from the client
+const maxRequestIDLength = 128
+
+// isValidRequestID checks that the request id of the client is safe to log and echo,
+// it only holds letters, digits and the separators of common id formats
+func isValidRequestID(requestID string) bool {
+	if requestID == "" || len(requestID) > maxRequestIDLength {
+		return false
+	}
+
+	for _, r := range requestID {
+		isAlphanumeric := (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9')
+		if !isAlphanumeric && !strings.ContainsRune("-_.:", r) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// actorMiddleware is a middleware to put the client ip and the request id into the request context,
+// so that changes made by the request are audited with them
+func actorMiddleware() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		actor := domainaudit.Actor{
+			IP:        ctx.ClientIP(),
+			RequestID: ctx.GetString(requestIDContextKey),
+		}
+		ctx.Request = ctx.Request.WithContext(domainaudit.WithActor(ctx.Request.Context(), actor))
+
+		ctx.Next()
+	}
+}
+
+// unmatchedRoute labels the requests that match no route, so that unknown paths do not each get their own series
+const unmatchedRoute = "unmatched"
+
+// metricsMiddleware is a middleware to record the route, status and duration of every request
+func metricsMiddleware(metrics port.Metrics) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		start := time.Now()
+
+		ctx.Next()
+
+		route := ctx.FullPath()
+		if route == "" {
+			route = unmatchedRoute
+		}
+
+		metrics.ObserveRequest(ctx.Request.Method, route, ctx.Writer.Status(), time.Since(start))
+	}
+}
+
+// tracingMiddleware is a middleware to record a span for every request,
+// continuing the trace of the client when it sends W3C trace context headers
+func tracingMiddleware() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		parent := otel.GetTextMapPropagator().Extract(ctx.Request.Context(), propagation.HeaderCarrier(ctx.Request.Header))
+
+		route := ctx.FullPath()
+		if route == "" {
+			route = unmatchedRoute
+		}
+
+		spanCtx, span := otel.Tracer(tracerName).Start(parent, ctx.Request.Method+" "+route,
+			trace.WithSpanKind(trace.SpanKindServer),
+			trace.WithAttributes(
+				semconv.HTTPRequestMethodKey.String(ctx.Request.Method),
+				semconv.HTTPRoute(route),
+				semconv.URLPath(ctx.Request.URL.Path),
+				semconv.ClientAddress(ctx.ClientIP()),
+			),
+		)
+		defer span.End()
+
+		ctx.Request = ctx.Request.WithContext(spanCtx)
+
+		ctx.Next()
+
+		status := ctx.Writer.Status()
+		span.SetAttributes(semconv.HTTPResponseStatusCode(status))
+		if status >= http.StatusInternalServerError {
+			span.SetStatus(codes.Error, http.StatusText(status))
+		}
+	}
+}
+
+// recoveryMiddleware is a middleware to recover from panics, logging them with the request context
+// and answering with an internal error
+func recoveryMiddleware() gin.HandlerFunc {
+	return gin.CustomRecoveryWithWriter(io.Discard, func(ctx *gin.Context, recovered any) {
+		slog.ErrorContext(ctx, "Panic recovered", "error", recovered, "stack", string(debug.Stack()))
+		handleAbort(ctx, domain.ErrInternal)
+	})
+}
+
 // authMiddleware is a middleware to check if the user is authenticated
 func authMiddleware(token port.TokenService) gin.HandlerFunc {
 	return func(ctx *gin.Context) {
@@ -46,29 +201,69 @@ func authMiddleware(token port.TokenService) gin.HandlerFunc {
 		}
 
 		accessToken := fields[1]
-		payload, err := token.VerifyToken(accessToken)
+		payload, err := token.VerifyToken(ctx, accessToken)
 		if err != nil {
 			handleAbort(ctx, err)
 			return
 		}
 
-		ctx.Set(authorizationPayloadKey, payload)
+		setAuthPayload(ctx, payload)
 		ctx.Next()
 	}
 }
 
-// adminMiddleware is a middleware to check if the user is an admin
-func adminMiddleware() gin.HandlerFunc {
+// authOrAPIKeyMiddleware is a middleware to check if the request carries a valid API key,
+// falling back to the authorization header when it does not send one
+func authOrAPIKeyMiddleware(token port.TokenService, apiKeys port.APIKeyService) gin.HandlerFunc {
+	tokenAuth := authMiddleware(token)
+
 	return func(ctx *gin.Context) {
-		payload := getAuthPayload(ctx, authorizationPayloadKey)
+		apiKey := ctx.GetHeader(apiKeyHeaderKey)
+		if apiKey == "" {
+			tokenAuth(ctx)
+			return
+		}
 
-		isAdmin := payload.Role == domainuser.Admin
-		if !isAdmin {
-			err := domain.ErrForbidden
+		payload, err := apiKeys.VerifyAPIKey(ctx, apiKey)
+		if err != nil {
 			handleAbort(ctx, err)
 			return
 		}
 
+		setAuthPayload(ctx, payload)
+		ctx.Next()
+	}
+}
+
+// setAuthPayload puts the payload into the context, and its user into the actor and the log attributes of the request context
+func setAuthPayload(ctx *gin.Context, payload *domainauth.TokenPayload) {
+	ctx.Set(authorizationPayloadKey, payload)
+
+	actor := domainaudit.ActorFromContext(ctx.Request.Context())
+	actor.UserID = payload.UserID
+	actor.APIKeyID = payload.APIKeyID
+	reqCtx := domainaudit.WithActor(ctx.Request.Context(), actor)
+
+	attrs := []slog.Attr{slog.Uint64("user_id", payload.UserID)}
+	if payload.APIKeyID != 0 {
+		attrs = append(attrs, slog.Uint64("api_key_id", payload.APIKeyID))
+	}
+	ctx.Request = ctx.Request.WithContext(logger.WithAttrs(reqCtx, attrs...))
+}
+
+// requirePermission is a middleware to check if the user's role grants all of the given permissions
+func requirePermission(permissions ...string) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		payload := getAuthPayload(ctx, authorizationPayloadKey)
+
+		for _, permission := range permissions {
+			if !slices.Contains(payload.Permissions, permission) {
+				err := domain.ErrForbidden
+				handleAbort(ctx, err)
+				return
+			}
+		}
+
 		ctx.Next()
 	}
 }
@@ -0,0 +1,192 @@
+package http
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/TienMinh25/go-hexagonal-architecture/internal/adapter/metrics"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/stretchr/testify/assert"
+)
+
+type metricsMiddlewareTestedInput struct {
+	method string
+	path   string
+}
+
+type metricsMiddlewareExpectedOutput struct {
+	series    string
+	rawSeries string
+}
+
+func TestMetricsMiddleware(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	testCases := []struct {
+		desc     string
+		input    metricsMiddlewareTestedInput
+		expected metricsMiddlewareExpectedOutput
+	}{
+		{
+			desc: "Success_RouteTemplate",
+			input: metricsMiddlewareTestedInput{
+				method: http.MethodGet,
+				path:   "/products/42",
+			},
+			expected: metricsMiddlewareExpectedOutput{
+				series:    `pos_http_requests_total{method="GET",route="/products/:id",status="200"} 1`,
+				rawSeries: `route="/products/42"`,
+			},
+		},
+		{
+			desc: "Success_ErrorStatus",
+			input: metricsMiddlewareTestedInput{
+				method: http.MethodDelete,
+				path:   "/products/42",
+			},
+			expected: metricsMiddlewareExpectedOutput{
+				series:    `pos_http_requests_total{method="DELETE",route="/products/:id",status="403"} 1`,
+				rawSeries: `route="/products/42"`,
+			},
+		},
+		{
+			desc: "Success_UnmatchedRoute",
+			input: metricsMiddlewareTestedInput{
+				method: http.MethodGet,
+				path:   "/unknown/42",
+			},
+			expected: metricsMiddlewareExpectedOutput{
+				series:    `pos_http_requests_total{method="GET",route="unmatched",status="404"} 1`,
+				rawSeries: `route="/unknown/42"`,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.desc, func(t *testing.T) {
+			t.Parallel()
+
+			// the pool connects lazily, so its statistics are collected without a database
+			pool, err := pgxpool.New(context.Background(), "postgres://localhost:5432/pos")
+			assert.NoError(t, err, "Pool mismatch")
+			defer pool.Close()
+
+			appMetrics, err := metrics.New(pool)
+			assert.NoError(t, err, "Metrics mismatch")
+
+			router := gin.New()
+			router.Use(metricsMiddleware(appMetrics))
+			router.GET("/products/:id", func(ctx *gin.Context) {
+				ctx.Status(http.StatusOK)
+			})
+			router.DELETE("/products/:id", func(ctx *gin.Context) {
+				ctx.AbortWithStatus(http.StatusForbidden)
+			})
+
+			router.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(tc.input.method, tc.input.path, nil))
+
+			rec := httptest.NewRecorder()
+			appMetrics.Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+			assert.Equal(t, http.StatusOK, rec.Code, "Status mismatch")
+
+			body := rec.Body.String()
+			assert.Contains(t, body, tc.expected.series, "Series mismatch")
+			assert.NotContains(t, body, tc.expected.rawSeries, "Raw path mismatch")
+			assert.Contains(t, body, "pos_db_pool_max_connections", "Pool metrics mismatch")
+		})
+	}
+}
+
+type requestIDMiddlewareTestedInput struct {
+	requestID string
+}
+
+type requestIDMiddlewareExpectedOutput struct {
+	propagated bool
+}
+
+func TestRequestIDMiddleware(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	testCases := []struct {
+		desc     string
+		input    requestIDMiddlewareTestedInput
+		expected requestIDMiddlewareExpectedOutput
+	}{
+		{
+			desc: "Success_Propagated",
+			input: requestIDMiddlewareTestedInput{
+				requestID: "client-1.trace_2:span-3",
+			},
+			expected: requestIDMiddlewareExpectedOutput{
+				propagated: true,
+			},
+		},
+		{
+			desc: "Success_Generated",
+			input: requestIDMiddlewareTestedInput{
+				requestID: "",
+			},
+			expected: requestIDMiddlewareExpectedOutput{
+				propagated: false,
+			},
+		},
+		{
+			desc: "Success_InvalidReplaced",
+			input: requestIDMiddlewareTestedInput{
+				requestID: "id\nlevel=ERROR msg=forged",
+			},
+			expected: requestIDMiddlewareExpectedOutput{
+				propagated: false,
+			},
+		},
+		{
+			desc: "Success_OversizedReplaced",
+			input: requestIDMiddlewareTestedInput{
+				requestID: strings.Repeat("a", maxRequestIDLength+1),
+			},
+			expected: requestIDMiddlewareExpectedOutput{
+				propagated: false,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.desc, func(t *testing.T) {
+			t.Parallel()
+
+			var contextRequestID string
+			router := gin.New()
+			router.Use(requestIDMiddleware())
+			router.GET("/orders/:id", func(ctx *gin.Context) {
+				contextRequestID = ctx.GetString(requestIDContextKey)
+				ctx.Status(http.StatusOK)
+			})
+
+			req := httptest.NewRequest(http.MethodGet, "/orders/42", nil)
+			if tc.input.requestID != "" {
+				req.Header.Set(requestIDHeaderKey, tc.input.requestID)
+			}
+
+			rec := httptest.NewRecorder()
+			router.ServeHTTP(rec, req)
+
+			requestID := rec.Header().Get(requestIDHeaderKey)
+			assert.Equal(t, requestID, contextRequestID, "Context request id mismatch")
+
+			if tc.expected.propagated {
+				assert.Equal(t, tc.input.requestID, requestID, "Request id mismatch")
+			} else {
+				_, err := uuid.Parse(requestID)
+				assert.NoError(t, err, "Generated request id mismatch")
+			}
+		})
+	}
+}
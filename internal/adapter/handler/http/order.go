@@ -1,6 +1,7 @@
 package http
 
 import (
+	domaingiftcard "github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain/giftcard"
 	domainorder "github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain/order"
 	"github.com/TienMinh25/go-hexagonal-architecture/internal/application/port"
 	modelv1 "github.com/TienMinh25/go-hexagonal-architecture/pkg/model/v1"
@@ -53,11 +54,27 @@ func (oh *OrderHandler) CreateOrder(ctx *gin.Context) {
 	authPayload := getAuthPayload(ctx, authorizationPayloadKey)
 
 	order := domainorder.Order{
-		UserID:       authPayload.UserID,
-		PaymentID:    req.PaymentID,
-		CustomerName: req.CustomerName,
-		TotalPaid:    float64(req.TotalPaid),
-		Products:     products,
+		UserID:         authPayload.UserID,
+		PaymentID:      req.PaymentID,
+		CustomerName:   req.CustomerName,
+		TotalPaid:      float64(req.TotalPaid),
+		PointsRedeemed: req.RedeemPoints,
+		Products:       products,
+	}
+
+	if req.CustomerID != 0 {
+		order.CustomerID = &req.CustomerID
+	}
+
+	// orders taken after a PIN login are attributed to the terminal of the session
+	if authPayload.TerminalID != 0 {
+		order.TerminalID = &authPayload.TerminalID
+	}
+
+	if req.GiftCardCode != "" {
+		order.GiftCard = &domaingiftcard.GiftCard{
+			Code: req.GiftCardCode,
+		}
 	}
 
 	_, err := oh.svc.CreateOrder(ctx, &order)
@@ -110,12 +127,13 @@ func (oh *OrderHandler) GetOrder(ctx *gin.Context) {
 //	@Tags			Orders
 //	@Accept			json
 //	@Produce		json
-//	@Param			skip	query		uint64					true	"Skip records"
-//	@Param			limit	query		uint64					true	"Limit records"
-//	@Success		200		{object}	modelv1.Meta			"Orders displayed"
-//	@Failure		400		{object}	modelv1.ErrorResponse	"Validation error"
-//	@Failure		401		{object}	modelv1.ErrorResponse	"Unauthorized error"
-//	@Failure		500		{object}	modelv1.ErrorResponse	"Internal server error"
+//	@Param			customer_id	query		uint64					false	"Customer ID"
+//	@Param			skip		query		uint64					true	"Skip records"
+//	@Param			limit		query		uint64					true	"Limit records"
+//	@Success		200			{object}	modelv1.Meta			"Orders displayed"
+//	@Failure		400			{object}	modelv1.ErrorResponse	"Validation error"
+//	@Failure		401			{object}	modelv1.ErrorResponse	"Unauthorized error"
+//	@Failure		500			{object}	modelv1.ErrorResponse	"Internal server error"
 //	@Router			/orders [get]
 //	@Security		BearerAuth
 func (oh *OrderHandler) ListOrders(ctx *gin.Context) {
@@ -127,7 +145,7 @@ func (oh *OrderHandler) ListOrders(ctx *gin.Context) {
 		return
 	}
 
-	orders, err := oh.svc.ListOrders(ctx, req.Skip, req.Limit)
+	orders, err := oh.svc.ListOrders(ctx, req.CustomerID, req.Skip, req.Limit)
 	if err != nil {
 		handleError(ctx, err)
 		return
@@ -143,3 +161,38 @@ func (oh *OrderHandler) ListOrders(ctx *gin.Context) {
 
 	handleSuccess(ctx, rsp)
 }
+
+// RefundOrder godoc
+//
+//	@Summary		Refund an order
+//	@Description	Refund an order by id, restocking its products and reversing the loyalty points it earned or redeemed
+//	@Tags			Orders
+//	@Accept			json
+//	@Produce		json
+//	@Param			id	path		uint64					true	"Order ID"
+//	@Success		200	{object}	modelv1.OrderResponse	"Order refunded"
+//	@Failure		400	{object}	modelv1.ErrorResponse	"Validation error"
+//	@Failure		401	{object}	modelv1.ErrorResponse	"Unauthorized error"
+//	@Failure		403	{object}	modelv1.ErrorResponse	"Forbidden error"
+//	@Failure		404	{object}	modelv1.ErrorResponse	"Data not found error"
+//	@Failure		409	{object}	modelv1.ErrorResponse	"Order already refunded error"
+//	@Failure		500	{object}	modelv1.ErrorResponse	"Internal server error"
+//	@Router			/orders/{id}/refund [post]
+//	@Security		BearerAuth
+func (oh *OrderHandler) RefundOrder(ctx *gin.Context) {
+	var req modelv1.RefundOrderRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		validationError(ctx, err)
+		return
+	}
+
+	order, err := oh.svc.RefundOrder(ctx, req.ID)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	rsp := newOrderResponse(order)
+
+	handleSuccess(ctx, rsp)
+}
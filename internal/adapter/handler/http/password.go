@@ -0,0 +1,109 @@
+package http
+
+import (
+	"github.com/TienMinh25/go-hexagonal-architecture/internal/application/port"
+	modelv1 "github.com/TienMinh25/go-hexagonal-architecture/pkg/model/v1"
+	"github.com/gin-gonic/gin"
+)
+
+// PasswordHandler represents the HTTP handler for password-related requests
+type PasswordHandler struct {
+	svc port.PasswordService
+}
+
+// NewPasswordHandler creates a new PasswordHandler instance
+func NewPasswordHandler(svc port.PasswordService) *PasswordHandler {
+	return &PasswordHandler{
+		svc,
+	}
+}
+
+// ChangePassword godoc
+//
+//	@Summary		Change own password
+//	@Description	Changes the password of the logged in user given the current one and revokes the user's refresh tokens
+//	@Tags			Users
+//	@Accept			json
+//	@Produce		json
+//	@Param			changePasswordRequest	body		modelv1.ChangePasswordRequest	true	"Change password request"
+//	@Success		200						{object}	modelv1.Response				"Password changed"
+//	@Failure		400						{object}	modelv1.ErrorResponse			"Validation error"
+//	@Failure		401						{object}	modelv1.ErrorResponse			"Unauthorized error"
+//	@Failure		403						{object}	modelv1.ErrorResponse			"Incorrect password error"
+//	@Failure		500						{object}	modelv1.ErrorResponse			"Internal server error"
+//	@Router			/users/me/password [post]
+//	@Security		BearerAuth
+func (ph *PasswordHandler) ChangePassword(ctx *gin.Context) {
+	var req modelv1.ChangePasswordRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		validationError(ctx, err)
+		return
+	}
+
+	authPayload := getAuthPayload(ctx, authorizationPayloadKey)
+
+	err := ph.svc.ChangePassword(ctx, authPayload.UserID, req.CurrentPassword, req.NewPassword)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	handleSuccess(ctx, nil)
+}
+
+// ForgotPassword godoc
+//
+//	@Summary		Request a password reset
+//	@Description	Mails a single-use password reset link to the user with the email. The response is the same whether or not the email belongs to a user, the mail is sent after it. Requests are throttled per email and client ip.
+//	@Tags			Auth
+//	@Accept			json
+//	@Produce		json
+//	@Param			forgotPasswordRequest	body		modelv1.ForgotPasswordRequest	true	"Forgot password request"
+//	@Success		200						{object}	modelv1.Response				"Reset link sent if the user exists"
+//	@Failure		400						{object}	modelv1.ErrorResponse			"Validation error"
+//	@Failure		429						{object}	modelv1.ErrorResponse			"Too many requests error"
+//	@Router			/auth/password/forgot [post]
+func (ph *PasswordHandler) ForgotPassword(ctx *gin.Context) {
+	var req modelv1.ForgotPasswordRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		validationError(ctx, err)
+		return
+	}
+
+	err := ph.svc.RequestPasswordReset(ctx, req.Email, ctx.ClientIP())
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	handleSuccess(ctx, nil)
+}
+
+// ResetPassword godoc
+//
+//	@Summary		Reset a password
+//	@Description	Sets a new password with the token from a password reset link and revokes the user's refresh tokens. A token can be used once.
+//	@Tags			Auth
+//	@Accept			json
+//	@Produce		json
+//	@Param			resetPasswordRequest	body		modelv1.ResetPasswordRequest	true	"Reset password request"
+//	@Success		200						{object}	modelv1.Response				"Password reset"
+//	@Failure		400						{object}	modelv1.ErrorResponse			"Validation error"
+//	@Failure		401						{object}	modelv1.ErrorResponse			"Invalid reset token error"
+//	@Failure		500						{object}	modelv1.ErrorResponse			"Internal server error"
+//	@Router			/auth/password/reset [post]
+func (ph *PasswordHandler) ResetPassword(ctx *gin.Context) {
+	var req modelv1.ResetPasswordRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		validationError(ctx, err)
+		return
+	}
+
+	err := ph.svc.ResetPassword(ctx, req.Token, req.Password)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	handleSuccess(ctx, nil)
+}
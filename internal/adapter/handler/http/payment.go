@@ -28,6 +28,7 @@ func NewPaymentHandler(svc port.PaymentService) *PaymentHandler {
 //	@Produce		json
 //	@Param			createPaymentRequest	body		modelv1.CreatePaymentRequest	true	"Create payment request"
 //	@Success		200						{object}	modelv1.PaymentResponse			"Payment created"
+//	@Header			200						{string}	ETag							"Version of the payment"
 //	@Failure		400						{object}	modelv1.ErrorResponse			"Validation error"
 //	@Failure		401						{object}	modelv1.ErrorResponse			"Unauthorized error"
 //	@Failure		403						{object}	modelv1.ErrorResponse			"Forbidden error"
@@ -56,6 +57,7 @@ func (ph *PaymentHandler) CreatePayment(ctx *gin.Context) {
 	}
 
 	rsp := newPaymentResponse(&payment)
+	setETag(ctx, payment.Version)
 
 	handleSuccess(ctx, rsp)
 }
@@ -69,6 +71,7 @@ func (ph *PaymentHandler) CreatePayment(ctx *gin.Context) {
 //	@Produce		json
 //	@Param			id	path		int						true	"Payment ID"
 //	@Success		200	{object}	modelv1.PaymentResponse	"Payment retrieved"
+//	@Header			200	{string}	ETag					"Version of the payment"
 //	@Failure		400	{object}	modelv1.ErrorResponse	"Validation error"
 //	@Failure		404	{object}	modelv1.ErrorResponse	"Data not found error"
 //	@Failure		500	{object}	modelv1.ErrorResponse	"Internal server error"
@@ -88,6 +91,7 @@ func (ph *PaymentHandler) GetPayment(ctx *gin.Context) {
 	}
 
 	rsp := newPaymentResponse(payment)
+	setETag(ctx, payment.Version)
 
 	handleSuccess(ctx, rsp)
 }
@@ -99,11 +103,12 @@ func (ph *PaymentHandler) GetPayment(ctx *gin.Context) {
 //	@Tags			Payments
 //	@Accept			json
 //	@Produce		json
-//	@Param			skip	query		uint64					true	"Skip"
-//	@Param			limit	query		uint64					true	"Limit"
-//	@Success		200		{object}	modelv1.Meta			"Payments displayed"
-//	@Failure		400		{object}	modelv1.ErrorResponse	"Validation error"
-//	@Failure		500		{object}	modelv1.ErrorResponse	"Internal server error"
+//	@Param			skip				query		uint64					true	"Skip"
+//	@Param			limit				query		uint64					true	"Limit"
+//	@Param			include_archived	query		bool					false	"Include archived records"
+//	@Success		200					{object}	modelv1.Meta			"Payments displayed"
+//	@Failure		400					{object}	modelv1.ErrorResponse	"Validation error"
+//	@Failure		500					{object}	modelv1.ErrorResponse	"Internal server error"
 //	@Router			/payments [get]
 //	@Security		BearerAuth
 func (ph *PaymentHandler) ListPayments(ctx *gin.Context) {
@@ -115,7 +120,7 @@ func (ph *PaymentHandler) ListPayments(ctx *gin.Context) {
 		return
 	}
 
-	payments, err := ph.svc.ListPayments(ctx, req.Skip, req.Limit)
+	payments, err := ph.svc.ListPayments(ctx, req.Skip, req.Limit, req.IncludeArchived)
 	if err != nil {
 		handleError(ctx, err)
 		return
@@ -140,13 +145,17 @@ func (ph *PaymentHandler) ListPayments(ctx *gin.Context) {
 //	@Accept			json
 //	@Produce		json
 //	@Param			id						path		int								true	"Payment ID"
+//	@Param			If-Match				header		string							true	"ETag of the payment version being modified"
 //	@Param			updatePaymentRequest	body		modelv1.UpdatePaymentRequest	true	"Update payment request"
 //	@Success		200						{object}	modelv1.PaymentResponse			"Payment updated"
+//	@Header			200						{string}	ETag							"Version of the payment"
 //	@Failure		400						{object}	modelv1.ErrorResponse			"Validation error"
 //	@Failure		401						{object}	modelv1.ErrorResponse			"Unauthorized error"
 //	@Failure		403						{object}	modelv1.ErrorResponse			"Forbidden error"
 //	@Failure		404						{object}	modelv1.ErrorResponse			"Data not found error"
 //	@Failure		409						{object}	modelv1.ErrorResponse			"Data conflict error"
+//	@Failure		412						{object}	modelv1.ErrorResponse			"Version mismatch error"
+//	@Failure		428						{object}	modelv1.ErrorResponse			"Version required error"
 //	@Failure		500						{object}	modelv1.ErrorResponse			"Internal server error"
 //	@Router			/payments/{id} [put]
 //	@Security		BearerAuth
@@ -164,11 +173,18 @@ func (ph *PaymentHandler) UpdatePayment(ctx *gin.Context) {
 		return
 	}
 
+	version, err := getIfMatchVersion(ctx)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
 	payment := domainpayment.Payment{
-		ID:   id,
-		Name: req.Name,
-		Type: req.Type,
-		Logo: req.Logo,
+		ID:      id,
+		Name:    req.Name,
+		Type:    req.Type,
+		Logo:    req.Logo,
+		Version: version,
 	}
 
 	_, err = ph.svc.UpdatePayment(ctx, &payment)
@@ -178,6 +194,7 @@ func (ph *PaymentHandler) UpdatePayment(ctx *gin.Context) {
 	}
 
 	rsp := newPaymentResponse(&payment)
+	setETag(ctx, payment.Version)
 
 	handleSuccess(ctx, rsp)
 }
@@ -185,31 +202,77 @@ func (ph *PaymentHandler) UpdatePayment(ctx *gin.Context) {
 // DeletePayment godoc
 //
 //	@Summary		Delete a payment
-//	@Description	Delete a payment by id
+//	@Description	Archive a payment by id, keeping it resolvable from past orders
+//	@Tags			Payments
+//	@Accept			json
+//	@Produce		json
+//	@Param			id			path		uint64					true	"Payment ID"
+//	@Param			If-Match	header		string					true	"ETag of the payment version being modified"
+//	@Success		200			{object}	modelv1.Response		"Payment deleted"
+//	@Failure		400			{object}	modelv1.ErrorResponse	"Validation error"
+//	@Failure		401			{object}	modelv1.ErrorResponse	"Unauthorized error"
+//	@Failure		403			{object}	modelv1.ErrorResponse	"Forbidden error"
+//	@Failure		404			{object}	modelv1.ErrorResponse	"Data not found error"
+//	@Failure		412			{object}	modelv1.ErrorResponse	"Version mismatch error"
+//	@Failure		428			{object}	modelv1.ErrorResponse	"Version required error"
+//	@Failure		500			{object}	modelv1.ErrorResponse	"Internal server error"
+//	@Router			/payments/{id} [delete]
+//	@Security		BearerAuth
+func (ph *PaymentHandler) DeletePayment(ctx *gin.Context) {
+	var req modelv1.DeletePaymentRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		validationError(ctx, err)
+		return
+	}
+
+	version, err := getIfMatchVersion(ctx)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	err = ph.svc.DeletePayment(ctx, req.ID, version)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	handleSuccess(ctx, nil)
+}
+
+// RestorePayment godoc
+//
+//	@Summary		Restore a payment
+//	@Description	Restore an archived payment by id
 //	@Tags			Payments
 //	@Accept			json
 //	@Produce		json
 //	@Param			id	path		uint64					true	"Payment ID"
-//	@Success		200	{object}	modelv1.Response		"Payment deleted"
+//	@Success		200	{object}	modelv1.PaymentResponse	"Payment restored"
+//	@Header			200	{string}	ETag					"Version of the payment"
 //	@Failure		400	{object}	modelv1.ErrorResponse	"Validation error"
 //	@Failure		401	{object}	modelv1.ErrorResponse	"Unauthorized error"
 //	@Failure		403	{object}	modelv1.ErrorResponse	"Forbidden error"
 //	@Failure		404	{object}	modelv1.ErrorResponse	"Data not found error"
+//	@Failure		409	{object}	modelv1.ErrorResponse	"Data not archived error"
 //	@Failure		500	{object}	modelv1.ErrorResponse	"Internal server error"
-//	@Router			/payments/{id} [delete]
+//	@Router			/payments/{id}/restore [post]
 //	@Security		BearerAuth
-func (ph *PaymentHandler) DeletePayment(ctx *gin.Context) {
-	var req modelv1.DeletePaymentRequest
+func (ph *PaymentHandler) RestorePayment(ctx *gin.Context) {
+	var req modelv1.RestorePaymentRequest
 	if err := ctx.ShouldBindUri(&req); err != nil {
 		validationError(ctx, err)
 		return
 	}
 
-	err := ph.svc.DeletePayment(ctx, req.ID)
+	payment, err := ph.svc.RestorePayment(ctx, req.ID)
 	if err != nil {
 		handleError(ctx, err)
 		return
 	}
 
-	handleSuccess(ctx, nil)
+	rsp := newPaymentResponse(payment)
+	setETag(ctx, payment.Version)
+
+	handleSuccess(ctx, rsp)
 }
@@ -28,6 +28,7 @@ func NewProductHandler(svc port.ProductService) *ProductHandler {
 //	@Produce		json
 //	@Param			createProductRequest	body		modelv1.CreateProductRequest	true	"Create product request"
 //	@Success		200						{object}	modelv1.ProductResponse			"Product created"
+//	@Header			200						{string}	ETag							"Version of the product"
 //	@Failure		400						{object}	modelv1.ErrorResponse			"Validation error"
 //	@Failure		401						{object}	modelv1.ErrorResponse			"Unauthorized error"
 //	@Failure		403						{object}	modelv1.ErrorResponse			"Forbidden error"
@@ -36,6 +37,7 @@ func NewProductHandler(svc port.ProductService) *ProductHandler {
 //	@Failure		500						{object}	modelv1.ErrorResponse			"Internal server error"
 //	@Router			/products [post]
 //	@Security		BearerAuth
+//	@Security		APIKeyAuth
 func (ph *ProductHandler) CreateProduct(ctx *gin.Context) {
 	var req modelv1.CreateProductRequest
 	if err := ctx.ShouldBindJSON(&req); err != nil {
@@ -58,6 +60,7 @@ func (ph *ProductHandler) CreateProduct(ctx *gin.Context) {
 	}
 
 	rsp := newProductResponse(&product)
+	setETag(ctx, product.Version)
 
 	handleSuccess(ctx, rsp)
 }
@@ -71,11 +74,13 @@ func (ph *ProductHandler) CreateProduct(ctx *gin.Context) {
 //	@Produce		json
 //	@Param			id	path		uint64					true	"Product ID"
 //	@Success		200	{object}	modelv1.ProductResponse	"Product retrieved"
+//	@Header			200	{string}	ETag					"Version of the product"
 //	@Failure		400	{object}	modelv1.ErrorResponse	"Validation error"
 //	@Failure		404	{object}	modelv1.ErrorResponse	"Data not found error"
 //	@Failure		500	{object}	modelv1.ErrorResponse	"Internal server error"
 //	@Router			/products/{id} [get]
 //	@Security		BearerAuth
+//	@Security		APIKeyAuth
 func (ph *ProductHandler) GetProduct(ctx *gin.Context) {
 	var req modelv1.GetProductRequest
 	if err := ctx.ShouldBindUri(&req); err != nil {
@@ -90,6 +95,7 @@ func (ph *ProductHandler) GetProduct(ctx *gin.Context) {
 	}
 
 	rsp := newProductResponse(product)
+	setETag(ctx, product.Version)
 
 	handleSuccess(ctx, rsp)
 }
@@ -101,15 +107,17 @@ func (ph *ProductHandler) GetProduct(ctx *gin.Context) {
 //	@Tags			Products
 //	@Accept			json
 //	@Produce		json
-//	@Param			category_id	query		uint64					false	"Category ID"
-//	@Param			q			query		string					false	"Query"
-//	@Param			skip		query		uint64					true	"Skip"
-//	@Param			limit		query		uint64					true	"Limit"
-//	@Success		200			{object}	modelv1.Meta			"Products retrieved"
-//	@Failure		400			{object}	modelv1.ErrorResponse	"Validation error"
-//	@Failure		500			{object}	modelv1.ErrorResponse	"Internal server error"
+//	@Param			category_id			query		uint64					false	"Category ID"
+//	@Param			q					query		string					false	"Query"
+//	@Param			skip				query		uint64					true	"Skip"
+//	@Param			limit				query		uint64					true	"Limit"
+//	@Param			include_archived	query		bool					false	"Include archived records"
+//	@Success		200					{object}	modelv1.Meta			"Products retrieved"
+//	@Failure		400					{object}	modelv1.ErrorResponse	"Validation error"
+//	@Failure		500					{object}	modelv1.ErrorResponse	"Internal server error"
 //	@Router			/products [get]
 //	@Security		BearerAuth
+//	@Security		APIKeyAuth
 func (ph *ProductHandler) ListProducts(ctx *gin.Context) {
 	var req modelv1.ListProductsRequest
 	var productsList []modelv1.ProductResponse
@@ -119,7 +127,7 @@ func (ph *ProductHandler) ListProducts(ctx *gin.Context) {
 		return
 	}
 
-	products, err := ph.svc.ListProducts(ctx, req.Query, req.CategoryID, req.Skip, req.Limit)
+	products, err := ph.svc.ListProducts(ctx, req.Query, req.CategoryID, req.Skip, req.Limit, req.IncludeArchived)
 	if err != nil {
 		handleError(ctx, err)
 		return
@@ -144,16 +152,21 @@ func (ph *ProductHandler) ListProducts(ctx *gin.Context) {
 //	@Accept			json
 //	@Produce		json
 //	@Param			id						path		uint64							true	"Product ID"
+//	@Param			If-Match				header		string							true	"ETag of the product version being modified"
 //	@Param			updateProductRequest	body		modelv1.UpdateProductRequest	true	"Update product request"
 //	@Success		200						{object}	modelv1.ProductResponse			"Product updated"
+//	@Header			200						{string}	ETag							"Version of the product"
 //	@Failure		400						{object}	modelv1.ErrorResponse			"Validation error"
 //	@Failure		401						{object}	modelv1.ErrorResponse			"Unauthorized error"
 //	@Failure		403						{object}	modelv1.ErrorResponse			"Forbidden error"
 //	@Failure		404						{object}	modelv1.ErrorResponse			"Data not found error"
 //	@Failure		409						{object}	modelv1.ErrorResponse			"Data conflict error"
+//	@Failure		412						{object}	modelv1.ErrorResponse			"Version mismatch error"
+//	@Failure		428						{object}	modelv1.ErrorResponse			"Version required error"
 //	@Failure		500						{object}	modelv1.ErrorResponse			"Internal server error"
 //	@Router			/products/{id} [put]
 //	@Security		BearerAuth
+//	@Security		APIKeyAuth
 func (ph *ProductHandler) UpdateProduct(ctx *gin.Context) {
 	var req modelv1.UpdateProductRequest
 	if err := ctx.ShouldBindJSON(&req); err != nil {
@@ -168,6 +181,12 @@ func (ph *ProductHandler) UpdateProduct(ctx *gin.Context) {
 		return
 	}
 
+	version, err := getIfMatchVersion(ctx)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
 	product := domainproduct.Product{
 		ID:         id,
 		CategoryID: req.CategoryID,
@@ -175,6 +194,7 @@ func (ph *ProductHandler) UpdateProduct(ctx *gin.Context) {
 		Image:      req.Image,
 		Price:      req.Price,
 		Stock:      req.Stock,
+		Version:    version,
 	}
 
 	_, err = ph.svc.UpdateProduct(ctx, &product)
@@ -184,6 +204,7 @@ func (ph *ProductHandler) UpdateProduct(ctx *gin.Context) {
 	}
 
 	rsp := newProductResponse(&product)
+	setETag(ctx, product.Version)
 
 	handleSuccess(ctx, rsp)
 }
@@ -191,31 +212,79 @@ func (ph *ProductHandler) UpdateProduct(ctx *gin.Context) {
 // DeleteProduct godoc
 //
 //	@Summary		Delete a product
-//	@Description	Delete a product by id
+//	@Description	Archive a product by id, keeping it resolvable from past orders
+//	@Tags			Products
+//	@Accept			json
+//	@Produce		json
+//	@Param			id			path		uint64					true	"Product ID"
+//	@Param			If-Match	header		string					true	"ETag of the product version being modified"
+//	@Success		200			{object}	modelv1.Response		"Product deleted"
+//	@Failure		400			{object}	modelv1.ErrorResponse	"Validation error"
+//	@Failure		401			{object}	modelv1.ErrorResponse	"Unauthorized error"
+//	@Failure		403			{object}	modelv1.ErrorResponse	"Forbidden error"
+//	@Failure		404			{object}	modelv1.ErrorResponse	"Data not found error"
+//	@Failure		412			{object}	modelv1.ErrorResponse	"Version mismatch error"
+//	@Failure		428			{object}	modelv1.ErrorResponse	"Version required error"
+//	@Failure		500			{object}	modelv1.ErrorResponse	"Internal server error"
+//	@Router			/products/{id} [delete]
+//	@Security		BearerAuth
+//	@Security		APIKeyAuth
+func (ph *ProductHandler) DeleteProduct(ctx *gin.Context) {
+	var req modelv1.DeleteProductRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		validationError(ctx, err)
+		return
+	}
+
+	version, err := getIfMatchVersion(ctx)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	err = ph.svc.DeleteProduct(ctx, req.ID, version)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	handleSuccess(ctx, nil)
+}
+
+// RestoreProduct godoc
+//
+//	@Summary		Restore a product
+//	@Description	Restore an archived product by id
 //	@Tags			Products
 //	@Accept			json
 //	@Produce		json
 //	@Param			id	path		uint64					true	"Product ID"
-//	@Success		200	{object}	modelv1.Response		"Product deleted"
+//	@Success		200	{object}	modelv1.ProductResponse	"Product restored"
+//	@Header			200	{string}	ETag					"Version of the product"
 //	@Failure		400	{object}	modelv1.ErrorResponse	"Validation error"
 //	@Failure		401	{object}	modelv1.ErrorResponse	"Unauthorized error"
 //	@Failure		403	{object}	modelv1.ErrorResponse	"Forbidden error"
 //	@Failure		404	{object}	modelv1.ErrorResponse	"Data not found error"
+//	@Failure		409	{object}	modelv1.ErrorResponse	"Data not archived error"
 //	@Failure		500	{object}	modelv1.ErrorResponse	"Internal server error"
-//	@Router			/products/{id} [delete]
+//	@Router			/products/{id}/restore [post]
 //	@Security		BearerAuth
-func (ph *ProductHandler) DeleteProduct(ctx *gin.Context) {
-	var req modelv1.DeleteProductRequest
+//	@Security		APIKeyAuth
+func (ph *ProductHandler) RestoreProduct(ctx *gin.Context) {
+	var req modelv1.RestoreProductRequest
 	if err := ctx.ShouldBindUri(&req); err != nil {
 		validationError(ctx, err)
 		return
 	}
 
-	err := ph.svc.DeleteProduct(ctx, req.ID)
+	product, err := ph.svc.RestoreProduct(ctx, req.ID)
 	if err != nil {
 		handleError(ctx, err)
 		return
 	}
 
-	handleSuccess(ctx, nil)
+	rsp := newProductResponse(product)
+	setETag(ctx, product.Version)
+
+	handleSuccess(ctx, rsp)
 }
@@ -1,17 +1,34 @@
 package http
 
 import (
+	"encoding/base64"
+	"encoding/json"
 	"errors"
+	"fmt"
+	"io"
+	"log/slog"
 	"net/http"
+	"strings"
 
+	"github.com/TienMinh25/go-hexagonal-architecture/internal/adapter/i18n"
 	"github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain"
+	domainaudit "github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain/audit"
+	domainauth "github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain/auth"
 	domaincategory "github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain/category"
+	domaincustomer "github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain/customer"
+	domaingiftcard "github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain/giftcard"
+	domainhealth "github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain/health"
+	domainloyalty "github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain/loyalty"
 	domainorder "github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain/order"
 	domainpayment "github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain/payment"
 	domainproduct "github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain/product"
+	domainrole "github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain/role"
+	domainterminal "github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain/terminal"
 	domainuser "github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain/user"
 	modelv1 "github.com/TienMinh25/go-hexagonal-architecture/pkg/model/v1"
 	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+	ut "github.com/go-playground/universal-translator"
 	"github.com/go-playground/validator/v10"
 )
 
@@ -39,26 +56,33 @@ func newUserResponse(user *domainuser.User) modelv1.UserResponse {
 		ID:        user.ID,
 		Name:      user.Name,
 		Email:     user.Email,
+		Locale:    user.Locale,
 		CreatedAt: user.CreatedAt,
 		UpdatedAt: user.UpdatedAt,
+		DeletedAt: user.DeletedAt,
+		Version:   user.Version,
 	}
 }
 
 // newPaymentResponse is a helper function to create a response body for handling payment data
 func newPaymentResponse(payment *domainpayment.Payment) modelv1.PaymentResponse {
 	return modelv1.PaymentResponse{
-		ID:   payment.ID,
-		Name: payment.Name,
-		Type: payment.Type,
-		Logo: payment.Logo,
+		ID:        payment.ID,
+		Name:      payment.Name,
+		Type:      payment.Type,
+		Logo:      payment.Logo,
+		DeletedAt: payment.DeletedAt,
+		Version:   payment.Version,
 	}
 }
 
 // newCategoryResponse is a helper function to create a response body for handling category data
 func newCategoryResponse(category *domaincategory.Category) modelv1.CategoryResponse {
 	return modelv1.CategoryResponse{
-		ID:   category.ID,
-		Name: category.Name,
+		ID:        category.ID,
+		Name:      category.Name,
+		DeletedAt: category.DeletedAt,
+		Version:   category.Version,
 	}
 }
 
@@ -74,31 +98,247 @@ func newProductResponse(product *domainproduct.Product) modelv1.ProductResponse
 		Category:  newCategoryResponse(product.Category),
 		CreatedAt: product.CreatedAt,
 		UpdatedAt: product.UpdatedAt,
+		DeletedAt: product.DeletedAt,
+		Version:   product.Version,
+	}
+}
+
+// newCustomerResponse is a helper function to create a response body for handling customer data
+func newCustomerResponse(customer *domaincustomer.Customer) modelv1.CustomerResponse {
+	return modelv1.CustomerResponse{
+		ID:        customer.ID,
+		Name:      customer.Name,
+		Phone:     customer.Phone,
+		Email:     customer.Email,
+		Notes:     customer.Notes,
+		CreatedAt: customer.CreatedAt,
+		UpdatedAt: customer.UpdatedAt,
+		DeletedAt: customer.DeletedAt,
+		Version:   customer.Version,
+	}
+}
+
+// newPurchaseSummaryResponse is a helper function to create a response body for handling a customer's purchase summary
+func newPurchaseSummaryResponse(summary *domaincustomer.PurchaseSummary) modelv1.PurchaseSummaryResponse {
+	return modelv1.PurchaseSummaryResponse{
+		CustomerID:    summary.CustomerID,
+		Visits:        summary.Visits,
+		LifetimeSpend: summary.LifetimeSpend,
+		FirstVisitAt:  summary.FirstVisitAt,
+		LastVisitAt:   summary.LastVisitAt,
+	}
+}
+
+// newLoyaltyBalanceResponse is a helper function to create a response body for handling a customer's points balance
+func newLoyaltyBalanceResponse(balance *domainloyalty.Balance) modelv1.LoyaltyBalanceResponse {
+	return modelv1.LoyaltyBalanceResponse{
+		CustomerID: balance.CustomerID,
+		Points:     balance.Points,
+		Value:      balance.Value,
+	}
+}
+
+// newGiftCardResponse is a helper function to create a response body for handling gift card data
+func newGiftCardResponse(giftCard *domaingiftcard.GiftCard) modelv1.GiftCardResponse {
+	return modelv1.GiftCardResponse{
+		ID:             giftCard.ID,
+		Code:           giftCard.Code,
+		InitialBalance: giftCard.InitialBalance,
+		Balance:        giftCard.Balance,
+		ExpiresAt:      giftCard.ExpiresAt,
+		CreatedAt:      giftCard.CreatedAt,
+		UpdatedAt:      giftCard.UpdatedAt,
+	}
+}
+
+// newGiftCardSaleResponse is a helper function to create a response body for handling a gift card sold through an order
+func newGiftCardSaleResponse(giftCard *domaingiftcard.GiftCard, order *domainorder.Order) modelv1.GiftCardSaleResponse {
+	return modelv1.GiftCardSaleResponse{
+		GiftCard:    newGiftCardResponse(giftCard),
+		OrderID:     order.ID,
+		ReceiptCode: order.ReceiptCode.String(),
+		TotalPrice:  order.TotalPrice,
+		TotalPaid:   order.TotalPaid,
+		TotalReturn: order.TotalReturn,
 	}
 }
 
 // newAuthResponse is a helper function to create a response body for handling authentication data
-func newAuthResponse(token string) modelv1.AuthResponse {
+func newAuthResponse(tokens *domainauth.TokenPair) modelv1.AuthResponse {
 	return modelv1.AuthResponse{
-		AccessToken: token,
+		AccessToken:  tokens.AccessToken,
+		RefreshToken: tokens.RefreshToken,
 	}
 }
 
+// newLoginResponse is a helper function to create a response body for handling login data
+func newLoginResponse(result *domainauth.LoginResult) modelv1.LoginResponse {
+	if result.Tokens == nil {
+		return modelv1.LoginResponse{
+			TwoFactorRequired: true,
+			Challenge:         result.Challenge,
+		}
+	}
+
+	return modelv1.LoginResponse{
+		AccessToken:  result.Tokens.AccessToken,
+		RefreshToken: result.Tokens.RefreshToken,
+	}
+}
+
+// newRoleResponse is a helper function to create a response body for handling role data
+func newRoleResponse(role *domainrole.Role) modelv1.RoleResponse {
+	return modelv1.RoleResponse{
+		ID:          role.ID,
+		Name:        role.Name,
+		Description: role.Description,
+		IsSystem:    role.IsSystem,
+		Permissions: role.Permissions,
+		CreatedAt:   role.CreatedAt,
+		UpdatedAt:   role.UpdatedAt,
+	}
+}
+
+// newPermissionResponse is a helper function to create a response body for handling permission data
+func newPermissionResponse(permission *domainrole.Permission) modelv1.PermissionResponse {
+	return modelv1.PermissionResponse{
+		ID:          permission.ID,
+		Name:        permission.Name,
+		Description: permission.Description,
+	}
+}
+
+// newTerminalResponse is a helper function to create a response body for handling terminal data
+func newTerminalResponse(terminal *domainterminal.Terminal) modelv1.TerminalResponse {
+	return modelv1.TerminalResponse{
+		ID:        terminal.ID,
+		Name:      terminal.Name,
+		CreatedAt: terminal.CreatedAt,
+		RevokedAt: terminal.RevokedAt,
+	}
+}
+
+// newInvitationResponse is a helper function to create a response body for handling invitation data
+func newInvitationResponse(invitation *domainuser.Invitation) modelv1.InvitationResponse {
+	return modelv1.InvitationResponse{
+		ID:         invitation.ID,
+		Email:      invitation.Email,
+		Role:       invitation.Role,
+		InvitedBy:  invitation.InvitedBy,
+		ExpiresAt:  invitation.ExpiresAt,
+		AcceptedAt: invitation.AcceptedAt,
+		RevokedAt:  invitation.RevokedAt,
+		CreatedAt:  invitation.CreatedAt,
+	}
+}
+
+// newAPIKeyResponse is a helper function to create a response body for handling API key data
+func newAPIKeyResponse(key *domainauth.APIKey) modelv1.APIKeyResponse {
+	return modelv1.APIKeyResponse{
+		ID:         key.ID,
+		Name:       key.Name,
+		Prefix:     key.Prefix,
+		Scopes:     key.Scopes,
+		CreatedBy:  key.CreatedBy,
+		ExpiresAt:  key.ExpiresAt,
+		LastUsedAt: key.LastUsedAt,
+		RevokedAt:  key.RevokedAt,
+		CreatedAt:  key.CreatedAt,
+	}
+}
+
+// newAuditLogResponse is a helper function to create a response body for handling audit log data
+func newAuditLogResponse(log *domainaudit.Log) modelv1.AuditLogResponse {
+	return modelv1.AuditLogResponse{
+		ID:        log.ID,
+		ActorID:   log.ActorID,
+		APIKeyID:  log.APIKeyID,
+		Action:    string(log.Action),
+		Entity:    log.Entity,
+		EntityID:  log.EntityID,
+		Before:    log.Before,
+		After:     log.After,
+		Changes:   log.Changes,
+		IP:        log.IP,
+		RequestID: log.RequestID,
+		PrevHash:  log.PrevHash,
+		Hash:      log.Hash,
+		CreatedAt: log.CreatedAt,
+	}
+}
+
+// newAuditVerificationResponse is a helper function to create a response body for handling an audit log chain check
+func newAuditVerificationResponse(verification *domainaudit.Verification) modelv1.AuditVerificationResponse {
+	return modelv1.AuditVerificationResponse{
+		Valid:    verification.Valid,
+		Checked:  verification.Checked,
+		BrokenAt: verification.BrokenAt,
+	}
+}
+
+// newKeySetResponse is a helper function to create a response body for handling public keys in JSON Web Key format
+func newKeySetResponse(keys []domainauth.PublicKey) modelv1.KeySetResponse {
+	keySet := modelv1.KeySetResponse{
+		Keys: []modelv1.KeyResponse{},
+	}
+
+	for _, key := range keys {
+		keySet.Keys = append(keySet.Keys, modelv1.KeyResponse{
+			KeyType:   "OKP",
+			Curve:     "Ed25519",
+			KeyID:     key.ID,
+			Algorithm: key.Algorithm,
+			Use:       "sig",
+			X:         base64.RawURLEncoding.EncodeToString(key.Key),
+		})
+	}
+
+	return keySet
+}
+
+// newReadinessResponse is a helper function to create a response body for handling the readiness report
+func newReadinessResponse(report *domainhealth.Report) modelv1.ReadinessResponse {
+	rsp := modelv1.ReadinessResponse{
+		Status:     string(report.Status),
+		Draining:   report.Draining,
+		Components: []modelv1.ComponentResponse{},
+	}
+
+	for _, component := range report.Components {
+		rsp.Components = append(rsp.Components, modelv1.ComponentResponse{
+			Name:      component.Name,
+			Status:    string(component.Status),
+			LatencyMs: float64(component.Latency.Microseconds()) / 1000,
+			Error:     component.Error,
+		})
+	}
+
+	return rsp
+}
+
 // newOrderResponse is a helper function to create a response body for handling order data
 func newOrderResponse(order *domainorder.Order) modelv1.OrderResponse {
 	return modelv1.OrderResponse{
-		ID:           order.ID,
-		UserID:       order.UserID,
-		PaymentID:    order.PaymentID,
-		CustomerName: order.CustomerName,
-		TotalPrice:   order.TotalPrice,
-		TotalPaid:    order.TotalPaid,
-		TotalReturn:  order.TotalReturn,
-		ReceiptCode:  order.ReceiptCode.String(),
-		Products:     newOrderProductResponse(order.Products),
-		PaymentType:  newPaymentResponse(order.Payment),
-		CreatedAt:    order.CreatedAt,
-		UpdatedAt:    order.UpdatedAt,
+		ID:             order.ID,
+		UserID:         order.UserID,
+		PaymentID:      order.PaymentID,
+		CustomerID:     order.CustomerID,
+		CustomerName:   order.CustomerName,
+		TotalPrice:     order.TotalPrice,
+		TotalPaid:      order.TotalPaid,
+		TotalReturn:    order.TotalReturn,
+		PointsRedeemed: order.PointsRedeemed,
+		PointsEarned:   order.PointsEarned,
+		GiftCardID:     order.GiftCardID,
+		GiftCardAmount: order.GiftCardAmount,
+		SoldGiftCardID: order.SoldGiftCardID,
+		TerminalID:     order.TerminalID,
+		ReceiptCode:    order.ReceiptCode.String(),
+		Products:       newOrderProductResponse(order.Products),
+		PaymentType:    newPaymentResponse(order.Payment),
+		CreatedAt:      order.CreatedAt,
+		UpdatedAt:      order.UpdatedAt,
+		RefundedAt:     order.RefundedAt,
 	}
 }
 
@@ -124,75 +364,217 @@ func newOrderProductResponse(orderProduct []domainorder.OrderProduct) []modelv1.
 	return orderProductResponses
 }
 
-// errorStatusMap is a map of defined error messages and their corresponding http status codes
+// errorStatusMap is a map of defined errors and their corresponding http status codes, errors are matched by code
 var errorStatusMap = map[error]int{
-	domain.ErrInternal:                   http.StatusInternalServerError,
-	domain.ErrDataNotFound:               http.StatusNotFound,
-	domain.ErrConflictingData:            http.StatusConflict,
-	domain.ErrInvalidCredentials:         http.StatusUnauthorized,
-	domain.ErrUnauthorized:               http.StatusUnauthorized,
-	domain.ErrEmptyAuthorizationHeader:   http.StatusUnauthorized,
-	domain.ErrInvalidAuthorizationHeader: http.StatusUnauthorized,
-	domain.ErrInvalidAuthorizationType:   http.StatusUnauthorized,
-	domain.ErrInvalidToken:               http.StatusUnauthorized,
-	domain.ErrExpiredToken:               http.StatusUnauthorized,
-	domain.ErrForbidden:                  http.StatusForbidden,
-	domain.ErrNoUpdatedData:              http.StatusBadRequest,
-	domain.ErrInsufficientStock:          http.StatusBadRequest,
-	domain.ErrInsufficientPayment:        http.StatusBadRequest,
+	domain.ErrInternal:                    http.StatusInternalServerError,
+	domain.ErrDataNotFound:                http.StatusNotFound,
+	domain.ErrConflictingData:             http.StatusConflict,
+	domain.ErrDataArchived:                http.StatusConflict,
+	domain.ErrDataNotArchived:             http.StatusConflict,
+	domain.ErrVersionMismatch:             http.StatusPreconditionFailed,
+	domain.ErrVersionRequired:             http.StatusPreconditionRequired,
+	domain.ErrInvalidCredentials:          http.StatusUnauthorized,
+	domain.ErrIncorrectPassword:           http.StatusForbidden,
+	domain.ErrInvalidResetToken:           http.StatusUnauthorized,
+	domain.ErrInvalidAPIKey:               http.StatusUnauthorized,
+	domain.ErrInvalidAPIKeyExpiry:         http.StatusBadRequest,
+	domain.ErrInvalidPIN:                  http.StatusUnauthorized,
+	domain.ErrInvalidTerminal:             http.StatusUnauthorized,
+	domain.ErrInvalidChallenge:            http.StatusUnauthorized,
+	domain.ErrInvalidTwoFactorCode:        http.StatusUnauthorized,
+	domain.ErrTwoFactorEnabled:            http.StatusConflict,
+	domain.ErrTwoFactorNotEnabled:         http.StatusConflict,
+	domain.ErrTwoFactorRequired:           http.StatusForbidden,
+	domain.ErrTooManyLoginAttempts:        http.StatusTooManyRequests,
+	domain.ErrTooManyResetRequests:        http.StatusTooManyRequests,
+	domain.ErrAccountLocked:               http.StatusLocked,
+	domain.ErrUnauthorized:                http.StatusUnauthorized,
+	domain.ErrEmptyAuthorizationHeader:    http.StatusUnauthorized,
+	domain.ErrInvalidAuthorizationHeader:  http.StatusUnauthorized,
+	domain.ErrInvalidAuthorizationType:    http.StatusUnauthorized,
+	domain.ErrInvalidToken:                http.StatusUnauthorized,
+	domain.ErrExpiredToken:                http.StatusUnauthorized,
+	domain.ErrRevokedToken:                http.StatusUnauthorized,
+	domain.ErrInvalidRefreshToken:         http.StatusUnauthorized,
+	domain.ErrExpiredRefreshToken:         http.StatusUnauthorized,
+	domain.ErrRefreshTokenReused:          http.StatusUnauthorized,
+	domain.ErrForbidden:                   http.StatusForbidden,
+	domain.ErrNoUpdatedData:               http.StatusBadRequest,
+	domain.ErrInsufficientStock:           http.StatusBadRequest,
+	domain.ErrInsufficientPayment:         http.StatusBadRequest,
+	domain.ErrCustomerRequired:            http.StatusBadRequest,
+	domain.ErrRedemptionDisabled:          http.StatusBadRequest,
+	domain.ErrInsufficientPoints:          http.StatusBadRequest,
+	domain.ErrRedemptionExceedsTotal:      http.StatusBadRequest,
+	domain.ErrGiftCardRequired:            http.StatusBadRequest,
+	domain.ErrGiftCardExpired:             http.StatusBadRequest,
+	domain.ErrInvalidGiftCardExpiry:       http.StatusBadRequest,
+	domain.ErrGiftCardPayment:             http.StatusBadRequest,
+	domain.ErrInsufficientGiftCardBalance: http.StatusBadRequest,
+	domain.ErrOrderRefunded:               http.StatusConflict,
+	domain.ErrUnknownRole:                 http.StatusBadRequest,
+	domain.ErrUnknownPermission:           http.StatusBadRequest,
+	domain.ErrSystemRole:                  http.StatusConflict,
+	domain.ErrRoleInUse:                   http.StatusConflict,
+	domain.ErrRegistrationClosed:          http.StatusForbidden,
+	domain.ErrInvitationRequired:          http.StatusForbidden,
+	domain.ErrInvalidInvitation:           http.StatusForbidden,
 }
 
+// problemJSON is the media type of problem details, clients accepting it get errors in that format
+const problemJSON = "application/problem+json"
+
+// problemTypePrefix starts the type of the problem details, it is followed by the error code
+const problemTypePrefix = "urn:problem:"
+
 // validationError sends an error response for some specific request validation error
 func validationError(ctx *gin.Context, err error) {
-	errMsgs := parseError(err)
-	errRsp := newErrorResponse(errMsgs)
-	ctx.JSON(http.StatusBadRequest, errRsp)
+	trans := requestTranslator(ctx)
+	errMsgs, fieldErrs := parseError(trans, err)
+	writeError(ctx, trans, http.StatusBadRequest, domain.ErrInvalidRequest, errMsgs, fieldErrs)
 }
 
 // handleError determines the status code of an error and returns a JSON response with the error message and status code
 func handleError(ctx *gin.Context, err error) {
-	statusCode, ok := errorStatusMap[err]
-	if !ok {
-		statusCode = http.StatusInternalServerError
-	}
-
-	errMsg := parseError(err)
-	errRsp := newErrorResponse(errMsg)
-	ctx.JSON(statusCode, errRsp)
+	trans := requestTranslator(ctx)
+	statusCode, domainErr := resolveError(ctx, err)
+	writeError(ctx, trans, statusCode, domainErr, []string{errorMessage(trans, domainErr)}, nil)
 }
 
 // handleAbort sends an error response and aborts the request with the specified status code and error message
 func handleAbort(ctx *gin.Context, err error) {
-	statusCode, ok := errorStatusMap[err]
-	if !ok {
-		statusCode = http.StatusInternalServerError
+	handleError(ctx, err)
+	ctx.Abort()
+}
+
+// resolveError finds the domain error in the chain of err to determine the status code of the response.
+// Errors without a domain error are internal, the cause of internal errors is only logged
+func resolveError(ctx *gin.Context, err error) (int, *domain.Error) {
+	var domainErr *domain.Error
+	if !errors.As(err, &domainErr) {
+		domainErr = domain.ErrInternal
+	}
+
+	statusCode := http.StatusInternalServerError
+	for target, code := range errorStatusMap {
+		if domainErr.Is(target) {
+			statusCode = code
+			break
+		}
+	}
+
+	if statusCode >= http.StatusInternalServerError {
+		slog.ErrorContext(ctx, "Request failed", "code", domainErr.Code, "error", err)
 	}
 
-	errMsg := parseError(err)
-	errRsp := newErrorResponse(errMsg)
-	ctx.AbortWithStatusJSON(statusCode, errRsp)
+	return statusCode, domainErr
 }
 
-// parseError parses error messages from the error object and returns a slice of error messages
-func parseError(err error) []string {
-	var errMsgs []string
+// requestTranslator negotiates the locale of the messages sent for the request,
+// the preference of the authenticated user comes before the Accept-Language header
+func requestTranslator(ctx *gin.Context) ut.Translator {
+	var preference string
+	if payload, ok := ctx.Get(authorizationPayloadKey); ok {
+		preference = payload.(*domainauth.TokenPayload).Locale
+	}
+
+	translator := ctx.MustGet(translatorContextKey).(*i18n.Translator)
+	return translator.Negotiate(preference, ctx.GetHeader("Accept-Language"))
+}
+
+// errorMessage translates the message of the error by its code, it falls back to the English message it is defined with
+func errorMessage(trans ut.Translator, domainErr *domain.Error) string {
+	return i18n.Message(trans, domainErr.Code, domainErr.Message)
+}
+
+// writeError sends the error as problem details when the client prefers them, otherwise in the error envelope
+func writeError(ctx *gin.Context, trans ut.Translator, statusCode int, domainErr *domain.Error, errMsgs []string, fieldErrs []modelv1.FieldError) {
+	ctx.Writer.Header().Add("Vary", "Accept")
+	ctx.Writer.Header().Add("Vary", "Accept-Language")
+	ctx.Header("Content-Language", trans.Locale())
+
+	if ctx.NegotiateFormat(binding.MIMEJSON, problemJSON) == problemJSON {
+		detail := errorMessage(trans, domainErr)
+		if len(fieldErrs) == 0 {
+			detail = strings.Join(errMsgs, "; ")
+		}
+
+		ctx.Header("Content-Type", problemJSON)
+		ctx.JSON(statusCode, newProblemResponse(ctx, statusCode, domainErr.Code, detail, fieldErrs))
+		return
+	}
+
+	ctx.JSON(statusCode, newErrorResponse(ctx, domainErr.Code, errMsgs))
+}
+
+// parseError parses error messages from the error object in the locale of the translator
+// and returns them along with the fields that failed validation
+func parseError(trans ut.Translator, err error) ([]string, []modelv1.FieldError) {
+	var validationErrs validator.ValidationErrors
+	if errors.As(err, &validationErrs) {
+		errMsgs := make([]string, 0, len(validationErrs))
+		fieldErrs := make([]modelv1.FieldError, 0, len(validationErrs))
+		for _, validationErr := range validationErrs {
+			field := fieldPath(validationErr)
+			fieldErr := modelv1.FieldError{
+				Field:   field,
+				Rule:    validationErr.Tag(),
+				Param:   validationErr.Param(),
+				Message: validationErr.Translate(trans),
+			}
 
-	if errors.As(err, &validator.ValidationErrors{}) {
-		for _, err := range err.(validator.ValidationErrors) {
-			errMsgs = append(errMsgs, err.Error())
+			errMsgs = append(errMsgs, fieldErr.Message)
+			fieldErrs = append(fieldErrs, fieldErr)
 		}
-	} else {
-		errMsgs = append(errMsgs, err.Error())
+
+		return errMsgs, fieldErrs
 	}
 
-	return errMsgs
+	var typeErr *json.UnmarshalTypeError
+	if errors.As(err, &typeErr) && typeErr.Field != "" {
+		fieldErr := modelv1.FieldError{
+			Field:   typeErr.Field,
+			Rule:    "type",
+			Param:   typeErr.Type.String(),
+			Message: i18n.Message(trans, i18n.FieldType, fmt.Sprintf("%s must be of type %s", typeErr.Field, typeErr.Type), typeErr.Field, typeErr.Type.String()),
+		}
+
+		return []string{fieldErr.Message}, []modelv1.FieldError{fieldErr}
+	}
+
+	var syntaxErr *json.SyntaxError
+	switch {
+	case errors.As(err, &syntaxErr), errors.Is(err, io.ErrUnexpectedEOF):
+		return []string{i18n.Message(trans, i18n.RequestBodyInvalid, "request body is not valid JSON")}, nil
+	case errors.Is(err, io.EOF):
+		return []string{i18n.Message(trans, i18n.RequestBodyEmpty, "request body is empty")}, nil
+	default:
+		// other errors of the binding, such as parsing a path parameter, tell about the internals rather than the request
+		return []string{errorMessage(trans, domain.ErrInvalidRequest)}, nil
+	}
 }
 
-// NewErrorResponse is a helper function to create an error response body
-func newErrorResponse(errMsgs []string) modelv1.ErrorResponse {
+// NewErrorResponse is a helper function to create an error response body carrying the code of the error and the id of the request
+func newErrorResponse(ctx *gin.Context, code string, errMsgs []string) modelv1.ErrorResponse {
 	return modelv1.ErrorResponse{
-		Success:  false,
-		Messages: errMsgs,
+		Success:   false,
+		Code:      code,
+		Messages:  errMsgs,
+		RequestID: ctx.GetString(requestIDContextKey),
+	}
+}
+
+// newProblemResponse is a helper function to create a problem details response body
+func newProblemResponse(ctx *gin.Context, statusCode int, code, detail string, fieldErrs []modelv1.FieldError) modelv1.ProblemResponse {
+	return modelv1.ProblemResponse{
+		Type:      problemTypePrefix + code,
+		Title:     http.StatusText(statusCode),
+		Status:    statusCode,
+		Detail:    detail,
+		Instance:  ctx.Request.URL.Path,
+		Code:      code,
+		RequestID: ctx.GetString(requestIDContextKey),
+		Errors:    fieldErrs,
 	}
 }
 
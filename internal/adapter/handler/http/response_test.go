@@ -0,0 +1,191 @@
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/TienMinh25/go-hexagonal-architecture/internal/adapter/i18n"
+	"github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain"
+	modelv1 "github.com/TienMinh25/go-hexagonal-architecture/pkg/model/v1"
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+	"github.com/go-playground/validator/v10"
+	"github.com/stretchr/testify/assert"
+)
+
+type validationErrorTestedInput struct {
+	method string
+	path   string
+	body   string
+	accept string
+}
+
+type validationErrorExpectedOutput struct {
+	contentType string
+	messages    []string
+	fields      []string
+}
+
+func TestValidationError(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	translator, err := i18n.New()
+	assert.NoError(t, err, "Translator mismatch")
+
+	v := binding.Validator.Engine().(*validator.Validate)
+	v.RegisterTagNameFunc(fieldName)
+	assert.NoError(t, translator.RegisterValidator(v), "Validator mismatch")
+
+	router := gin.New()
+	router.Use(requestIDMiddleware(), localeMiddleware(translator))
+	router.POST("/orders", func(ctx *gin.Context) {
+		var req modelv1.CreateOrderRequest
+		if err := ctx.ShouldBindJSON(&req); err != nil {
+			validationError(ctx, err)
+			return
+		}
+	})
+	router.GET("/orders/:id", func(ctx *gin.Context) {
+		var req modelv1.GetOrderRequest
+		if err := ctx.ShouldBindUri(&req); err != nil {
+			validationError(ctx, err)
+			return
+		}
+	})
+
+	invalidOrder := `{"customer_name":"John Doe","total_paid":-1}`
+	invalidOrderFields := []string{"payment_id", "total_paid", "products"}
+
+	testCases := []struct {
+		desc     string
+		input    validationErrorTestedInput
+		expected validationErrorExpectedOutput
+	}{
+		{
+			desc: "Success_JSONFieldNames",
+			input: validationErrorTestedInput{
+				method: http.MethodPost,
+				path:   "/orders",
+				body:   invalidOrder,
+				accept: "application/json",
+			},
+			expected: validationErrorExpectedOutput{
+				contentType: "application/json",
+				messages: []string{
+					"payment_id is a required field",
+					"total_paid must be 0 or greater",
+					"products is a required field",
+				},
+			},
+		},
+		{
+			desc: "Success_ProblemFieldNames",
+			input: validationErrorTestedInput{
+				method: http.MethodPost,
+				path:   "/orders",
+				body:   invalidOrder,
+				accept: problemJSON,
+			},
+			expected: validationErrorExpectedOutput{
+				contentType: problemJSON,
+				fields:      invalidOrderFields,
+			},
+		},
+		{
+			desc: "Success_ProblemPreferred",
+			input: validationErrorTestedInput{
+				method: http.MethodPost,
+				path:   "/orders",
+				body:   invalidOrder,
+				accept: "application/problem+json, application/json;q=0.5",
+			},
+			expected: validationErrorExpectedOutput{
+				contentType: problemJSON,
+				fields:      invalidOrderFields,
+			},
+		},
+		{
+			desc: "Success_NoAccept",
+			input: validationErrorTestedInput{
+				method: http.MethodPost,
+				path:   "/orders",
+				body:   "",
+			},
+			expected: validationErrorExpectedOutput{
+				contentType: "application/json",
+				messages:    []string{"request body is empty"},
+			},
+		},
+		{
+			desc: "Success_URIFieldName",
+			input: validationErrorTestedInput{
+				method: http.MethodGet,
+				path:   "/orders/0",
+				accept: problemJSON,
+			},
+			expected: validationErrorExpectedOutput{
+				contentType: problemJSON,
+				fields:      []string{"id"},
+			},
+		},
+		{
+			desc: "Success_UnknownErrorNotLeaked",
+			input: validationErrorTestedInput{
+				method: http.MethodGet,
+				path:   "/orders/abc",
+				accept: "application/json",
+			},
+			expected: validationErrorExpectedOutput{
+				contentType: "application/json",
+				messages:    []string{domain.ErrInvalidRequest.Message},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.desc, func(t *testing.T) {
+			t.Parallel()
+
+			req := httptest.NewRequest(tc.input.method, tc.input.path, strings.NewReader(tc.input.body))
+			req.Header.Set("Content-Type", "application/json")
+			if tc.input.accept != "" {
+				req.Header.Set("Accept", tc.input.accept)
+			}
+
+			rec := httptest.NewRecorder()
+			router.ServeHTTP(rec, req)
+
+			assert.Equal(t, http.StatusBadRequest, rec.Code, "Status mismatch")
+			assert.True(t, strings.HasPrefix(rec.Header().Get("Content-Type"), tc.expected.contentType), "Content type mismatch")
+			assert.Contains(t, rec.Header().Values("Vary"), "Accept", "Vary mismatch")
+
+			if tc.expected.contentType == problemJSON {
+				var rsp modelv1.ProblemResponse
+				assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &rsp), "Body mismatch")
+				assert.Equal(t, problemTypePrefix+domain.ErrInvalidRequest.Code, rsp.Type, "Type mismatch")
+				assert.Equal(t, http.StatusBadRequest, rsp.Status, "Status mismatch")
+				assert.Equal(t, tc.input.path, rsp.Instance, "Instance mismatch")
+				assert.Equal(t, rec.Header().Get(requestIDHeaderKey), rsp.RequestID, "Request id mismatch")
+
+				fields := []string{}
+				for _, fieldErr := range rsp.Errors {
+					fields = append(fields, fieldErr.Field)
+					assert.NotEmpty(t, fieldErr.Rule, "Rule mismatch")
+					assert.True(t, strings.HasPrefix(fieldErr.Message, fieldErr.Field), "Message mismatch")
+				}
+				assert.Equal(t, tc.expected.fields, fields, "Fields mismatch")
+				return
+			}
+
+			var rsp modelv1.ErrorResponse
+			assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &rsp), "Body mismatch")
+			assert.Equal(t, domain.ErrInvalidRequest.Code, rsp.Code, "Code mismatch")
+			assert.Equal(t, rec.Header().Get(requestIDHeaderKey), rsp.RequestID, "Request id mismatch")
+			assert.Equal(t, tc.expected.messages, rsp.Messages, "Messages mismatch")
+		})
+	}
+}
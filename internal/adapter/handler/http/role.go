@@ -0,0 +1,227 @@
+package http
+
+import (
+	domainrole "github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain/role"
+	"github.com/TienMinh25/go-hexagonal-architecture/internal/application/port"
+	modelv1 "github.com/TienMinh25/go-hexagonal-architecture/pkg/model/v1"
+	"github.com/gin-gonic/gin"
+)
+
+// RoleHandler represents the HTTP handler for role and permission-related requests
+type RoleHandler struct {
+	svc port.RoleService
+}
+
+// NewRoleHandler creates a new RoleHandler instance
+func NewRoleHandler(svc port.RoleService) *RoleHandler {
+	return &RoleHandler{
+		svc,
+	}
+}
+
+// CreateRole godoc
+//
+//	@Summary		Create a new role
+//	@Description	Create a new custom role granted the given permissions
+//	@Tags			Roles
+//	@Accept			json
+//	@Produce		json
+//	@Param			createRoleRequest	body		modelv1.CreateRoleRequest	true	"Create role request"
+//	@Success		200					{object}	modelv1.RoleResponse		"Role created"
+//	@Failure		400					{object}	modelv1.ErrorResponse		"Validation error"
+//	@Failure		401					{object}	modelv1.ErrorResponse		"Unauthorized error"
+//	@Failure		403					{object}	modelv1.ErrorResponse		"Forbidden error"
+//	@Failure		409					{object}	modelv1.ErrorResponse		"Data conflict error"
+//	@Failure		500					{object}	modelv1.ErrorResponse		"Internal server error"
+//	@Router			/roles [post]
+//	@Security		BearerAuth
+func (rh *RoleHandler) CreateRole(ctx *gin.Context) {
+	var req modelv1.CreateRoleRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		validationError(ctx, err)
+		return
+	}
+
+	role := domainrole.Role{
+		Name:        req.Name,
+		Description: req.Description,
+		Permissions: req.Permissions,
+	}
+
+	createdRole, err := rh.svc.CreateRole(ctx, &role)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	rsp := newRoleResponse(createdRole)
+
+	handleSuccess(ctx, rsp)
+}
+
+// GetRole godoc
+//
+//	@Summary		Get a role
+//	@Description	Get a role with its permissions by id
+//	@Tags			Roles
+//	@Accept			json
+//	@Produce		json
+//	@Param			id	path		uint64					true	"Role ID"
+//	@Success		200	{object}	modelv1.RoleResponse	"Role retrieved"
+//	@Failure		400	{object}	modelv1.ErrorResponse	"Validation error"
+//	@Failure		401	{object}	modelv1.ErrorResponse	"Unauthorized error"
+//	@Failure		403	{object}	modelv1.ErrorResponse	"Forbidden error"
+//	@Failure		404	{object}	modelv1.ErrorResponse	"Data not found error"
+//	@Failure		500	{object}	modelv1.ErrorResponse	"Internal server error"
+//	@Router			/roles/{id} [get]
+//	@Security		BearerAuth
+func (rh *RoleHandler) GetRole(ctx *gin.Context) {
+	var req modelv1.GetRoleRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		validationError(ctx, err)
+		return
+	}
+
+	role, err := rh.svc.GetRole(ctx, req.ID)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	rsp := newRoleResponse(role)
+
+	handleSuccess(ctx, rsp)
+}
+
+// ListRoles godoc
+//
+//	@Summary		List roles
+//	@Description	List the built-in and custom roles with their permissions
+//	@Tags			Roles
+//	@Accept			json
+//	@Produce		json
+//	@Success		200	{array}		modelv1.RoleResponse	"Roles displayed"
+//	@Failure		401	{object}	modelv1.ErrorResponse	"Unauthorized error"
+//	@Failure		403	{object}	modelv1.ErrorResponse	"Forbidden error"
+//	@Failure		500	{object}	modelv1.ErrorResponse	"Internal server error"
+//	@Router			/roles [get]
+//	@Security		BearerAuth
+func (rh *RoleHandler) ListRoles(ctx *gin.Context) {
+	var rolesList []modelv1.RoleResponse
+
+	roles, err := rh.svc.ListRoles(ctx)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	for _, role := range roles {
+		rolesList = append(rolesList, newRoleResponse(&role))
+	}
+
+	handleSuccess(ctx, rolesList)
+}
+
+// ListPermissions godoc
+//
+//	@Summary		List permissions
+//	@Description	List the permissions that can be granted to roles
+//	@Tags			Roles
+//	@Accept			json
+//	@Produce		json
+//	@Success		200	{array}		modelv1.PermissionResponse	"Permissions displayed"
+//	@Failure		401	{object}	modelv1.ErrorResponse		"Unauthorized error"
+//	@Failure		403	{object}	modelv1.ErrorResponse		"Forbidden error"
+//	@Failure		500	{object}	modelv1.ErrorResponse		"Internal server error"
+//	@Router			/permissions [get]
+//	@Security		BearerAuth
+func (rh *RoleHandler) ListPermissions(ctx *gin.Context) {
+	var permissionsList []modelv1.PermissionResponse
+
+	permissions, err := rh.svc.ListPermissions(ctx)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	for _, permission := range permissions {
+		permissionsList = append(permissionsList, newPermissionResponse(&permission))
+	}
+
+	handleSuccess(ctx, permissionsList)
+}
+
+// SetRolePermissions godoc
+//
+//	@Summary		Set the permissions of a role
+//	@Description	Replace the permissions granted to a role. Users of the role get the new permissions with their next access token. The admin role always keeps every permission.
+//	@Tags			Roles
+//	@Accept			json
+//	@Produce		json
+//	@Param			id							path		uint64								true	"Role ID"
+//	@Param			setRolePermissionsRequest	body		modelv1.SetRolePermissionsRequest	true	"Set role permissions request"
+//	@Success		200							{object}	modelv1.RoleResponse				"Role permissions set"
+//	@Failure		400							{object}	modelv1.ErrorResponse				"Validation error"
+//	@Failure		401							{object}	modelv1.ErrorResponse				"Unauthorized error"
+//	@Failure		403							{object}	modelv1.ErrorResponse				"Forbidden error"
+//	@Failure		404							{object}	modelv1.ErrorResponse				"Data not found error"
+//	@Failure		409							{object}	modelv1.ErrorResponse				"Data conflict error"
+//	@Failure		500							{object}	modelv1.ErrorResponse				"Internal server error"
+//	@Router			/roles/{id}/permissions [put]
+//	@Security		BearerAuth
+func (rh *RoleHandler) SetRolePermissions(ctx *gin.Context) {
+	var uri modelv1.GetRoleRequest
+	if err := ctx.ShouldBindUri(&uri); err != nil {
+		validationError(ctx, err)
+		return
+	}
+
+	var req modelv1.SetRolePermissionsRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		validationError(ctx, err)
+		return
+	}
+
+	role, err := rh.svc.SetRolePermissions(ctx, uri.ID, req.Permissions)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	rsp := newRoleResponse(role)
+
+	handleSuccess(ctx, rsp)
+}
+
+// DeleteRole godoc
+//
+//	@Summary		Delete a role
+//	@Description	Delete a custom role that is no longer assigned to any user
+//	@Tags			Roles
+//	@Accept			json
+//	@Produce		json
+//	@Param			id	path		uint64					true	"Role ID"
+//	@Success		200	{object}	modelv1.Response		"Role deleted"
+//	@Failure		400	{object}	modelv1.ErrorResponse	"Validation error"
+//	@Failure		401	{object}	modelv1.ErrorResponse	"Unauthorized error"
+//	@Failure		403	{object}	modelv1.ErrorResponse	"Forbidden error"
+//	@Failure		404	{object}	modelv1.ErrorResponse	"Data not found error"
+//	@Failure		409	{object}	modelv1.ErrorResponse	"Data conflict error"
+//	@Failure		500	{object}	modelv1.ErrorResponse	"Internal server error"
+//	@Router			/roles/{id} [delete]
+//	@Security		BearerAuth
+func (rh *RoleHandler) DeleteRole(ctx *gin.Context) {
+	var req modelv1.DeleteRoleRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		validationError(ctx, err)
+		return
+	}
+
+	err := rh.svc.DeleteRole(ctx, req.ID)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	handleSuccess(ctx, nil)
+}
@@ -1,10 +1,15 @@
 package http
 
 import (
+	"context"
+	"errors"
 	"log/slog"
-	"strings"
+	"net"
+	"net/http"
 
 	"github.com/TienMinh25/go-hexagonal-architecture/config"
+	"github.com/TienMinh25/go-hexagonal-architecture/internal/adapter/i18n"
+	domainrole "github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain/role"
 	"github.com/TienMinh25/go-hexagonal-architecture/internal/application/port"
 	"github.com/gin-contrib/cors"
 	"github.com/gin-gonic/gin"
@@ -18,18 +23,33 @@ import (
 // Router is a wrapper for HTTP router
 type Router struct {
 	*gin.Engine
+	server *http.Server
 }
 
 // NewRouter creates a new HTTP router
 func NewRouter(
 	config *config.HTTP,
 	token port.TokenService,
+	apiKeys port.APIKeyService,
+	metrics port.Metrics,
+	healthHandler HealthHandler,
+	metricsHandler MetricsHandler,
 	userHandler UserHandler,
 	authHandler AuthHandler,
+	passwordHandler PasswordHandler,
+	invitationHandler InvitationHandler,
+	keyHandler KeyHandler,
+	roleHandler RoleHandler,
+	terminalHandler TerminalHandler,
+	apiKeyHandler APIKeyHandler,
+	auditHandler AuditHandler,
 	paymentHandler PaymentHandler,
 	categoryHandler CategoryHandler,
 	productHandler ProductHandler,
 	orderHandler OrderHandler,
+	customerHandler CustomerHandler,
+	loyaltyHandler LoyaltyHandler,
+	giftCardHandler GiftCardHandler,
 ) (*Router, error) {
 	// Disable debug mode in production
 	if config.Env == "production" {
@@ -38,16 +58,41 @@ func NewRouter(
 
 	// CORS
 	ginConfig := cors.DefaultConfig()
-	allowedOrigins := config.AllowedOrigins
-	originsList := strings.Split(allowedOrigins, ",")
-	ginConfig.AllowOrigins = originsList
+	ginConfig.AllowOrigins = config.AllowedOrigins
+	ginConfig.AddAllowHeaders("If-Match", apiKeyHeaderKey, requestIDHeaderKey, "traceparent", "tracestate", "baggage")
+	ginConfig.AddExposeHeaders("ETag", requestIDHeaderKey)
 
 	router := gin.New()
-	router.Use(sloggin.New(slog.Default()), gin.Recovery(), cors.New(ginConfig))
+	// Use cases read the actor of the request from the request context through the gin context
+	router.ContextWithFallback = true
+
+	// Client IP is only taken from forwarding headers set by trusted proxies
+	if err := router.SetTrustedProxies(config.TrustedProxies); err != nil {
+		return nil, err
+	}
+
+	// The request id is logged from the request context, along with the user and the trace.
+	// Probes and scrapes come every few seconds, logging them would drown the requests that matter
+	requestLogger := sloggin.NewWithConfig(slog.Default(), sloggin.Config{
+		DefaultLevel:     slog.LevelInfo,
+		ClientErrorLevel: slog.LevelWarn,
+		ServerErrorLevel: slog.LevelError,
+		Filters:          []sloggin.Filter{sloggin.IgnorePath("/healthz", "/readyz", "/metrics")},
+	})
+
+	// Messages are translated into the locale of the user, validation errors included
+	translator, err := i18n.New()
+	if err != nil {
+		return nil, err
+	}
+
+	router.Use(requestIDMiddleware(), localeMiddleware(translator), tracingMiddleware(), requestLogger, metricsMiddleware(metrics), recoveryMiddleware(), cors.New(ginConfig), actorMiddleware())
 
 	// Custom validators
 	v, ok := binding.Validator.Engine().(*validator.Validate)
 	if ok {
+		v.RegisterTagNameFunc(fieldName)
+
 		if err := v.RegisterValidation("user_role", userRoleValidator); err != nil {
 			return nil, err
 		}
@@ -56,11 +101,28 @@ func NewRouter(
 			return nil, err
 		}
 
+		if err := v.RegisterValidation("locale", localeValidator); err != nil {
+			return nil, err
+		}
+
+		if err := translator.RegisterValidator(v); err != nil {
+			return nil, err
+		}
 	}
 
 	// Swagger
 	router.GET("/docs/*any", ginSwagger.WrapHandler(swaggerFiles.Handler))
 
+	// Health probes
+	router.GET("/healthz", healthHandler.Liveness)
+	router.GET("/readyz", healthHandler.Readiness)
+
+	// Metrics
+	router.GET("/metrics", metricsHandler.Scrape)
+
+	// Token verification keys
+	router.GET("/.well-known/jwks.json", keyHandler.GetKeySet)
+
 	v1 := router.Group("/v1")
 	{
 		user := v1.Group("/users")
@@ -71,49 +133,128 @@ func NewRouter(
 			authUser := user.Group("/").Use(authMiddleware(token))
 			{
 				authUser.GET("/", userHandler.ListUsers)
+				authUser.GET("/me", userHandler.GetMe)
+				authUser.PUT("/me", userHandler.UpdateMe)
 				authUser.GET("/:id", userHandler.GetUser)
+				authUser.POST("/me/password", passwordHandler.ChangePassword)
 
-				admin := authUser.Use(adminMiddleware())
+				write := authUser.Use(requirePermission(domainrole.UsersWrite))
 				{
-					admin.PUT("/:id", userHandler.UpdateUser)
-					admin.DELETE("/:id", userHandler.DeleteUser)
+					write.PUT("/:id", userHandler.UpdateUser)
+					write.DELETE("/:id", userHandler.DeleteUser)
+					write.POST("/:id/restore", userHandler.RestoreUser)
+					write.POST("/:id/unlock", authHandler.UnlockUser)
+					write.PUT("/:id/pin", authHandler.SetPIN)
 				}
 			}
 		}
+		invitation := v1.Group("/invitations").Use(authMiddleware(token), requirePermission(domainrole.UsersWrite))
+		{
+			invitation.POST("/", invitationHandler.CreateInvitation)
+			invitation.GET("/", invitationHandler.ListInvitations)
+			invitation.DELETE("/:id", invitationHandler.RevokeInvitation)
+		}
+		auth := v1.Group("/auth")
+		{
+			auth.POST("/refresh", authHandler.Refresh)
+			auth.POST("/pin", authHandler.PINLogin)
+			auth.POST("/password/forgot", passwordHandler.ForgotPassword)
+			auth.POST("/password/reset", passwordHandler.ResetPassword)
+			auth.POST("/2fa/verify", authHandler.VerifyTwoFactor)
+			auth.POST("/2fa/enroll", authMiddleware(token), authHandler.EnrollTwoFactor)
+			auth.POST("/2fa/confirm", authMiddleware(token), authHandler.ConfirmTwoFactor)
+			auth.POST("/2fa/disable", authMiddleware(token), authHandler.DisableTwoFactor)
+			auth.POST("/logout", authMiddleware(token), authHandler.Logout)
+		}
+		role := v1.Group("/roles").Use(authMiddleware(token), requirePermission(domainrole.RolesManage))
+		{
+			role.POST("/", roleHandler.CreateRole)
+			role.GET("/", roleHandler.ListRoles)
+			role.GET("/:id", roleHandler.GetRole)
+			role.PUT("/:id/permissions", roleHandler.SetRolePermissions)
+			role.DELETE("/:id", roleHandler.DeleteRole)
+		}
+		v1.GET("/permissions", authMiddleware(token), requirePermission(domainrole.RolesManage), roleHandler.ListPermissions)
+		terminal := v1.Group("/terminals").Use(authMiddleware(token), requirePermission(domainrole.TerminalsManage))
+		{
+			terminal.POST("/", terminalHandler.RegisterTerminal)
+			terminal.GET("/", terminalHandler.ListTerminals)
+			terminal.GET("/:id", terminalHandler.GetTerminal)
+			terminal.DELETE("/:id", terminalHandler.RevokeTerminal)
+		}
+		apiKey := v1.Group("/api-keys").Use(authMiddleware(token), requirePermission(domainrole.APIKeysManage))
+		{
+			apiKey.POST("/", apiKeyHandler.CreateAPIKey)
+			apiKey.GET("/", apiKeyHandler.ListAPIKeys)
+			apiKey.DELETE("/:id", apiKeyHandler.RevokeAPIKey)
+		}
+		audit := v1.Group("/audit-logs").Use(authMiddleware(token), requirePermission(domainrole.AuditLogsRead))
+		{
+			audit.GET("/", auditHandler.ListAuditLogs)
+			audit.GET("/verify", auditHandler.VerifyAuditLogs)
+		}
 		payment := v1.Group("/payments").Use(authMiddleware(token))
 		{
 			payment.GET("/", paymentHandler.ListPayments)
 			payment.GET("/:id", paymentHandler.GetPayment)
 
-			admin := payment.Use(adminMiddleware())
+			write := payment.Use(requirePermission(domainrole.PaymentsWrite))
 			{
-				admin.POST("/", paymentHandler.CreatePayment)
-				admin.PUT("/:id", paymentHandler.UpdatePayment)
-				admin.DELETE("/:id", paymentHandler.DeletePayment)
+				write.POST("/", paymentHandler.CreatePayment)
+				write.PUT("/:id", paymentHandler.UpdatePayment)
+				write.DELETE("/:id", paymentHandler.DeletePayment)
+				write.POST("/:id/restore", paymentHandler.RestorePayment)
 			}
 		}
-		category := v1.Group("/categories").Use(authMiddleware(token))
+		category := v1.Group("/categories").Use(authOrAPIKeyMiddleware(token, apiKeys))
 		{
 			category.GET("/", categoryHandler.ListCategories)
 			category.GET("/:id", categoryHandler.GetCategory)
 
-			admin := category.Use(adminMiddleware())
+			write := category.Use(requirePermission(domainrole.CategoriesWrite))
 			{
-				admin.POST("/", categoryHandler.CreateCategory)
-				admin.PUT("/:id", categoryHandler.UpdateCategory)
-				admin.DELETE("/:id", categoryHandler.DeleteCategory)
+				write.POST("/", categoryHandler.CreateCategory)
+				write.PUT("/:id", categoryHandler.UpdateCategory)
+				write.DELETE("/:id", categoryHandler.DeleteCategory)
+				write.POST("/:id/restore", categoryHandler.RestoreCategory)
 			}
 		}
-		product := v1.Group("/products").Use(authMiddleware(token))
+		product := v1.Group("/products").Use(authOrAPIKeyMiddleware(token, apiKeys))
 		{
 			product.GET("/", productHandler.ListProducts)
 			product.GET("/:id", productHandler.GetProduct)
 
-			admin := product.Use(adminMiddleware())
+			write := product.Use(requirePermission(domainrole.ProductsWrite))
+			{
+				write.POST("/", productHandler.CreateProduct)
+				write.PUT("/:id", productHandler.UpdateProduct)
+				write.DELETE("/:id", productHandler.DeleteProduct)
+				write.POST("/:id/restore", productHandler.RestoreProduct)
+			}
+		}
+		customer := v1.Group("/customers").Use(authMiddleware(token))
+		{
+			customer.POST("/", customerHandler.CreateCustomer)
+			customer.GET("/", customerHandler.ListCustomers)
+			customer.GET("/:id", customerHandler.GetCustomer)
+			customer.GET("/:id/orders", customerHandler.ListCustomerOrders)
+			customer.GET("/:id/points", loyaltyHandler.GetBalance)
+			customer.PUT("/:id", customerHandler.UpdateCustomer)
+
+			archive := customer.Use(requirePermission(domainrole.CustomersDelete))
 			{
-				admin.POST("/", productHandler.CreateProduct)
-				admin.PUT("/:id", productHandler.UpdateProduct)
-				admin.DELETE("/:id", productHandler.DeleteProduct)
+				archive.DELETE("/:id", customerHandler.DeleteCustomer)
+				archive.POST("/:id/restore", customerHandler.RestoreCustomer)
+			}
+		}
+		giftCard := v1.Group("/gift-cards").Use(authMiddleware(token))
+		{
+			giftCard.GET("/:code", giftCardHandler.GetGiftCard)
+
+			write := giftCard.Use(requirePermission(domainrole.GiftCardsWrite))
+			{
+				write.POST("/", giftCardHandler.IssueGiftCard)
+				write.POST("/:code/top-up", giftCardHandler.TopUpGiftCard)
 			}
 		}
 		order := v1.Group("/orders").Use(authMiddleware(token))
@@ -121,15 +262,50 @@ func NewRouter(
 			order.POST("/", orderHandler.CreateOrder)
 			order.GET("/", orderHandler.ListOrders)
 			order.GET("/:id", orderHandler.GetOrder)
+
+			refund := order.Use(requirePermission(domainrole.OrdersRefund))
+			{
+				refund.POST("/:id/refund", orderHandler.RefundOrder)
+			}
 		}
 	}
 
+	server := &http.Server{
+		Handler:           router,
+		ReadTimeout:       config.ReadTimeout,
+		ReadHeaderTimeout: config.ReadHeaderTimeout,
+		WriteTimeout:      config.WriteTimeout,
+		IdleTimeout:       config.IdleTimeout,
+	}
+
 	return &Router{
 		router,
+		server,
 	}, nil
 }
 
-// Serve starts the HTTP server
+// Serve starts the HTTP server and blocks until it fails or is shut down
 func (r *Router) Serve(listenAddr string) error {
-	return r.Run(listenAddr)
+	listener, err := net.Listen("tcp", listenAddr)
+	if err != nil {
+		return err
+	}
+
+	err = r.server.Serve(listener)
+	if errors.Is(err, http.ErrServerClosed) {
+		return nil
+	}
+
+	return err
+}
+
+// Shutdown stops accepting new connections and waits for in-flight requests to finish
+// until the context is done, the connections left are closed then
+func (r *Router) Shutdown(ctx context.Context) error {
+	err := r.server.Shutdown(ctx)
+	if err != nil {
+		return errors.Join(err, r.server.Close())
+	}
+
+	return nil
 }
@@ -0,0 +1,171 @@
+package http
+
+import (
+	domainterminal "github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain/terminal"
+	"github.com/TienMinh25/go-hexagonal-architecture/internal/application/port"
+	modelv1 "github.com/TienMinh25/go-hexagonal-architecture/pkg/model/v1"
+	"github.com/gin-gonic/gin"
+)
+
+// TerminalHandler represents the HTTP handler for POS terminal-related requests
+type TerminalHandler struct {
+	svc port.TerminalService
+}
+
+// NewTerminalHandler creates a new TerminalHandler instance
+func NewTerminalHandler(svc port.TerminalService) *TerminalHandler {
+	return &TerminalHandler{
+		svc,
+	}
+}
+
+// RegisterTerminal godoc
+//
+//	@Summary		Register a new terminal
+//	@Description	Register a new POS terminal and get its device secret, which is only shown once
+//	@Tags			Terminals
+//	@Accept			json
+//	@Produce		json
+//	@Param			registerTerminalRequest	body		modelv1.RegisterTerminalRequest		true	"Register terminal request"
+//	@Success		200						{object}	modelv1.RegisterTerminalResponse	"Terminal registered"
+//	@Failure		400						{object}	modelv1.ErrorResponse				"Validation error"
+//	@Failure		401						{object}	modelv1.ErrorResponse				"Unauthorized error"
+//	@Failure		403						{object}	modelv1.ErrorResponse				"Forbidden error"
+//	@Failure		500						{object}	modelv1.ErrorResponse				"Internal server error"
+//	@Router			/terminals [post]
+//	@Security		BearerAuth
+func (th *TerminalHandler) RegisterTerminal(ctx *gin.Context) {
+	var req modelv1.RegisterTerminalRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		validationError(ctx, err)
+		return
+	}
+
+	terminal := domainterminal.Terminal{
+		Name: req.Name,
+	}
+
+	registeredTerminal, secret, err := th.svc.RegisterTerminal(ctx, &terminal)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	rsp := modelv1.RegisterTerminalResponse{
+		TerminalResponse: newTerminalResponse(registeredTerminal),
+		Secret:           secret,
+	}
+
+	handleSuccess(ctx, rsp)
+}
+
+// GetTerminal godoc
+//
+//	@Summary		Get a terminal
+//	@Description	Get a terminal by id
+//	@Tags			Terminals
+//	@Accept			json
+//	@Produce		json
+//	@Param			id	path		uint64						true	"Terminal ID"
+//	@Success		200	{object}	modelv1.TerminalResponse	"Terminal retrieved"
+//	@Failure		400	{object}	modelv1.ErrorResponse		"Validation error"
+//	@Failure		401	{object}	modelv1.ErrorResponse		"Unauthorized error"
+//	@Failure		403	{object}	modelv1.ErrorResponse		"Forbidden error"
+//	@Failure		404	{object}	modelv1.ErrorResponse		"Data not found error"
+//	@Failure		500	{object}	modelv1.ErrorResponse		"Internal server error"
+//	@Router			/terminals/{id} [get]
+//	@Security		BearerAuth
+func (th *TerminalHandler) GetTerminal(ctx *gin.Context) {
+	var req modelv1.GetTerminalRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		validationError(ctx, err)
+		return
+	}
+
+	terminal, err := th.svc.GetTerminal(ctx, req.ID)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	rsp := newTerminalResponse(terminal)
+
+	handleSuccess(ctx, rsp)
+}
+
+// ListTerminals godoc
+//
+//	@Summary		List terminals
+//	@Description	List registered and revoked terminals with pagination
+//	@Tags			Terminals
+//	@Accept			json
+//	@Produce		json
+//	@Param			skip	query		uint64					true	"Skip"
+//	@Param			limit	query		uint64					true	"Limit"
+//	@Success		200		{object}	modelv1.Meta			"Terminals displayed"
+//	@Failure		400		{object}	modelv1.ErrorResponse	"Validation error"
+//	@Failure		401		{object}	modelv1.ErrorResponse	"Unauthorized error"
+//	@Failure		403		{object}	modelv1.ErrorResponse	"Forbidden error"
+//	@Failure		500		{object}	modelv1.ErrorResponse	"Internal server error"
+//	@Router			/terminals [get]
+//	@Security		BearerAuth
+func (th *TerminalHandler) ListTerminals(ctx *gin.Context) {
+	var req modelv1.ListTerminalsRequest
+	var terminalsList []modelv1.TerminalResponse
+
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		validationError(ctx, err)
+		return
+	}
+
+	terminals, err := th.svc.ListTerminals(ctx, req.Skip, req.Limit)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	for _, terminal := range terminals {
+		terminalsList = append(terminalsList, newTerminalResponse(&terminal))
+	}
+
+	total := uint64(len(terminalsList))
+	meta := newMeta(total, req.Limit, req.Skip)
+	rsp := toMap(meta, terminalsList, "terminals")
+
+	handleSuccess(ctx, rsp)
+}
+
+// RevokeTerminal godoc
+//
+//	@Summary		Revoke a terminal
+//	@Description	Revoke a terminal so that no more PIN logins are accepted on it
+//	@Tags			Terminals
+//	@Accept			json
+//	@Produce		json
+//	@Param			id	path		uint64						true	"Terminal ID"
+//	@Success		200	{object}	modelv1.TerminalResponse	"Terminal revoked"
+//	@Failure		400	{object}	modelv1.ErrorResponse		"Validation error"
+//	@Failure		401	{object}	modelv1.ErrorResponse		"Unauthorized error"
+//	@Failure		403	{object}	modelv1.ErrorResponse		"Forbidden error"
+//	@Failure		404	{object}	modelv1.ErrorResponse		"Data not found error"
+//	@Failure		409	{object}	modelv1.ErrorResponse		"Data conflict error"
+//	@Failure		500	{object}	modelv1.ErrorResponse		"Internal server error"
+//	@Router			/terminals/{id} [delete]
+//	@Security		BearerAuth
+func (th *TerminalHandler) RevokeTerminal(ctx *gin.Context) {
+	var req modelv1.RevokeTerminalRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		validationError(ctx, err)
+		return
+	}
+
+	terminal, err := th.svc.RevokeTerminal(ctx, req.ID)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	rsp := newTerminalResponse(terminal)
+
+	handleSuccess(ctx, rsp)
+}
@@ -22,14 +22,16 @@ func NewUserHandler(svc port.UserService) *UserHandler {
 // Register godoc
 //
 //	@Summary		Register a new user
-//	@Description	create a new user account with default role "cashier"
+//	@Description	create a new user account as allowed by the registration policy, with role "cashier" or the role of the invitation
 //	@Tags			Users
 //	@Accept			json
 //	@Produce		json
 //	@Param			registerRequest	body		modelv1.RegisterRequest	true	"Register request"
 //	@Success		200				{object}	modelv1.UserResponse	"User created"
+//	@Header			200				{string}	ETag					"Version of the user"
 //	@Failure		400				{object}	modelv1.ErrorResponse	"Validation error"
 //	@Failure		401				{object}	modelv1.ErrorResponse	"Unauthorized error"
+//	@Failure		403				{object}	modelv1.ErrorResponse	"Registration closed or invalid invitation error"
 //	@Failure		404				{object}	modelv1.ErrorResponse	"Data not found error"
 //	@Failure		409				{object}	modelv1.ErrorResponse	"Data conflict error"
 //	@Failure		500				{object}	modelv1.ErrorResponse	"Internal server error"
@@ -47,13 +49,14 @@ func (uh *UserHandler) Register(ctx *gin.Context) {
 		Password: req.Password,
 	}
 
-	_, err := uh.svc.Register(ctx, &user)
+	_, err := uh.svc.Register(ctx, &user, req.InvitationToken)
 	if err != nil {
 		handleError(ctx, err)
 		return
 	}
 
 	rsp := newUserResponse(&user)
+	setETag(ctx, user.Version)
 
 	handleSuccess(ctx, rsp)
 }
@@ -65,11 +68,12 @@ func (uh *UserHandler) Register(ctx *gin.Context) {
 //	@Tags			Users
 //	@Accept			json
 //	@Produce		json
-//	@Param			skip	query		uint64					true	"Skip"
-//	@Param			limit	query		uint64					true	"Limit"
-//	@Success		200		{object}	modelv1.Meta			"Users displayed"
-//	@Failure		400		{object}	modelv1.ErrorResponse	"Validation error"
-//	@Failure		500		{object}	modelv1.ErrorResponse	"Internal server error"
+//	@Param			skip				query		uint64					true	"Skip"
+//	@Param			limit				query		uint64					true	"Limit"
+//	@Param			include_archived	query		bool					false	"Include archived records"
+//	@Success		200					{object}	modelv1.Meta			"Users displayed"
+//	@Failure		400					{object}	modelv1.ErrorResponse	"Validation error"
+//	@Failure		500					{object}	modelv1.ErrorResponse	"Internal server error"
 //	@Router			/users [get]
 //	@Security		BearerAuth
 func (uh *UserHandler) ListUsers(ctx *gin.Context) {
@@ -81,7 +85,7 @@ func (uh *UserHandler) ListUsers(ctx *gin.Context) {
 		return
 	}
 
-	users, err := uh.svc.ListUsers(ctx, req.Skip, req.Limit)
+	users, err := uh.svc.ListUsers(ctx, req.Skip, req.Limit, req.IncludeArchived)
 	if err != nil {
 		handleError(ctx, err)
 		return
@@ -107,6 +111,7 @@ func (uh *UserHandler) ListUsers(ctx *gin.Context) {
 //	@Produce		json
 //	@Param			id	path		uint64					true	"User ID"
 //	@Success		200	{object}	modelv1.UserResponse	"User displayed"
+//	@Header			200	{string}	ETag					"Version of the user"
 //	@Failure		400	{object}	modelv1.ErrorResponse	"Validation error"
 //	@Failure		404	{object}	modelv1.ErrorResponse	"Data not found error"
 //	@Failure		500	{object}	modelv1.ErrorResponse	"Internal server error"
@@ -126,6 +131,92 @@ func (uh *UserHandler) GetUser(ctx *gin.Context) {
 	}
 
 	rsp := newUserResponse(user)
+	setETag(ctx, user.Version)
+
+	handleSuccess(ctx, rsp)
+}
+
+// GetMe godoc
+//
+//	@Summary		Get own profile
+//	@Description	Get the profile of the logged in user
+//	@Tags			Users
+//	@Accept			json
+//	@Produce		json
+//	@Success		200	{object}	modelv1.UserResponse	"User displayed"
+//	@Header			200	{string}	ETag					"Version of the user"
+//	@Failure		401	{object}	modelv1.ErrorResponse	"Unauthorized error"
+//	@Failure		404	{object}	modelv1.ErrorResponse	"Data not found error"
+//	@Failure		500	{object}	modelv1.ErrorResponse	"Internal server error"
+//	@Router			/users/me [get]
+//	@Security		BearerAuth
+func (uh *UserHandler) GetMe(ctx *gin.Context) {
+	authPayload := getAuthPayload(ctx, authorizationPayloadKey)
+
+	user, err := uh.svc.GetUser(ctx, authPayload.UserID)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	rsp := newUserResponse(user)
+	setETag(ctx, user.Version)
+
+	handleSuccess(ctx, rsp)
+}
+
+// UpdateMe godoc
+//
+//	@Summary		Update own profile
+//	@Description	Update the name, email or message locale (en, vi or id) of the logged in user, the password is changed at /users/me/password. Changing the email requires the current password.
+//	@Tags			Users
+//	@Accept			json
+//	@Produce		json
+//	@Param			If-Match		header		string					true	"ETag of the user version being modified"
+//	@Param			updateMeRequest	body		modelv1.UpdateMeRequest	true	"Update profile request"
+//	@Success		200				{object}	modelv1.UserResponse	"User updated"
+//	@Header			200				{string}	ETag					"Version of the user"
+//	@Failure		400				{object}	modelv1.ErrorResponse	"Validation error"
+//	@Failure		401				{object}	modelv1.ErrorResponse	"Unauthorized error"
+//	@Failure		403				{object}	modelv1.ErrorResponse	"Incorrect password error"
+//	@Failure		404				{object}	modelv1.ErrorResponse	"Data not found error"
+//	@Failure		409				{object}	modelv1.ErrorResponse	"Data conflict error"
+//	@Failure		412				{object}	modelv1.ErrorResponse	"Version mismatch error"
+//	@Failure		428				{object}	modelv1.ErrorResponse	"Version required error"
+//	@Failure		500				{object}	modelv1.ErrorResponse	"Internal server error"
+//	@Router			/users/me [put]
+//	@Security		BearerAuth
+func (uh *UserHandler) UpdateMe(ctx *gin.Context) {
+	var req modelv1.UpdateMeRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		validationError(ctx, err)
+		return
+	}
+
+	version, err := getIfMatchVersion(ctx)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	authPayload := getAuthPayload(ctx, authorizationPayloadKey)
+
+	user := domainuser.User{
+		ID:      authPayload.UserID,
+		Name:    req.Name,
+		Email:   req.Email,
+		Locale:  req.Locale,
+		Version: version,
+	}
+
+	_, err = uh.svc.UpdateMe(ctx, &user, req.CurrentPassword)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	rsp := newUserResponse(&user)
+	setETag(ctx, user.Version)
 
 	handleSuccess(ctx, rsp)
 }
@@ -138,12 +229,16 @@ func (uh *UserHandler) GetUser(ctx *gin.Context) {
 //	@Accept			json
 //	@Produce		json
 //	@Param			id					path		uint64						true	"User ID"
+//	@Param			If-Match			header		string						true	"ETag of the user version being modified"
 //	@Param			updateUserRequest	body		modelv1.UpdateUserRequest	true	"Update user request"
 //	@Success		200					{object}	modelv1.UserResponse		"User updated"
+//	@Header			200					{string}	ETag						"Version of the user"
 //	@Failure		400					{object}	modelv1.ErrorResponse		"Validation error"
 //	@Failure		401					{object}	modelv1.ErrorResponse		"Unauthorized error"
 //	@Failure		403					{object}	modelv1.ErrorResponse		"Forbidden error"
 //	@Failure		404					{object}	modelv1.ErrorResponse		"Data not found error"
+//	@Failure		412					{object}	modelv1.ErrorResponse		"Version mismatch error"
+//	@Failure		428					{object}	modelv1.ErrorResponse		"Version required error"
 //	@Failure		500					{object}	modelv1.ErrorResponse		"Internal server error"
 //	@Router			/users/{id} [put]
 //	@Security		BearerAuth
@@ -161,12 +256,19 @@ func (uh *UserHandler) UpdateUser(ctx *gin.Context) {
 		return
 	}
 
+	version, err := getIfMatchVersion(ctx)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
 	user := domainuser.User{
 		ID:       id,
 		Name:     req.Name,
 		Email:    req.Email,
 		Password: req.Password,
 		Role:     req.Role,
+		Version:  version,
 	}
 
 	_, err = uh.svc.UpdateUser(ctx, &user)
@@ -176,6 +278,7 @@ func (uh *UserHandler) UpdateUser(ctx *gin.Context) {
 	}
 
 	rsp := newUserResponse(&user)
+	setETag(ctx, user.Version)
 
 	handleSuccess(ctx, rsp)
 }
@@ -183,31 +286,77 @@ func (uh *UserHandler) UpdateUser(ctx *gin.Context) {
 // DeleteUser godoc
 //
 //	@Summary		Delete a user
-//	@Description	Delete a user by id
+//	@Description	Archive a user by id, keeping it resolvable from past orders
+//	@Tags			Users
+//	@Accept			json
+//	@Produce		json
+//	@Param			id			path		uint64					true	"User ID"
+//	@Param			If-Match	header		string					true	"ETag of the user version being modified"
+//	@Success		200			{object}	modelv1.Response		"User deleted"
+//	@Failure		400			{object}	modelv1.ErrorResponse	"Validation error"
+//	@Failure		401			{object}	modelv1.ErrorResponse	"Unauthorized error"
+//	@Failure		403			{object}	modelv1.ErrorResponse	"Forbidden error"
+//	@Failure		404			{object}	modelv1.ErrorResponse	"Data not found error"
+//	@Failure		412			{object}	modelv1.ErrorResponse	"Version mismatch error"
+//	@Failure		428			{object}	modelv1.ErrorResponse	"Version required error"
+//	@Failure		500			{object}	modelv1.ErrorResponse	"Internal server error"
+//	@Router			/users/{id} [delete]
+//	@Security		BearerAuth
+func (uh *UserHandler) DeleteUser(ctx *gin.Context) {
+	var req modelv1.DeleteUserRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		validationError(ctx, err)
+		return
+	}
+
+	version, err := getIfMatchVersion(ctx)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	err = uh.svc.DeleteUser(ctx, req.ID, version)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	handleSuccess(ctx, nil)
+}
+
+// RestoreUser godoc
+//
+//	@Summary		Restore a user
+//	@Description	Restore an archived user by id
 //	@Tags			Users
 //	@Accept			json
 //	@Produce		json
 //	@Param			id	path		uint64					true	"User ID"
-//	@Success		200	{object}	modelv1.Response		"User deleted"
+//	@Success		200	{object}	modelv1.UserResponse	"User restored"
+//	@Header			200	{string}	ETag					"Version of the user"
 //	@Failure		400	{object}	modelv1.ErrorResponse	"Validation error"
 //	@Failure		401	{object}	modelv1.ErrorResponse	"Unauthorized error"
 //	@Failure		403	{object}	modelv1.ErrorResponse	"Forbidden error"
 //	@Failure		404	{object}	modelv1.ErrorResponse	"Data not found error"
+//	@Failure		409	{object}	modelv1.ErrorResponse	"Data not archived error"
 //	@Failure		500	{object}	modelv1.ErrorResponse	"Internal server error"
-//	@Router			/users/{id} [delete]
+//	@Router			/users/{id}/restore [post]
 //	@Security		BearerAuth
-func (uh *UserHandler) DeleteUser(ctx *gin.Context) {
-	var req modelv1.DeleteUserRequest
+func (uh *UserHandler) RestoreUser(ctx *gin.Context) {
+	var req modelv1.RestoreUserRequest
 	if err := ctx.ShouldBindUri(&req); err != nil {
 		validationError(ctx, err)
 		return
 	}
 
-	err := uh.svc.DeleteUser(ctx, req.ID)
+	user, err := uh.svc.RestoreUser(ctx, req.ID)
 	if err != nil {
 		handleError(ctx, err)
 		return
 	}
 
-	handleSuccess(ctx, nil)
+	rsp := newUserResponse(user)
+	setETag(ctx, user.Version)
+
+	handleSuccess(ctx, rsp)
 }
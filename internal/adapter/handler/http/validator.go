@@ -1,21 +1,21 @@
 package http
 
 import (
+	"reflect"
+	"regexp"
+	"strings"
+
+	"github.com/TienMinh25/go-hexagonal-architecture/internal/adapter/i18n"
 	domainpayment "github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain/payment"
-	domainuser "github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain/user"
 	"github.com/go-playground/validator/v10"
 )
 
-// userRoleValidator is a custom validator for validating user roles
-var userRoleValidator validator.Func = func(fl validator.FieldLevel) bool {
-	userRole := fl.Field().Interface().(domainuser.UserRole)
+// roleNamePattern is the format of role names, whether the role exists is checked by the database
+var roleNamePattern = regexp.MustCompile(`^[a-z][a-z0-9_-]{1,31}$`)
 
-	switch userRole {
-	case "admin", "cashier":
-		return true
-	default:
-		return false
-	}
+// userRoleValidator is a custom validator for validating user role names
+var userRoleValidator validator.Func = func(fl validator.FieldLevel) bool {
+	return roleNamePattern.MatchString(fl.Field().String())
 }
 
 // paymentTypeValidator is a custom validator for validating payment types
@@ -23,9 +23,42 @@ var paymentTypeValidator validator.Func = func(fl validator.FieldLevel) bool {
 	paymentType := fl.Field().Interface().(domainpayment.PaymentType)
 
 	switch paymentType {
-	case "CASH", "E-WALLET", "EDC":
+	case "CASH", "E-WALLET", "EDC", "GIFT_CARD":
 		return true
 	default:
 		return false
 	}
-}
\ No newline at end of file
+}
+
+// localeValidator is a custom validator for validating the locales messages can be translated into
+var localeValidator validator.Func = func(fl validator.FieldLevel) bool {
+	return i18n.Supported(fl.Field().String())
+}
+
+// fieldNameTags are the tags naming a field in the requests, in the order they are looked up
+var fieldNameTags = []string{"json", "uri", "form"}
+
+// fieldName names the fields of validation errors as the client sends them rather than by their Go name
+func fieldName(field reflect.StructField) string {
+	for _, tag := range fieldNameTags {
+		name, _, _ := strings.Cut(field.Tag.Get(tag), ",")
+		if name == "-" {
+			return ""
+		}
+		if name != "" {
+			return name
+		}
+	}
+
+	return field.Name
+}
+
+// fieldPath returns the path of the field from the root of the request, such as products[0].quantity
+func fieldPath(fieldErr validator.FieldError) string {
+	_, path, ok := strings.Cut(fieldErr.Namespace(), ".")
+	if !ok {
+		return fieldErr.Field()
+	}
+
+	return path
+}
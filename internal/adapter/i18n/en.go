@@ -0,0 +1,23 @@
+package i18n
+
+// Keys of the messages about requests that cannot be parsed, they are not domain errors
+const (
+	RequestBodyEmpty   = "request_body_empty"
+	RequestBodyInvalid = "request_body_invalid"
+	FieldType          = "field_type"
+)
+
+// enMessages are the English messages, the messages of domain errors are the ones they are defined with
+var enMessages = map[string]string{
+	RequestBodyEmpty:   "request body is empty",
+	RequestBodyInvalid: "request body is not valid JSON",
+	FieldType:          "{0} must be of type {1}",
+}
+
+// enValidationMessages are the English messages of the custom validation rules
+var enValidationMessages = map[string]string{
+	"required_without": "{0} is required when {1} is not provided",
+	"user_role":        "{0} must be a role name of lowercase letters, digits, - and _",
+	"payment_type":     "{0} must be one of CASH, E-WALLET, EDC or GIFT_CARD",
+	"locale":           "{0} must be one of en, vi or id",
+}
@@ -0,0 +1,128 @@
+package i18n
+
+import (
+	"slices"
+
+	"github.com/go-playground/locales"
+	"github.com/go-playground/locales/en"
+	"github.com/go-playground/locales/id"
+	"github.com/go-playground/locales/vi"
+	ut "github.com/go-playground/universal-translator"
+	"github.com/go-playground/validator/v10"
+	entranslations "github.com/go-playground/validator/v10/translations/en"
+	idtranslations "github.com/go-playground/validator/v10/translations/id"
+	vitranslations "github.com/go-playground/validator/v10/translations/vi"
+	"golang.org/x/text/language"
+)
+
+// catalog holds the messages of a locale keyed by error code or message key,
+// along with the messages of the validation rules the validator does not translate
+type catalog struct {
+	locale             locales.Translator
+	messages           map[string]string
+	validationMessages map[string]string
+	registerValidator  func(v *validator.Validate, trans ut.Translator) error
+}
+
+// catalogs are the supported locales, English comes first as it is the fallback
+var catalogs = []catalog{
+	{en.New(), enMessages, enValidationMessages, entranslations.RegisterDefaultTranslations},
+	{vi.New(), viMessages, viValidationMessages, vitranslations.RegisterDefaultTranslations},
+	{id.New(), idMessages, idValidationMessages, idtranslations.RegisterDefaultTranslations},
+}
+
+// Supported reports whether messages can be translated into the locale
+func Supported(locale string) bool {
+	return slices.ContainsFunc(catalogs, func(c catalog) bool {
+		return c.locale.Locale() == locale
+	})
+}
+
+// Translator translates the messages sent to clients into the supported locales
+type Translator struct {
+	universal *ut.UniversalTranslator
+}
+
+// New creates a new translator with the catalogs of all supported locales
+func New() (*Translator, error) {
+	supported := make([]locales.Translator, 0, len(catalogs))
+	for _, c := range catalogs {
+		supported = append(supported, c.locale)
+	}
+
+	universal := ut.New(supported[0], supported...)
+
+	for _, c := range catalogs {
+		trans, _ := universal.GetTranslator(c.locale.Locale())
+		for key, message := range c.messages {
+			if err := trans.Add(key, message, false); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return &Translator{
+		universal,
+	}, nil
+}
+
+// RegisterValidator registers the translations of the validation rules into the validator
+func (t *Translator) RegisterValidator(v *validator.Validate) error {
+	for _, c := range catalogs {
+		trans, _ := t.universal.GetTranslator(c.locale.Locale())
+
+		if err := c.registerValidator(v, trans); err != nil {
+			return err
+		}
+
+		for tag, message := range c.validationMessages {
+			err := v.RegisterTranslation(tag, trans, registerMessage(tag, message), translateField)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// Negotiate returns the translator of the locale to send messages in.
+// The preference of the user comes first, then the languages of the Accept-Language header by quality, then English
+func (t *Translator) Negotiate(preference, acceptLanguage string) ut.Translator {
+	candidates := []string{}
+	if preference != "" {
+		candidates = append(candidates, preference)
+	}
+
+	tags, _, _ := language.ParseAcceptLanguage(acceptLanguage)
+	for _, tag := range tags {
+		base, _ := tag.Base()
+		candidates = append(candidates, base.String())
+	}
+
+	trans, _ := t.universal.FindTranslator(candidates...)
+	return trans
+}
+
+// Message translates the message of the key with its parameters,
+// the fallback is returned when the locale has no translation for it
+func Message(trans ut.Translator, key, fallback string, params ...string) string {
+	message, err := trans.T(key, params...)
+	if err != nil || message == "" {
+		return fallback
+	}
+
+	return message
+}
+
+// registerMessage adds the message of a validation rule, it replaces the one of the validator if any
+func registerMessage(tag, message string) validator.RegisterTranslationsFunc {
+	return func(trans ut.Translator) error {
+		return trans.Add(tag, message, true)
+	}
+}
+
+// translateField fills the message of a validation rule with the name of the field and the parameter of the rule
+func translateField(trans ut.Translator, fieldErr validator.FieldError) string {
+	return Message(trans, fieldErr.Tag(), fieldErr.Error(), fieldErr.Field(), fieldErr.Param())
+}
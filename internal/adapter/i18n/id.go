@@ -0,0 +1,78 @@
+package i18n
+
+import "github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain"
+
+// idMessages are the Indonesian messages keyed by error code
+var idMessages = map[string]string{
+	RequestBodyEmpty:   "isi permintaan kosong",
+	RequestBodyInvalid: "isi permintaan bukan JSON yang valid",
+	FieldType:          "{0} harus bertipe {1}",
+
+	domain.ErrInternal.Code:                    "terjadi kesalahan internal",
+	domain.ErrInvalidRequest.Code:              "permintaan tidak valid",
+	domain.ErrDataNotFound.Code:                "data tidak ditemukan",
+	domain.ErrNoUpdatedData.Code:               "tidak ada data untuk diperbarui",
+	domain.ErrConflictingData.Code:             "data bentrok dengan data yang sudah ada",
+	domain.ErrDataArchived.Code:                "data sudah diarsipkan",
+	domain.ErrDataNotArchived.Code:             "data belum diarsipkan",
+	domain.ErrVersionMismatch.Code:             "data telah diubah oleh permintaan lain",
+	domain.ErrVersionRequired.Code:             "versi data tidak diberikan di header If-Match",
+	domain.ErrInsufficientStock.Code:           "stok produk tidak mencukupi",
+	domain.ErrInsufficientPayment.Code:         "jumlah yang dibayar kurang dari total harga",
+	domain.ErrCustomerRequired.Code:            "pelanggan diperlukan untuk menukar poin",
+	domain.ErrRedemptionDisabled.Code:          "penukaran poin sedang dinonaktifkan",
+	domain.ErrInsufficientPoints.Code:          "saldo poin pelanggan tidak mencukupi",
+	domain.ErrRedemptionExceedsTotal.Code:      "nilai poin yang ditukar melebihi total harga",
+	domain.ErrGiftCardRequired.Code:            "kode kartu hadiah diperlukan untuk pembayaran dengan kartu hadiah",
+	domain.ErrGiftCardExpired.Code:             "kartu hadiah sudah kedaluwarsa",
+	domain.ErrInvalidGiftCardExpiry.Code:       "masa berlaku kartu hadiah harus di masa depan",
+	domain.ErrGiftCardPayment.Code:             "kartu hadiah tidak dapat dibayar dengan kartu hadiah",
+	domain.ErrInsufficientGiftCardBalance.Code: "saldo kartu hadiah tidak mencukupi",
+	domain.ErrOrderRefunded.Code:               "pesanan sudah dikembalikan dananya",
+	domain.ErrUnknownRole.Code:                 "peran tidak ada",
+	domain.ErrUnknownPermission.Code:           "izin tidak ada",
+	domain.ErrSystemRole.Code:                  "peran bawaan tidak dapat diubah",
+	domain.ErrRoleInUse.Code:                   "peran masih digunakan oleh pengguna",
+	domain.ErrRegistrationClosed.Code:          "pendaftaran ditutup",
+	domain.ErrInvitationRequired.Code:          "undangan diperlukan untuk mendaftar",
+	domain.ErrInvalidInvitation.Code:           "undangan tidak valid atau sudah kedaluwarsa",
+	domain.ErrTokenDuration.Code:               "format durasi token tidak valid",
+	domain.ErrTokenKey.Code:                    "konfigurasi kunci token tidak valid",
+	domain.ErrTokenCreation.Code:               "gagal membuat token",
+	domain.ErrExpiredToken.Code:                "access token sudah kedaluwarsa",
+	domain.ErrInvalidToken.Code:                "access token tidak valid",
+	domain.ErrRevokedToken.Code:                "access token sudah dicabut",
+	domain.ErrInvalidRefreshToken.Code:         "refresh token tidak valid",
+	domain.ErrExpiredRefreshToken.Code:         "refresh token sudah kedaluwarsa",
+	domain.ErrRefreshTokenReused.Code:          "refresh token sudah pernah digunakan",
+	domain.ErrInvalidCredentials.Code:          "email atau kata sandi salah",
+	domain.ErrIncorrectPassword.Code:           "kata sandi saat ini salah",
+	domain.ErrInvalidResetToken.Code:           "token atur ulang kata sandi tidak valid atau sudah kedaluwarsa",
+	domain.ErrInvalidPIN.Code:                  "pengguna atau PIN salah",
+	domain.ErrInvalidTerminal.Code:             "terminal belum terdaftar atau kredensialnya tidak valid",
+	domain.ErrInvalidChallenge.Code:            "tantangan login tidak valid atau sudah kedaluwarsa",
+	domain.ErrInvalidTwoFactorCode.Code:        "kode autentikasi dua faktor tidak valid",
+	domain.ErrTwoFactorEnabled.Code:            "autentikasi dua faktor sudah aktif",
+	domain.ErrTwoFactorNotEnabled.Code:         "autentikasi dua faktor belum aktif",
+	domain.ErrTwoFactorRequired.Code:           "autentikasi dua faktor wajib untuk peran ini",
+	domain.ErrTooManyLoginAttempts.Code:        "terlalu banyak percobaan login gagal, coba lagi nanti",
+	domain.ErrTooManyResetRequests.Code:        "terlalu banyak permintaan atur ulang kata sandi, coba lagi nanti",
+	domain.ErrAccountLocked.Code:               "akun dikunci setelah terlalu banyak percobaan login gagal",
+	domain.ErrInvalidAPIKey.Code:               "API key tidak valid atau sudah kedaluwarsa",
+	domain.ErrInvalidAPIKeyExpiry.Code:         "masa berlaku API key harus di masa depan",
+	domain.ErrMigrationOutdated.Code:           "skema database belum berada di migrasi terbaru",
+	domain.ErrMigrationDirty.Code:              "skema database rusak setelah migrasi yang gagal",
+	domain.ErrEmptyAuthorizationHeader.Code:    "header Authorization tidak diberikan",
+	domain.ErrInvalidAuthorizationHeader.Code:  "format header Authorization tidak valid",
+	domain.ErrInvalidAuthorizationType.Code:    "jenis otorisasi tidak didukung",
+	domain.ErrUnauthorized.Code:                "pengguna tidak berwenang mengakses sumber daya",
+	domain.ErrForbidden.Code:                   "pengguna dilarang mengakses sumber daya",
+}
+
+// idValidationMessages are the Indonesian messages of the validation rules the validator does not translate
+var idValidationMessages = map[string]string{
+	"required_without": "{0} wajib diisi jika {1} tidak diberikan",
+	"user_role":        "{0} harus berupa nama peran dari huruf kecil, angka, - dan _",
+	"payment_type":     "{0} harus salah satu dari CASH, E-WALLET, EDC atau GIFT_CARD",
+	"locale":           "{0} harus salah satu dari en, vi atau id",
+}
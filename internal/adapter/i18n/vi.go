@@ -0,0 +1,78 @@
+package i18n
+
+import "github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain"
+
+// viMessages are the Vietnamese messages keyed by error code
+var viMessages = map[string]string{
+	RequestBodyEmpty:   "nội dung yêu cầu bị trống",
+	RequestBodyInvalid: "nội dung yêu cầu không phải JSON hợp lệ",
+	FieldType:          "{0} phải có kiểu {1}",
+
+	domain.ErrInternal.Code:                    "lỗi hệ thống",
+	domain.ErrInvalidRequest.Code:              "yêu cầu không hợp lệ",
+	domain.ErrDataNotFound.Code:                "không tìm thấy dữ liệu",
+	domain.ErrNoUpdatedData.Code:               "không có dữ liệu để cập nhật",
+	domain.ErrConflictingData.Code:             "dữ liệu trùng với dữ liệu đã có",
+	domain.ErrDataArchived.Code:                "dữ liệu đã được lưu trữ",
+	domain.ErrDataNotArchived.Code:             "dữ liệu chưa được lưu trữ",
+	domain.ErrVersionMismatch.Code:             "dữ liệu đã bị thay đổi bởi một yêu cầu khác",
+	domain.ErrVersionRequired.Code:             "chưa cung cấp phiên bản dữ liệu trong header If-Match",
+	domain.ErrInsufficientStock.Code:           "sản phẩm không đủ tồn kho",
+	domain.ErrInsufficientPayment.Code:         "số tiền đã trả nhỏ hơn tổng tiền",
+	domain.ErrCustomerRequired.Code:            "cần có khách hàng để đổi điểm",
+	domain.ErrRedemptionDisabled.Code:          "chức năng đổi điểm đang tắt",
+	domain.ErrInsufficientPoints.Code:          "khách hàng không đủ điểm",
+	domain.ErrRedemptionExceedsTotal.Code:      "giá trị điểm đổi vượt quá tổng tiền",
+	domain.ErrGiftCardRequired.Code:            "cần mã thẻ quà tặng để thanh toán bằng thẻ quà tặng",
+	domain.ErrGiftCardExpired.Code:             "thẻ quà tặng đã hết hạn",
+	domain.ErrInvalidGiftCardExpiry.Code:       "hạn dùng của thẻ quà tặng phải ở trong tương lai",
+	domain.ErrGiftCardPayment.Code:             "không thể thanh toán thẻ quà tặng bằng thẻ quà tặng",
+	domain.ErrInsufficientGiftCardBalance.Code: "số dư thẻ quà tặng không đủ",
+	domain.ErrOrderRefunded.Code:               "đơn hàng đã được hoàn tiền",
+	domain.ErrUnknownRole.Code:                 "vai trò không tồn tại",
+	domain.ErrUnknownPermission.Code:           "quyền không tồn tại",
+	domain.ErrSystemRole.Code:                  "không thể thay đổi vai trò mặc định",
+	domain.ErrRoleInUse.Code:                   "vai trò vẫn đang được gán cho người dùng",
+	domain.ErrRegistrationClosed.Code:          "đã đóng đăng ký",
+	domain.ErrInvitationRequired.Code:          "cần có lời mời để đăng ký",
+	domain.ErrInvalidInvitation.Code:           "lời mời không hợp lệ hoặc đã hết hạn",
+	domain.ErrTokenDuration.Code:               "định dạng thời hạn token không hợp lệ",
+	domain.ErrTokenKey.Code:                    "cấu hình khóa token không hợp lệ",
+	domain.ErrTokenCreation.Code:               "không thể tạo token",
+	domain.ErrExpiredToken.Code:                "access token đã hết hạn",
+	domain.ErrInvalidToken.Code:                "access token không hợp lệ",
+	domain.ErrRevokedToken.Code:                "access token đã bị thu hồi",
+	domain.ErrInvalidRefreshToken.Code:         "refresh token không hợp lệ",
+	domain.ErrExpiredRefreshToken.Code:         "refresh token đã hết hạn",
+	domain.ErrRefreshTokenReused.Code:          "refresh token đã được sử dụng",
+	domain.ErrInvalidCredentials.Code:          "email hoặc mật khẩu không đúng",
+	domain.ErrIncorrectPassword.Code:           "mật khẩu hiện tại không đúng",
+	domain.ErrInvalidResetToken.Code:           "mã đặt lại mật khẩu không hợp lệ hoặc đã hết hạn",
+	domain.ErrInvalidPIN.Code:                  "người dùng hoặc mã PIN không đúng",
+	domain.ErrInvalidTerminal.Code:             "máy bán hàng chưa được đăng ký hoặc thông tin xác thực không hợp lệ",
+	domain.ErrInvalidChallenge.Code:            "phiên đăng nhập không hợp lệ hoặc đã hết hạn",
+	domain.ErrInvalidTwoFactorCode.Code:        "mã xác thực hai lớp không hợp lệ",
+	domain.ErrTwoFactorEnabled.Code:            "xác thực hai lớp đã được bật",
+	domain.ErrTwoFactorNotEnabled.Code:         "xác thực hai lớp chưa được bật",
+	domain.ErrTwoFactorRequired.Code:           "vai trò này bắt buộc xác thực hai lớp",
+	domain.ErrTooManyLoginAttempts.Code:        "đăng nhập sai quá nhiều lần, vui lòng thử lại sau",
+	domain.ErrTooManyResetRequests.Code:        "yêu cầu đặt lại mật khẩu quá nhiều lần, vui lòng thử lại sau",
+	domain.ErrAccountLocked.Code:               "tài khoản đã bị khóa do đăng nhập sai quá nhiều lần",
+	domain.ErrInvalidAPIKey.Code:               "API key không hợp lệ hoặc đã hết hạn",
+	domain.ErrInvalidAPIKeyExpiry.Code:         "hạn dùng của API key phải ở trong tương lai",
+	domain.ErrMigrationOutdated.Code:           "cơ sở dữ liệu chưa ở phiên bản migration mới nhất",
+	domain.ErrMigrationDirty.Code:              "cơ sở dữ liệu bị lỗi sau một migration thất bại",
+	domain.ErrEmptyAuthorizationHeader.Code:    "chưa cung cấp header Authorization",
+	domain.ErrInvalidAuthorizationHeader.Code:  "định dạng header Authorization không hợp lệ",
+	domain.ErrInvalidAuthorizationType.Code:    "kiểu xác thực không được hỗ trợ",
+	domain.ErrUnauthorized.Code:                "người dùng chưa được xác thực để truy cập tài nguyên",
+	domain.ErrForbidden.Code:                   "người dùng không có quyền truy cập tài nguyên",
+}
+
+// viValidationMessages are the Vietnamese messages of the validation rules the validator does not translate
+var viValidationMessages = map[string]string{
+	"required_without": "{0} không được bỏ trống khi không có {1}",
+	"user_role":        "{0} phải là tên vai trò gồm chữ thường, chữ số, - và _",
+	"payment_type":     "{0} phải là một trong CASH, E-WALLET, EDC hoặc GIFT_CARD",
+	"locale":           "{0} phải là một trong en, vi hoặc id",
+}
@@ -0,0 +1,61 @@
+package logger
+
+import (
+	"context"
+	"log/slog"
+	"slices"
+
+	"go.opentelemetry.io/otel/trace"
+)
+
+// attrsKey is the key of the log attributes carried by a context
+type attrsKey struct{}
+
+// WithAttrs returns a copy of the context carrying the attributes,
+// they are added to every line logged with the context or a context derived from it
+func WithAttrs(ctx context.Context, attrs ...slog.Attr) context.Context {
+	existing, _ := ctx.Value(attrsKey{}).([]slog.Attr)
+	return context.WithValue(ctx, attrsKey{}, append(slices.Clip(existing), attrs...))
+}
+
+/**
+ * contextHandler implements slog.Handler interface
+ * and adds the attributes of the context and its trace to the records of the handler it wraps
+ */
+type contextHandler struct {
+	slog.Handler
+}
+
+// newContextHandler wraps the handler to log the attributes carried by the context
+func newContextHandler(handler slog.Handler) slog.Handler {
+	return &contextHandler{
+		handler,
+	}
+}
+
+// Handle adds the attributes of the context to the record before handling it
+func (ch *contextHandler) Handle(ctx context.Context, record slog.Record) error {
+	if attrs, ok := ctx.Value(attrsKey{}).([]slog.Attr); ok {
+		record.AddAttrs(attrs...)
+	}
+
+	spanContext := trace.SpanContextFromContext(ctx)
+	if spanContext.IsValid() {
+		record.AddAttrs(
+			slog.String("trace_id", spanContext.TraceID().String()),
+			slog.String("span_id", spanContext.SpanID().String()),
+		)
+	}
+
+	return ch.Handler.Handle(ctx, record)
+}
+
+// WithAttrs returns a handler with the attributes that still adds the attributes of the context
+func (ch *contextHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return newContextHandler(ch.Handler.WithAttrs(attrs))
+}
+
+// WithGroup returns a handler with the group that still adds the attributes of the context
+func (ch *contextHandler) WithGroup(name string) slog.Handler {
+	return newContextHandler(ch.Handler.WithGroup(name))
+}
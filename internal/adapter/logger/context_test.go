@@ -0,0 +1,95 @@
+package logger
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"log/slog"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type withAttrsTestedInput struct {
+	ctx func() context.Context
+}
+
+type withAttrsExpectedOutput struct {
+	attrs map[string]any
+}
+
+func TestWithAttrs(t *testing.T) {
+	requestCtx := WithAttrs(context.Background(), slog.String("request_id", "req-1"))
+
+	testCases := []struct {
+		desc     string
+		input    withAttrsTestedInput
+		expected withAttrsExpectedOutput
+	}{
+		{
+			desc: "Success_RequestID",
+			input: withAttrsTestedInput{
+				ctx: func() context.Context {
+					return requestCtx
+				},
+			},
+			expected: withAttrsExpectedOutput{
+				attrs: map[string]any{"request_id": "req-1"},
+			},
+		},
+		{
+			desc: "Success_DerivedContext",
+			input: withAttrsTestedInput{
+				ctx: func() context.Context {
+					ctx, cancel := context.WithCancel(requestCtx)
+					defer cancel()
+					return WithAttrs(ctx, slog.String("route", "/orders/:id"))
+				},
+			},
+			expected: withAttrsExpectedOutput{
+				attrs: map[string]any{"request_id": "req-1", "route": "/orders/:id"},
+			},
+		},
+		{
+			desc: "Success_SiblingsDoNotShareAttrs",
+			input: withAttrsTestedInput{
+				ctx: func() context.Context {
+					WithAttrs(requestCtx, slog.String("route", "/orders"))
+					return WithAttrs(requestCtx, slog.String("user_id", "7"))
+				},
+			},
+			expected: withAttrsExpectedOutput{
+				attrs: map[string]any{"request_id": "req-1", "user_id": "7"},
+			},
+		},
+		{
+			desc: "Success_NoAttrs",
+			input: withAttrsTestedInput{
+				ctx: context.Background,
+			},
+			expected: withAttrsExpectedOutput{
+				attrs: map[string]any{},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.desc, func(t *testing.T) {
+			t.Parallel()
+
+			var buf bytes.Buffer
+			logger := slog.New(newContextHandler(slog.NewJSONHandler(&buf, nil))).With("component", "test")
+
+			logger.InfoContext(tc.input.ctx(), "Logged")
+
+			var line map[string]any
+			assert.NoError(t, json.Unmarshal(buf.Bytes(), &line), "Line mismatch")
+
+			for _, key := range []string{"time", "level", "msg", "component"} {
+				delete(line, key)
+			}
+			assert.Equal(t, tc.expected.attrs, line, "Attributes mismatch")
+		})
+	}
+}
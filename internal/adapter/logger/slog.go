@@ -12,10 +12,11 @@ import (
 // logger is the default logger used by the application
 var logger *slog.Logger
 
-// Set sets the logger configuration based on the environment
+// Set sets the logger configuration based on the environment.
+// Lines logged with a context carry its request attributes and trace
 func Set(config *config.App) {
 	logger = slog.New(
-		slog.NewTextHandler(os.Stderr, nil),
+		newContextHandler(slog.NewTextHandler(os.Stderr, nil)),
 	)
 
 	if config.Env == "production" {
@@ -28,10 +29,10 @@ func Set(config *config.App) {
 		}
 
 		logger = slog.New(
-			slogmulti.Fanout(
+			newContextHandler(slogmulti.Fanout(
 				slog.NewJSONHandler(logRotate, nil),
 				slog.NewTextHandler(os.Stderr, nil),
-			),
+			)),
 		)
 	}
 
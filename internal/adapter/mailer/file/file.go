@@ -0,0 +1,59 @@
+package file
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"os"
+	"sync"
+	"time"
+
+	domainmail "github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain/mail"
+	"github.com/TienMinh25/go-hexagonal-architecture/internal/application/port"
+)
+
+/**
+ * fileMailer implements port.Mailer interface
+ * for local development, appending emails to a file
+ * or writing them to the log instead of delivering them
+ */
+type fileMailer struct {
+	path string
+	mu   sync.Mutex
+}
+
+// New creates a new file mailer instance, emails are logged when the path is empty
+func New(path string) port.Mailer {
+	return &fileMailer{
+		path: path,
+	}
+}
+
+// Send appends the message to the file or logs it
+func (fm *fileMailer) Send(ctx context.Context, message *domainmail.Message) error {
+	if fm.path == "" {
+		slog.InfoContext(ctx, "Mail sent", "to", message.To, "subject", message.Subject, "body", message.Body)
+		return nil
+	}
+
+	fm.mu.Lock()
+	defer fm.mu.Unlock()
+
+	f, err := os.OpenFile(fm.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return err
+	}
+
+	_, err = fmt.Fprintf(f, "Date: %s\nTo: %s\nSubject: %s\n\n%s\n\n----\n\n",
+		time.Now().Format(time.RFC1123Z),
+		message.To,
+		message.Subject,
+		message.Body,
+	)
+	if err != nil {
+		f.Close()
+		return err
+	}
+
+	return f.Close()
+}
@@ -0,0 +1,125 @@
+package smtp
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net"
+	"net/smtp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/TienMinh25/go-hexagonal-architecture/config"
+	domainmail "github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain/mail"
+	"github.com/TienMinh25/go-hexagonal-architecture/internal/application/port"
+)
+
+/**
+ * smtpMailer implements port.Mailer interface
+ * and delivers emails through an SMTP server,
+ * upgrading the connection with STARTTLS when the server offers it
+ */
+type smtpMailer struct {
+	host string
+	addr string
+	from string
+	auth smtp.Auth
+}
+
+// errHeaderInjection is returned when a header value would start a new header line
+var errHeaderInjection = errors.New("mail header contains a line break")
+
+// New creates a new SMTP mailer instance, authenticating only when a user is configured
+func New(config *config.Mail) port.Mailer {
+	var auth smtp.Auth
+	if config.SMTPUser != "" {
+		auth = smtp.PlainAuth("", config.SMTPUser, config.SMTPPassword, config.SMTPHost)
+	}
+
+	return &smtpMailer{
+		config.SMTPHost,
+		net.JoinHostPort(config.SMTPHost, strconv.Itoa(config.SMTPPort)),
+		config.From,
+		auth,
+	}
+}
+
+// Send sends the message through the SMTP server, giving up when the context is done
+func (sm *smtpMailer) Send(ctx context.Context, message *domainmail.Message) error {
+	if strings.ContainsAny(message.To+message.Subject, "\r\n") {
+		return errHeaderInjection
+	}
+
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, "tcp", sm.addr)
+	if err != nil {
+		return err
+	}
+
+	if deadline, ok := ctx.Deadline(); ok {
+		if err := conn.SetDeadline(deadline); err != nil {
+			conn.Close()
+			return err
+		}
+	}
+
+	client, err := smtp.NewClient(conn, sm.host)
+	if err != nil {
+		conn.Close()
+		return err
+	}
+	defer client.Close()
+
+	if ok, _ := client.Extension("STARTTLS"); ok {
+		if err := client.StartTLS(&tls.Config{ServerName: sm.host}); err != nil {
+			return err
+		}
+	}
+
+	if sm.auth != nil {
+		if err := client.Auth(sm.auth); err != nil {
+			return err
+		}
+	}
+
+	if err := client.Mail(sm.from); err != nil {
+		return err
+	}
+
+	if err := client.Rcpt(message.To); err != nil {
+		return err
+	}
+
+	w, err := client.Data()
+	if err != nil {
+		return err
+	}
+
+	if _, err := w.Write(sm.format(message)); err != nil {
+		return err
+	}
+
+	if err := w.Close(); err != nil {
+		return err
+	}
+
+	return client.Quit()
+}
+
+// format renders the headers and body of the message
+func (sm *smtpMailer) format(message *domainmail.Message) []byte {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "From: %s\r\n", sm.from)
+	fmt.Fprintf(&b, "To: %s\r\n", message.To)
+	fmt.Fprintf(&b, "Subject: %s\r\n", message.Subject)
+	fmt.Fprintf(&b, "Date: %s\r\n", time.Now().Format(time.RFC1123Z))
+	b.WriteString("MIME-Version: 1.0\r\n")
+	b.WriteString("Content-Type: text/plain; charset=UTF-8\r\n")
+	b.WriteString("\r\n")
+	b.WriteString(strings.ReplaceAll(message.Body, "\n", "\r\n"))
+
+	return []byte(b.String())
+}
@@ -0,0 +1,139 @@
+package metrics
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	domainorder "github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain/order"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+// namespace prefixes the name of every metric of the application
+const namespace = "pos"
+
+/**
+ * Metrics implements port.Metrics interface
+ * and provides an access to the prometheus library
+ */
+type Metrics struct {
+	registry       *prometheus.Registry
+	requests       *prometheus.CounterVec
+	requestLatency *prometheus.HistogramVec
+	cacheLookups   *prometheus.CounterVec
+	orders         prometheus.Counter
+	revenue        prometheus.Counter
+	rejections     *prometheus.CounterVec
+}
+
+// New creates a new metrics instance with its own registry,
+// collecting the Go runtime, the process and the database pool next to the application metrics
+func New(pool *pgxpool.Pool) (*Metrics, error) {
+	m := &Metrics{
+		registry: prometheus.NewRegistry(),
+		requests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "http",
+			Name:      "requests_total",
+			Help:      "Number of HTTP requests served, by method, route and status.",
+		}, []string{"method", "route", "status"}),
+		requestLatency: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: "http",
+			Name:      "request_duration_seconds",
+			Help:      "Duration of HTTP requests, by method, route and status.",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{"method", "route", "status"}),
+		cacheLookups: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "cache",
+			Name:      "lookups_total",
+			Help:      "Number of cache lookups, by result hit or miss.",
+		}, []string{"result"}),
+		orders: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "orders",
+			Name:      "created_total",
+			Help:      "Number of orders created.",
+		}),
+		revenue: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "orders",
+			Name:      "revenue_total",
+			Help:      "Total price of the orders created.",
+		}),
+		rejections: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "orders",
+			Name:      "rejected_total",
+			Help:      "Number of orders rejected, by reason.",
+		}, []string{"reason"}),
+	}
+
+	// the rejection reasons are known upfront, so they are exported at zero before the first rejection
+	for _, reason := range []domainorder.Rejection{
+		domainorder.RejectedInsufficientStock,
+		domainorder.RejectedInsufficientPayment,
+	} {
+		m.rejections.WithLabelValues(string(reason))
+	}
+	m.cacheLookups.WithLabelValues("hit")
+	m.cacheLookups.WithLabelValues("miss")
+
+	for _, collector := range []prometheus.Collector{
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		newPoolCollector(pool),
+		m.requests,
+		m.requestLatency,
+		m.cacheLookups,
+		m.orders,
+		m.revenue,
+		m.rejections,
+	} {
+		err := m.registry.Register(collector)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return m, nil
+}
+
+// Handler returns the HTTP handler exposing the metrics in the prometheus text format
+func (m *Metrics) Handler() http.Handler {
+	return promhttp.HandlerFor(m.registry, promhttp.HandlerOpts{
+		Registry: m.registry,
+	})
+}
+
+// ObserveRequest records an HTTP request served by the route with its status and duration
+func (m *Metrics) ObserveRequest(method, route string, status int, duration time.Duration) {
+	code := strconv.Itoa(status)
+	m.requests.WithLabelValues(method, route, code).Inc()
+	m.requestLatency.WithLabelValues(method, route, code).Observe(duration.Seconds())
+}
+
+// ObserveCacheLookup records a lookup of the cache, a hit when the key was found
+func (m *Metrics) ObserveCacheLookup(hit bool) {
+	result := "miss"
+	if hit {
+		result = "hit"
+	}
+
+	m.cacheLookups.WithLabelValues(result).Inc()
+}
+
+// OrderCreated records a created order and the revenue it brings
+func (m *Metrics) OrderCreated(totalPrice float64) {
+	m.orders.Inc()
+	m.revenue.Add(totalPrice)
+}
+
+// OrderRejected records an order rejected for the reason
+func (m *Metrics) OrderRejected(reason domainorder.Rejection) {
+	m.rejections.WithLabelValues(string(reason)).Inc()
+}
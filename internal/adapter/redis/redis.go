@@ -2,23 +2,26 @@ package redis
 
 import (
 	"context"
+	"errors"
 	"time"
 
 	"github.com/TienMinh25/go-hexagonal-architecture/config"
 	storageredis "github.com/TienMinh25/go-hexagonal-architecture/infrastructure/storage/redis"
+	"github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain"
 	"github.com/TienMinh25/go-hexagonal-architecture/internal/application/port"
 	"github.com/redis/go-redis/v9"
 )
 
 /**
  * Redis implements port.CacheRepository interface
- * and provides an access to the redis library
+ * and provides an access to the redis library and the metrics
  */
 type redisCache struct {
-	client *redis.Client
+	client  *redis.Client
+	metrics port.Metrics
 }
 
-func NewRedis(ctx context.Context, config *config.Redis) (port.CacheRepository, error) {
+func NewRedis(ctx context.Context, config *config.Redis, metrics port.Metrics) (port.CacheRepository, error) {
 	client, err := storageredis.NewRedis(ctx, config)
 
 	if err != nil {
@@ -26,7 +29,8 @@ func NewRedis(ctx context.Context, config *config.Redis) (port.CacheRepository,
 	}
 
 	return &redisCache{
-		client: client,
+		client:  client,
+		metrics: metrics,
 	}, err
 }
 
@@ -35,13 +39,36 @@ func (r *redisCache) Set(ctx context.Context, key string, value []byte, ttl time
 	return r.client.Set(ctx, key, value, ttl).Err()
 }
 
-// Get retrieves the value from the redis database
+// Get retrieves the value from the redis database, counting the lookup as a hit or a miss
 func (r *redisCache) Get(ctx context.Context, key string) ([]byte, error) {
 	res, err := r.client.Get(ctx, key).Result()
+	switch {
+	case err == nil:
+		r.metrics.ObserveCacheLookup(true)
+	case errors.Is(err, redis.Nil):
+		r.metrics.ObserveCacheLookup(false)
+		return nil, domain.ErrDataNotFound
+	}
+
 	bytes := []byte(res)
 	return bytes, err
 }
 
+// incrementScript increments a counter and sets its ttl in milliseconds when the counter is created
+var incrementScript = redis.NewScript(`
+local count = redis.call("INCR", KEYS[1])
+if count == 1 then
+	redis.call("PEXPIRE", KEYS[1], ARGV[1])
+end
+return count
+`)
+
+// Increment atomically increments the counter of the key in the redis database,
+// the ttl is set when the counter is created so that the counter expires a fixed time after the first increment
+func (r *redisCache) Increment(ctx context.Context, key string, ttl time.Duration) (int64, error) {
+	return incrementScript.Run(ctx, r.client, []string{key}, ttl.Milliseconds()).Int64()
+}
+
 // Delete removes the value from the redis database
 func (r *redisCache) Delete(ctx context.Context, key string) error {
 	return r.client.Del(ctx, key).Err()
@@ -74,6 +101,11 @@ func (r *redisCache) DeleteByPrefix(ctx context.Context, prefix string) error {
 	return nil
 }
 
+// Ping checks that the redis database accepts connections
+func (r *redisCache) Ping(ctx context.Context) error {
+	return r.client.Ping(ctx).Err()
+}
+
 // Close closes the connection to the redis database
 func (r *redisCache) Close() error {
 	return r.client.Close()
@@ -0,0 +1,201 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	storagepostgres "github.com/TienMinh25/go-hexagonal-architecture/infrastructure/storage/postgres"
+	"github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain"
+	domainauth "github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain/auth"
+	"github.com/TienMinh25/go-hexagonal-architecture/internal/application/port"
+	"github.com/jackc/pgx/v5"
+)
+
+/**
+ * apiKeyRepository implements port.APIKeyRepository interface
+ * and provides an access to the postgres database
+ */
+type apiKeyRepository struct {
+	db *storagepostgres.DB
+}
+
+// NewAPIKeyRepository creates a new API key repository instance
+func NewAPIKeyRepository(db *storagepostgres.DB) port.APIKeyRepository {
+	return &apiKeyRepository{
+		db,
+	}
+}
+
+// CreateAPIKey creates a new API key record in the database
+func (ar *apiKeyRepository) CreateAPIKey(ctx context.Context, key *domainauth.APIKey) (*domainauth.APIKey, error) {
+	query := ar.db.QueryBuilder.Insert("api_keys").
+		Columns("name", "prefix", "key_hash", "scopes", "created_by", "expires_at").
+		Values(key.Name, key.Prefix, key.KeyHash, key.Scopes, key.CreatedBy, key.ExpiresAt).
+		Suffix("RETURNING *")
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	err = ar.db.QueryRow(ctx, sql, args...).Scan(
+		&key.ID,
+		&key.Name,
+		&key.Prefix,
+		&key.KeyHash,
+		&key.Scopes,
+		&key.CreatedBy,
+		&key.ExpiresAt,
+		&key.LastUsedAt,
+		&key.RevokedAt,
+		&key.CreatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return key, nil
+}
+
+// GetAPIKeyByID retrieves an API key record from the database by id
+func (ar *apiKeyRepository) GetAPIKeyByID(ctx context.Context, id uint64) (*domainauth.APIKey, error) {
+	return ar.getAPIKey(ctx, sq.Eq{"id": id})
+}
+
+// GetAPIKeyByHash retrieves an API key record from the database by the hash of its value
+func (ar *apiKeyRepository) GetAPIKeyByHash(ctx context.Context, keyHash string) (*domainauth.APIKey, error) {
+	return ar.getAPIKey(ctx, sq.Eq{"key_hash": keyHash})
+}
+
+// getAPIKey retrieves the API key record matching the condition from the database
+func (ar *apiKeyRepository) getAPIKey(ctx context.Context, where sq.Eq) (*domainauth.APIKey, error) {
+	var key domainauth.APIKey
+
+	query := ar.db.QueryBuilder.Select("*").
+		From("api_keys").
+		Where(where).
+		Limit(1)
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	err = ar.db.QueryRow(ctx, sql, args...).Scan(
+		&key.ID,
+		&key.Name,
+		&key.Prefix,
+		&key.KeyHash,
+		&key.Scopes,
+		&key.CreatedBy,
+		&key.ExpiresAt,
+		&key.LastUsedAt,
+		&key.RevokedAt,
+		&key.CreatedAt,
+	)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, domain.ErrDataNotFound
+		}
+		return nil, err
+	}
+
+	return &key, nil
+}
+
+// ListAPIKeys retrieves a list of API keys from the database
+func (ar *apiKeyRepository) ListAPIKeys(ctx context.Context, skip, limit uint64) ([]domainauth.APIKey, error) {
+	var key domainauth.APIKey
+	var keys []domainauth.APIKey
+
+	query := ar.db.QueryBuilder.Select("*").
+		From("api_keys").
+		OrderBy("id").
+		Limit(limit).
+		Offset((skip - 1) * limit)
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := ar.db.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		err := rows.Scan(
+			&key.ID,
+			&key.Name,
+			&key.Prefix,
+			&key.KeyHash,
+			&key.Scopes,
+			&key.CreatedBy,
+			&key.ExpiresAt,
+			&key.LastUsedAt,
+			&key.RevokedAt,
+			&key.CreatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		keys = append(keys, key)
+	}
+
+	return keys, nil
+}
+
+// RevokeAPIKey revokes an API key record in the database that is not revoked yet
+func (ar *apiKeyRepository) RevokeAPIKey(ctx context.Context, id uint64) (*domainauth.APIKey, error) {
+	var key domainauth.APIKey
+
+	query := ar.db.QueryBuilder.Update("api_keys").
+		Set("revoked_at", time.Now()).
+		Where(sq.Eq{"id": id, "revoked_at": nil}).
+		Suffix("RETURNING *")
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	err = ar.db.QueryRow(ctx, sql, args...).Scan(
+		&key.ID,
+		&key.Name,
+		&key.Prefix,
+		&key.KeyHash,
+		&key.Scopes,
+		&key.CreatedBy,
+		&key.ExpiresAt,
+		&key.LastUsedAt,
+		&key.RevokedAt,
+		&key.CreatedAt,
+	)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, domain.ErrDataNotFound
+		}
+		return nil, err
+	}
+
+	return &key, nil
+}
+
+// TouchAPIKey sets the last_used_at of an API key record in the database
+func (ar *apiKeyRepository) TouchAPIKey(ctx context.Context, id uint64, usedAt time.Time) error {
+	query := ar.db.QueryBuilder.Update("api_keys").
+		Set("last_used_at", usedAt).
+		Where(sq.Eq{"id": id})
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return err
+	}
+
+	_, err = ar.db.Exec(ctx, sql, args...)
+	return err
+}
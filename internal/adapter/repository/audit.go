@@ -0,0 +1,161 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	storagepostgres "github.com/TienMinh25/go-hexagonal-architecture/infrastructure/storage/postgres"
+	domainaudit "github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain/audit"
+	"github.com/TienMinh25/go-hexagonal-architecture/internal/application/port"
+	"github.com/jackc/pgx/v5"
+)
+
+/**
+ * auditRepository implements port.AuditRepository interface
+ * and provides an access to the postgres database
+ */
+type auditRepository struct {
+	db *storagepostgres.DB
+}
+
+// NewAuditRepository creates a new audit repository instance
+func NewAuditRepository(db *storagepostgres.DB) port.AuditRepository {
+	return &auditRepository{
+		db,
+	}
+}
+
+// AppendAuditLog creates a new audit log record in the database, chained to the last one.
+// The table is locked against other appends so that no two logs follow the same one
+func (ar *auditRepository) AppendAuditLog(ctx context.Context, log *domainaudit.Log) (*domainaudit.Log, error) {
+	err := pgx.BeginFunc(ctx, ar.db, func(tx pgx.Tx) error {
+		_, err := tx.Exec(ctx, `LOCK TABLE "audit_logs" IN SHARE ROW EXCLUSIVE MODE`)
+		if err != nil {
+			return err
+		}
+
+		lastQuery := ar.db.QueryBuilder.Select("hash").
+			From("audit_logs").
+			OrderBy("id DESC").
+			Limit(1)
+
+		sql, args, err := lastQuery.ToSql()
+		if err != nil {
+			return err
+		}
+
+		log.PrevHash = ""
+		err = tx.QueryRow(ctx, sql, args...).Scan(&log.PrevHash)
+		if err != nil && err != pgx.ErrNoRows {
+			return err
+		}
+
+		// postgres keeps microseconds, the hash has to cover the time as it is stored
+		log.CreatedAt = time.Now().UTC().Truncate(time.Microsecond)
+		log.Hash = log.ComputeHash()
+
+		insertQuery := ar.db.QueryBuilder.Insert("audit_logs").
+			Columns("actor_id", "api_key_id", "action", "entity", "entity_id", "before", "after", "changes",
+				"ip", "request_id", "prev_hash", "hash", "created_at").
+			Values(log.ActorID, log.APIKeyID, log.Action, log.Entity, log.EntityID, log.Before, log.After, log.Changes,
+				log.IP, log.RequestID, log.PrevHash, log.Hash, log.CreatedAt).
+			Suffix("RETURNING id")
+
+		sql, args, err = insertQuery.ToSql()
+		if err != nil {
+			return err
+		}
+
+		return tx.QueryRow(ctx, sql, args...).Scan(&log.ID)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return log, nil
+}
+
+// ListAuditLogs retrieves a list of audit logs matching the filter from the database, newest first
+func (ar *auditRepository) ListAuditLogs(ctx context.Context, filter domainaudit.Filter, skip, limit uint64) ([]domainaudit.Log, error) {
+	query := ar.db.QueryBuilder.Select("*").
+		From("audit_logs").
+		OrderBy("id DESC").
+		Limit(limit).
+		Offset((skip - 1) * limit)
+
+	if filter.ActorID != 0 {
+		query = query.Where(sq.Eq{"actor_id": filter.ActorID})
+	}
+	if filter.Action != "" {
+		query = query.Where(sq.Eq{"action": filter.Action})
+	}
+	if filter.Entity != "" {
+		query = query.Where(sq.Eq{"entity": filter.Entity})
+	}
+	if filter.EntityID != 0 {
+		query = query.Where(sq.Eq{"entity_id": filter.EntityID})
+	}
+	if filter.From != nil {
+		query = query.Where(sq.GtOrEq{"created_at": filter.From})
+	}
+	if filter.To != nil {
+		query = query.Where(sq.Lt{"created_at": filter.To})
+	}
+
+	return ar.listAuditLogs(ctx, query)
+}
+
+// ListAuditLogsAfter retrieves audit logs from the database in chain order, starting after the given id
+func (ar *auditRepository) ListAuditLogsAfter(ctx context.Context, afterID, limit uint64) ([]domainaudit.Log, error) {
+	query := ar.db.QueryBuilder.Select("*").
+		From("audit_logs").
+		Where(sq.Gt{"id": afterID}).
+		OrderBy("id").
+		Limit(limit)
+
+	return ar.listAuditLogs(ctx, query)
+}
+
+// listAuditLogs retrieves the audit logs selected by the query from the database
+func (ar *auditRepository) listAuditLogs(ctx context.Context, query sq.SelectBuilder) ([]domainaudit.Log, error) {
+	var log domainaudit.Log
+	var logs []domainaudit.Log
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := ar.db.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		err := rows.Scan(
+			&log.ID,
+			&log.ActorID,
+			&log.APIKeyID,
+			&log.Action,
+			&log.Entity,
+			&log.EntityID,
+			&log.Before,
+			&log.After,
+			&log.Changes,
+			&log.IP,
+			&log.RequestID,
+			&log.PrevHash,
+			&log.Hash,
+			&log.CreatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		logs = append(logs, log)
+	}
+
+	return logs, nil
+}
@@ -47,6 +47,8 @@ func (cr *categoryRepository) CreateCategory(ctx context.Context, category *doma
 		&returnCategory.Name,
 		&returnCategory.CreatedAt,
 		&returnCategory.UpdatedAt,
+		&returnCategory.DeletedAt,
+		&returnCategory.Version,
 	)
 
 	if err != nil {
@@ -78,6 +80,8 @@ func (cr *categoryRepository) GetCategoryByID(ctx context.Context, id uint64) (*
 		&returnCategory.Name,
 		&returnCategory.CreatedAt,
 		&returnCategory.UpdatedAt,
+		&returnCategory.DeletedAt,
+		&returnCategory.Version,
 	)
 	if err != nil {
 		if err == pgx.ErrNoRows {
@@ -90,7 +94,7 @@ func (cr *categoryRepository) GetCategoryByID(ctx context.Context, id uint64) (*
 }
 
 // ListCategories retrieves a list of categories from the database
-func (cr *categoryRepository) ListCategories(ctx context.Context, skip, limit uint64) ([]domaincategory.Category, error) {
+func (cr *categoryRepository) ListCategories(ctx context.Context, skip, limit uint64, includeArchived bool) ([]domaincategory.Category, error) {
 	var category model.Category
 	var categories []domaincategory.Category
 
@@ -100,6 +104,10 @@ func (cr *categoryRepository) ListCategories(ctx context.Context, skip, limit ui
 		Limit(limit).
 		Offset((skip - 1) * limit)
 
+	if !includeArchived {
+		query = query.Where(sq.Eq{"deleted_at": nil})
+	}
+
 	sql, args, err := query.ToSql()
 	if err != nil {
 		return nil, err
@@ -116,6 +124,8 @@ func (cr *categoryRepository) ListCategories(ctx context.Context, skip, limit ui
 			&category.Name,
 			&category.CreatedAt,
 			&category.UpdatedAt,
+			&category.DeletedAt,
+			&category.Version,
 		)
 		if err != nil {
 			return nil, err
@@ -132,7 +142,8 @@ func (cr *categoryRepository) UpdateCategory(ctx context.Context, category *doma
 	query := cr.db.QueryBuilder.Update("categories").
 		Set("name", category.Name).
 		Set("updated_at", time.Now()).
-		Where(sq.Eq{"id": category.ID}).
+		Set("version", sq.Expr("version + 1")).
+		Where(sq.Eq{"id": category.ID, "version": category.Version}).
 		Suffix("RETURNING *")
 
 	sql, args, err := query.ToSql()
@@ -147,8 +158,13 @@ func (cr *categoryRepository) UpdateCategory(ctx context.Context, category *doma
 		&updatedCategory.Name,
 		&updatedCategory.CreatedAt,
 		&updatedCategory.UpdatedAt,
+		&updatedCategory.DeletedAt,
+		&updatedCategory.Version,
 	)
 	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, domain.ErrVersionMismatch
+		}
 		if errCode := cr.db.ErrorCode(err); errCode == "23505" {
 			return nil, domain.ErrConflictingData
 		}
@@ -158,20 +174,61 @@ func (cr *categoryRepository) UpdateCategory(ctx context.Context, category *doma
 	return updatedCategory.ToDomain(), nil
 }
 
-// DeleteCategory deletes a category record from the database by id
-func (cr *categoryRepository) DeleteCategory(ctx context.Context, id uint64) error {
-	query := cr.db.QueryBuilder.Delete("categories").
-		Where(sq.Eq{"id": id})
+// DeleteCategory archives a category record in the database by id
+func (cr *categoryRepository) DeleteCategory(ctx context.Context, id, version uint64) error {
+	query := cr.db.QueryBuilder.Update("categories").
+		Set("deleted_at", time.Now()).
+		Set("version", sq.Expr("version + 1")).
+		Where(sq.Eq{"id": id, "deleted_at": nil, "version": version})
 
 	sql, args, err := query.ToSql()
 	if err != nil {
 		return err
 	}
 
-	_, err = cr.db.Exec(ctx, sql, args...)
+	result, err := cr.db.Exec(ctx, sql, args...)
 	if err != nil {
 		return err
 	}
 
+	if result.RowsAffected() == 0 {
+		return domain.ErrVersionMismatch
+	}
+
 	return nil
 }
+
+// RestoreCategory restores an archived category record in the database by id
+func (cr *categoryRepository) RestoreCategory(ctx context.Context, id uint64) (*domaincategory.Category, error) {
+	query := cr.db.QueryBuilder.Update("categories").
+		Set("deleted_at", nil).
+		Set("updated_at", time.Now()).
+		Set("version", sq.Expr("version + 1")).
+		Where(sq.Eq{"id": id}).
+		Where(sq.NotEq{"deleted_at": nil}).
+		Suffix("RETURNING *")
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	var restoredCategory model.Category
+
+	err = cr.db.QueryRow(ctx, sql, args...).Scan(
+		&restoredCategory.ID,
+		&restoredCategory.Name,
+		&restoredCategory.CreatedAt,
+		&restoredCategory.UpdatedAt,
+		&restoredCategory.DeletedAt,
+		&restoredCategory.Version,
+	)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, domain.ErrDataNotFound
+		}
+		return nil, err
+	}
+
+	return restoredCategory.ToDomain(), nil
+}
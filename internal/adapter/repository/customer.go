@@ -0,0 +1,294 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	storagepostgres "github.com/TienMinh25/go-hexagonal-architecture/infrastructure/storage/postgres"
+	"github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain"
+	domaincustomer "github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain/customer"
+	"github.com/TienMinh25/go-hexagonal-architecture/internal/application/port"
+	"github.com/jackc/pgx/v5"
+)
+
+/**
+ * customerRepository implements port.CustomerRepository interface
+ * and provides an access to the postgres database
+ */
+type customerRepository struct {
+	db *storagepostgres.DB
+}
+
+// NewCustomerRepository creates a new customer repository instance
+func NewCustomerRepository(db *storagepostgres.DB) port.CustomerRepository {
+	return &customerRepository{
+		db,
+	}
+}
+
+// CreateCustomer creates a new customer record in the database
+func (cr *customerRepository) CreateCustomer(ctx context.Context, customer *domaincustomer.Customer) (*domaincustomer.Customer, error) {
+	query := cr.db.QueryBuilder.Insert("customers").
+		Columns("name", "phone", "email", "notes").
+		Values(customer.Name, customer.Phone, customer.Email, customer.Notes).
+		Suffix("RETURNING *")
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	err = cr.db.QueryRow(ctx, sql, args...).Scan(
+		&customer.ID,
+		&customer.Name,
+		&customer.Phone,
+		&customer.Email,
+		&customer.Notes,
+		&customer.CreatedAt,
+		&customer.UpdatedAt,
+		&customer.DeletedAt,
+		&customer.Version,
+	)
+	if err != nil {
+		if errCode := cr.db.ErrorCode(err); errCode == "23505" {
+			return nil, domain.ErrConflictingData
+		}
+		return nil, err
+	}
+
+	return customer, nil
+}
+
+// GetCustomerByID retrieves a customer record from the database by id
+func (cr *customerRepository) GetCustomerByID(ctx context.Context, id uint64) (*domaincustomer.Customer, error) {
+	var customer domaincustomer.Customer
+
+	query := cr.db.QueryBuilder.Select("*").
+		From("customers").
+		Where(sq.Eq{"id": id}).
+		Limit(1)
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	err = cr.db.QueryRow(ctx, sql, args...).Scan(
+		&customer.ID,
+		&customer.Name,
+		&customer.Phone,
+		&customer.Email,
+		&customer.Notes,
+		&customer.CreatedAt,
+		&customer.UpdatedAt,
+		&customer.DeletedAt,
+		&customer.Version,
+	)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, domain.ErrDataNotFound
+		}
+		return nil, err
+	}
+
+	return &customer, nil
+}
+
+// ListCustomers retrieves a list of customers from the database
+func (cr *customerRepository) ListCustomers(ctx context.Context, search string, skip, limit uint64, includeArchived bool) ([]domaincustomer.Customer, error) {
+	var customer domaincustomer.Customer
+	var customers []domaincustomer.Customer
+
+	query := cr.db.QueryBuilder.Select("*").
+		From("customers").
+		OrderBy("id").
+		Limit(limit).
+		Offset((skip - 1) * limit)
+
+	if search != "" {
+		pattern := "%" + search + "%"
+		query = query.Where(sq.Or{
+			sq.ILike{"name": pattern},
+			sq.ILike{"phone": pattern},
+			sq.ILike{"email": pattern},
+		})
+	}
+
+	if !includeArchived {
+		query = query.Where(sq.Eq{"deleted_at": nil})
+	}
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := cr.db.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	for rows.Next() {
+		err := rows.Scan(
+			&customer.ID,
+			&customer.Name,
+			&customer.Phone,
+			&customer.Email,
+			&customer.Notes,
+			&customer.CreatedAt,
+			&customer.UpdatedAt,
+			&customer.DeletedAt,
+			&customer.Version,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		customers = append(customers, customer)
+	}
+
+	return customers, nil
+}
+
+// UpdateCustomer updates a customer record in the database
+func (cr *customerRepository) UpdateCustomer(ctx context.Context, customer *domaincustomer.Customer) (*domaincustomer.Customer, error) {
+	name := nullString(customer.Name)
+	phone := nullString(customer.Phone)
+	email := nullString(customer.Email)
+	notes := nullString(customer.Notes)
+
+	query := cr.db.QueryBuilder.Update("customers").
+		Set("name", sq.Expr("COALESCE(?, name)", name)).
+		Set("phone", sq.Expr("COALESCE(?, phone)", phone)).
+		Set("email", sq.Expr("COALESCE(?, email)", email)).
+		Set("notes", sq.Expr("COALESCE(?, notes)", notes)).
+		Set("updated_at", time.Now()).
+		Set("version", sq.Expr("version + 1")).
+		Where(sq.Eq{"id": customer.ID, "version": customer.Version}).
+		Suffix("RETURNING *")
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	err = cr.db.QueryRow(ctx, sql, args...).Scan(
+		&customer.ID,
+		&customer.Name,
+		&customer.Phone,
+		&customer.Email,
+		&customer.Notes,
+		&customer.CreatedAt,
+		&customer.UpdatedAt,
+		&customer.DeletedAt,
+		&customer.Version,
+	)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, domain.ErrVersionMismatch
+		}
+		if errCode := cr.db.ErrorCode(err); errCode == "23505" {
+			return nil, domain.ErrConflictingData
+		}
+		return nil, err
+	}
+
+	return customer, nil
+}
+
+// DeleteCustomer archives a customer record in the database by id
+func (cr *customerRepository) DeleteCustomer(ctx context.Context, id, version uint64) error {
+	query := cr.db.QueryBuilder.Update("customers").
+		Set("deleted_at", time.Now()).
+		Set("version", sq.Expr("version + 1")).
+		Where(sq.Eq{"id": id, "deleted_at": nil, "version": version})
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return err
+	}
+
+	result, err := cr.db.Exec(ctx, sql, args...)
+	if err != nil {
+		return err
+	}
+
+	if result.RowsAffected() == 0 {
+		return domain.ErrVersionMismatch
+	}
+
+	return nil
+}
+
+// RestoreCustomer restores an archived customer record in the database by id
+func (cr *customerRepository) RestoreCustomer(ctx context.Context, id uint64) (*domaincustomer.Customer, error) {
+	var customer domaincustomer.Customer
+
+	query := cr.db.QueryBuilder.Update("customers").
+		Set("deleted_at", nil).
+		Set("updated_at", time.Now()).
+		Set("version", sq.Expr("version + 1")).
+		Where(sq.Eq{"id": id}).
+		Where(sq.NotEq{"deleted_at": nil}).
+		Suffix("RETURNING *")
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	err = cr.db.QueryRow(ctx, sql, args...).Scan(
+		&customer.ID,
+		&customer.Name,
+		&customer.Phone,
+		&customer.Email,
+		&customer.Notes,
+		&customer.CreatedAt,
+		&customer.UpdatedAt,
+		&customer.DeletedAt,
+		&customer.Version,
+	)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, domain.ErrDataNotFound
+		}
+		return nil, err
+	}
+
+	return &customer, nil
+}
+
+// GetPurchaseSummary aggregates the orders of a customer from the database
+func (cr *customerRepository) GetPurchaseSummary(ctx context.Context, id uint64) (*domaincustomer.PurchaseSummary, error) {
+	summary := domaincustomer.PurchaseSummary{
+		CustomerID: id,
+	}
+
+	query := cr.db.QueryBuilder.Select(
+		"COUNT(*)",
+		"COALESCE(SUM(total_price), 0)",
+		"MIN(created_at)",
+		"MAX(created_at)",
+	).
+		From("orders").
+		// refunded orders are neither visits nor spend
+		Where(sq.Eq{"customer_id": id, "refunded_at": nil})
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	err = cr.db.QueryRow(ctx, sql, args...).Scan(
+		&summary.Visits,
+		&summary.LifetimeSpend,
+		&summary.FirstVisitAt,
+		&summary.LastVisitAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &summary, nil
+}
@@ -0,0 +1,263 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	storagepostgres "github.com/TienMinh25/go-hexagonal-architecture/infrastructure/storage/postgres"
+	"github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain"
+	domaingiftcard "github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain/giftcard"
+	domainorder "github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain/order"
+	"github.com/TienMinh25/go-hexagonal-architecture/internal/application/port"
+	"github.com/jackc/pgx/v5"
+)
+
+/**
+ * giftCardRepository implements port.GiftCardRepository interface
+ * and provides an access to the postgres database
+ */
+type giftCardRepository struct {
+	db *storagepostgres.DB
+}
+
+// NewGiftCardRepository creates a new gift card repository instance
+func NewGiftCardRepository(db *storagepostgres.DB) port.GiftCardRepository {
+	return &giftCardRepository{
+		db,
+	}
+}
+
+// CreateGiftCard creates a new gift card record, the order selling it and its issue transaction in the database
+func (gr *giftCardRepository) CreateGiftCard(ctx context.Context, giftCard *domaingiftcard.GiftCard, order *domainorder.Order) (*domaingiftcard.GiftCard, error) {
+	query := gr.db.QueryBuilder.Insert("gift_cards").
+		Columns("code", "initial_balance", "balance", "expires_at").
+		Values(giftCard.Code, giftCard.InitialBalance, giftCard.InitialBalance, giftCard.ExpiresAt).
+		Suffix("RETURNING *")
+
+	err := pgx.BeginFunc(ctx, gr.db, func(tx pgx.Tx) error {
+		sql, args, err := query.ToSql()
+		if err != nil {
+			return err
+		}
+
+		err = tx.QueryRow(ctx, sql, args...).Scan(
+			&giftCard.ID,
+			&giftCard.Code,
+			&giftCard.InitialBalance,
+			&giftCard.Balance,
+			&giftCard.ExpiresAt,
+			&giftCard.CreatedAt,
+			&giftCard.UpdatedAt,
+		)
+		if err != nil {
+			if errCode := gr.db.ErrorCode(err); errCode == "23505" {
+				return domain.ErrConflictingData
+			}
+			return err
+		}
+
+		err = createGiftCardSale(ctx, gr.db, tx, giftCard.ID, order)
+		if err != nil {
+			return err
+		}
+
+		return createGiftCardTransaction(ctx, gr.db, tx, giftCard.ID, &order.ID, domaingiftcard.Issue, giftCard.InitialBalance)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return giftCard, nil
+}
+
+// GetGiftCardByCode retrieves a gift card record from the database by code
+func (gr *giftCardRepository) GetGiftCardByCode(ctx context.Context, code string) (*domaingiftcard.GiftCard, error) {
+	var giftCard domaingiftcard.GiftCard
+
+	query := gr.db.QueryBuilder.Select("*").
+		From("gift_cards").
+		Where(sq.Eq{"code": code}).
+		Limit(1)
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	err = gr.db.QueryRow(ctx, sql, args...).Scan(
+		&giftCard.ID,
+		&giftCard.Code,
+		&giftCard.InitialBalance,
+		&giftCard.Balance,
+		&giftCard.ExpiresAt,
+		&giftCard.CreatedAt,
+		&giftCard.UpdatedAt,
+	)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, domain.ErrDataNotFound
+		}
+		return nil, err
+	}
+
+	return &giftCard, nil
+}
+
+// TopUpGiftCard adds an amount to the balance of an unexpired gift card, creates the order selling it and records the transaction in the database
+func (gr *giftCardRepository) TopUpGiftCard(ctx context.Context, id uint64, amount float64, order *domainorder.Order) (*domaingiftcard.GiftCard, error) {
+	var giftCard domaingiftcard.GiftCard
+
+	query := gr.db.QueryBuilder.Update("gift_cards").
+		Set("balance", sq.Expr("balance + ?", amount)).
+		Set("updated_at", time.Now()).
+		Where(sq.Eq{"id": id}).
+		Where(sq.Or{sq.Eq{"expires_at": nil}, sq.Expr("expires_at > now()")}).
+		Suffix("RETURNING *")
+
+	err := pgx.BeginFunc(ctx, gr.db, func(tx pgx.Tx) error {
+		sql, args, err := query.ToSql()
+		if err != nil {
+			return err
+		}
+
+		err = tx.QueryRow(ctx, sql, args...).Scan(
+			&giftCard.ID,
+			&giftCard.Code,
+			&giftCard.InitialBalance,
+			&giftCard.Balance,
+			&giftCard.ExpiresAt,
+			&giftCard.CreatedAt,
+			&giftCard.UpdatedAt,
+		)
+		if err != nil {
+			if err == pgx.ErrNoRows {
+				return domain.ErrGiftCardExpired
+			}
+			return err
+		}
+
+		err = createGiftCardSale(ctx, gr.db, tx, giftCard.ID, order)
+		if err != nil {
+			return err
+		}
+
+		return createGiftCardTransaction(ctx, gr.db, tx, giftCard.ID, &order.ID, domaingiftcard.TopUp, amount)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &giftCard, nil
+}
+
+// redeemGiftCard atomically decrements the balance of an unexpired gift card for an order
+func redeemGiftCard(ctx context.Context, db *storagepostgres.DB, tx pgx.Tx, id, orderID uint64, amount float64) error {
+	var balance float64
+
+	query := db.QueryBuilder.Update("gift_cards").
+		Set("balance", sq.Expr("balance - ?", amount)).
+		Set("updated_at", time.Now()).
+		Where(sq.Eq{"id": id}).
+		Where(sq.GtOrEq{"balance": amount}).
+		Where(sq.Or{sq.Eq{"expires_at": nil}, sq.Expr("expires_at > now()")}).
+		Suffix("RETURNING balance")
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return err
+	}
+
+	err = tx.QueryRow(ctx, sql, args...).Scan(&balance)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return domain.ErrInsufficientGiftCardBalance
+		}
+		return err
+	}
+
+	return createGiftCardTransaction(ctx, db, tx, id, &orderID, domaingiftcard.Redeem, -amount)
+}
+
+// refundGiftCard gives back the amount an order took from a gift card
+func refundGiftCard(ctx context.Context, db *storagepostgres.DB, tx pgx.Tx, id, orderID uint64, amount float64) error {
+	query := db.QueryBuilder.Update("gift_cards").
+		Set("balance", sq.Expr("balance + ?", amount)).
+		Set("updated_at", time.Now()).
+		Where(sq.Eq{"id": id})
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(ctx, sql, args...)
+	if err != nil {
+		return err
+	}
+
+	return createGiftCardTransaction(ctx, db, tx, id, &orderID, domaingiftcard.Refund, amount)
+}
+
+// createGiftCardSale inserts the order a gift card is sold or topped up with, it has no products
+func createGiftCardSale(ctx context.Context, db *storagepostgres.DB, tx pgx.Tx, id uint64, order *domainorder.Order) error {
+	query := db.QueryBuilder.Insert("orders").
+		Columns("user_id", "payment_id", "customer_id", "customer_name", "total_price", "total_paid", "total_return", "sold_gift_card_id", "terminal_id").
+		Values(order.UserID, order.PaymentID, order.CustomerID, order.CustomerName, order.TotalPrice, order.TotalPaid, order.TotalReturn, id, order.TerminalID).
+		Suffix("RETURNING id, receipt_code, created_at, updated_at, sold_gift_card_id")
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return err
+	}
+
+	return tx.QueryRow(ctx, sql, args...).Scan(
+		&order.ID,
+		&order.ReceiptCode,
+		&order.CreatedAt,
+		&order.UpdatedAt,
+		&order.SoldGiftCardID,
+	)
+}
+
+// refundGiftCardSale takes the amount an order sold back off a gift card, which fails once it has been spent
+func refundGiftCardSale(ctx context.Context, db *storagepostgres.DB, tx pgx.Tx, id, orderID uint64, amount float64) error {
+	var balance float64
+
+	query := db.QueryBuilder.Update("gift_cards").
+		Set("balance", sq.Expr("balance - ?", amount)).
+		Set("updated_at", time.Now()).
+		Where(sq.Eq{"id": id}).
+		Where(sq.GtOrEq{"balance": amount}).
+		Suffix("RETURNING balance")
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return err
+	}
+
+	err = tx.QueryRow(ctx, sql, args...).Scan(&balance)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return domain.ErrInsufficientGiftCardBalance
+		}
+		return err
+	}
+
+	return createGiftCardTransaction(ctx, db, tx, id, &orderID, domaingiftcard.Refund, -amount)
+}
+
+// createGiftCardTransaction inserts a gift card transaction record
+func createGiftCardTransaction(ctx context.Context, db *storagepostgres.DB, tx pgx.Tx, id uint64, orderID *uint64, transactionType domaingiftcard.TransactionType, amount float64) error {
+	query := db.QueryBuilder.Insert("gift_card_transactions").
+		Columns("gift_card_id", "order_id", "type", "amount").
+		Values(id, orderID, transactionType, amount)
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(ctx, sql, args...)
+	return err
+}
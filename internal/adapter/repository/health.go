@@ -0,0 +1,67 @@
+package repository
+
+import (
+	"context"
+
+	storagepostgres "github.com/TienMinh25/go-hexagonal-architecture/infrastructure/storage/postgres"
+	"github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain"
+	"github.com/TienMinh25/go-hexagonal-architecture/internal/application/port"
+	"github.com/jackc/pgx/v5"
+)
+
+/**
+ * healthRepository implements port.HealthRepository interface
+ * and provides an access to the postgres database
+ */
+type healthRepository struct {
+	db *storagepostgres.DB
+}
+
+// NewHealthRepository creates a new health repository instance
+func NewHealthRepository(db *storagepostgres.DB) port.HealthRepository {
+	return &healthRepository{
+		db,
+	}
+}
+
+// Ping checks that the database accepts connections
+func (hr *healthRepository) Ping(ctx context.Context) error {
+	return hr.db.Ping(ctx)
+}
+
+// CheckMigrations compares the version recorded by the migrations with the last embedded migration
+func (hr *healthRepository) CheckMigrations(ctx context.Context) error {
+	latest, err := hr.db.LatestMigration()
+	if err != nil {
+		return err
+	}
+
+	query := hr.db.QueryBuilder.Select("version", "dirty").
+		From("schema_migrations").
+		Limit(1)
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return err
+	}
+
+	var version int64
+	var dirty bool
+	err = hr.db.QueryRow(ctx, sql, args...).Scan(&version, &dirty)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return domain.ErrMigrationOutdated
+		}
+		return err
+	}
+
+	if dirty {
+		return domain.ErrMigrationDirty
+	}
+
+	if version != int64(latest) {
+		return domain.ErrMigrationOutdated
+	}
+
+	return nil
+}
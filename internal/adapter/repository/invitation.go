@@ -0,0 +1,242 @@
+package repository
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	storagepostgres "github.com/TienMinh25/go-hexagonal-architecture/infrastructure/storage/postgres"
+	"github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain"
+	domainuser "github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain/user"
+	"github.com/TienMinh25/go-hexagonal-architecture/internal/application/port"
+	"github.com/jackc/pgx/v5"
+)
+
+/**
+ * invitationRepository implements port.InvitationRepository interface
+ * and provides an access to the postgres database
+ */
+type invitationRepository struct {
+	db *storagepostgres.DB
+}
+
+// NewInvitationRepository creates a new invitation repository instance
+func NewInvitationRepository(db *storagepostgres.DB) port.InvitationRepository {
+	return &invitationRepository{
+		db,
+	}
+}
+
+// CreateInvitation creates a new invitation record in the database
+func (ir *invitationRepository) CreateInvitation(ctx context.Context, invitation *domainuser.Invitation) (*domainuser.Invitation, error) {
+	query := ir.db.QueryBuilder.Insert("invitations").
+		Columns("email", "role", "token_hash", "invited_by", "expires_at").
+		Values(invitation.Email, invitation.Role, invitation.TokenHash, invitation.InvitedBy, invitation.ExpiresAt).
+		Suffix("RETURNING *")
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	err = ir.db.QueryRow(ctx, sql, args...).Scan(
+		&invitation.ID,
+		&invitation.Email,
+		&invitation.Role,
+		&invitation.TokenHash,
+		&invitation.InvitedBy,
+		&invitation.ExpiresAt,
+		&invitation.AcceptedAt,
+		&invitation.RevokedAt,
+		&invitation.CreatedAt,
+	)
+	if err != nil {
+		if ir.db.ErrorCode(err) == "23503" {
+			return nil, domain.ErrUnknownRole
+		}
+		return nil, err
+	}
+
+	return invitation, nil
+}
+
+// GetInvitationByID retrieves an invitation record from the database by id
+func (ir *invitationRepository) GetInvitationByID(ctx context.Context, id uint64) (*domainuser.Invitation, error) {
+	var invitation domainuser.Invitation
+
+	query := ir.db.QueryBuilder.Select("*").
+		From("invitations").
+		Where(sq.Eq{"id": id}).
+		Limit(1)
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	err = ir.db.QueryRow(ctx, sql, args...).Scan(
+		&invitation.ID,
+		&invitation.Email,
+		&invitation.Role,
+		&invitation.TokenHash,
+		&invitation.InvitedBy,
+		&invitation.ExpiresAt,
+		&invitation.AcceptedAt,
+		&invitation.RevokedAt,
+		&invitation.CreatedAt,
+	)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, domain.ErrDataNotFound
+		}
+		return nil, err
+	}
+
+	return &invitation, nil
+}
+
+// ListInvitations retrieves a list of invitations from the database, newest first
+func (ir *invitationRepository) ListInvitations(ctx context.Context, skip, limit uint64) ([]domainuser.Invitation, error) {
+	var invitation domainuser.Invitation
+	var invitations []domainuser.Invitation
+
+	query := ir.db.QueryBuilder.Select("*").
+		From("invitations").
+		OrderBy("id DESC").
+		Limit(limit).
+		Offset((skip - 1) * limit)
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := ir.db.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		err := rows.Scan(
+			&invitation.ID,
+			&invitation.Email,
+			&invitation.Role,
+			&invitation.TokenHash,
+			&invitation.InvitedBy,
+			&invitation.ExpiresAt,
+			&invitation.AcceptedAt,
+			&invitation.RevokedAt,
+			&invitation.CreatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		invitations = append(invitations, invitation)
+	}
+
+	return invitations, nil
+}
+
+// RevokeInvitation revokes an invitation record in the database that is neither accepted nor revoked yet
+func (ir *invitationRepository) RevokeInvitation(ctx context.Context, id uint64) (*domainuser.Invitation, error) {
+	var invitation domainuser.Invitation
+
+	query := ir.db.QueryBuilder.Update("invitations").
+		Set("revoked_at", time.Now()).
+		Where(sq.Eq{"id": id, "accepted_at": nil, "revoked_at": nil}).
+		Suffix("RETURNING *")
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	err = ir.db.QueryRow(ctx, sql, args...).Scan(
+		&invitation.ID,
+		&invitation.Email,
+		&invitation.Role,
+		&invitation.TokenHash,
+		&invitation.InvitedBy,
+		&invitation.ExpiresAt,
+		&invitation.AcceptedAt,
+		&invitation.RevokedAt,
+		&invitation.CreatedAt,
+	)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, domain.ErrDataNotFound
+		}
+		return nil, err
+	}
+
+	return &invitation, nil
+}
+
+// AcceptInvitation accepts an invitation and creates the invited user in one transaction
+func (ir *invitationRepository) AcceptInvitation(ctx context.Context, tokenHash string, user *domainuser.User) (*domainuser.User, error) {
+	now := time.Now()
+
+	acceptQuery := ir.db.QueryBuilder.Update("invitations").
+		Set("accepted_at", now).
+		Where(sq.Eq{"token_hash": tokenHash, "accepted_at": nil, "revoked_at": nil}).
+		Where(sq.Gt{"expires_at": now}).
+		Where(sq.Expr("lower(email) = ?", strings.ToLower(user.Email))).
+		Suffix("RETURNING role")
+
+	err := pgx.BeginFunc(ctx, ir.db, func(tx pgx.Tx) error {
+		sql, args, err := acceptQuery.ToSql()
+		if err != nil {
+			return err
+		}
+
+		err = tx.QueryRow(ctx, sql, args...).Scan(&user.Role)
+		if err != nil {
+			if err == pgx.ErrNoRows {
+				return domain.ErrInvalidInvitation
+			}
+			return err
+		}
+
+		createQuery := ir.db.QueryBuilder.Insert("users").
+			Columns("name", "email", "password", "role").
+			Values(user.Name, user.Email, user.Password, user.Role).
+			Suffix("RETURNING *")
+
+		sql, args, err = createQuery.ToSql()
+		if err != nil {
+			return err
+		}
+
+		err = tx.QueryRow(ctx, sql, args...).Scan(
+			&user.ID,
+			&user.Name,
+			&user.Email,
+			&user.Password,
+			&user.Role,
+			&user.CreatedAt,
+			&user.UpdatedAt,
+			&user.DeletedAt,
+			&user.Version,
+			&user.Locale,
+		)
+		if err != nil {
+			switch ir.db.ErrorCode(err) {
+			case "23505":
+				return domain.ErrConflictingData
+			case "23503":
+				return domain.ErrUnknownRole
+			}
+			return err
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return user, nil
+}
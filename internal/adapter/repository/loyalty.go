@@ -0,0 +1,45 @@
+package repository
+
+import (
+	"context"
+
+	sq "github.com/Masterminds/squirrel"
+	storagepostgres "github.com/TienMinh25/go-hexagonal-architecture/infrastructure/storage/postgres"
+	"github.com/TienMinh25/go-hexagonal-architecture/internal/application/port"
+)
+
+/**
+ * loyaltyRepository implements port.LoyaltyRepository interface
+ * and provides an access to the postgres database
+ */
+type loyaltyRepository struct {
+	db *storagepostgres.DB
+}
+
+// NewLoyaltyRepository creates a new loyalty repository instance
+func NewLoyaltyRepository(db *storagepostgres.DB) port.LoyaltyRepository {
+	return &loyaltyRepository{
+		db,
+	}
+}
+
+// GetBalance sums the loyalty ledger entries of a customer from the database
+func (lr *loyaltyRepository) GetBalance(ctx context.Context, customerID uint64) (int64, error) {
+	var balance int64
+
+	query := lr.db.QueryBuilder.Select("COALESCE(SUM(points), 0)").
+		From("loyalty_entries").
+		Where(sq.Eq{"customer_id": customerID})
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return 0, err
+	}
+
+	err = lr.db.QueryRow(ctx, sql, args...).Scan(&balance)
+	if err != nil {
+		return 0, err
+	}
+
+	return balance, nil
+}
@@ -0,0 +1,23 @@
+package model
+
+import (
+	"encoding/json"
+	"time"
+)
+
+type AuditLog struct {
+	ID        uint64          `db:"id"`
+	ActorID   uint64          `db:"actor_id"`
+	APIKeyID  uint64          `db:"api_key_id"`
+	Action    string          `db:"action"`
+	Entity    string          `db:"entity"`
+	EntityID  uint64          `db:"entity_id"`
+	Before    json.RawMessage `db:"before"`
+	After     json.RawMessage `db:"after"`
+	Changes   json.RawMessage `db:"changes"`
+	IP        string          `db:"ip"`
+	RequestID string          `db:"request_id"`
+	PrevHash  string          `db:"prev_hash"`
+	Hash      string          `db:"hash"`
+	CreatedAt time.Time       `db:"created_at"`
+}
@@ -7,10 +7,12 @@ import (
 )
 
 type Category struct {
-	ID        uint64    `db:"id"`
-	Name      string    `db:"name"`
-	CreatedAt time.Time `db:"created_at"`
-	UpdatedAt time.Time `db:"updated_at"`
+	ID        uint64     `db:"id"`
+	Name      string     `db:"name"`
+	CreatedAt time.Time  `db:"created_at"`
+	UpdatedAt time.Time  `db:"updated_at"`
+	DeletedAt *time.Time `db:"deleted_at"`
+	Version   uint64     `db:"version"`
 }
 
 func (c Category) ToDomain() *domaincategory.Category {
@@ -19,5 +21,7 @@ func (c Category) ToDomain() *domaincategory.Category {
 		Name:      c.Name,
 		CreatedAt: c.CreatedAt,
 		UpdatedAt: c.UpdatedAt,
+		DeletedAt: c.DeletedAt,
+		Version:   c.Version,
 	}
 }
@@ -0,0 +1,15 @@
+package model
+
+import "time"
+
+type Customer struct {
+	ID        uint64     `db:"id"`
+	Name      string     `db:"name"`
+	Phone     string     `db:"phone"`
+	Email     string     `db:"email"`
+	Notes     string     `db:"notes"`
+	CreatedAt time.Time  `db:"created_at"`
+	UpdatedAt time.Time  `db:"updated_at"`
+	DeletedAt *time.Time `db:"deleted_at"`
+	Version   uint64     `db:"version"`
+}
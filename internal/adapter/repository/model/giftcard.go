@@ -0,0 +1,22 @@
+package model
+
+import "time"
+
+type GiftCard struct {
+	ID             uint64     `db:"id"`
+	Code           string     `db:"code"`
+	InitialBalance float64    `db:"initial_balance"`
+	Balance        float64    `db:"balance"`
+	ExpiresAt      *time.Time `db:"expires_at"`
+	CreatedAt      time.Time  `db:"created_at"`
+	UpdatedAt      time.Time  `db:"updated_at"`
+}
+
+type GiftCardTransaction struct {
+	ID         uint64    `db:"id"`
+	GiftCardID uint64    `db:"gift_card_id"`
+	OrderID    *uint64   `db:"order_id"`
+	Type       string    `db:"type"`
+	Amount     float64   `db:"amount"`
+	CreatedAt  time.Time `db:"created_at"`
+}
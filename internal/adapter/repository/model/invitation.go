@@ -0,0 +1,15 @@
+package model
+
+import "time"
+
+type Invitation struct {
+	ID         uint64     `db:"id"`
+	Email      string     `db:"email"`
+	Role       string     `db:"role"`
+	TokenHash  string     `db:"token_hash"`
+	InvitedBy  uint64     `db:"invited_by"`
+	ExpiresAt  time.Time  `db:"expires_at"`
+	AcceptedAt *time.Time `db:"accepted_at"`
+	RevokedAt  *time.Time `db:"revoked_at"`
+	CreatedAt  time.Time  `db:"created_at"`
+}
@@ -0,0 +1,12 @@
+package model
+
+import "time"
+
+type LoyaltyEntry struct {
+	ID         uint64    `db:"id"`
+	CustomerID uint64    `db:"customer_id"`
+	OrderID    *uint64   `db:"order_id"`
+	Type       string    `db:"type"`
+	Points     int64     `db:"points"`
+	CreatedAt  time.Time `db:"created_at"`
+}
@@ -7,19 +7,25 @@ import (
 )
 
 type Order struct {
-	ID           uint64         `db:"id"`
-	UserID       uint64         `db:"user_id"`
-	PaymentID    uint64         `db:"payment_id"`
-	CustomerName string         `db:"customer_name"`
-	TotalPrice   float64        `db:"total_price"`
-	TotalPaid    float64        `db:"total_paid"`
-	TotalReturn  float64        `db:"total_return"`
-	ReceiptCode  uuid.UUID      `db:"receipt_code"`
-	CreatedAt    time.Time      `db:"created_at"`
-	UpdatedAt    time.Time      `db:"updated_at"`
-	User         *User          `db:"user"`
-	Payment      *Payment       `db:"payment"`
-	Products     []OrderProduct `db:"products"`
+	ID             uint64         `db:"id"`
+	UserID         uint64         `db:"user_id"`
+	PaymentID      uint64         `db:"payment_id"`
+	CustomerName   string         `db:"customer_name"`
+	TotalPrice     float64        `db:"total_price"`
+	TotalPaid      float64        `db:"total_paid"`
+	TotalReturn    float64        `db:"total_return"`
+	ReceiptCode    uuid.UUID      `db:"receipt_code"`
+	CreatedAt      time.Time      `db:"created_at"`
+	UpdatedAt      time.Time      `db:"updated_at"`
+	CustomerID     *uint64        `db:"customer_id"`
+	PointsRedeemed int64          `db:"points_redeemed"`
+	PointsEarned   int64          `db:"points_earned"`
+	RefundedAt     *time.Time     `db:"refunded_at"`
+	GiftCardID     *uint64        `db:"gift_card_id"`
+	GiftCardAmount float64        `db:"gift_card_amount"`
+	User           *User          `db:"user"`
+	Payment        *Payment       `db:"payment"`
+	Products       []OrderProduct `db:"products"`
 }
 
 type OrderProduct struct {
@@ -0,0 +1,12 @@
+package model
+
+import "time"
+
+type PasswordResetToken struct {
+	ID        uint64     `db:"id"`
+	UserID    uint64     `db:"user_id"`
+	TokenHash string     `db:"token_hash"`
+	ExpiresAt time.Time  `db:"expires_at"`
+	UsedAt    *time.Time `db:"used_at"`
+	CreatedAt time.Time  `db:"created_at"`
+}
@@ -0,0 +1,11 @@
+package model
+
+import "time"
+
+type Terminal struct {
+	ID         uint64     `db:"id"`
+	Name       string     `db:"name"`
+	SecretHash string     `db:"secret_hash"`
+	CreatedAt  time.Time  `db:"created_at"`
+	RevokedAt  *time.Time `db:"revoked_at"`
+}
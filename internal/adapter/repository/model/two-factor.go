@@ -0,0 +1,18 @@
+package model
+
+import "time"
+
+type TOTP struct {
+	UserID      uint64     `db:"user_id"`
+	Secret      string     `db:"secret"`
+	ConfirmedAt *time.Time `db:"confirmed_at"`
+	CreatedAt   time.Time  `db:"created_at"`
+}
+
+type RecoveryCode struct {
+	ID        uint64     `db:"id"`
+	UserID    uint64     `db:"user_id"`
+	CodeHash  string     `db:"code_hash"`
+	UsedAt    *time.Time `db:"used_at"`
+	CreatedAt time.Time  `db:"created_at"`
+}
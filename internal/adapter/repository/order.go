@@ -7,6 +7,7 @@ import (
 	sq "github.com/Masterminds/squirrel"
 	storagepostgres "github.com/TienMinh25/go-hexagonal-architecture/infrastructure/storage/postgres"
 	"github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain"
+	domainloyalty "github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain/loyalty"
 	domainorder "github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain/order"
 	domainproduct "github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain/product"
 	"github.com/TienMinh25/go-hexagonal-architecture/internal/application/port"
@@ -34,11 +35,18 @@ func (or *orderRepository) CreateOrder(ctx context.Context, order *domainorder.O
 	var products []domainorder.OrderProduct
 
 	orderQuery := or.db.QueryBuilder.Insert("orders").
-		Columns("user_id", "payment_id", "customer_name", "total_price", "total_paid", "total_return").
-		Values(order.UserID, order.PaymentID, order.CustomerName, order.TotalPrice, order.TotalPaid, order.TotalReturn).
+		Columns("user_id", "payment_id", "customer_id", "customer_name", "total_price", "total_paid", "total_return", "points_redeemed", "points_earned", "gift_card_id", "gift_card_amount", "terminal_id").
+		Values(order.UserID, order.PaymentID, order.CustomerID, order.CustomerName, order.TotalPrice, order.TotalPaid, order.TotalReturn, order.PointsRedeemed, order.PointsEarned, order.GiftCardID, order.GiftCardAmount, order.TerminalID).
 		Suffix("RETURNING *")
 
 	err := pgx.BeginFunc(ctx, or.db, func(tx pgx.Tx) error {
+		if order.PointsRedeemed > 0 {
+			err := or.checkPointsBalance(ctx, tx, *order.CustomerID, order.PointsRedeemed)
+			if err != nil {
+				return err
+			}
+		}
+
 		sql, args, err := orderQuery.ToSql()
 		if err != nil {
 			return err
@@ -55,6 +63,14 @@ func (or *orderRepository) CreateOrder(ctx context.Context, order *domainorder.O
 			&order.ReceiptCode,
 			&order.CreatedAt,
 			&order.UpdatedAt,
+			&order.CustomerID,
+			&order.PointsRedeemed,
+			&order.PointsEarned,
+			&order.RefundedAt,
+			&order.GiftCardID,
+			&order.GiftCardAmount,
+			&order.SoldGiftCardID,
+			&order.TerminalID,
 		)
 		if err != nil {
 			return err
@@ -89,6 +105,7 @@ func (or *orderRepository) CreateOrder(ctx context.Context, order *domainorder.O
 			productQuery := or.db.QueryBuilder.Update("products").
 				Set("stock", sq.Expr("stock - ?", orderProduct.Quantity)).
 				Set("updated_at", time.Now()).
+				Set("version", sq.Expr("version + 1")).
 				Where(sq.Eq{"id": orderProduct.ProductID}).
 				Suffix("RETURNING stock")
 
@@ -111,6 +128,27 @@ func (or *orderRepository) CreateOrder(ctx context.Context, order *domainorder.O
 
 		order.Products = products
 
+		if order.GiftCardID != nil && order.GiftCardAmount > 0 {
+			err = redeemGiftCard(ctx, or.db, tx, *order.GiftCardID, order.ID, order.GiftCardAmount)
+			if err != nil {
+				return err
+			}
+		}
+
+		if order.PointsRedeemed > 0 {
+			err = or.createLoyaltyEntry(ctx, tx, *order.CustomerID, order.ID, domainloyalty.Redeem, -order.PointsRedeemed)
+			if err != nil {
+				return err
+			}
+		}
+
+		if order.PointsEarned > 0 {
+			err = or.createLoyaltyEntry(ctx, tx, *order.CustomerID, order.ID, domainloyalty.Earn, order.PointsEarned)
+			if err != nil {
+				return err
+			}
+		}
+
 		return nil
 	})
 	if err != nil {
@@ -152,6 +190,14 @@ func (or *orderRepository) GetOrderByID(ctx context.Context, id uint64) (*domain
 			&order.ReceiptCode,
 			&order.CreatedAt,
 			&order.UpdatedAt,
+			&order.CustomerID,
+			&order.PointsRedeemed,
+			&order.PointsEarned,
+			&order.RefundedAt,
+			&order.GiftCardID,
+			&order.GiftCardAmount,
+			&order.SoldGiftCardID,
+			&order.TerminalID,
 		)
 		if err != nil {
 			if err == pgx.ErrNoRows {
@@ -196,8 +242,8 @@ func (or *orderRepository) GetOrderByID(ctx context.Context, id uint64) (*domain
 	return &order, nil
 }
 
-// ListOrders lists all orders from the database
-func (or *orderRepository) ListOrders(ctx context.Context, skip, limit uint64) ([]domainorder.Order, error) {
+// ListOrders lists all orders from the database, optionally only those of one customer
+func (or *orderRepository) ListOrders(ctx context.Context, customerID, skip, limit uint64) ([]domainorder.Order, error) {
 	var order domainorder.Order
 	var orderProduct domainorder.OrderProduct
 	var orders []domainorder.Order
@@ -208,6 +254,10 @@ func (or *orderRepository) ListOrders(ctx context.Context, skip, limit uint64) (
 		Limit(limit).
 		Offset((skip - 1) * limit)
 
+	if customerID != 0 {
+		ordersQuery = ordersQuery.Where(sq.Eq{"customer_id": customerID})
+	}
+
 	err := pgx.BeginFunc(ctx, or.db, func(tx pgx.Tx) error {
 		sql, args, err := ordersQuery.ToSql()
 		if err != nil {
@@ -231,6 +281,14 @@ func (or *orderRepository) ListOrders(ctx context.Context, skip, limit uint64) (
 				&order.ReceiptCode,
 				&order.CreatedAt,
 				&order.UpdatedAt,
+				&order.CustomerID,
+				&order.PointsRedeemed,
+				&order.PointsEarned,
+				&order.RefundedAt,
+				&order.GiftCardID,
+				&order.GiftCardAmount,
+				&order.SoldGiftCardID,
+				&order.TerminalID,
 			)
 			if err != nil {
 				return err
@@ -280,3 +338,187 @@ func (or *orderRepository) ListOrders(ctx context.Context, skip, limit uint64) (
 
 	return orders, nil
 }
+
+// RefundOrder marks an order as refunded, restocks its products and reverses its gift card and loyalty points tenders in the database
+func (or *orderRepository) RefundOrder(ctx context.Context, id uint64, points int64) (*domainorder.Order, error) {
+	var order domainorder.Order
+	var orderProduct domainorder.OrderProduct
+
+	orderQuery := or.db.QueryBuilder.Update("orders").
+		Set("refunded_at", time.Now()).
+		Set("updated_at", time.Now()).
+		Where(sq.Eq{"id": id, "refunded_at": nil}).
+		Suffix("RETURNING *")
+
+	orderProductQuery := or.db.QueryBuilder.Select("*").
+		From("order_products").
+		Where(sq.Eq{"order_id": id})
+
+	err := pgx.BeginFunc(ctx, or.db, func(tx pgx.Tx) error {
+		sql, args, err := orderQuery.ToSql()
+		if err != nil {
+			return err
+		}
+
+		err = tx.QueryRow(ctx, sql, args...).Scan(
+			&order.ID,
+			&order.UserID,
+			&order.PaymentID,
+			&order.CustomerName,
+			&order.TotalPrice,
+			&order.TotalPaid,
+			&order.TotalReturn,
+			&order.ReceiptCode,
+			&order.CreatedAt,
+			&order.UpdatedAt,
+			&order.CustomerID,
+			&order.PointsRedeemed,
+			&order.PointsEarned,
+			&order.RefundedAt,
+			&order.GiftCardID,
+			&order.GiftCardAmount,
+			&order.SoldGiftCardID,
+			&order.TerminalID,
+		)
+		if err != nil {
+			if err == pgx.ErrNoRows {
+				return domain.ErrOrderRefunded
+			}
+			return err
+		}
+
+		sql, args, err = orderProductQuery.ToSql()
+		if err != nil {
+			return err
+		}
+
+		rows, err := tx.Query(ctx, sql, args...)
+		if err != nil {
+			return err
+		}
+
+		for rows.Next() {
+			err = rows.Scan(
+				&orderProduct.ID,
+				&orderProduct.OrderID,
+				&orderProduct.ProductID,
+				&orderProduct.Quantity,
+				&orderProduct.TotalPrice,
+				&orderProduct.CreatedAt,
+				&orderProduct.UpdatedAt,
+			)
+			if err != nil {
+				return err
+			}
+
+			order.Products = append(order.Products, orderProduct)
+		}
+
+		for _, orderProduct := range order.Products {
+			productQuery := or.db.QueryBuilder.Update("products").
+				Set("stock", sq.Expr("stock + ?", orderProduct.Quantity)).
+				Set("updated_at", time.Now()).
+				Set("version", sq.Expr("version + 1")).
+				Where(sq.Eq{"id": orderProduct.ProductID})
+
+			sql, args, err := productQuery.ToSql()
+			if err != nil {
+				return err
+			}
+
+			_, err = tx.Exec(ctx, sql, args...)
+			if err != nil {
+				return err
+			}
+		}
+
+		if order.GiftCardID != nil && order.GiftCardAmount > 0 {
+			err = refundGiftCard(ctx, or.db, tx, *order.GiftCardID, order.ID, order.GiftCardAmount)
+			if err != nil {
+				return err
+			}
+		}
+
+		// refunding the sale or top-up of a gift card takes its amount back off the card
+		if order.SoldGiftCardID != nil {
+			err = refundGiftCardSale(ctx, or.db, tx, *order.SoldGiftCardID, order.ID, order.TotalPrice)
+			if err != nil {
+				return err
+			}
+		}
+
+		if order.CustomerID == nil || points == 0 {
+			return nil
+		}
+
+		// the balance may have been spent since the use case read it, and it never goes below zero
+		if points < 0 {
+			err = or.checkPointsBalance(ctx, tx, *order.CustomerID, -points)
+			if err != nil {
+				return err
+			}
+		}
+
+		return or.createLoyaltyEntry(ctx, tx, *order.CustomerID, order.ID, domainloyalty.Reversal, points)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &order, nil
+}
+
+// checkPointsBalance locks the customer and checks that its points balance covers the points to redeem
+func (or *orderRepository) checkPointsBalance(ctx context.Context, tx pgx.Tx, customerID uint64, points int64) error {
+	var balance int64
+
+	lockQuery := or.db.QueryBuilder.Select("id").
+		From("customers").
+		Where(sq.Eq{"id": customerID}).
+		Suffix("FOR UPDATE")
+
+	sql, args, err := lockQuery.ToSql()
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(ctx, sql, args...)
+	if err != nil {
+		return err
+	}
+
+	balanceQuery := or.db.QueryBuilder.Select("COALESCE(SUM(points), 0)").
+		From("loyalty_entries").
+		Where(sq.Eq{"customer_id": customerID})
+
+	sql, args, err = balanceQuery.ToSql()
+	if err != nil {
+		return err
+	}
+
+	err = tx.QueryRow(ctx, sql, args...).Scan(&balance)
+	if err != nil {
+		return err
+	}
+
+	if balance < points {
+		return domain.ErrInsufficientPoints
+	}
+
+	return nil
+}
+
+// createLoyaltyEntry inserts a loyalty ledger entry of an order
+func (or *orderRepository) createLoyaltyEntry(ctx context.Context, tx pgx.Tx, customerID, orderID uint64, entryType domainloyalty.EntryType, points int64) error {
+	query := or.db.QueryBuilder.Insert("loyalty_entries").
+		Columns("customer_id", "order_id", "type", "points").
+		Values(customerID, orderID, entryType, points)
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(ctx, sql, args...)
+	return err
+}
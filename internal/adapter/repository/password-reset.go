@@ -0,0 +1,121 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	storagepostgres "github.com/TienMinh25/go-hexagonal-architecture/infrastructure/storage/postgres"
+	"github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain"
+	domainauth "github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain/auth"
+	"github.com/TienMinh25/go-hexagonal-architecture/internal/application/port"
+	"github.com/jackc/pgx/v5"
+)
+
+/**
+ * passwordResetRepository implements port.PasswordResetRepository interface
+ * and provides an access to the postgres database
+ */
+type passwordResetRepository struct {
+	db *storagepostgres.DB
+}
+
+// NewPasswordResetRepository creates a new password reset repository instance
+func NewPasswordResetRepository(db *storagepostgres.DB) port.PasswordResetRepository {
+	return &passwordResetRepository{
+		db,
+	}
+}
+
+// CreatePasswordResetToken creates a new password reset token record in the database
+func (pr *passwordResetRepository) CreatePasswordResetToken(ctx context.Context, token *domainauth.PasswordResetToken) (*domainauth.PasswordResetToken, error) {
+	query := pr.db.QueryBuilder.Insert("password_reset_tokens").
+		Columns("user_id", "token_hash", "expires_at").
+		Values(token.UserID, token.TokenHash, token.ExpiresAt).
+		Suffix("RETURNING *")
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	err = pr.db.QueryRow(ctx, sql, args...).Scan(
+		&token.ID,
+		&token.UserID,
+		&token.TokenHash,
+		&token.ExpiresAt,
+		&token.UsedAt,
+		&token.CreatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return token, nil
+}
+
+// ResetPassword uses a reset token and replaces the password of its user in one transaction
+func (pr *passwordResetRepository) ResetPassword(ctx context.Context, tokenHash, passwordHash string) (uint64, error) {
+	var userID uint64
+
+	now := time.Now()
+
+	useQuery := pr.db.QueryBuilder.Update("password_reset_tokens").
+		Set("used_at", now).
+		Where(sq.Eq{"token_hash": tokenHash, "used_at": nil}).
+		Where(sq.Gt{"expires_at": now}).
+		Suffix("RETURNING user_id")
+
+	err := pgx.BeginFunc(ctx, pr.db, func(tx pgx.Tx) error {
+		sql, args, err := useQuery.ToSql()
+		if err != nil {
+			return err
+		}
+
+		err = tx.QueryRow(ctx, sql, args...).Scan(&userID)
+		if err != nil {
+			if err == pgx.ErrNoRows {
+				return domain.ErrInvalidResetToken
+			}
+			return err
+		}
+
+		userQuery := pr.db.QueryBuilder.Update("users").
+			Set("password", passwordHash).
+			Set("updated_at", now).
+			Set("version", sq.Expr("version + 1")).
+			Where(sq.Eq{"id": userID, "deleted_at": nil})
+
+		sql, args, err = userQuery.ToSql()
+		if err != nil {
+			return err
+		}
+
+		result, err := tx.Exec(ctx, sql, args...)
+		if err != nil {
+			return err
+		}
+
+		// the user was archived after the token was sent
+		if result.RowsAffected() == 0 {
+			return domain.ErrInvalidResetToken
+		}
+
+		discardQuery := pr.db.QueryBuilder.Update("password_reset_tokens").
+			Set("used_at", now).
+			Where(sq.Eq{"user_id": userID, "used_at": nil})
+
+		sql, args, err = discardQuery.ToSql()
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.Exec(ctx, sql, args...)
+		return err
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return userID, nil
+}
@@ -46,6 +46,8 @@ func (pr *paymentRepository) CreatePayment(ctx context.Context, payment *domainp
 		&payment.Logo,
 		&payment.CreatedAt,
 		&payment.UpdatedAt,
+		&payment.DeletedAt,
+		&payment.Version,
 	)
 	if err != nil {
 		if errCode := pr.db.ErrorCode(err); errCode == "23505" {
@@ -78,6 +80,8 @@ func (pr *paymentRepository) GetPaymentByID(ctx context.Context, id uint64) (*do
 		&payment.Logo,
 		&payment.CreatedAt,
 		&payment.UpdatedAt,
+		&payment.DeletedAt,
+		&payment.Version,
 	)
 	if err != nil {
 		if err == pgx.ErrNoRows {
@@ -90,7 +94,7 @@ func (pr *paymentRepository) GetPaymentByID(ctx context.Context, id uint64) (*do
 }
 
 // ListPayments retrieves a list of payments from the database
-func (pr *paymentRepository) ListPayments(ctx context.Context, skip, limit uint64) ([]domainpayment.Payment, error) {
+func (pr *paymentRepository) ListPayments(ctx context.Context, skip, limit uint64, includeArchived bool) ([]domainpayment.Payment, error) {
 	var payment domainpayment.Payment
 	var payments []domainpayment.Payment
 
@@ -100,6 +104,10 @@ func (pr *paymentRepository) ListPayments(ctx context.Context, skip, limit uint6
 		Limit(limit).
 		Offset((skip - 1) * limit)
 
+	if !includeArchived {
+		query = query.Where(sq.Eq{"deleted_at": nil})
+	}
+
 	sql, args, err := query.ToSql()
 	if err != nil {
 		return nil, err
@@ -118,6 +126,8 @@ func (pr *paymentRepository) ListPayments(ctx context.Context, skip, limit uint6
 			&payment.Logo,
 			&payment.CreatedAt,
 			&payment.UpdatedAt,
+			&payment.DeletedAt,
+			&payment.Version,
 		)
 		if err != nil {
 			return nil, err
@@ -140,7 +150,8 @@ func (pr *paymentRepository) UpdatePayment(ctx context.Context, payment *domainp
 		Set("type", sq.Expr("COALESCE(?, type)", paymentType)).
 		Set("logo", sq.Expr("COALESCE(?, logo)", logo)).
 		Set("updated_at", time.Now()).
-		Where(sq.Eq{"id": payment.ID}).
+		Set("version", sq.Expr("version + 1")).
+		Where(sq.Eq{"id": payment.ID, "version": payment.Version}).
 		Suffix("RETURNING *")
 
 	sql, args, err := query.ToSql()
@@ -155,8 +166,13 @@ func (pr *paymentRepository) UpdatePayment(ctx context.Context, payment *domainp
 		&payment.Logo,
 		&payment.CreatedAt,
 		&payment.UpdatedAt,
+		&payment.DeletedAt,
+		&payment.Version,
 	)
 	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, domain.ErrVersionMismatch
+		}
 		if errCode := pr.db.ErrorCode(err); errCode == "23505" {
 			return nil, domain.ErrConflictingData
 		}
@@ -166,20 +182,63 @@ func (pr *paymentRepository) UpdatePayment(ctx context.Context, payment *domainp
 	return payment, nil
 }
 
-// DeletePayment deletes a payment record from the database by id
-func (pr *paymentRepository) DeletePayment(ctx context.Context, id uint64) error {
-	query := pr.db.QueryBuilder.Delete("payments").
-		Where(sq.Eq{"id": id})
+// DeletePayment archives a payment record in the database by id
+func (pr *paymentRepository) DeletePayment(ctx context.Context, id, version uint64) error {
+	query := pr.db.QueryBuilder.Update("payments").
+		Set("deleted_at", time.Now()).
+		Set("version", sq.Expr("version + 1")).
+		Where(sq.Eq{"id": id, "deleted_at": nil, "version": version})
 
 	sql, args, err := query.ToSql()
 	if err != nil {
 		return err
 	}
 
-	_, err = pr.db.Exec(ctx, sql, args...)
+	result, err := pr.db.Exec(ctx, sql, args...)
 	if err != nil {
 		return err
 	}
 
+	if result.RowsAffected() == 0 {
+		return domain.ErrVersionMismatch
+	}
+
 	return nil
 }
+
+// RestorePayment restores an archived payment record in the database by id
+func (pr *paymentRepository) RestorePayment(ctx context.Context, id uint64) (*domainpayment.Payment, error) {
+	var payment domainpayment.Payment
+
+	query := pr.db.QueryBuilder.Update("payments").
+		Set("deleted_at", nil).
+		Set("updated_at", time.Now()).
+		Set("version", sq.Expr("version + 1")).
+		Where(sq.Eq{"id": id}).
+		Where(sq.NotEq{"deleted_at": nil}).
+		Suffix("RETURNING *")
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	err = pr.db.QueryRow(ctx, sql, args...).Scan(
+		&payment.ID,
+		&payment.Name,
+		&payment.Type,
+		&payment.Logo,
+		&payment.CreatedAt,
+		&payment.UpdatedAt,
+		&payment.DeletedAt,
+		&payment.Version,
+	)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, domain.ErrDataNotFound
+		}
+		return nil, err
+	}
+
+	return &payment, nil
+}
@@ -49,6 +49,8 @@ func (pr *productRepository) CreateProduct(ctx context.Context, product *domainp
 		&product.Image,
 		&product.CreatedAt,
 		&product.UpdatedAt,
+		&product.DeletedAt,
+		&product.Version,
 	)
 	if err != nil {
 		if errCode := pr.db.ErrorCode(err); errCode == "23505" {
@@ -84,6 +86,8 @@ func (pr *productRepository) GetProductByID(ctx context.Context, id uint64) (*do
 		&product.Image,
 		&product.CreatedAt,
 		&product.UpdatedAt,
+		&product.DeletedAt,
+		&product.Version,
 	)
 	if err != nil {
 		if err == pgx.ErrNoRows {
@@ -96,7 +100,7 @@ func (pr *productRepository) GetProductByID(ctx context.Context, id uint64) (*do
 }
 
 // ListProducts retrieves a list of products from the database
-func (pr *productRepository) ListProducts(ctx context.Context, search string, categoryId, skip, limit uint64) ([]domainproduct.Product, error) {
+func (pr *productRepository) ListProducts(ctx context.Context, search string, categoryId, skip, limit uint64, includeArchived bool) ([]domainproduct.Product, error) {
 	var product domainproduct.Product
 	var products []domainproduct.Product
 
@@ -114,6 +118,10 @@ func (pr *productRepository) ListProducts(ctx context.Context, search string, ca
 		query = query.Where(sq.ILike{"name": "%" + search + "%"})
 	}
 
+	if !includeArchived {
+		query = query.Where(sq.Eq{"deleted_at": nil})
+	}
+
 	sql, args, err := query.ToSql()
 	if err != nil {
 		return nil, err
@@ -135,6 +143,8 @@ func (pr *productRepository) ListProducts(ctx context.Context, search string, ca
 			&product.Image,
 			&product.CreatedAt,
 			&product.UpdatedAt,
+			&product.DeletedAt,
+			&product.Version,
 		)
 		if err != nil {
 			return nil, err
@@ -161,7 +171,8 @@ func (pr *productRepository) UpdateProduct(ctx context.Context, product *domainp
 		Set("price", sq.Expr("COALESCE(?, price)", price)).
 		Set("stock", sq.Expr("COALESCE(?, stock)", stock)).
 		Set("updated_at", time.Now()).
-		Where(sq.Eq{"id": product.ID}).
+		Set("version", sq.Expr("version + 1")).
+		Where(sq.Eq{"id": product.ID, "version": product.Version}).
 		Suffix("RETURNING *")
 
 	sql, args, err := query.ToSql()
@@ -179,8 +190,13 @@ func (pr *productRepository) UpdateProduct(ctx context.Context, product *domainp
 		&product.Image,
 		&product.CreatedAt,
 		&product.UpdatedAt,
+		&product.DeletedAt,
+		&product.Version,
 	)
 	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, domain.ErrVersionMismatch
+		}
 		if errCode := pr.db.ErrorCode(err); errCode == "23505" {
 			return nil, domain.ErrConflictingData
 		}
@@ -190,20 +206,66 @@ func (pr *productRepository) UpdateProduct(ctx context.Context, product *domainp
 	return product, nil
 }
 
-// DeleteProduct deletes a product record from the database by id
-func (pr *productRepository) DeleteProduct(ctx context.Context, id uint64) error {
-	query := pr.db.QueryBuilder.Delete("products").
-		Where(sq.Eq{"id": id})
+// DeleteProduct archives a product record in the database by id
+func (pr *productRepository) DeleteProduct(ctx context.Context, id, version uint64) error {
+	query := pr.db.QueryBuilder.Update("products").
+		Set("deleted_at", time.Now()).
+		Set("version", sq.Expr("version + 1")).
+		Where(sq.Eq{"id": id, "deleted_at": nil, "version": version})
 
 	sql, args, err := query.ToSql()
 	if err != nil {
 		return err
 	}
 
-	_, err = pr.db.Exec(ctx, sql, args...)
+	result, err := pr.db.Exec(ctx, sql, args...)
 	if err != nil {
 		return err
 	}
 
+	if result.RowsAffected() == 0 {
+		return domain.ErrVersionMismatch
+	}
+
 	return nil
 }
+
+// RestoreProduct restores an archived product record in the database by id
+func (pr *productRepository) RestoreProduct(ctx context.Context, id uint64) (*domainproduct.Product, error) {
+	var product domainproduct.Product
+
+	query := pr.db.QueryBuilder.Update("products").
+		Set("deleted_at", nil).
+		Set("updated_at", time.Now()).
+		Set("version", sq.Expr("version + 1")).
+		Where(sq.Eq{"id": id}).
+		Where(sq.NotEq{"deleted_at": nil}).
+		Suffix("RETURNING *")
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	err = pr.db.QueryRow(ctx, sql, args...).Scan(
+		&product.ID,
+		&product.CategoryID,
+		&product.SKU,
+		&product.Name,
+		&product.Stock,
+		&product.Price,
+		&product.Image,
+		&product.CreatedAt,
+		&product.UpdatedAt,
+		&product.DeletedAt,
+		&product.Version,
+	)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, domain.ErrDataNotFound
+		}
+		return nil, err
+	}
+
+	return &product, nil
+}
@@ -0,0 +1,172 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	storagepostgres "github.com/TienMinh25/go-hexagonal-architecture/infrastructure/storage/postgres"
+	"github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain"
+	domainauth "github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain/auth"
+	"github.com/TienMinh25/go-hexagonal-architecture/internal/application/port"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+/**
+ * refreshTokenRepository implements port.RefreshTokenRepository interface
+ * and provides an access to the postgres database
+ */
+type refreshTokenRepository struct {
+	db *storagepostgres.DB
+}
+
+// NewRefreshTokenRepository creates a new refresh token repository instance
+func NewRefreshTokenRepository(db *storagepostgres.DB) port.RefreshTokenRepository {
+	return &refreshTokenRepository{
+		db,
+	}
+}
+
+// CreateRefreshToken creates a new refresh token record in the database
+func (rr *refreshTokenRepository) CreateRefreshToken(ctx context.Context, refreshToken *domainauth.RefreshToken) (*domainauth.RefreshToken, error) {
+	query := rr.db.QueryBuilder.Insert("refresh_tokens").
+		Columns("user_id", "token_hash", "family_id", "expires_at").
+		Values(refreshToken.UserID, refreshToken.TokenHash, refreshToken.FamilyID, refreshToken.ExpiresAt).
+		Suffix("RETURNING *")
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	err = rr.db.QueryRow(ctx, sql, args...).Scan(
+		&refreshToken.ID,
+		&refreshToken.UserID,
+		&refreshToken.TokenHash,
+		&refreshToken.FamilyID,
+		&refreshToken.ExpiresAt,
+		&refreshToken.UsedAt,
+		&refreshToken.RevokedAt,
+		&refreshToken.CreatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return refreshToken, nil
+}
+
+// GetRefreshTokenByHash retrieves a refresh token record from the database by the hash of its value
+func (rr *refreshTokenRepository) GetRefreshTokenByHash(ctx context.Context, tokenHash string) (*domainauth.RefreshToken, error) {
+	var refreshToken domainauth.RefreshToken
+
+	query := rr.db.QueryBuilder.Select("*").
+		From("refresh_tokens").
+		Where(sq.Eq{"token_hash": tokenHash}).
+		Limit(1)
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	err = rr.db.QueryRow(ctx, sql, args...).Scan(
+		&refreshToken.ID,
+		&refreshToken.UserID,
+		&refreshToken.TokenHash,
+		&refreshToken.FamilyID,
+		&refreshToken.ExpiresAt,
+		&refreshToken.UsedAt,
+		&refreshToken.RevokedAt,
+		&refreshToken.CreatedAt,
+	)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, domain.ErrDataNotFound
+		}
+		return nil, err
+	}
+
+	return &refreshToken, nil
+}
+
+// RotateRefreshToken marks a refresh token as used and inserts its replacement in one transaction
+func (rr *refreshTokenRepository) RotateRefreshToken(ctx context.Context, id uint64, refreshToken *domainauth.RefreshToken) (*domainauth.RefreshToken, error) {
+	useQuery := rr.db.QueryBuilder.Update("refresh_tokens").
+		Set("used_at", time.Now()).
+		Where(sq.Eq{"id": id, "used_at": nil, "revoked_at": nil})
+
+	err := pgx.BeginFunc(ctx, rr.db, func(tx pgx.Tx) error {
+		sql, args, err := useQuery.ToSql()
+		if err != nil {
+			return err
+		}
+
+		result, err := tx.Exec(ctx, sql, args...)
+		if err != nil {
+			return err
+		}
+
+		// another request rotated the token first
+		if result.RowsAffected() == 0 {
+			return domain.ErrRefreshTokenReused
+		}
+
+		createQuery := rr.db.QueryBuilder.Insert("refresh_tokens").
+			Columns("user_id", "token_hash", "family_id", "expires_at").
+			Values(refreshToken.UserID, refreshToken.TokenHash, refreshToken.FamilyID, refreshToken.ExpiresAt).
+			Suffix("RETURNING *")
+
+		sql, args, err = createQuery.ToSql()
+		if err != nil {
+			return err
+		}
+
+		return tx.QueryRow(ctx, sql, args...).Scan(
+			&refreshToken.ID,
+			&refreshToken.UserID,
+			&refreshToken.TokenHash,
+			&refreshToken.FamilyID,
+			&refreshToken.ExpiresAt,
+			&refreshToken.UsedAt,
+			&refreshToken.RevokedAt,
+			&refreshToken.CreatedAt,
+		)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return refreshToken, nil
+}
+
+// RevokeRefreshTokenFamily revokes all refresh token records of a family in the database
+func (rr *refreshTokenRepository) RevokeRefreshTokenFamily(ctx context.Context, familyID uuid.UUID) error {
+	query := rr.db.QueryBuilder.Update("refresh_tokens").
+		Set("revoked_at", time.Now()).
+		Where(sq.Eq{"family_id": familyID, "revoked_at": nil})
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return err
+	}
+
+	_, err = rr.db.Exec(ctx, sql, args...)
+	return err
+}
+
+// RevokeUserRefreshTokens revokes all refresh token records of a user in the database
+func (rr *refreshTokenRepository) RevokeUserRefreshTokens(ctx context.Context, userID uint64) error {
+	query := rr.db.QueryBuilder.Update("refresh_tokens").
+		Set("revoked_at", time.Now()).
+		Where(sq.Eq{"user_id": userID, "revoked_at": nil})
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return err
+	}
+
+	_, err = rr.db.Exec(ctx, sql, args...)
+	return err
+}
@@ -0,0 +1,347 @@
+package repository
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	storagepostgres "github.com/TienMinh25/go-hexagonal-architecture/infrastructure/storage/postgres"
+	"github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain"
+	domainrole "github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain/role"
+	domainuser "github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain/user"
+	"github.com/TienMinh25/go-hexagonal-architecture/internal/application/port"
+	"github.com/jackc/pgx/v5"
+)
+
+/**
+ * roleRepository implements port.RoleRepository interface
+ * and provides an access to the postgres database
+ */
+type roleRepository struct {
+	db *storagepostgres.DB
+}
+
+// NewRoleRepository creates a new role repository instance
+func NewRoleRepository(db *storagepostgres.DB) port.RoleRepository {
+	return &roleRepository{
+		db,
+	}
+}
+
+// CreateRole creates a new role record and grants its permissions in the database
+func (rr *roleRepository) CreateRole(ctx context.Context, role *domainrole.Role) (*domainrole.Role, error) {
+	query := rr.db.QueryBuilder.Insert("roles").
+		Columns("name", "description").
+		Values(role.Name, role.Description).
+		Suffix("RETURNING id")
+
+	err := pgx.BeginFunc(ctx, rr.db, func(tx pgx.Tx) error {
+		sql, args, err := query.ToSql()
+		if err != nil {
+			return err
+		}
+
+		err = tx.QueryRow(ctx, sql, args...).Scan(&role.ID)
+		if err != nil {
+			if errCode := rr.db.ErrorCode(err); errCode == "23505" {
+				return domain.ErrConflictingData
+			}
+			return err
+		}
+
+		return grantPermissions(ctx, rr.db, tx, role.ID, role.Permissions)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return rr.GetRoleByID(ctx, role.ID)
+}
+
+// GetRoleByID retrieves a role record with its permissions from the database by id
+func (rr *roleRepository) GetRoleByID(ctx context.Context, id uint64) (*domainrole.Role, error) {
+	var role domainrole.Role
+
+	query := rr.db.QueryBuilder.Select("*").
+		From("roles").
+		Where(sq.Eq{"id": id}).
+		Limit(1)
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	err = rr.db.QueryRow(ctx, sql, args...).Scan(
+		&role.ID,
+		&role.Name,
+		&role.Description,
+		&role.IsSystem,
+		&role.CreatedAt,
+		&role.UpdatedAt,
+	)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, domain.ErrDataNotFound
+		}
+		return nil, err
+	}
+
+	permissions, err := rr.listPermissionNames(ctx, sq.Eq{"role_permissions.role_id": id})
+	if err != nil {
+		return nil, err
+	}
+
+	role.Permissions = permissions[id]
+
+	return &role, nil
+}
+
+// ListRoles retrieves all role records with their permissions from the database
+func (rr *roleRepository) ListRoles(ctx context.Context) ([]domainrole.Role, error) {
+	var role domainrole.Role
+	var roles []domainrole.Role
+
+	query := rr.db.QueryBuilder.Select("*").
+		From("roles").
+		OrderBy("id")
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := rr.db.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		err := rows.Scan(
+			&role.ID,
+			&role.Name,
+			&role.Description,
+			&role.IsSystem,
+			&role.CreatedAt,
+			&role.UpdatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		roles = append(roles, role)
+	}
+
+	permissions, err := rr.listPermissionNames(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range roles {
+		roles[i].Permissions = permissions[roles[i].ID]
+	}
+
+	return roles, nil
+}
+
+// ListPermissions retrieves all permission records from the database
+func (rr *roleRepository) ListPermissions(ctx context.Context) ([]domainrole.Permission, error) {
+	var permission domainrole.Permission
+	var permissions []domainrole.Permission
+
+	query := rr.db.QueryBuilder.Select("*").
+		From("permissions").
+		OrderBy("name")
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := rr.db.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		err := rows.Scan(
+			&permission.ID,
+			&permission.Name,
+			&permission.Description,
+			&permission.CreatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		permissions = append(permissions, permission)
+	}
+
+	return permissions, nil
+}
+
+// ListRolePermissions retrieves the names of the permissions granted to a role by name from the database
+func (rr *roleRepository) ListRolePermissions(ctx context.Context, role domainuser.UserRole) ([]string, error) {
+	query := rr.db.QueryBuilder.Select("permissions.name").
+		From("permissions").
+		Join("role_permissions ON role_permissions.permission_id = permissions.id").
+		Join("roles ON roles.id = role_permissions.role_id").
+		Where(sq.Eq{"roles.name": role}).
+		OrderBy("permissions.name")
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := rr.db.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	return pgx.CollectRows(rows, pgx.RowTo[string])
+}
+
+// SetRolePermissions replaces the permissions granted to a role in the database
+func (rr *roleRepository) SetRolePermissions(ctx context.Context, id uint64, permissions []string) (*domainrole.Role, error) {
+	query := rr.db.QueryBuilder.Update("roles").
+		Set("updated_at", time.Now()).
+		Where(sq.Eq{"id": id})
+
+	deleteQuery := rr.db.QueryBuilder.Delete("role_permissions").
+		Where(sq.Eq{"role_id": id})
+
+	err := pgx.BeginFunc(ctx, rr.db, func(tx pgx.Tx) error {
+		sql, args, err := query.ToSql()
+		if err != nil {
+			return err
+		}
+
+		result, err := tx.Exec(ctx, sql, args...)
+		if err != nil {
+			return err
+		}
+
+		if result.RowsAffected() == 0 {
+			return domain.ErrDataNotFound
+		}
+
+		sql, args, err = deleteQuery.ToSql()
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.Exec(ctx, sql, args...)
+		if err != nil {
+			return err
+		}
+
+		return grantPermissions(ctx, rr.db, tx, id, permissions)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return rr.GetRoleByID(ctx, id)
+}
+
+// DeleteRole deletes a role record from the database by id
+func (rr *roleRepository) DeleteRole(ctx context.Context, id uint64) error {
+	query := rr.db.QueryBuilder.Delete("roles").
+		Where(sq.Eq{"id": id})
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return err
+	}
+
+	result, err := rr.db.Exec(ctx, sql, args...)
+	if err != nil {
+		if errCode := rr.db.ErrorCode(err); errCode == "23503" {
+			return domain.ErrRoleInUse
+		}
+		return err
+	}
+
+	if result.RowsAffected() == 0 {
+		return domain.ErrDataNotFound
+	}
+
+	return nil
+}
+
+// listPermissionNames retrieves the names of the permissions granted to the roles matching the filter, by role id
+func (rr *roleRepository) listPermissionNames(ctx context.Context, filter sq.Sqlizer) (map[uint64][]string, error) {
+	var roleID uint64
+	var name string
+	permissions := make(map[uint64][]string)
+
+	query := rr.db.QueryBuilder.Select("role_permissions.role_id", "permissions.name").
+		From("role_permissions").
+		Join("permissions ON permissions.id = role_permissions.permission_id").
+		OrderBy("permissions.name")
+
+	if filter != nil {
+		query = query.Where(filter)
+	}
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := rr.db.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		err := rows.Scan(&roleID, &name)
+		if err != nil {
+			return nil, err
+		}
+
+		permissions[roleID] = append(permissions[roleID], name)
+	}
+
+	return permissions, rows.Err()
+}
+
+// grantPermissions grants the permissions of the given names to a role within a transaction,
+// failing when any of the names is not a known permission
+func grantPermissions(ctx context.Context, db *storagepostgres.DB, tx pgx.Tx, roleID uint64, permissions []string) error {
+	permissions = slices.Compact(slices.Sorted(slices.Values(permissions)))
+	if len(permissions) == 0 {
+		return nil
+	}
+
+	selectQuery := db.QueryBuilder.Select().
+		Column("?::bigint", roleID).
+		Column("id").
+		From("permissions").
+		Where(sq.Eq{"name": permissions})
+
+	query := db.QueryBuilder.Insert("role_permissions").
+		Columns("role_id", "permission_id").
+		Select(selectQuery)
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return err
+	}
+
+	result, err := tx.Exec(ctx, sql, args...)
+	if err != nil {
+		return err
+	}
+
+	if result.RowsAffected() != int64(len(permissions)) {
+		return domain.ErrUnknownPermission
+	}
+
+	return nil
+}
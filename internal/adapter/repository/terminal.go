@@ -0,0 +1,156 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	storagepostgres "github.com/TienMinh25/go-hexagonal-architecture/infrastructure/storage/postgres"
+	"github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain"
+	domainterminal "github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain/terminal"
+	"github.com/TienMinh25/go-hexagonal-architecture/internal/application/port"
+	"github.com/jackc/pgx/v5"
+)
+
+/**
+ * terminalRepository implements port.TerminalRepository interface
+ * and provides an access to the postgres database
+ */
+type terminalRepository struct {
+	db *storagepostgres.DB
+}
+
+// NewTerminalRepository creates a new terminal repository instance
+func NewTerminalRepository(db *storagepostgres.DB) port.TerminalRepository {
+	return &terminalRepository{
+		db,
+	}
+}
+
+// CreateTerminal creates a new terminal record in the database
+func (tr *terminalRepository) CreateTerminal(ctx context.Context, terminal *domainterminal.Terminal) (*domainterminal.Terminal, error) {
+	query := tr.db.QueryBuilder.Insert("terminals").
+		Columns("name", "secret_hash").
+		Values(terminal.Name, terminal.SecretHash).
+		Suffix("RETURNING *")
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	err = tr.db.QueryRow(ctx, sql, args...).Scan(
+		&terminal.ID,
+		&terminal.Name,
+		&terminal.SecretHash,
+		&terminal.CreatedAt,
+		&terminal.RevokedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return terminal, nil
+}
+
+// GetTerminalByID retrieves a terminal record from the database by id
+func (tr *terminalRepository) GetTerminalByID(ctx context.Context, id uint64) (*domainterminal.Terminal, error) {
+	var terminal domainterminal.Terminal
+
+	query := tr.db.QueryBuilder.Select("*").
+		From("terminals").
+		Where(sq.Eq{"id": id}).
+		Limit(1)
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	err = tr.db.QueryRow(ctx, sql, args...).Scan(
+		&terminal.ID,
+		&terminal.Name,
+		&terminal.SecretHash,
+		&terminal.CreatedAt,
+		&terminal.RevokedAt,
+	)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, domain.ErrDataNotFound
+		}
+		return nil, err
+	}
+
+	return &terminal, nil
+}
+
+// ListTerminals retrieves a list of terminals from the database
+func (tr *terminalRepository) ListTerminals(ctx context.Context, skip, limit uint64) ([]domainterminal.Terminal, error) {
+	var terminal domainterminal.Terminal
+	var terminals []domainterminal.Terminal
+
+	query := tr.db.QueryBuilder.Select("*").
+		From("terminals").
+		OrderBy("id").
+		Limit(limit).
+		Offset((skip - 1) * limit)
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := tr.db.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		err := rows.Scan(
+			&terminal.ID,
+			&terminal.Name,
+			&terminal.SecretHash,
+			&terminal.CreatedAt,
+			&terminal.RevokedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		terminals = append(terminals, terminal)
+	}
+
+	return terminals, nil
+}
+
+// RevokeTerminal revokes a terminal record in the database that is not revoked yet
+func (tr *terminalRepository) RevokeTerminal(ctx context.Context, id uint64) (*domainterminal.Terminal, error) {
+	var terminal domainterminal.Terminal
+
+	query := tr.db.QueryBuilder.Update("terminals").
+		Set("revoked_at", time.Now()).
+		Where(sq.Eq{"id": id, "revoked_at": nil}).
+		Suffix("RETURNING *")
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	err = tr.db.QueryRow(ctx, sql, args...).Scan(
+		&terminal.ID,
+		&terminal.Name,
+		&terminal.SecretHash,
+		&terminal.CreatedAt,
+		&terminal.RevokedAt,
+	)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, domain.ErrDataNotFound
+		}
+		return nil, err
+	}
+
+	return &terminal, nil
+}
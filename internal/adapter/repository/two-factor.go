@@ -0,0 +1,178 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	storagepostgres "github.com/TienMinh25/go-hexagonal-architecture/infrastructure/storage/postgres"
+	"github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain"
+	domainauth "github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain/auth"
+	"github.com/TienMinh25/go-hexagonal-architecture/internal/application/port"
+	"github.com/jackc/pgx/v5"
+)
+
+/**
+ * twoFactorRepository implements port.TwoFactorRepository interface
+ * and provides an access to the postgres database
+ */
+type twoFactorRepository struct {
+	db *storagepostgres.DB
+}
+
+// NewTwoFactorRepository creates a new two-factor repository instance
+func NewTwoFactorRepository(db *storagepostgres.DB) port.TwoFactorRepository {
+	return &twoFactorRepository{
+		db,
+	}
+}
+
+// SaveTOTP creates or replaces the unconfirmed TOTP record of a user in the database
+func (tr *twoFactorRepository) SaveTOTP(ctx context.Context, totp *domainauth.TOTP) (*domainauth.TOTP, error) {
+	query := tr.db.QueryBuilder.Insert("user_totp").
+		Columns("user_id", "secret").
+		Values(totp.UserID, totp.Secret).
+		Suffix("ON CONFLICT (user_id) DO UPDATE SET secret = EXCLUDED.secret, confirmed_at = NULL, created_at = now() RETURNING *")
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	err = tr.db.QueryRow(ctx, sql, args...).Scan(
+		&totp.UserID,
+		&totp.Secret,
+		&totp.ConfirmedAt,
+		&totp.CreatedAt,
+	)
+	if err != nil {
+		if tr.db.ErrorCode(err) == "23503" {
+			return nil, domain.ErrDataNotFound
+		}
+		return nil, err
+	}
+
+	return totp, nil
+}
+
+// GetTOTP retrieves the TOTP record of a user from the database
+func (tr *twoFactorRepository) GetTOTP(ctx context.Context, userID uint64) (*domainauth.TOTP, error) {
+	var totp domainauth.TOTP
+
+	query := tr.db.QueryBuilder.Select("*").
+		From("user_totp").
+		Where(sq.Eq{"user_id": userID}).
+		Limit(1)
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	err = tr.db.QueryRow(ctx, sql, args...).Scan(
+		&totp.UserID,
+		&totp.Secret,
+		&totp.ConfirmedAt,
+		&totp.CreatedAt,
+	)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, domain.ErrDataNotFound
+		}
+		return nil, err
+	}
+
+	return &totp, nil
+}
+
+// ConfirmTOTP confirms the TOTP record of a user and replaces its recovery codes in one transaction
+func (tr *twoFactorRepository) ConfirmTOTP(ctx context.Context, userID uint64, recoveryCodeHashes []string) error {
+	confirmQuery := tr.db.QueryBuilder.Update("user_totp").
+		Set("confirmed_at", time.Now()).
+		Where(sq.Eq{"user_id": userID, "confirmed_at": nil})
+
+	deleteQuery := tr.db.QueryBuilder.Delete("recovery_codes").
+		Where(sq.Eq{"user_id": userID})
+
+	insertQuery := tr.db.QueryBuilder.Insert("recovery_codes").
+		Columns("user_id", "code_hash")
+	for _, codeHash := range recoveryCodeHashes {
+		insertQuery = insertQuery.Values(userID, codeHash)
+	}
+
+	return pgx.BeginFunc(ctx, tr.db, func(tx pgx.Tx) error {
+		sql, args, err := confirmQuery.ToSql()
+		if err != nil {
+			return err
+		}
+
+		result, err := tx.Exec(ctx, sql, args...)
+		if err != nil {
+			return err
+		}
+
+		// the enrollment was deleted or confirmed by another request
+		if result.RowsAffected() == 0 {
+			return domain.ErrTwoFactorNotEnabled
+		}
+
+		sql, args, err = deleteQuery.ToSql()
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.Exec(ctx, sql, args...)
+		if err != nil {
+			return err
+		}
+
+		if len(recoveryCodeHashes) == 0 {
+			return nil
+		}
+
+		sql, args, err = insertQuery.ToSql()
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.Exec(ctx, sql, args...)
+		return err
+	})
+}
+
+// UseRecoveryCode marks an unused recovery code record of a user as used in the database
+func (tr *twoFactorRepository) UseRecoveryCode(ctx context.Context, userID uint64, codeHash string) error {
+	query := tr.db.QueryBuilder.Update("recovery_codes").
+		Set("used_at", time.Now()).
+		Where(sq.Eq{"user_id": userID, "code_hash": codeHash, "used_at": nil})
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return err
+	}
+
+	result, err := tr.db.Exec(ctx, sql, args...)
+	if err != nil {
+		return err
+	}
+
+	if result.RowsAffected() == 0 {
+		return domain.ErrDataNotFound
+	}
+
+	return nil
+}
+
+// DeleteTOTP deletes the TOTP record of a user from the database, its recovery codes are deleted in cascade
+func (tr *twoFactorRepository) DeleteTOTP(ctx context.Context, userID uint64) error {
+	query := tr.db.QueryBuilder.Delete("user_totp").
+		Where(sq.Eq{"user_id": userID})
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return err
+	}
+
+	_, err = tr.db.Exec(ctx, sql, args...)
+	return err
+}
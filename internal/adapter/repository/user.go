@@ -30,8 +30,8 @@ func NewUserRepository(db *storagepostgres.DB) port.UserRepository {
 // CreateUser creates a new user in the database
 func (ur *userRepository) CreateUser(ctx context.Context, user *domainuser.User) (*domainuser.User, error) {
 	query := ur.db.QueryBuilder.Insert("users").
-		Columns("name", "email", "password").
-		Values(user.Name, user.Email, user.Password).
+		Columns("name", "email", "password", "role").
+		Values(user.Name, user.Email, user.Password, user.Role).
 		Suffix("RETURNING *")
 
 	sql, args, err := query.ToSql()
@@ -47,10 +47,16 @@ func (ur *userRepository) CreateUser(ctx context.Context, user *domainuser.User)
 		&user.Role,
 		&user.CreatedAt,
 		&user.UpdatedAt,
+		&user.DeletedAt,
+		&user.Version,
+		&user.Locale,
 	)
 	if err != nil {
-		if errCode := ur.db.ErrorCode(err); errCode == "23505" {
+		switch ur.db.ErrorCode(err) {
+		case "23505":
 			return nil, domain.ErrConflictingData
+		case "23503":
+			return nil, domain.ErrUnknownRole
 		}
 		return nil, err
 	}
@@ -80,6 +86,9 @@ func (ur *userRepository) GetUserByID(ctx context.Context, id uint64) (*domainus
 		&user.Role,
 		&user.CreatedAt,
 		&user.UpdatedAt,
+		&user.DeletedAt,
+		&user.Version,
+		&user.Locale,
 	)
 	if err != nil {
 		if err == pgx.ErrNoRows {
@@ -113,6 +122,9 @@ func (ur *userRepository) GetUserByEmail(ctx context.Context, email string) (*do
 		&user.Role,
 		&user.CreatedAt,
 		&user.UpdatedAt,
+		&user.DeletedAt,
+		&user.Version,
+		&user.Locale,
 	)
 	if err != nil {
 		if err == pgx.ErrNoRows {
@@ -125,7 +137,7 @@ func (ur *userRepository) GetUserByEmail(ctx context.Context, email string) (*do
 }
 
 // ListUsers lists all users from the database
-func (ur *userRepository) ListUsers(ctx context.Context, skip, limit uint64) ([]domainuser.User, error) {
+func (ur *userRepository) ListUsers(ctx context.Context, skip, limit uint64, includeArchived bool) ([]domainuser.User, error) {
 	var user domainuser.User
 	var users []domainuser.User
 
@@ -135,6 +147,10 @@ func (ur *userRepository) ListUsers(ctx context.Context, skip, limit uint64) ([]
 		Limit(limit).
 		Offset((skip - 1) * limit)
 
+	if !includeArchived {
+		query = query.Where(sq.Eq{"deleted_at": nil})
+	}
+
 	sql, args, err := query.ToSql()
 	if err != nil {
 		return nil, err
@@ -155,6 +171,9 @@ func (ur *userRepository) ListUsers(ctx context.Context, skip, limit uint64) ([]
 			&user.Role,
 			&user.CreatedAt,
 			&user.UpdatedAt,
+			&user.DeletedAt,
+			&user.Version,
+			&user.Locale,
 		)
 		if err != nil {
 			return nil, err
@@ -172,14 +191,17 @@ func (ur *userRepository) UpdateUser(ctx context.Context, user *domainuser.User)
 	email := nullString(user.Email)
 	password := nullString(user.Password)
 	role := nullString(string(user.Role))
+	locale := nullString(user.Locale)
 
 	query := ur.db.QueryBuilder.Update("users").
 		Set("name", sq.Expr("COALESCE(?, name)", name)).
 		Set("email", sq.Expr("COALESCE(?, email)", email)).
 		Set("password", sq.Expr("COALESCE(?, password)", password)).
 		Set("role", sq.Expr("COALESCE(?, role)", role)).
+		Set("locale", sq.Expr("COALESCE(?, locale)", locale)).
 		Set("updated_at", time.Now()).
-		Where(sq.Eq{"id": user.ID}).
+		Set("version", sq.Expr("version + 1")).
+		Where(sq.Eq{"id": user.ID, "version": user.Version}).
 		Suffix("RETURNING *")
 
 	sql, args, err := query.ToSql()
@@ -195,10 +217,19 @@ func (ur *userRepository) UpdateUser(ctx context.Context, user *domainuser.User)
 		&user.Role,
 		&user.CreatedAt,
 		&user.UpdatedAt,
+		&user.DeletedAt,
+		&user.Version,
+		&user.Locale,
 	)
 	if err != nil {
-		if errCode := ur.db.ErrorCode(err); errCode == "23505" {
+		if err == pgx.ErrNoRows {
+			return nil, domain.ErrVersionMismatch
+		}
+		switch ur.db.ErrorCode(err) {
+		case "23505":
 			return nil, domain.ErrConflictingData
+		case "23503":
+			return nil, domain.ErrUnknownRole
 		}
 		return nil, err
 	}
@@ -206,10 +237,100 @@ func (ur *userRepository) UpdateUser(ctx context.Context, user *domainuser.User)
 	return user, nil
 }
 
-// DeleteUser deletes a user by ID from the database
-func (ur *userRepository) DeleteUser(ctx context.Context, id uint64) error {
-	query := ur.db.QueryBuilder.Delete("users").
-		Where(sq.Eq{"id": id})
+// UpdateUserPassword replaces the password hash of an active user in the database
+func (ur *userRepository) UpdateUserPassword(ctx context.Context, id uint64, passwordHash string) error {
+	query := ur.db.QueryBuilder.Update("users").
+		Set("password", passwordHash).
+		Set("updated_at", time.Now()).
+		Set("version", sq.Expr("version + 1")).
+		Where(sq.Eq{"id": id, "deleted_at": nil})
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return err
+	}
+
+	result, err := ur.db.Exec(ctx, sql, args...)
+	if err != nil {
+		return err
+	}
+
+	if result.RowsAffected() == 0 {
+		return domain.ErrDataNotFound
+	}
+
+	return nil
+}
+
+// DeleteUser archives a user by ID in the database
+func (ur *userRepository) DeleteUser(ctx context.Context, id, version uint64) error {
+	query := ur.db.QueryBuilder.Update("users").
+		Set("deleted_at", time.Now()).
+		Set("version", sq.Expr("version + 1")).
+		Where(sq.Eq{"id": id, "deleted_at": nil, "version": version})
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return err
+	}
+
+	result, err := ur.db.Exec(ctx, sql, args...)
+	if err != nil {
+		return err
+	}
+
+	if result.RowsAffected() == 0 {
+		return domain.ErrVersionMismatch
+	}
+
+	return nil
+}
+
+// RestoreUser restores an archived user by ID in the database
+func (ur *userRepository) RestoreUser(ctx context.Context, id uint64) (*domainuser.User, error) {
+	var user domainuser.User
+
+	query := ur.db.QueryBuilder.Update("users").
+		Set("deleted_at", nil).
+		Set("updated_at", time.Now()).
+		Set("version", sq.Expr("version + 1")).
+		Where(sq.Eq{"id": id}).
+		Where(sq.NotEq{"deleted_at": nil}).
+		Suffix("RETURNING *")
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	err = ur.db.QueryRow(ctx, sql, args...).Scan(
+		&user.ID,
+		&user.Name,
+		&user.Email,
+		&user.Password,
+		&user.Role,
+		&user.CreatedAt,
+		&user.UpdatedAt,
+		&user.DeletedAt,
+		&user.Version,
+		&user.Locale,
+	)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, domain.ErrDataNotFound
+		}
+		return nil, err
+	}
+
+	return &user, nil
+}
+
+// SetUserPIN inserts or replaces the PIN hash of a user in the database
+func (ur *userRepository) SetUserPIN(ctx context.Context, id uint64, pinHash string) error {
+	query := ur.db.QueryBuilder.Insert("user_pins").
+		Columns("user_id", "pin_hash", "updated_at").
+		Values(id, pinHash, time.Now()).
+		Suffix("ON CONFLICT (user_id) DO UPDATE SET pin_hash = EXCLUDED.pin_hash, updated_at = EXCLUDED.updated_at")
 
 	sql, args, err := query.ToSql()
 	if err != nil {
@@ -218,8 +339,36 @@ func (ur *userRepository) DeleteUser(ctx context.Context, id uint64) error {
 
 	_, err = ur.db.Exec(ctx, sql, args...)
 	if err != nil {
+		if ur.db.ErrorCode(err) == "23503" {
+			return domain.ErrDataNotFound
+		}
 		return err
 	}
 
 	return nil
 }
+
+// GetUserPIN gets the PIN hash of a user from the database
+func (ur *userRepository) GetUserPIN(ctx context.Context, id uint64) (string, error) {
+	var pinHash string
+
+	query := ur.db.QueryBuilder.Select("pin_hash").
+		From("user_pins").
+		Where(sq.Eq{"user_id": id}).
+		Limit(1)
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return "", err
+	}
+
+	err = ur.db.QueryRow(ctx, sql, args...).Scan(&pinHash)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return "", domain.ErrDataNotFound
+		}
+		return "", err
+	}
+
+	return pinHash, nil
+}
@@ -0,0 +1,59 @@
+package tracing
+
+import (
+	"context"
+
+	domainauth "github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain/auth"
+	"github.com/TienMinh25/go-hexagonal-architecture/internal/application/port"
+)
+
+/**
+ * apiKeyService implements port.APIKeyService interface
+ * and traces the use cases of the API key service it wraps
+ */
+type apiKeyService struct {
+	svc port.APIKeyService
+}
+
+// NewAPIKeyService wraps the API key service to trace its use cases
+func NewAPIKeyService(svc port.APIKeyService) port.APIKeyService {
+	return &apiKeyService{
+		svc,
+	}
+}
+
+// CreateAPIKey traces APIKeyService.CreateAPIKey
+func (as *apiKeyService) CreateAPIKey(ctx context.Context, key *domainauth.APIKey, creatorPermissions []string) (*domainauth.APIKey, string, error) {
+	ctx, span := startSpan(ctx, "APIKeyService.CreateAPIKey")
+	result, value, err := as.svc.CreateAPIKey(ctx, key, creatorPermissions)
+	endSpan(span, err)
+
+	return result, value, err
+}
+
+// ListAPIKeys traces APIKeyService.ListAPIKeys
+func (as *apiKeyService) ListAPIKeys(ctx context.Context, skip, limit uint64) ([]domainauth.APIKey, error) {
+	ctx, span := startSpan(ctx, "APIKeyService.ListAPIKeys")
+	result, err := as.svc.ListAPIKeys(ctx, skip, limit)
+	endSpan(span, err)
+
+	return result, err
+}
+
+// RevokeAPIKey traces APIKeyService.RevokeAPIKey
+func (as *apiKeyService) RevokeAPIKey(ctx context.Context, id uint64) (*domainauth.APIKey, error) {
+	ctx, span := startSpan(ctx, "APIKeyService.RevokeAPIKey")
+	result, err := as.svc.RevokeAPIKey(ctx, id)
+	endSpan(span, err)
+
+	return result, err
+}
+
+// VerifyAPIKey traces APIKeyService.VerifyAPIKey
+func (as *apiKeyService) VerifyAPIKey(ctx context.Context, key string) (*domainauth.TokenPayload, error) {
+	ctx, span := startSpan(ctx, "APIKeyService.VerifyAPIKey")
+	result, err := as.svc.VerifyAPIKey(ctx, key)
+	endSpan(span, err)
+
+	return result, err
+}
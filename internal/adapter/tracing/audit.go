@@ -0,0 +1,50 @@
+package tracing
+
+import (
+	"context"
+
+	domainaudit "github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain/audit"
+	"github.com/TienMinh25/go-hexagonal-architecture/internal/application/port"
+)
+
+/**
+ * auditService implements port.AuditService interface
+ * and traces the use cases of the audit service it wraps
+ */
+type auditService struct {
+	svc port.AuditService
+}
+
+// NewAuditService wraps the audit service to trace its use cases
+func NewAuditService(svc port.AuditService) port.AuditService {
+	return &auditService{
+		svc,
+	}
+}
+
+// Record traces AuditService.Record
+func (as *auditService) Record(ctx context.Context, action domainaudit.Action, entity string, entityID uint64, before, after any) error {
+	ctx, span := startSpan(ctx, "AuditService.Record")
+	err := as.svc.Record(ctx, action, entity, entityID, before, after)
+	endSpan(span, err)
+
+	return err
+}
+
+// ListAuditLogs traces AuditService.ListAuditLogs
+func (as *auditService) ListAuditLogs(ctx context.Context, filter domainaudit.Filter, skip, limit uint64) ([]domainaudit.Log, error) {
+	ctx, span := startSpan(ctx, "AuditService.ListAuditLogs")
+	result, err := as.svc.ListAuditLogs(ctx, filter, skip, limit)
+	endSpan(span, err)
+
+	return result, err
+}
+
+// VerifyAuditLogs traces AuditService.VerifyAuditLogs
+func (as *auditService) VerifyAuditLogs(ctx context.Context) (*domainaudit.Verification, error) {
+	ctx, span := startSpan(ctx, "AuditService.VerifyAuditLogs")
+	result, err := as.svc.VerifyAuditLogs(ctx)
+	endSpan(span, err)
+
+	return result, err
+}
@@ -0,0 +1,113 @@
+package tracing
+
+import (
+	"context"
+
+	domainauth "github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain/auth"
+	"github.com/TienMinh25/go-hexagonal-architecture/internal/application/port"
+)
+
+/**
+ * authService implements port.AuthService interface
+ * and traces the use cases of the auth service it wraps
+ */
+type authService struct {
+	svc port.AuthService
+}
+
+// NewAuthService wraps the auth service to trace its use cases
+func NewAuthService(svc port.AuthService) port.AuthService {
+	return &authService{
+		svc,
+	}
+}
+
+// Login traces AuthService.Login
+func (as *authService) Login(ctx context.Context, email, password, ip string) (*domainauth.LoginResult, error) {
+	ctx, span := startSpan(ctx, "AuthService.Login")
+	result, err := as.svc.Login(ctx, email, password, ip)
+	endSpan(span, err)
+
+	return result, err
+}
+
+// VerifyTwoFactor traces AuthService.VerifyTwoFactor
+func (as *authService) VerifyTwoFactor(ctx context.Context, challenge, code, ip string) (*domainauth.TokenPair, error) {
+	ctx, span := startSpan(ctx, "AuthService.VerifyTwoFactor")
+	result, err := as.svc.VerifyTwoFactor(ctx, challenge, code, ip)
+	endSpan(span, err)
+
+	return result, err
+}
+
+// EnrollTwoFactor traces AuthService.EnrollTwoFactor
+func (as *authService) EnrollTwoFactor(ctx context.Context, userID uint64) (*domainauth.TOTPEnrollment, error) {
+	ctx, span := startSpan(ctx, "AuthService.EnrollTwoFactor")
+	result, err := as.svc.EnrollTwoFactor(ctx, userID)
+	endSpan(span, err)
+
+	return result, err
+}
+
+// ConfirmTwoFactor traces AuthService.ConfirmTwoFactor
+func (as *authService) ConfirmTwoFactor(ctx context.Context, userID uint64, code string) ([]string, error) {
+	ctx, span := startSpan(ctx, "AuthService.ConfirmTwoFactor")
+	result, err := as.svc.ConfirmTwoFactor(ctx, userID, code)
+	endSpan(span, err)
+
+	return result, err
+}
+
+// DisableTwoFactor traces AuthService.DisableTwoFactor
+func (as *authService) DisableTwoFactor(ctx context.Context, userID uint64, code string) error {
+	ctx, span := startSpan(ctx, "AuthService.DisableTwoFactor")
+	err := as.svc.DisableTwoFactor(ctx, userID, code)
+	endSpan(span, err)
+
+	return err
+}
+
+// PINLogin traces AuthService.PINLogin
+func (as *authService) PINLogin(ctx context.Context, terminalID uint64, terminalSecret string, userID uint64, pin, ip string) (string, error) {
+	ctx, span := startSpan(ctx, "AuthService.PINLogin")
+	result, err := as.svc.PINLogin(ctx, terminalID, terminalSecret, userID, pin, ip)
+	endSpan(span, err)
+
+	return result, err
+}
+
+// SetPIN traces AuthService.SetPIN
+func (as *authService) SetPIN(ctx context.Context, userID uint64, pin string) error {
+	ctx, span := startSpan(ctx, "AuthService.SetPIN")
+	err := as.svc.SetPIN(ctx, userID, pin)
+	endSpan(span, err)
+
+	return err
+}
+
+// UnlockUser traces AuthService.UnlockUser
+func (as *authService) UnlockUser(ctx context.Context, id uint64) error {
+	ctx, span := startSpan(ctx, "AuthService.UnlockUser")
+	err := as.svc.UnlockUser(ctx, id)
+	endSpan(span, err)
+
+	return err
+}
+
+// Refresh traces AuthService.Refresh
+func (as *authService) Refresh(ctx context.Context, refreshToken string) (*domainauth.TokenPair, error) {
+	ctx, span := startSpan(ctx, "AuthService.Refresh")
+	result, err := as.svc.Refresh(ctx, refreshToken)
+	endSpan(span, err)
+
+	return result, err
+}
+
+// Logout traces AuthService.Logout
+func (as *authService) Logout(ctx context.Context, payload *domainauth.TokenPayload, refreshToken string) error {
+	ctx, span := startSpan(ctx, "AuthService.Logout")
+	err := as.svc.Logout(ctx, payload, refreshToken)
+	endSpan(span, err)
+
+	return err
+}
@@ -0,0 +1,77 @@
+package tracing
+
+import (
+	"context"
+
+	domaincategory "github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain/category"
+	"github.com/TienMinh25/go-hexagonal-architecture/internal/application/port"
+)
+
+/**
+ * categoryService implements port.CategoryService interface
+ * and traces the use cases of the category service it wraps
+ */
+type categoryService struct {
+	svc port.CategoryService
+}
+
+// NewCategoryService wraps the category service to trace its use cases
+func NewCategoryService(svc port.CategoryService) port.CategoryService {
+	return &categoryService{
+		svc,
+	}
+}
+
+// CreateCategory traces CategoryService.CreateCategory
+func (cs *categoryService) CreateCategory(ctx context.Context, category *domaincategory.Category) (*domaincategory.Category, error) {
+	ctx, span := startSpan(ctx, "CategoryService.CreateCategory")
+	result, err := cs.svc.CreateCategory(ctx, category)
+	endSpan(span, err)
+
+	return result, err
+}
+
+// GetCategory traces CategoryService.GetCategory
+func (cs *categoryService) GetCategory(ctx context.Context, id uint64) (*domaincategory.Category, error) {
+	ctx, span := startSpan(ctx, "CategoryService.GetCategory")
+	result, err := cs.svc.GetCategory(ctx, id)
+	endSpan(span, err)
+
+	return result, err
+}
+
+// ListCategories traces CategoryService.ListCategories
+func (cs *categoryService) ListCategories(ctx context.Context, skip, limit uint64, includeArchived bool) ([]domaincategory.Category, error) {
+	ctx, span := startSpan(ctx, "CategoryService.ListCategories")
+	result, err := cs.svc.ListCategories(ctx, skip, limit, includeArchived)
+	endSpan(span, err)
+
+	return result, err
+}
+
+// UpdateCategory traces CategoryService.UpdateCategory
+func (cs *categoryService) UpdateCategory(ctx context.Context, category *domaincategory.Category) (*domaincategory.Category, error) {
+	ctx, span := startSpan(ctx, "CategoryService.UpdateCategory")
+	result, err := cs.svc.UpdateCategory(ctx, category)
+	endSpan(span, err)
+
+	return result, err
+}
+
+// DeleteCategory traces CategoryService.DeleteCategory
+func (cs *categoryService) DeleteCategory(ctx context.Context, id, version uint64) error {
+	ctx, span := startSpan(ctx, "CategoryService.DeleteCategory")
+	err := cs.svc.DeleteCategory(ctx, id, version)
+	endSpan(span, err)
+
+	return err
+}
+
+// RestoreCategory traces CategoryService.RestoreCategory
+func (cs *categoryService) RestoreCategory(ctx context.Context, id uint64) (*domaincategory.Category, error) {
+	ctx, span := startSpan(ctx, "CategoryService.RestoreCategory")
+	result, err := cs.svc.RestoreCategory(ctx, id)
+	endSpan(span, err)
+
+	return result, err
+}
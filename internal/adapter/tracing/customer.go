@@ -0,0 +1,86 @@
+package tracing
+
+import (
+	"context"
+
+	domaincustomer "github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain/customer"
+	"github.com/TienMinh25/go-hexagonal-architecture/internal/application/port"
+)
+
+/**
+ * customerService implements port.CustomerService interface
+ * and traces the use cases of the customer service it wraps
+ */
+type customerService struct {
+	svc port.CustomerService
+}
+
+// NewCustomerService wraps the customer service to trace its use cases
+func NewCustomerService(svc port.CustomerService) port.CustomerService {
+	return &customerService{
+		svc,
+	}
+}
+
+// CreateCustomer traces CustomerService.CreateCustomer
+func (cs *customerService) CreateCustomer(ctx context.Context, customer *domaincustomer.Customer) (*domaincustomer.Customer, error) {
+	ctx, span := startSpan(ctx, "CustomerService.CreateCustomer")
+	result, err := cs.svc.CreateCustomer(ctx, customer)
+	endSpan(span, err)
+
+	return result, err
+}
+
+// GetCustomer traces CustomerService.GetCustomer
+func (cs *customerService) GetCustomer(ctx context.Context, id uint64) (*domaincustomer.Customer, error) {
+	ctx, span := startSpan(ctx, "CustomerService.GetCustomer")
+	result, err := cs.svc.GetCustomer(ctx, id)
+	endSpan(span, err)
+
+	return result, err
+}
+
+// ListCustomers traces CustomerService.ListCustomers
+func (cs *customerService) ListCustomers(ctx context.Context, search string, skip, limit uint64, includeArchived bool) ([]domaincustomer.Customer, error) {
+	ctx, span := startSpan(ctx, "CustomerService.ListCustomers")
+	result, err := cs.svc.ListCustomers(ctx, search, skip, limit, includeArchived)
+	endSpan(span, err)
+
+	return result, err
+}
+
+// UpdateCustomer traces CustomerService.UpdateCustomer
+func (cs *customerService) UpdateCustomer(ctx context.Context, customer *domaincustomer.Customer) (*domaincustomer.Customer, error) {
+	ctx, span := startSpan(ctx, "CustomerService.UpdateCustomer")
+	result, err := cs.svc.UpdateCustomer(ctx, customer)
+	endSpan(span, err)
+
+	return result, err
+}
+
+// DeleteCustomer traces CustomerService.DeleteCustomer
+func (cs *customerService) DeleteCustomer(ctx context.Context, id, version uint64) error {
+	ctx, span := startSpan(ctx, "CustomerService.DeleteCustomer")
+	err := cs.svc.DeleteCustomer(ctx, id, version)
+	endSpan(span, err)
+
+	return err
+}
+
+// RestoreCustomer traces CustomerService.RestoreCustomer
+func (cs *customerService) RestoreCustomer(ctx context.Context, id uint64) (*domaincustomer.Customer, error) {
+	ctx, span := startSpan(ctx, "CustomerService.RestoreCustomer")
+	result, err := cs.svc.RestoreCustomer(ctx, id)
+	endSpan(span, err)
+
+	return result, err
+}
+
+// GetPurchaseSummary traces CustomerService.GetPurchaseSummary
+func (cs *customerService) GetPurchaseSummary(ctx context.Context, id uint64) (*domaincustomer.PurchaseSummary, error) {
+	ctx, span := startSpan(ctx, "CustomerService.GetPurchaseSummary")
+	result, err := cs.svc.GetPurchaseSummary(ctx, id)
+	endSpan(span, err)
+
+	return result, err
+}
@@ -0,0 +1,51 @@
+package tracing
+
+import (
+	"context"
+
+	domaingiftcard "github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain/giftcard"
+	domainorder "github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain/order"
+	"github.com/TienMinh25/go-hexagonal-architecture/internal/application/port"
+)
+
+/**
+ * giftCardService implements port.GiftCardService interface
+ * and traces the use cases of the gift card service it wraps
+ */
+type giftCardService struct {
+	svc port.GiftCardService
+}
+
+// NewGiftCardService wraps the gift card service to trace its use cases
+func NewGiftCardService(svc port.GiftCardService) port.GiftCardService {
+	return &giftCardService{
+		svc,
+	}
+}
+
+// IssueGiftCard traces GiftCardService.IssueGiftCard
+func (gs *giftCardService) IssueGiftCard(ctx context.Context, giftCard *domaingiftcard.GiftCard, order *domainorder.Order) (*domaingiftcard.GiftCard, error) {
+	ctx, span := startSpan(ctx, "GiftCardService.IssueGiftCard")
+	result, err := gs.svc.IssueGiftCard(ctx, giftCard, order)
+	endSpan(span, err)
+
+	return result, err
+}
+
+// GetGiftCard traces GiftCardService.GetGiftCard
+func (gs *giftCardService) GetGiftCard(ctx context.Context, code string) (*domaingiftcard.GiftCard, error) {
+	ctx, span := startSpan(ctx, "GiftCardService.GetGiftCard")
+	result, err := gs.svc.GetGiftCard(ctx, code)
+	endSpan(span, err)
+
+	return result, err
+}
+
+// TopUpGiftCard traces GiftCardService.TopUpGiftCard
+func (gs *giftCardService) TopUpGiftCard(ctx context.Context, code string, amount float64, order *domainorder.Order) (*domaingiftcard.GiftCard, error) {
+	ctx, span := startSpan(ctx, "GiftCardService.TopUpGiftCard")
+	result, err := gs.svc.TopUpGiftCard(ctx, code, amount, order)
+	endSpan(span, err)
+
+	return result, err
+}
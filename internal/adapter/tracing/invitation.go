@@ -0,0 +1,50 @@
+package tracing
+
+import (
+	"context"
+
+	domainuser "github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain/user"
+	"github.com/TienMinh25/go-hexagonal-architecture/internal/application/port"
+)
+
+/**
+ * invitationService implements port.InvitationService interface
+ * and traces the use cases of the invitation service it wraps
+ */
+type invitationService struct {
+	svc port.InvitationService
+}
+
+// NewInvitationService wraps the invitation service to trace its use cases
+func NewInvitationService(svc port.InvitationService) port.InvitationService {
+	return &invitationService{
+		svc,
+	}
+}
+
+// CreateInvitation traces InvitationService.CreateInvitation
+func (is *invitationService) CreateInvitation(ctx context.Context, invitation *domainuser.Invitation) (*domainuser.Invitation, string, error) {
+	ctx, span := startSpan(ctx, "InvitationService.CreateInvitation")
+	result, value, err := is.svc.CreateInvitation(ctx, invitation)
+	endSpan(span, err)
+
+	return result, value, err
+}
+
+// ListInvitations traces InvitationService.ListInvitations
+func (is *invitationService) ListInvitations(ctx context.Context, skip, limit uint64) ([]domainuser.Invitation, error) {
+	ctx, span := startSpan(ctx, "InvitationService.ListInvitations")
+	result, err := is.svc.ListInvitations(ctx, skip, limit)
+	endSpan(span, err)
+
+	return result, err
+}
+
+// RevokeInvitation traces InvitationService.RevokeInvitation
+func (is *invitationService) RevokeInvitation(ctx context.Context, id uint64) (*domainuser.Invitation, error) {
+	ctx, span := startSpan(ctx, "InvitationService.RevokeInvitation")
+	result, err := is.svc.RevokeInvitation(ctx, id)
+	endSpan(span, err)
+
+	return result, err
+}
@@ -0,0 +1,32 @@
+package tracing
+
+import (
+	"context"
+
+	domainloyalty "github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain/loyalty"
+	"github.com/TienMinh25/go-hexagonal-architecture/internal/application/port"
+)
+
+/**
+ * loyaltyService implements port.LoyaltyService interface
+ * and traces the use cases of the loyalty service it wraps
+ */
+type loyaltyService struct {
+	svc port.LoyaltyService
+}
+
+// NewLoyaltyService wraps the loyalty service to trace its use cases
+func NewLoyaltyService(svc port.LoyaltyService) port.LoyaltyService {
+	return &loyaltyService{
+		svc,
+	}
+}
+
+// GetBalance traces LoyaltyService.GetBalance
+func (ls *loyaltyService) GetBalance(ctx context.Context, customerID uint64) (*domainloyalty.Balance, error) {
+	ctx, span := startSpan(ctx, "LoyaltyService.GetBalance")
+	result, err := ls.svc.GetBalance(ctx, customerID)
+	endSpan(span, err)
+
+	return result, err
+}
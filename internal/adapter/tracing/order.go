@@ -0,0 +1,59 @@
+package tracing
+
+import (
+	"context"
+
+	domainorder "github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain/order"
+	"github.com/TienMinh25/go-hexagonal-architecture/internal/application/port"
+)
+
+/**
+ * orderService implements port.OrderService interface
+ * and traces the use cases of the order service it wraps
+ */
+type orderService struct {
+	svc port.OrderService
+}
+
+// NewOrderService wraps the order service to trace its use cases
+func NewOrderService(svc port.OrderService) port.OrderService {
+	return &orderService{
+		svc,
+	}
+}
+
+// CreateOrder traces OrderService.CreateOrder
+func (os *orderService) CreateOrder(ctx context.Context, order *domainorder.Order) (*domainorder.Order, error) {
+	ctx, span := startSpan(ctx, "OrderService.CreateOrder")
+	result, err := os.svc.CreateOrder(ctx, order)
+	endSpan(span, err)
+
+	return result, err
+}
+
+// GetOrder traces OrderService.GetOrder
+func (os *orderService) GetOrder(ctx context.Context, id uint64) (*domainorder.Order, error) {
+	ctx, span := startSpan(ctx, "OrderService.GetOrder")
+	result, err := os.svc.GetOrder(ctx, id)
+	endSpan(span, err)
+
+	return result, err
+}
+
+// ListOrders traces OrderService.ListOrders
+func (os *orderService) ListOrders(ctx context.Context, customerID, skip, limit uint64) ([]domainorder.Order, error) {
+	ctx, span := startSpan(ctx, "OrderService.ListOrders")
+	result, err := os.svc.ListOrders(ctx, customerID, skip, limit)
+	endSpan(span, err)
+
+	return result, err
+}
+
+// RefundOrder traces OrderService.RefundOrder
+func (os *orderService) RefundOrder(ctx context.Context, id uint64) (*domainorder.Order, error) {
+	ctx, span := startSpan(ctx, "OrderService.RefundOrder")
+	result, err := os.svc.RefundOrder(ctx, id)
+	endSpan(span, err)
+
+	return result, err
+}
@@ -0,0 +1,54 @@
+package tracing
+
+import (
+	"context"
+
+	"github.com/TienMinh25/go-hexagonal-architecture/internal/application/port"
+)
+
+/**
+ * passwordService implements port.PasswordService interface
+ * and traces the use cases of the password service it wraps
+ */
+type passwordService struct {
+	svc port.PasswordService
+}
+
+// NewPasswordService wraps the password service to trace its use cases
+func NewPasswordService(svc port.PasswordService) port.PasswordService {
+	return &passwordService{
+		svc,
+	}
+}
+
+// ChangePassword traces PasswordService.ChangePassword
+func (ps *passwordService) ChangePassword(ctx context.Context, userID uint64, currentPassword, newPassword string) error {
+	ctx, span := startSpan(ctx, "PasswordService.ChangePassword")
+	err := ps.svc.ChangePassword(ctx, userID, currentPassword, newPassword)
+	endSpan(span, err)
+
+	return err
+}
+
+// RequestPasswordReset traces PasswordService.RequestPasswordReset
+func (ps *passwordService) RequestPasswordReset(ctx context.Context, email, ip string) error {
+	ctx, span := startSpan(ctx, "PasswordService.RequestPasswordReset")
+	err := ps.svc.RequestPasswordReset(ctx, email, ip)
+	endSpan(span, err)
+
+	return err
+}
+
+// ResetPassword traces PasswordService.ResetPassword
+func (ps *passwordService) ResetPassword(ctx context.Context, token, newPassword string) error {
+	ctx, span := startSpan(ctx, "PasswordService.ResetPassword")
+	err := ps.svc.ResetPassword(ctx, token, newPassword)
+	endSpan(span, err)
+
+	return err
+}
+
+// Close waits on PasswordService.Close, which is part of the shutdown rather than a use case to trace
+func (ps *passwordService) Close(ctx context.Context) error {
+	return ps.svc.Close(ctx)
+}
@@ -0,0 +1,77 @@
+package tracing
+
+import (
+	"context"
+
+	domainpayment "github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain/payment"
+	"github.com/TienMinh25/go-hexagonal-architecture/internal/application/port"
+)
+
+/**
+ * paymentService implements port.PaymentService interface
+ * and traces the use cases of the payment service it wraps
+ */
+type paymentService struct {
+	svc port.PaymentService
+}
+
+// NewPaymentService wraps the payment service to trace its use cases
+func NewPaymentService(svc port.PaymentService) port.PaymentService {
+	return &paymentService{
+		svc,
+	}
+}
+
+// CreatePayment traces PaymentService.CreatePayment
+func (ps *paymentService) CreatePayment(ctx context.Context, payment *domainpayment.Payment) (*domainpayment.Payment, error) {
+	ctx, span := startSpan(ctx, "PaymentService.CreatePayment")
+	result, err := ps.svc.CreatePayment(ctx, payment)
+	endSpan(span, err)
+
+	return result, err
+}
+
+// GetPayment traces PaymentService.GetPayment
+func (ps *paymentService) GetPayment(ctx context.Context, id uint64) (*domainpayment.Payment, error) {
+	ctx, span := startSpan(ctx, "PaymentService.GetPayment")
+	result, err := ps.svc.GetPayment(ctx, id)
+	endSpan(span, err)
+
+	return result, err
+}
+
+// ListPayments traces PaymentService.ListPayments
+func (ps *paymentService) ListPayments(ctx context.Context, skip, limit uint64, includeArchived bool) ([]domainpayment.Payment, error) {
+	ctx, span := startSpan(ctx, "PaymentService.ListPayments")
+	result, err := ps.svc.ListPayments(ctx, skip, limit, includeArchived)
+	endSpan(span, err)
+
+	return result, err
+}
+
+// UpdatePayment traces PaymentService.UpdatePayment
+func (ps *paymentService) UpdatePayment(ctx context.Context, payment *domainpayment.Payment) (*domainpayment.Payment, error) {
+	ctx, span := startSpan(ctx, "PaymentService.UpdatePayment")
+	result, err := ps.svc.UpdatePayment(ctx, payment)
+	endSpan(span, err)
+
+	return result, err
+}
+
+// DeletePayment traces PaymentService.DeletePayment
+func (ps *paymentService) DeletePayment(ctx context.Context, id, version uint64) error {
+	ctx, span := startSpan(ctx, "PaymentService.DeletePayment")
+	err := ps.svc.DeletePayment(ctx, id, version)
+	endSpan(span, err)
+
+	return err
+}
+
+// RestorePayment traces PaymentService.RestorePayment
+func (ps *paymentService) RestorePayment(ctx context.Context, id uint64) (*domainpayment.Payment, error) {
+	ctx, span := startSpan(ctx, "PaymentService.RestorePayment")
+	result, err := ps.svc.RestorePayment(ctx, id)
+	endSpan(span, err)
+
+	return result, err
+}
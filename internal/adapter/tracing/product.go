@@ -0,0 +1,77 @@
+package tracing
+
+import (
+	"context"
+
+	domainproduct "github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain/product"
+	"github.com/TienMinh25/go-hexagonal-architecture/internal/application/port"
+)
+
+/**
+ * productService implements port.ProductService interface
+ * and traces the use cases of the product service it wraps
+ */
+type productService struct {
+	svc port.ProductService
+}
+
+// NewProductService wraps the product service to trace its use cases
+func NewProductService(svc port.ProductService) port.ProductService {
+	return &productService{
+		svc,
+	}
+}
+
+// CreateProduct traces ProductService.CreateProduct
+func (ps *productService) CreateProduct(ctx context.Context, product *domainproduct.Product) (*domainproduct.Product, error) {
+	ctx, span := startSpan(ctx, "ProductService.CreateProduct")
+	result, err := ps.svc.CreateProduct(ctx, product)
+	endSpan(span, err)
+
+	return result, err
+}
+
+// GetProduct traces ProductService.GetProduct
+func (ps *productService) GetProduct(ctx context.Context, id uint64) (*domainproduct.Product, error) {
+	ctx, span := startSpan(ctx, "ProductService.GetProduct")
+	result, err := ps.svc.GetProduct(ctx, id)
+	endSpan(span, err)
+
+	return result, err
+}
+
+// ListProducts traces ProductService.ListProducts
+func (ps *productService) ListProducts(ctx context.Context, search string, categoryId, skip, limit uint64, includeArchived bool) ([]domainproduct.Product, error) {
+	ctx, span := startSpan(ctx, "ProductService.ListProducts")
+	result, err := ps.svc.ListProducts(ctx, search, categoryId, skip, limit, includeArchived)
+	endSpan(span, err)
+
+	return result, err
+}
+
+// UpdateProduct traces ProductService.UpdateProduct
+func (ps *productService) UpdateProduct(ctx context.Context, product *domainproduct.Product) (*domainproduct.Product, error) {
+	ctx, span := startSpan(ctx, "ProductService.UpdateProduct")
+	result, err := ps.svc.UpdateProduct(ctx, product)
+	endSpan(span, err)
+
+	return result, err
+}
+
+// DeleteProduct traces ProductService.DeleteProduct
+func (ps *productService) DeleteProduct(ctx context.Context, id, version uint64) error {
+	ctx, span := startSpan(ctx, "ProductService.DeleteProduct")
+	err := ps.svc.DeleteProduct(ctx, id, version)
+	endSpan(span, err)
+
+	return err
+}
+
+// RestoreProduct traces ProductService.RestoreProduct
+func (ps *productService) RestoreProduct(ctx context.Context, id uint64) (*domainproduct.Product, error) {
+	ctx, span := startSpan(ctx, "ProductService.RestoreProduct")
+	result, err := ps.svc.RestoreProduct(ctx, id)
+	endSpan(span, err)
+
+	return result, err
+}
@@ -0,0 +1,77 @@
+package tracing
+
+import (
+	"context"
+
+	domainrole "github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain/role"
+	"github.com/TienMinh25/go-hexagonal-architecture/internal/application/port"
+)
+
+/**
+ * roleService implements port.RoleService interface
+ * and traces the use cases of the role service it wraps
+ */
+type roleService struct {
+	svc port.RoleService
+}
+
+// NewRoleService wraps the role service to trace its use cases
+func NewRoleService(svc port.RoleService) port.RoleService {
+	return &roleService{
+		svc,
+	}
+}
+
+// CreateRole traces RoleService.CreateRole
+func (rs *roleService) CreateRole(ctx context.Context, role *domainrole.Role) (*domainrole.Role, error) {
+	ctx, span := startSpan(ctx, "RoleService.CreateRole")
+	result, err := rs.svc.CreateRole(ctx, role)
+	endSpan(span, err)
+
+	return result, err
+}
+
+// GetRole traces RoleService.GetRole
+func (rs *roleService) GetRole(ctx context.Context, id uint64) (*domainrole.Role, error) {
+	ctx, span := startSpan(ctx, "RoleService.GetRole")
+	result, err := rs.svc.GetRole(ctx, id)
+	endSpan(span, err)
+
+	return result, err
+}
+
+// ListRoles traces RoleService.ListRoles
+func (rs *roleService) ListRoles(ctx context.Context) ([]domainrole.Role, error) {
+	ctx, span := startSpan(ctx, "RoleService.ListRoles")
+	result, err := rs.svc.ListRoles(ctx)
+	endSpan(span, err)
+
+	return result, err
+}
+
+// ListPermissions traces RoleService.ListPermissions
+func (rs *roleService) ListPermissions(ctx context.Context) ([]domainrole.Permission, error) {
+	ctx, span := startSpan(ctx, "RoleService.ListPermissions")
+	result, err := rs.svc.ListPermissions(ctx)
+	endSpan(span, err)
+
+	return result, err
+}
+
+// SetRolePermissions traces RoleService.SetRolePermissions
+func (rs *roleService) SetRolePermissions(ctx context.Context, id uint64, permissions []string) (*domainrole.Role, error) {
+	ctx, span := startSpan(ctx, "RoleService.SetRolePermissions")
+	result, err := rs.svc.SetRolePermissions(ctx, id, permissions)
+	endSpan(span, err)
+
+	return result, err
+}
+
+// DeleteRole traces RoleService.DeleteRole
+func (rs *roleService) DeleteRole(ctx context.Context, id uint64) error {
+	ctx, span := startSpan(ctx, "RoleService.DeleteRole")
+	err := rs.svc.DeleteRole(ctx, id)
+	endSpan(span, err)
+
+	return err
+}
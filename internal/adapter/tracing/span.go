@@ -0,0 +1,32 @@
+package tracing
+
+import (
+	"context"
+	"errors"
+
+	"github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// instrumentationName names the tracer of the use case spans
+const instrumentationName = "github.com/TienMinh25/go-hexagonal-architecture/internal/adapter/tracing"
+
+// startSpan starts the span of a use case call, the name is the service and method called
+func startSpan(ctx context.Context, name string) (context.Context, trace.Span) {
+	return otel.Tracer(instrumentationName).Start(ctx, name)
+}
+
+// endSpan ends the span of a use case call with its error. Errors the client caused are expected outcomes
+// of a use case, the span only fails when the use case could not process the request
+func endSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		if errors.Is(err, domain.ErrInternal) {
+			span.SetStatus(codes.Error, err.Error())
+		}
+	}
+
+	span.End()
+}
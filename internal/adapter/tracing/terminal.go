@@ -0,0 +1,59 @@
+package tracing
+
+import (
+	"context"
+
+	domainterminal "github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain/terminal"
+	"github.com/TienMinh25/go-hexagonal-architecture/internal/application/port"
+)
+
+/**
+ * terminalService implements port.TerminalService interface
+ * and traces the use cases of the terminal service it wraps
+ */
+type terminalService struct {
+	svc port.TerminalService
+}
+
+// NewTerminalService wraps the terminal service to trace its use cases
+func NewTerminalService(svc port.TerminalService) port.TerminalService {
+	return &terminalService{
+		svc,
+	}
+}
+
+// RegisterTerminal traces TerminalService.RegisterTerminal
+func (ts *terminalService) RegisterTerminal(ctx context.Context, terminal *domainterminal.Terminal) (*domainterminal.Terminal, string, error) {
+	ctx, span := startSpan(ctx, "TerminalService.RegisterTerminal")
+	result, value, err := ts.svc.RegisterTerminal(ctx, terminal)
+	endSpan(span, err)
+
+	return result, value, err
+}
+
+// GetTerminal traces TerminalService.GetTerminal
+func (ts *terminalService) GetTerminal(ctx context.Context, id uint64) (*domainterminal.Terminal, error) {
+	ctx, span := startSpan(ctx, "TerminalService.GetTerminal")
+	result, err := ts.svc.GetTerminal(ctx, id)
+	endSpan(span, err)
+
+	return result, err
+}
+
+// ListTerminals traces TerminalService.ListTerminals
+func (ts *terminalService) ListTerminals(ctx context.Context, skip, limit uint64) ([]domainterminal.Terminal, error) {
+	ctx, span := startSpan(ctx, "TerminalService.ListTerminals")
+	result, err := ts.svc.ListTerminals(ctx, skip, limit)
+	endSpan(span, err)
+
+	return result, err
+}
+
+// RevokeTerminal traces TerminalService.RevokeTerminal
+func (ts *terminalService) RevokeTerminal(ctx context.Context, id uint64) (*domainterminal.Terminal, error) {
+	ctx, span := startSpan(ctx, "TerminalService.RevokeTerminal")
+	result, err := ts.svc.RevokeTerminal(ctx, id)
+	endSpan(span, err)
+
+	return result, err
+}
@@ -0,0 +1,56 @@
+package tracing
+
+import (
+	"context"
+
+	"github.com/TienMinh25/go-hexagonal-architecture/config"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.34.0"
+)
+
+// New creates a tracer provider exporting the spans of the application with the exporter of the config,
+// and registers it globally along with the W3C trace context and baggage propagators
+func New(ctx context.Context, app *config.App, config *config.Tracing) (*sdktrace.TracerProvider, error) {
+	var exporter sdktrace.SpanExporter
+	if config.Exporter == "otlp" {
+		var err error
+		exporter, err = otlptracehttp.New(ctx)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	provider := NewProvider(app.Name, config.SampleRatio, exporter)
+
+	otel.SetTracerProvider(provider)
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(
+		propagation.TraceContext{},
+		propagation.Baggage{},
+	))
+
+	return provider, nil
+}
+
+// NewProvider creates a tracer provider batching the sampled spans to the exporter,
+// no span is recorded without an exporter. Tests give an in-memory exporter to collect the spans in process
+func NewProvider(serviceName string, sampleRatio float64, exporter sdktrace.SpanExporter) *sdktrace.TracerProvider {
+	res, _ := resource.Merge(resource.Default(), resource.NewSchemaless(semconv.ServiceName(serviceName)))
+
+	if exporter == nil {
+		return sdktrace.NewTracerProvider(
+			sdktrace.WithResource(res),
+			sdktrace.WithSampler(sdktrace.NeverSample()),
+		)
+	}
+
+	// a trace sampled by the caller is always recorded so that it is not cut in the middle
+	return sdktrace.NewTracerProvider(
+		sdktrace.WithResource(res),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(sampleRatio))),
+		sdktrace.WithBatcher(exporter),
+	)
+}
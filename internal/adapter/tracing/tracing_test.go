@@ -0,0 +1,109 @@
+package tracing
+
+import (
+	"context"
+	"testing"
+
+	"github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain"
+	domainorder "github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain/order"
+	"github.com/TienMinh25/go-hexagonal-architecture/internal/application/mock"
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.uber.org/mock/gomock"
+)
+
+type createOrderSpanExpectedOutput struct {
+	name   string
+	status codes.Code
+	events int
+}
+
+func TestOrderService_CreateOrder(t *testing.T) {
+	exporter := tracetest.NewInMemoryExporter()
+	provider := NewProvider("test", 1, exporter)
+	otel.SetTracerProvider(provider)
+
+	order := &domainorder.Order{
+		ID:         gofakeit.Uint64(),
+		TotalPrice: 10,
+	}
+
+	testCases := []struct {
+		desc     string
+		mocks    func(orderService *mock.MockOrderService)
+		expected createOrderSpanExpectedOutput
+	}{
+		{
+			desc: "Success",
+			mocks: func(orderService *mock.MockOrderService) {
+				orderService.EXPECT().
+					CreateOrder(gomock.Any(), gomock.Eq(order)).
+					Return(order, nil)
+			},
+			expected: createOrderSpanExpectedOutput{
+				name:   "OrderService.CreateOrder",
+				status: codes.Unset,
+				events: 0,
+			},
+		},
+		{
+			desc: "Success_ClientErrorNotFailed",
+			mocks: func(orderService *mock.MockOrderService) {
+				orderService.EXPECT().
+					CreateOrder(gomock.Any(), gomock.Eq(order)).
+					Return(nil, domain.ErrInsufficientStock)
+			},
+			expected: createOrderSpanExpectedOutput{
+				name:   "OrderService.CreateOrder",
+				status: codes.Unset,
+				events: 1,
+			},
+		},
+		{
+			desc: "Fail_InternalError",
+			mocks: func(orderService *mock.MockOrderService) {
+				orderService.EXPECT().
+					CreateOrder(gomock.Any(), gomock.Eq(order)).
+					Return(nil, domain.ErrInternal)
+			},
+			expected: createOrderSpanExpectedOutput{
+				name:   "OrderService.CreateOrder",
+				status: codes.Error,
+				events: 1,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			exporter.Reset()
+
+			orderService := mock.NewMockOrderService(ctrl)
+
+			tc.mocks(orderService)
+
+			tracedService := NewOrderService(orderService)
+
+			ctx, parent := otel.Tracer("test").Start(context.Background(), "parent")
+			_, _ = tracedService.CreateOrder(ctx, order)
+			parent.End()
+
+			assert.NoError(t, provider.ForceFlush(context.Background()), "Flush mismatch")
+
+			spans := exporter.GetSpans()
+			assert.Len(t, spans, 2, "Spans mismatch")
+
+			span := spans[0]
+			assert.Equal(t, tc.expected.name, span.Name, "Name mismatch")
+			assert.Equal(t, tc.expected.status, span.Status.Code, "Status mismatch")
+			assert.Len(t, span.Events, tc.expected.events, "Events mismatch")
+			assert.Equal(t, parent.SpanContext().SpanID(), span.Parent.SpanID(), "Parent mismatch")
+		})
+	}
+}
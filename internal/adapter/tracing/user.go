@@ -0,0 +1,86 @@
+package tracing
+
+import (
+	"context"
+
+	domainuser "github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain/user"
+	"github.com/TienMinh25/go-hexagonal-architecture/internal/application/port"
+)
+
+/**
+ * userService implements port.UserService interface
+ * and traces the use cases of the user service it wraps
+ */
+type userService struct {
+	svc port.UserService
+}
+
+// NewUserService wraps the user service to trace its use cases
+func NewUserService(svc port.UserService) port.UserService {
+	return &userService{
+		svc,
+	}
+}
+
+// Register traces UserService.Register
+func (us *userService) Register(ctx context.Context, user *domainuser.User, invitationToken string) (*domainuser.User, error) {
+	ctx, span := startSpan(ctx, "UserService.Register")
+	result, err := us.svc.Register(ctx, user, invitationToken)
+	endSpan(span, err)
+
+	return result, err
+}
+
+// GetUser traces UserService.GetUser
+func (us *userService) GetUser(ctx context.Context, id uint64) (*domainuser.User, error) {
+	ctx, span := startSpan(ctx, "UserService.GetUser")
+	result, err := us.svc.GetUser(ctx, id)
+	endSpan(span, err)
+
+	return result, err
+}
+
+// ListUsers traces UserService.ListUsers
+func (us *userService) ListUsers(ctx context.Context, skip, limit uint64, includeArchived bool) ([]domainuser.User, error) {
+	ctx, span := startSpan(ctx, "UserService.ListUsers")
+	result, err := us.svc.ListUsers(ctx, skip, limit, includeArchived)
+	endSpan(span, err)
+
+	return result, err
+}
+
+// UpdateUser traces UserService.UpdateUser
+func (us *userService) UpdateUser(ctx context.Context, user *domainuser.User) (*domainuser.User, error) {
+	ctx, span := startSpan(ctx, "UserService.UpdateUser")
+	result, err := us.svc.UpdateUser(ctx, user)
+	endSpan(span, err)
+
+	return result, err
+}
+
+// UpdateMe traces UserService.UpdateMe
+func (us *userService) UpdateMe(ctx context.Context, user *domainuser.User, currentPassword string) (*domainuser.User, error) {
+	ctx, span := startSpan(ctx, "UserService.UpdateMe")
+	result, err := us.svc.UpdateMe(ctx, user, currentPassword)
+	endSpan(span, err)
+
+	return result, err
+}
+
+// DeleteUser traces UserService.DeleteUser
+func (us *userService) DeleteUser(ctx context.Context, id, version uint64) error {
+	ctx, span := startSpan(ctx, "UserService.DeleteUser")
+	err := us.svc.DeleteUser(ctx, id, version)
+	endSpan(span, err)
+
+	return err
+}
+
+// RestoreUser traces UserService.RestoreUser
+func (us *userService) RestoreUser(ctx context.Context, id uint64) (*domainuser.User, error) {
+	ctx, span := startSpan(ctx, "UserService.RestoreUser")
+	result, err := us.svc.RestoreUser(ctx, id)
+	endSpan(span, err)
+
+	return result, err
+}
@@ -0,0 +1,26 @@
+package domainaudit
+
+import "context"
+
+// Actor is an entity that represents who made a request and where it came from.
+// UserID is zero for requests that are not authenticated, APIKeyID is zero unless an API key was used
+type Actor struct {
+	UserID    uint64
+	APIKeyID  uint64
+	IP        string
+	RequestID string
+}
+
+// actorKey is the key of the actor in a context
+type actorKey struct{}
+
+// WithActor returns a copy of the context carrying the actor
+func WithActor(ctx context.Context, actor Actor) context.Context {
+	return context.WithValue(ctx, actorKey{}, actor)
+}
+
+// ActorFromContext returns the actor carried by the context, a zero actor when there is none
+func ActorFromContext(ctx context.Context) Actor {
+	actor, _ := ctx.Value(actorKey{}).(Actor)
+	return actor
+}
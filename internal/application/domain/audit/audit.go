@@ -0,0 +1,228 @@
+package domainaudit
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"hash"
+	"reflect"
+	"strconv"
+	"time"
+)
+
+// Action is an enum for the kind of change an audit log records
+type Action string
+
+// Action enum values
+const (
+	Create         Action = "create"
+	Update         Action = "update"
+	Delete         Action = "delete"
+	Restore        Action = "restore"
+	Revoke         Action = "revoke"
+	Refund         Action = "refund"
+	TopUp          Action = "top_up"
+	Unlock         Action = "unlock"
+	SetPIN         Action = "set_pin"
+	SetPermissions Action = "set_permissions"
+	ChangePassword Action = "change_password"
+	ResetPassword  Action = "reset_password"
+	Enable2FA      Action = "enable_2fa"
+	Disable2FA     Action = "disable_2fa"
+)
+
+// Entity names of the records audit logs are kept for
+const (
+	User       = "user"
+	Invitation = "invitation"
+	Role       = "role"
+	Terminal   = "terminal"
+	APIKey     = "api_key"
+	Payment    = "payment"
+	Category   = "category"
+	Product    = "product"
+	Customer   = "customer"
+	Order      = "order"
+	GiftCard   = "gift_card"
+)
+
+// redactedFields are never written to audit logs, whichever entity they belong to or are nested in
+var redactedFields = map[string]bool{
+	"Password":   true,
+	"SecretHash": true,
+	"KeyHash":    true,
+	"TokenHash":  true,
+	"Code":       true,
+}
+
+// Log is an entity that represents a change made through the API.
+// Logs are chained by hash, each one covering the hash of the log before it,
+// so that editing or removing a log breaks the chain from there on
+type Log struct {
+	ID        uint64
+	ActorID   uint64
+	APIKeyID  uint64
+	Action    Action
+	Entity    string
+	EntityID  uint64
+	Before    json.RawMessage
+	After     json.RawMessage
+	Changes   json.RawMessage
+	IP        string
+	RequestID string
+	PrevHash  string
+	Hash      string
+	CreatedAt time.Time
+}
+
+// Change is the value of a field before and after a change
+type Change struct {
+	From any `json:"from"`
+	To   any `json:"to"`
+}
+
+// Filter is an entity that represents the criteria audit logs are listed by, zero values match everything
+type Filter struct {
+	ActorID  uint64
+	Action   Action
+	Entity   string
+	EntityID uint64
+	From     *time.Time
+	To       *time.Time
+}
+
+// Verification is an entity that represents the result of checking the hash chain of the audit logs
+type Verification struct {
+	// Valid is false when a log does not match its hash or the hash of the log before it
+	Valid bool
+	// Checked is the number of logs checked
+	Checked uint64
+	// BrokenAt is the id of the first log that does not match, zero when the chain is valid
+	BrokenAt uint64
+}
+
+// NewLog creates a log of an action on an entity, snapshotting its state before and after the change
+// without secret fields and listing the fields that changed. Either state is nil when there is none
+func NewLog(action Action, entity string, entityID uint64, before, after any) (*Log, error) {
+	beforeFields, err := snapshot(before)
+	if err != nil {
+		return nil, err
+	}
+
+	afterFields, err := snapshot(after)
+	if err != nil {
+		return nil, err
+	}
+
+	log := &Log{
+		Action:   action,
+		Entity:   entity,
+		EntityID: entityID,
+	}
+
+	if log.Before, err = marshalFields(beforeFields); err != nil {
+		return nil, err
+	}
+	if log.After, err = marshalFields(afterFields); err != nil {
+		return nil, err
+	}
+	if log.Changes, err = json.Marshal(diff(beforeFields, afterFields)); err != nil {
+		return nil, err
+	}
+
+	return log, nil
+}
+
+// ComputeHash returns the hash of the log chained to the hash of the log before it
+func (l *Log) ComputeHash() string {
+	h := sha256.New()
+
+	writeField(h, l.PrevHash)
+	writeField(h, strconv.FormatUint(l.ActorID, 10))
+	writeField(h, strconv.FormatUint(l.APIKeyID, 10))
+	writeField(h, string(l.Action))
+	writeField(h, l.Entity)
+	writeField(h, strconv.FormatUint(l.EntityID, 10))
+	writeField(h, string(l.Before))
+	writeField(h, string(l.After))
+	writeField(h, string(l.Changes))
+	writeField(h, l.IP)
+	writeField(h, l.RequestID)
+	writeField(h, l.CreatedAt.UTC().Format(time.RFC3339Nano))
+
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// writeField writes a field prefixed with its length so that moving bytes between fields changes the hash
+func writeField(h hash.Hash, field string) {
+	fmt.Fprintf(h, "%d:%s", len(field), field)
+}
+
+// snapshot returns the fields of an entity by name, without secret fields
+func snapshot(entity any) (map[string]any, error) {
+	if entity == nil || reflect.ValueOf(entity).Kind() == reflect.Pointer && reflect.ValueOf(entity).IsNil() {
+		return nil, nil
+	}
+
+	data, err := json.Marshal(entity)
+	if err != nil {
+		return nil, err
+	}
+
+	var fields map[string]any
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, err
+	}
+
+	redact(fields)
+
+	return fields, nil
+}
+
+// redact removes the secret fields of a decoded entity and of the entities nested in it
+func redact(value any) {
+	switch value := value.(type) {
+	case map[string]any:
+		for field, fieldValue := range value {
+			if redactedFields[field] {
+				delete(value, field)
+				continue
+			}
+			redact(fieldValue)
+		}
+	case []any:
+		for _, item := range value {
+			redact(item)
+		}
+	}
+}
+
+// marshalFields encodes the fields of a snapshot, nil when there is no snapshot
+func marshalFields(fields map[string]any) (json.RawMessage, error) {
+	if fields == nil {
+		return nil, nil
+	}
+
+	return json.Marshal(fields)
+}
+
+// diff returns the fields whose value differs between two snapshots
+func diff(before, after map[string]any) map[string]Change {
+	changes := map[string]Change{}
+
+	for field, from := range before {
+		to, ok := after[field]
+		if !ok || !reflect.DeepEqual(from, to) {
+			changes[field] = Change{From: from, To: to}
+		}
+	}
+
+	for field, to := range after {
+		if _, ok := before[field]; !ok {
+			changes[field] = Change{From: nil, To: to}
+		}
+	}
+
+	return changes
+}
@@ -0,0 +1,18 @@
+package domainauth
+
+import "time"
+
+// APIKey is an entity that represents a key machine clients authenticate with, only its hash is stored.
+// A key acts on behalf of the user who created it but is only granted its scopes
+type APIKey struct {
+	ID         uint64
+	Name       string
+	Prefix     string
+	KeyHash    string
+	Scopes     []string
+	CreatedBy  uint64
+	ExpiresAt  *time.Time
+	LastUsedAt *time.Time
+	RevokedAt  *time.Time
+	CreatedAt  time.Time
+}
@@ -0,0 +1,17 @@
+package domainauth
+
+import "time"
+
+// LockoutPolicy is an entity that represents how failed logins are throttled
+type LockoutPolicy struct {
+	// MaxAttempts is the number of failed logins to an account before it is locked
+	MaxAttempts int64
+	// IPMaxAttempts is the number of failed logins from an address or terminal before it is backed off
+	IPMaxAttempts int64
+	// BaseDelay is the wait after the first throttled failure, doubled on every further failure
+	BaseDelay time.Duration
+	// MaxDelay caps the wait between attempts
+	MaxDelay time.Duration
+	// LockoutDuration is how long an account stays locked and failures are remembered
+	LockoutDuration time.Duration
+}
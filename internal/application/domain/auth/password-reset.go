@@ -0,0 +1,27 @@
+package domainauth
+
+import "time"
+
+// PasswordResetToken is an entity that represents a single-use password reset token, only its hash is stored
+type PasswordResetToken struct {
+	ID        uint64
+	UserID    uint64
+	TokenHash string
+	ExpiresAt time.Time
+	UsedAt    *time.Time
+	CreatedAt time.Time
+}
+
+// PasswordResetPolicy is an entity that represents how password reset tokens are issued
+type PasswordResetPolicy struct {
+	// Duration is how long a reset token can be used
+	Duration time.Duration
+	// URL is the link sent to the user, the token is added to it as the token query parameter
+	URL string
+	// MaxRequests is the number of resets an email can request within the window
+	MaxRequests int64
+	// IPMaxRequests is the number of resets a client ip can request within the window, whatever the emails
+	IPMaxRequests int64
+	// Window is how long reset requests are counted for
+	Window time.Duration
+}
@@ -0,0 +1,10 @@
+package domainauth
+
+import "crypto/ed25519"
+
+// PublicKey is an entity that represents a public key other services verify tokens with
+type PublicKey struct {
+	ID        string
+	Algorithm string
+	Key       ed25519.PublicKey
+}
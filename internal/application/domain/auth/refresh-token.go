@@ -0,0 +1,26 @@
+package domainauth
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// RefreshToken is an entity that represents a refresh token, only its hash is stored.
+// Tokens rotated from the same login share a family so that a reused token can revoke all of them
+type RefreshToken struct {
+	ID        uint64
+	UserID    uint64
+	TokenHash string
+	FamilyID  uuid.UUID
+	ExpiresAt time.Time
+	UsedAt    *time.Time
+	RevokedAt *time.Time
+	CreatedAt time.Time
+}
+
+// TokenPair is an entity that represents the tokens given to a user on login and refresh
+type TokenPair struct {
+	AccessToken  string
+	RefreshToken string
+}
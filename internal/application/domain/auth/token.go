@@ -1,13 +1,23 @@
 package domainauth
 
 import (
+	"time"
+
 	domainuser "github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain/user"
 	"github.com/google/uuid"
 )
 
-// TokenPayload is an entity that represents the payload of the token
+// TokenPayload is an entity that represents the payload of the token.
+// TerminalID is the terminal a cashier logged in on with a PIN, zero for other logins.
+// APIKeyID is the API key a machine client authenticated with, zero for tokens.
+// Locale is the language the user prefers messages in, empty when they have not chosen one
 type TokenPayload struct {
-	ID     uuid.UUID
-	UserID uint64
-	Role   domainuser.UserRole
+	ID          uuid.UUID
+	UserID      uint64
+	Role        domainuser.UserRole
+	Locale      string
+	Permissions []string
+	TerminalID  uint64
+	APIKeyID    uint64
+	ExpiredAt   time.Time
 }
@@ -0,0 +1,33 @@
+package domainauth
+
+import "time"
+
+// TOTP is an entity that represents a user's TOTP enrollment, it only guards logins once confirmed with a valid code
+type TOTP struct {
+	UserID      uint64
+	Secret      string
+	ConfirmedAt *time.Time
+	CreatedAt   time.Time
+}
+
+// TOTPEnrollment is an entity that represents a started TOTP enrollment,
+// the provisioning uri is shown as a QR code for authenticator apps to scan
+type TOTPEnrollment struct {
+	Secret          string
+	ProvisioningURI string
+}
+
+// TwoFactorPolicy is an entity that represents how two-factor authentication is enforced
+type TwoFactorPolicy struct {
+	// Issuer names the application in authenticator apps
+	Issuer string
+	// RequireAdmin withholds the permissions of admins until they enroll
+	RequireAdmin bool
+}
+
+// LoginResult is an entity that represents the outcome of a password login,
+// either the tokens or a challenge to complete with a second factor
+type LoginResult struct {
+	Tokens    *TokenPair
+	Challenge string
+}
@@ -0,0 +1,25 @@
+package domaincustomer
+
+import "time"
+
+// Customer is an entity that represents a registered customer
+type Customer struct {
+	ID        uint64
+	Name      string
+	Phone     string
+	Email     string
+	Notes     string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	DeletedAt *time.Time
+	Version   uint64
+}
+
+// PurchaseSummary is a value object that aggregates a customer's order history
+type PurchaseSummary struct {
+	CustomerID    uint64
+	Visits        uint64
+	LifetimeSpend float64
+	FirstVisitAt  *time.Time
+	LastVisitAt   *time.Time
+}
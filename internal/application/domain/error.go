@@ -1,38 +1,167 @@
 package domain
 
-import "errors"
+// Error is an error of the application with a stable code clients can rely on,
+// a message safe to show them and the cause it was raised for, which is only logged
+type Error struct {
+	Code    string
+	Message string
+	Cause   error
+}
+
+// newError creates an error without a cause, it is compared with errors.Is
+func newError(code, message string) *Error {
+	return &Error{
+		Code:    code,
+		Message: message,
+	}
+}
+
+// Error returns the message of the error followed by its cause
+func (e *Error) Error() string {
+	if e.Cause == nil {
+		return e.Message
+	}
+
+	return e.Message + ": " + e.Cause.Error()
+}
+
+// Unwrap returns the cause of the error
+func (e *Error) Unwrap() error {
+	return e.Cause
+}
+
+// Is reports whether the target has the same code, so the error matches its definition once wrapped
+func (e *Error) Is(target error) bool {
+	t, ok := target.(*Error)
+	return ok && t.Code == e.Code
+}
+
+// Wrap returns a copy of the error caused by err
+func (e *Error) Wrap(err error) error {
+	return &Error{
+		Code:    e.Code,
+		Message: e.Message,
+		Cause:   err,
+	}
+}
 
 var (
 	// ErrInternal is an error for when an internal service fails to process the request
-	ErrInternal = errors.New("internal error")
+	ErrInternal = newError("internal_error", "internal error")
+	// ErrInvalidRequest is an error for when the request fails validation
+	ErrInvalidRequest = newError("invalid_request", "request is invalid")
 	// ErrDataNotFound is an error for when requested data is not found
-	ErrDataNotFound = errors.New("data not found")
+	ErrDataNotFound = newError("data_not_found", "data not found")
 	// ErrNoUpdatedData is an error for when no data is provided to update
-	ErrNoUpdatedData = errors.New("no data to update")
+	ErrNoUpdatedData = newError("no_updated_data", "no data to update")
 	// ErrConflictingData is an error for when data conflicts with existing data
-	ErrConflictingData = errors.New("data conflicts with existing data in unique column")
+	ErrConflictingData = newError("conflicting_data", "data conflicts with existing data in unique column")
+	// ErrDataArchived is an error for when requested data has been archived
+	ErrDataArchived = newError("data_archived", "data has been archived")
+	// ErrDataNotArchived is an error for when data to restore has not been archived
+	ErrDataNotArchived = newError("data_not_archived", "data is not archived")
+	// ErrVersionMismatch is an error for when data has been modified since the version the client holds
+	ErrVersionMismatch = newError("version_mismatch", "data has been modified by another request")
+	// ErrVersionRequired is an error for when the version of the data to modify is not provided
+	ErrVersionRequired = newError("version_required", "data version is not provided in If-Match header")
 	// ErrInsufficientStock is an error for when product stock is not enough
-	ErrInsufficientStock = errors.New("product stock is not enough")
+	ErrInsufficientStock = newError("insufficient_stock", "product stock is not enough")
 	// ErrInsufficientPayment is an error for when total paid is less than total price
-	ErrInsufficientPayment = errors.New("total paid is less than total price")
+	ErrInsufficientPayment = newError("insufficient_payment", "total paid is less than total price")
+	// ErrCustomerRequired is an error for when points are redeemed without a customer
+	ErrCustomerRequired = newError("customer_required", "customer is required to redeem points")
+	// ErrRedemptionDisabled is an error for when points are redeemed while they have no value
+	ErrRedemptionDisabled = newError("redemption_disabled", "points redemption is disabled")
+	// ErrInsufficientPoints is an error for when customer points balance is not enough
+	ErrInsufficientPoints = newError("insufficient_points", "customer points balance is not enough")
+	// ErrRedemptionExceedsTotal is an error for when redeemed points are worth more than total price
+	ErrRedemptionExceedsTotal = newError("redemption_exceeds_total", "redeemed points exceed total price")
+	// ErrGiftCardRequired is an error for when a gift card payment is made without a gift card
+	ErrGiftCardRequired = newError("gift_card_required", "gift card code is required for gift card payments")
+	// ErrGiftCardExpired is an error for when the gift card has expired
+	ErrGiftCardExpired = newError("gift_card_expired", "gift card has expired")
+	// ErrInvalidGiftCardExpiry is an error for when a gift card is issued with an expiry in the past
+	ErrInvalidGiftCardExpiry = newError("invalid_gift_card_expiry", "gift card expiry must be in the future")
+	// ErrGiftCardPayment is an error for when a gift card is sold or topped up with a gift card payment
+	ErrGiftCardPayment = newError("gift_card_payment", "gift cards cannot be paid with a gift card")
+	// ErrInsufficientGiftCardBalance is an error for when the gift card balance is not enough
+	ErrInsufficientGiftCardBalance = newError("insufficient_gift_card_balance", "gift card balance is not enough")
+	// ErrOrderRefunded is an error for when the order has already been refunded
+	ErrOrderRefunded = newError("order_refunded", "order has already been refunded")
+	// ErrUnknownRole is an error for when a user is assigned a role that does not exist
+	ErrUnknownRole = newError("unknown_role", "role does not exist")
+	// ErrUnknownPermission is an error for when a role is granted a permission that does not exist
+	ErrUnknownPermission = newError("unknown_permission", "permission does not exist")
+	// ErrSystemRole is an error for when a built-in role is deleted or the admin role's permissions are changed
+	ErrSystemRole = newError("system_role", "built-in role cannot be modified")
+	// ErrRoleInUse is an error for when a role to delete is still assigned to users
+	ErrRoleInUse = newError("role_in_use", "role is still assigned to users")
+	// ErrRegistrationClosed is an error for when an account is registered or invited while registration is closed
+	ErrRegistrationClosed = newError("registration_closed", "registration is closed")
+	// ErrInvitationRequired is an error for when an account is registered without an invitation while registration is invite-only
+	ErrInvitationRequired = newError("invitation_required", "an invitation is required to register")
+	// ErrInvalidInvitation is an error for when the invitation is unknown, accepted, revoked, expired or for another email
+	ErrInvalidInvitation = newError("invalid_invitation", "invitation is invalid or has expired")
 	// ErrTokenDuration is an error for when the token duration format is invalid
-	ErrTokenDuration = errors.New("invalid token duration format")
+	ErrTokenDuration = newError("token_duration", "invalid token duration format")
+	// ErrTokenKey is an error for when the token keys are not configured properly
+	ErrTokenKey = newError("token_key", "invalid token key configuration")
 	// ErrTokenCreation is an error for when the token creation fails
-	ErrTokenCreation = errors.New("error creating token")
+	ErrTokenCreation = newError("token_creation", "error creating token")
 	// ErrExpiredToken is an error for when the access token is expired
-	ErrExpiredToken = errors.New("access token has expired")
+	ErrExpiredToken = newError("expired_token", "access token has expired")
 	// ErrInvalidToken is an error for when the access token is invalid
-	ErrInvalidToken = errors.New("access token is invalid")
+	ErrInvalidToken = newError("invalid_token", "access token is invalid")
+	// ErrRevokedToken is an error for when the access token has been revoked
+	ErrRevokedToken = newError("revoked_token", "access token has been revoked")
+	// ErrInvalidRefreshToken is an error for when the refresh token is invalid
+	ErrInvalidRefreshToken = newError("invalid_refresh_token", "refresh token is invalid")
+	// ErrExpiredRefreshToken is an error for when the refresh token is expired
+	ErrExpiredRefreshToken = newError("expired_refresh_token", "refresh token has expired")
+	// ErrRefreshTokenReused is an error for when an already rotated refresh token is used again
+	ErrRefreshTokenReused = newError("refresh_token_reused", "refresh token has already been used")
 	// ErrInvalidCredentials is an error for when the credentials are invalid
-	ErrInvalidCredentials = errors.New("invalid email or password")
+	ErrInvalidCredentials = newError("invalid_credentials", "invalid email or password")
+	// ErrIncorrectPassword is an error for when the current password given to change it is wrong
+	ErrIncorrectPassword = newError("incorrect_password", "current password is incorrect")
+	// ErrInvalidResetToken is an error for when the password reset token is unknown, used or expired
+	ErrInvalidResetToken = newError("invalid_reset_token", "password reset token is invalid or has expired")
+	// ErrInvalidPIN is an error for when the user or PIN of a terminal login is invalid
+	ErrInvalidPIN = newError("invalid_pin", "invalid user or PIN")
+	// ErrInvalidTerminal is an error for when the terminal is not registered, revoked or its secret is wrong
+	ErrInvalidTerminal = newError("invalid_terminal", "terminal is not registered or its credentials are invalid")
+	// ErrInvalidChallenge is an error for when the two-factor login challenge is unknown or has expired
+	ErrInvalidChallenge = newError("invalid_challenge", "login challenge is invalid or has expired")
+	// ErrInvalidTwoFactorCode is an error for when the TOTP or recovery code is invalid
+	ErrInvalidTwoFactorCode = newError("invalid_two_factor_code", "two-factor code is invalid")
+	// ErrTwoFactorEnabled is an error for when two-factor authentication is enrolled again while already enabled
+	ErrTwoFactorEnabled = newError("two_factor_enabled", "two-factor authentication is already enabled")
+	// ErrTwoFactorNotEnabled is an error for when two-factor authentication is confirmed or disabled without being enrolled
+	ErrTwoFactorNotEnabled = newError("two_factor_not_enabled", "two-factor authentication is not enabled")
+	// ErrTwoFactorRequired is an error for when two-factor authentication is disabled for a role that requires it
+	ErrTwoFactorRequired = newError("two_factor_required", "two-factor authentication is required for the role")
+	// ErrTooManyLoginAttempts is an error for when logins are attempted again before the backoff delay has passed
+	ErrTooManyLoginAttempts = newError("too_many_login_attempts", "too many failed login attempts, try again later")
+	// ErrTooManyResetRequests is an error for when password resets are requested again before the window has passed
+	ErrTooManyResetRequests = newError("too_many_reset_requests", "too many password reset requests, try again later")
+	// ErrAccountLocked is an error for when the account is locked after too many failed logins
+	ErrAccountLocked = newError("account_locked", "account is locked after too many failed login attempts")
+	// ErrInvalidAPIKey is an error for when the API key is unknown, revoked or expired
+	ErrInvalidAPIKey = newError("invalid_api_key", "API key is invalid or has expired")
+	// ErrInvalidAPIKeyExpiry is an error for when an API key is created with an expiry in the past
+	ErrInvalidAPIKeyExpiry = newError("invalid_api_key_expiry", "API key expiry must be in the future")
+	// ErrMigrationOutdated is an error for when the database schema is not at the latest migration
+	ErrMigrationOutdated = newError("migration_outdated", "database schema is not at the latest migration")
+	// ErrMigrationDirty is an error for when a migration failed halfway and left the database schema dirty
+	ErrMigrationDirty = newError("migration_dirty", "database schema is dirty after a failed migration")
 	// ErrEmptyAuthorizationHeader is an error for when the authorization header is empty
-	ErrEmptyAuthorizationHeader = errors.New("authorization header is not provided")
+	ErrEmptyAuthorizationHeader = newError("empty_authorization_header", "authorization header is not provided")
 	// ErrInvalidAuthorizationHeader is an error for when the authorization header is invalid
-	ErrInvalidAuthorizationHeader = errors.New("authorization header format is invalid")
+	ErrInvalidAuthorizationHeader = newError("invalid_authorization_header", "authorization header format is invalid")
 	// ErrInvalidAuthorizationType is an error for when the authorization type is invalid
-	ErrInvalidAuthorizationType = errors.New("authorization type is not supported")
+	ErrInvalidAuthorizationType = newError("invalid_authorization_type", "authorization type is not supported")
 	// ErrUnauthorized is an error for when the user is unauthorized
-	ErrUnauthorized = errors.New("user is unauthorized to access the resource")
+	ErrUnauthorized = newError("unauthorized", "user is unauthorized to access the resource")
 	// ErrForbidden is an error for when the user is forbidden to access the resource
-	ErrForbidden = errors.New("user is forbidden to access the resource")
+	ErrForbidden = newError("forbidden", "user is forbidden to access the resource")
 )
@@ -0,0 +1,35 @@
+package domaingiftcard
+
+import "time"
+
+// TransactionType is an enum for gift card transaction's type
+type TransactionType string
+
+// TransactionType enum values
+const (
+	Issue  TransactionType = "ISSUE"
+	TopUp  TransactionType = "TOP_UP"
+	Redeem TransactionType = "REDEEM"
+	Refund TransactionType = "REFUND"
+)
+
+// GiftCard is an entity that represents a prepaid gift card
+type GiftCard struct {
+	ID             uint64
+	Code           string
+	InitialBalance float64
+	Balance        float64
+	ExpiresAt      *time.Time
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+// Transaction is an entity that represents a movement of a gift card balance
+type Transaction struct {
+	ID         uint64
+	GiftCardID uint64
+	OrderID    *uint64
+	Type       TransactionType
+	Amount     float64
+	CreatedAt  time.Time
+}
@@ -0,0 +1,35 @@
+package domainhealth
+
+import "time"
+
+// Status is the health of the application or of one of its components
+type Status string
+
+// Status enum values
+const (
+	StatusUp   Status = "up"
+	StatusDown Status = "down"
+)
+
+// Component names
+const (
+	Database   = "database"
+	Migrations = "migrations"
+	Cache      = "cache"
+)
+
+// Component is the result of checking one dependency of the application
+type Component struct {
+	Name    string
+	Status  Status
+	Latency time.Duration
+	Error   string
+}
+
+// Report is the readiness of the application to serve requests with the result of each check,
+// the application is ready only when all of its components are up and it is not draining
+type Report struct {
+	Status     Status
+	Draining   bool
+	Components []Component
+}
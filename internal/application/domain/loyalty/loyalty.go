@@ -0,0 +1,41 @@
+package domainloyalty
+
+import "time"
+
+// EntryType is an enum for loyalty ledger entry's type
+type EntryType string
+
+// EntryType enum values
+const (
+	Earn     EntryType = "EARN"
+	Redeem   EntryType = "REDEEM"
+	Reversal EntryType = "REVERSAL"
+)
+
+// Entry is an entity that represents a loyalty points ledger entry,
+// earned points are positive and redeemed points are negative
+type Entry struct {
+	ID         uint64
+	CustomerID uint64
+	OrderID    *uint64
+	Type       EntryType
+	Points     int64
+	CreatedAt  time.Time
+}
+
+// Balance is an entity that represents the points balance of a customer
+type Balance struct {
+	CustomerID uint64
+	Points     int64
+	Value      float64
+}
+
+// Policy is an entity that represents how points are earned and redeemed
+type Policy struct {
+	// EarnRate is the number of points earned per currency unit spent
+	EarnRate float64
+	// CategoryEarnRates overrides EarnRate for products of a category
+	CategoryEarnRates map[uint64]float64
+	// PointValue is the currency value of one point when redeemed
+	PointValue float64
+}
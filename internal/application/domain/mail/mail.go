@@ -0,0 +1,8 @@
+package domainmail
+
+// Message is an entity that represents a plain text email sent to a single recipient
+type Message struct {
+	To      string
+	Subject string
+	Body    string
+}
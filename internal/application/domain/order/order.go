@@ -3,6 +3,7 @@ package domainorder
 import (
 	"time"
 
+	domaingiftcard "github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain/giftcard"
 	domainpayment "github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain/payment"
 	domainuser "github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain/user"
 	"github.com/google/uuid"
@@ -10,17 +11,35 @@ import (
 
 // Order is an entity that represents an order
 type Order struct {
-	ID           uint64
-	UserID       uint64
-	PaymentID    uint64
-	CustomerName string
-	TotalPrice   float64
-	TotalPaid    float64
-	TotalReturn  float64
-	ReceiptCode  uuid.UUID
-	CreatedAt    time.Time
-	UpdatedAt    time.Time
-	User         *domainuser.User
-	Payment      *domainpayment.Payment
-	Products     []OrderProduct
+	ID             uint64
+	UserID         uint64
+	PaymentID      uint64
+	CustomerID     *uint64
+	CustomerName   string
+	TotalPrice     float64
+	TotalPaid      float64
+	TotalReturn    float64
+	ReceiptCode    uuid.UUID
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+	PointsRedeemed int64
+	PointsEarned   int64
+	RefundedAt     *time.Time
+	GiftCardID     *uint64
+	GiftCardAmount float64
+	SoldGiftCardID *uint64
+	TerminalID     *uint64
+	User           *domainuser.User
+	Payment        *domainpayment.Payment
+	GiftCard       *domaingiftcard.GiftCard
+	Products       []OrderProduct
 }
+
+// Rejection is the reason an order is rejected
+type Rejection string
+
+// Rejection enum values
+const (
+	RejectedInsufficientStock   Rejection = "insufficient_stock"
+	RejectedInsufficientPayment Rejection = "insufficient_payment"
+)
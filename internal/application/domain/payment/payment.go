@@ -7,9 +7,10 @@ type PaymentType string
 
 // PaymentType enum values
 const (
-	Cash    PaymentType = "CASH"
-	EWallet PaymentType = "E-WALLET"
-	EDC     PaymentType = "EDC"
+	Cash     PaymentType = "CASH"
+	EWallet  PaymentType = "E-WALLET"
+	EDC      PaymentType = "EDC"
+	GiftCard PaymentType = "GIFT_CARD"
 )
 
 // Payment is an entity that represents a payment
@@ -20,4 +21,6 @@ type Payment struct {
 	Logo      string
 	CreatedAt time.Time
 	UpdatedAt time.Time
+	DeletedAt *time.Time
+	Version   uint64
 }
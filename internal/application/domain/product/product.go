@@ -18,5 +18,7 @@ type Product struct {
 	Image      string
 	CreatedAt  time.Time
 	UpdatedAt  time.Time
+	DeletedAt  *time.Time
+	Version    uint64
 	Category   *domaincategory.Category
 }
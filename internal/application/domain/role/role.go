@@ -0,0 +1,38 @@
+package domainrole
+
+import "time"
+
+// Permission names checked by the API
+const (
+	UsersWrite      = "users:write"
+	RolesManage     = "roles:manage"
+	PaymentsWrite   = "payments:write"
+	CategoriesWrite = "categories:write"
+	ProductsWrite   = "products:write"
+	CustomersDelete = "customers:delete"
+	OrdersRefund    = "orders:refund"
+	ReportsRead     = "reports:read"
+	GiftCardsWrite  = "gift_cards:write"
+	TerminalsManage = "terminals:manage"
+	APIKeysManage   = "api_keys:manage"
+	AuditLogsRead   = "audit_logs:read"
+)
+
+// Role is an entity that represents a named set of permissions assigned to users
+type Role struct {
+	ID          uint64
+	Name        string
+	Description string
+	IsSystem    bool
+	Permissions []string
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+// Permission is an entity that represents an action a role can be granted
+type Permission struct {
+	ID          uint64
+	Name        string
+	Description string
+	CreatedAt   time.Time
+}
@@ -0,0 +1,12 @@
+package domainterminal
+
+import "time"
+
+// Terminal is an entity that represents a registered POS terminal, only the hash of its secret is stored
+type Terminal struct {
+	ID         uint64
+	Name       string
+	SecretHash string
+	CreatedAt  time.Time
+	RevokedAt  *time.Time
+}
@@ -0,0 +1,39 @@
+package domainuser
+
+import "time"
+
+// RegistrationMode is an enum for who may register a new account
+type RegistrationMode string
+
+// RegistrationMode values
+const (
+	// RegistrationClosed disables self-registration
+	RegistrationClosed RegistrationMode = "closed"
+	// RegistrationInvite only lets invited emails register, with the role they were invited as
+	RegistrationInvite RegistrationMode = "invite"
+	// RegistrationOpen lets anyone register as a cashier, invited emails still get the role they were invited as
+	RegistrationOpen RegistrationMode = "open"
+)
+
+// RegistrationPolicy is an entity that represents how new accounts are registered
+type RegistrationPolicy struct {
+	// Mode is who may register
+	Mode RegistrationMode
+	// InvitationDuration is how long an invitation can be accepted
+	InvitationDuration time.Duration
+	// InvitationURL is the link sent to invited emails, the token is added to it as the token query parameter
+	InvitationURL string
+}
+
+// Invitation is an entity that represents an invitation to register with a role, only the hash of its token is stored
+type Invitation struct {
+	ID         uint64
+	Email      string
+	Role       UserRole
+	TokenHash  string
+	InvitedBy  uint64
+	ExpiresAt  time.Time
+	AcceptedAt *time.Time
+	RevokedAt  *time.Time
+	CreatedAt  time.Time
+}
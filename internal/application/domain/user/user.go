@@ -5,9 +5,10 @@ import "time"
 // UserRole is an enum for user's role
 type UserRole string
 
-// UserRole enum values
+// Built-in UserRole values, custom roles can be created alongside them
 const (
 	Admin   UserRole = "admin"
+	Manager UserRole = "manager"
 	Cashier UserRole = "cashier"
 )
 
@@ -18,6 +19,9 @@ type User struct {
 	Email     string
 	Password  string
 	Role      UserRole
+	Locale    string
 	CreatedAt time.Time
 	UpdatedAt time.Time
+	DeletedAt *time.Time
+	Version   uint64
 }
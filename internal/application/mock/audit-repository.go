@@ -0,0 +1,87 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/TienMinh25/go-hexagonal-architecture/internal/application/port (interfaces: AuditRepository)
+//
+// Generated by this command:
+//
+//	mockgen -destination=../mock/audit-repository.go -package=mock github.com/TienMinh25/go-hexagonal-architecture/internal/application/port AuditRepository
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	domainaudit "github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain/audit"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockAuditRepository is a mock of AuditRepository interface.
+type MockAuditRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockAuditRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockAuditRepositoryMockRecorder is the mock recorder for MockAuditRepository.
+type MockAuditRepositoryMockRecorder struct {
+	mock *MockAuditRepository
+}
+
+// NewMockAuditRepository creates a new mock instance.
+func NewMockAuditRepository(ctrl *gomock.Controller) *MockAuditRepository {
+	mock := &MockAuditRepository{ctrl: ctrl}
+	mock.recorder = &MockAuditRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAuditRepository) EXPECT() *MockAuditRepositoryMockRecorder {
+	return m.recorder
+}
+
+// AppendAuditLog mocks base method.
+func (m *MockAuditRepository) AppendAuditLog(ctx context.Context, log *domainaudit.Log) (*domainaudit.Log, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AppendAuditLog", ctx, log)
+	ret0, _ := ret[0].(*domainaudit.Log)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AppendAuditLog indicates an expected call of AppendAuditLog.
+func (mr *MockAuditRepositoryMockRecorder) AppendAuditLog(ctx, log any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AppendAuditLog", reflect.TypeOf((*MockAuditRepository)(nil).AppendAuditLog), ctx, log)
+}
+
+// ListAuditLogs mocks base method.
+func (m *MockAuditRepository) ListAuditLogs(ctx context.Context, filter domainaudit.Filter, skip, limit uint64) ([]domainaudit.Log, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAuditLogs", ctx, filter, skip, limit)
+	ret0, _ := ret[0].([]domainaudit.Log)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAuditLogs indicates an expected call of ListAuditLogs.
+func (mr *MockAuditRepositoryMockRecorder) ListAuditLogs(ctx, filter, skip, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAuditLogs", reflect.TypeOf((*MockAuditRepository)(nil).ListAuditLogs), ctx, filter, skip, limit)
+}
+
+// ListAuditLogsAfter mocks base method.
+func (m *MockAuditRepository) ListAuditLogsAfter(ctx context.Context, afterID, limit uint64) ([]domainaudit.Log, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAuditLogsAfter", ctx, afterID, limit)
+	ret0, _ := ret[0].([]domainaudit.Log)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAuditLogsAfter indicates an expected call of ListAuditLogsAfter.
+func (mr *MockAuditRepositoryMockRecorder) ListAuditLogsAfter(ctx, afterID, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAuditLogsAfter", reflect.TypeOf((*MockAuditRepository)(nil).ListAuditLogsAfter), ctx, afterID, limit)
+}
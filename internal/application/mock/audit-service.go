@@ -0,0 +1,86 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/TienMinh25/go-hexagonal-architecture/internal/application/port (interfaces: AuditService)
+//
+// Generated by this command:
+//
+//	mockgen -destination=../mock/audit-service.go -package=mock github.com/TienMinh25/go-hexagonal-architecture/internal/application/port AuditService
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	domainaudit "github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain/audit"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockAuditService is a mock of AuditService interface.
+type MockAuditService struct {
+	ctrl     *gomock.Controller
+	recorder *MockAuditServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockAuditServiceMockRecorder is the mock recorder for MockAuditService.
+type MockAuditServiceMockRecorder struct {
+	mock *MockAuditService
+}
+
+// NewMockAuditService creates a new mock instance.
+func NewMockAuditService(ctrl *gomock.Controller) *MockAuditService {
+	mock := &MockAuditService{ctrl: ctrl}
+	mock.recorder = &MockAuditServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAuditService) EXPECT() *MockAuditServiceMockRecorder {
+	return m.recorder
+}
+
+// ListAuditLogs mocks base method.
+func (m *MockAuditService) ListAuditLogs(ctx context.Context, filter domainaudit.Filter, skip, limit uint64) ([]domainaudit.Log, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAuditLogs", ctx, filter, skip, limit)
+	ret0, _ := ret[0].([]domainaudit.Log)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAuditLogs indicates an expected call of ListAuditLogs.
+func (mr *MockAuditServiceMockRecorder) ListAuditLogs(ctx, filter, skip, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAuditLogs", reflect.TypeOf((*MockAuditService)(nil).ListAuditLogs), ctx, filter, skip, limit)
+}
+
+// Record mocks base method.
+func (m *MockAuditService) Record(ctx context.Context, action domainaudit.Action, entity string, entityID uint64, before, after any) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Record", ctx, action, entity, entityID, before, after)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Record indicates an expected call of Record.
+func (mr *MockAuditServiceMockRecorder) Record(ctx, action, entity, entityID, before, after any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Record", reflect.TypeOf((*MockAuditService)(nil).Record), ctx, action, entity, entityID, before, after)
+}
+
+// VerifyAuditLogs mocks base method.
+func (m *MockAuditService) VerifyAuditLogs(ctx context.Context) (*domainaudit.Verification, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VerifyAuditLogs", ctx)
+	ret0, _ := ret[0].(*domainaudit.Verification)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VerifyAuditLogs indicates an expected call of VerifyAuditLogs.
+func (mr *MockAuditServiceMockRecorder) VerifyAuditLogs(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyAuditLogs", reflect.TypeOf((*MockAuditService)(nil).VerifyAuditLogs), ctx)
+}
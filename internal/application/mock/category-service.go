@@ -57,17 +57,17 @@ func (mr *MockCategoryServiceMockRecorder) CreateCategory(ctx, category any) *go
 }
 
 // DeleteCategory mocks base method.
-func (m *MockCategoryService) DeleteCategory(ctx context.Context, id uint64) error {
+func (m *MockCategoryService) DeleteCategory(ctx context.Context, id, version uint64) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteCategory", ctx, id)
+	ret := m.ctrl.Call(m, "DeleteCategory", ctx, id, version)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // DeleteCategory indicates an expected call of DeleteCategory.
-func (mr *MockCategoryServiceMockRecorder) DeleteCategory(ctx, id any) *gomock.Call {
+func (mr *MockCategoryServiceMockRecorder) DeleteCategory(ctx, id, version any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCategory", reflect.TypeOf((*MockCategoryService)(nil).DeleteCategory), ctx, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCategory", reflect.TypeOf((*MockCategoryService)(nil).DeleteCategory), ctx, id, version)
 }
 
 // GetCategory mocks base method.
@@ -86,18 +86,33 @@ func (mr *MockCategoryServiceMockRecorder) GetCategory(ctx, id any) *gomock.Call
 }
 
 // ListCategories mocks base method.
-func (m *MockCategoryService) ListCategories(ctx context.Context, skip, limit uint64) ([]domaincategory.Category, error) {
+func (m *MockCategoryService) ListCategories(ctx context.Context, skip, limit uint64, includeArchived bool) ([]domaincategory.Category, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListCategories", ctx, skip, limit)
+	ret := m.ctrl.Call(m, "ListCategories", ctx, skip, limit, includeArchived)
 	ret0, _ := ret[0].([]domaincategory.Category)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListCategories indicates an expected call of ListCategories.
-func (mr *MockCategoryServiceMockRecorder) ListCategories(ctx, skip, limit any) *gomock.Call {
+func (mr *MockCategoryServiceMockRecorder) ListCategories(ctx, skip, limit, includeArchived any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCategories", reflect.TypeOf((*MockCategoryService)(nil).ListCategories), ctx, skip, limit)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCategories", reflect.TypeOf((*MockCategoryService)(nil).ListCategories), ctx, skip, limit, includeArchived)
+}
+
+// RestoreCategory mocks base method.
+func (m *MockCategoryService) RestoreCategory(ctx context.Context, id uint64) (*domaincategory.Category, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreCategory", ctx, id)
+	ret0, _ := ret[0].(*domaincategory.Category)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RestoreCategory indicates an expected call of RestoreCategory.
+func (mr *MockCategoryServiceMockRecorder) RestoreCategory(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreCategory", reflect.TypeOf((*MockCategoryService)(nil).RestoreCategory), ctx, id)
 }
 
 // UpdateCategory mocks base method.
@@ -0,0 +1,146 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/TienMinh25/go-hexagonal-architecture/internal/application/port (interfaces: CustomerRepository)
+//
+// Generated by this command:
+//
+//	mockgen -destination=../mock/customer-repository.go -package=mock github.com/TienMinh25/go-hexagonal-architecture/internal/application/port CustomerRepository
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	domaincustomer "github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain/customer"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockCustomerRepository is a mock of CustomerRepository interface.
+type MockCustomerRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockCustomerRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockCustomerRepositoryMockRecorder is the mock recorder for MockCustomerRepository.
+type MockCustomerRepositoryMockRecorder struct {
+	mock *MockCustomerRepository
+}
+
+// NewMockCustomerRepository creates a new mock instance.
+func NewMockCustomerRepository(ctrl *gomock.Controller) *MockCustomerRepository {
+	mock := &MockCustomerRepository{ctrl: ctrl}
+	mock.recorder = &MockCustomerRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCustomerRepository) EXPECT() *MockCustomerRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CreateCustomer mocks base method.
+func (m *MockCustomerRepository) CreateCustomer(ctx context.Context, customer *domaincustomer.Customer) (*domaincustomer.Customer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateCustomer", ctx, customer)
+	ret0, _ := ret[0].(*domaincustomer.Customer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateCustomer indicates an expected call of CreateCustomer.
+func (mr *MockCustomerRepositoryMockRecorder) CreateCustomer(ctx, customer any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCustomer", reflect.TypeOf((*MockCustomerRepository)(nil).CreateCustomer), ctx, customer)
+}
+
+// DeleteCustomer mocks base method.
+func (m *MockCustomerRepository) DeleteCustomer(ctx context.Context, id, version uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteCustomer", ctx, id, version)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteCustomer indicates an expected call of DeleteCustomer.
+func (mr *MockCustomerRepositoryMockRecorder) DeleteCustomer(ctx, id, version any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCustomer", reflect.TypeOf((*MockCustomerRepository)(nil).DeleteCustomer), ctx, id, version)
+}
+
+// GetCustomerByID mocks base method.
+func (m *MockCustomerRepository) GetCustomerByID(ctx context.Context, id uint64) (*domaincustomer.Customer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCustomerByID", ctx, id)
+	ret0, _ := ret[0].(*domaincustomer.Customer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCustomerByID indicates an expected call of GetCustomerByID.
+func (mr *MockCustomerRepositoryMockRecorder) GetCustomerByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCustomerByID", reflect.TypeOf((*MockCustomerRepository)(nil).GetCustomerByID), ctx, id)
+}
+
+// GetPurchaseSummary mocks base method.
+func (m *MockCustomerRepository) GetPurchaseSummary(ctx context.Context, id uint64) (*domaincustomer.PurchaseSummary, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPurchaseSummary", ctx, id)
+	ret0, _ := ret[0].(*domaincustomer.PurchaseSummary)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPurchaseSummary indicates an expected call of GetPurchaseSummary.
+func (mr *MockCustomerRepositoryMockRecorder) GetPurchaseSummary(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPurchaseSummary", reflect.TypeOf((*MockCustomerRepository)(nil).GetPurchaseSummary), ctx, id)
+}
+
+// ListCustomers mocks base method.
+func (m *MockCustomerRepository) ListCustomers(ctx context.Context, search string, skip, limit uint64, includeArchived bool) ([]domaincustomer.Customer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCustomers", ctx, search, skip, limit, includeArchived)
+	ret0, _ := ret[0].([]domaincustomer.Customer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCustomers indicates an expected call of ListCustomers.
+func (mr *MockCustomerRepositoryMockRecorder) ListCustomers(ctx, search, skip, limit, includeArchived any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCustomers", reflect.TypeOf((*MockCustomerRepository)(nil).ListCustomers), ctx, search, skip, limit, includeArchived)
+}
+
+// RestoreCustomer mocks base method.
+func (m *MockCustomerRepository) RestoreCustomer(ctx context.Context, id uint64) (*domaincustomer.Customer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreCustomer", ctx, id)
+	ret0, _ := ret[0].(*domaincustomer.Customer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RestoreCustomer indicates an expected call of RestoreCustomer.
+func (mr *MockCustomerRepositoryMockRecorder) RestoreCustomer(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreCustomer", reflect.TypeOf((*MockCustomerRepository)(nil).RestoreCustomer), ctx, id)
+}
+
+// UpdateCustomer mocks base method.
+func (m *MockCustomerRepository) UpdateCustomer(ctx context.Context, customer *domaincustomer.Customer) (*domaincustomer.Customer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateCustomer", ctx, customer)
+	ret0, _ := ret[0].(*domaincustomer.Customer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateCustomer indicates an expected call of UpdateCustomer.
+func (mr *MockCustomerRepositoryMockRecorder) UpdateCustomer(ctx, customer any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCustomer", reflect.TypeOf((*MockCustomerRepository)(nil).UpdateCustomer), ctx, customer)
+}
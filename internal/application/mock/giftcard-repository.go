@@ -0,0 +1,88 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/TienMinh25/go-hexagonal-architecture/internal/application/port (interfaces: GiftCardRepository)
+//
+// Generated by this command:
+//
+//	mockgen -destination=../mock/giftcard-repository.go -package=mock github.com/TienMinh25/go-hexagonal-architecture/internal/application/port GiftCardRepository
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	domaingiftcard "github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain/giftcard"
+	domainorder "github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain/order"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockGiftCardRepository is a mock of GiftCardRepository interface.
+type MockGiftCardRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockGiftCardRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockGiftCardRepositoryMockRecorder is the mock recorder for MockGiftCardRepository.
+type MockGiftCardRepositoryMockRecorder struct {
+	mock *MockGiftCardRepository
+}
+
+// NewMockGiftCardRepository creates a new mock instance.
+func NewMockGiftCardRepository(ctrl *gomock.Controller) *MockGiftCardRepository {
+	mock := &MockGiftCardRepository{ctrl: ctrl}
+	mock.recorder = &MockGiftCardRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGiftCardRepository) EXPECT() *MockGiftCardRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CreateGiftCard mocks base method.
+func (m *MockGiftCardRepository) CreateGiftCard(ctx context.Context, giftCard *domaingiftcard.GiftCard, order *domainorder.Order) (*domaingiftcard.GiftCard, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateGiftCard", ctx, giftCard, order)
+	ret0, _ := ret[0].(*domaingiftcard.GiftCard)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateGiftCard indicates an expected call of CreateGiftCard.
+func (mr *MockGiftCardRepositoryMockRecorder) CreateGiftCard(ctx, giftCard, order any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateGiftCard", reflect.TypeOf((*MockGiftCardRepository)(nil).CreateGiftCard), ctx, giftCard, order)
+}
+
+// GetGiftCardByCode mocks base method.
+func (m *MockGiftCardRepository) GetGiftCardByCode(ctx context.Context, code string) (*domaingiftcard.GiftCard, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGiftCardByCode", ctx, code)
+	ret0, _ := ret[0].(*domaingiftcard.GiftCard)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetGiftCardByCode indicates an expected call of GetGiftCardByCode.
+func (mr *MockGiftCardRepositoryMockRecorder) GetGiftCardByCode(ctx, code any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGiftCardByCode", reflect.TypeOf((*MockGiftCardRepository)(nil).GetGiftCardByCode), ctx, code)
+}
+
+// TopUpGiftCard mocks base method.
+func (m *MockGiftCardRepository) TopUpGiftCard(ctx context.Context, id uint64, amount float64, order *domainorder.Order) (*domaingiftcard.GiftCard, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TopUpGiftCard", ctx, id, amount, order)
+	ret0, _ := ret[0].(*domaingiftcard.GiftCard)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TopUpGiftCard indicates an expected call of TopUpGiftCard.
+func (mr *MockGiftCardRepositoryMockRecorder) TopUpGiftCard(ctx, id, amount, order any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TopUpGiftCard", reflect.TypeOf((*MockGiftCardRepository)(nil).TopUpGiftCard), ctx, id, amount, order)
+}
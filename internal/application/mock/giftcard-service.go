@@ -0,0 +1,88 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/TienMinh25/go-hexagonal-architecture/internal/application/port (interfaces: GiftCardService)
+//
+// Generated by this command:
+//
+//	mockgen -destination=../mock/giftcard-service.go -package=mock github.com/TienMinh25/go-hexagonal-architecture/internal/application/port GiftCardService
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	domaingiftcard "github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain/giftcard"
+	domainorder "github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain/order"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockGiftCardService is a mock of GiftCardService interface.
+type MockGiftCardService struct {
+	ctrl     *gomock.Controller
+	recorder *MockGiftCardServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockGiftCardServiceMockRecorder is the mock recorder for MockGiftCardService.
+type MockGiftCardServiceMockRecorder struct {
+	mock *MockGiftCardService
+}
+
+// NewMockGiftCardService creates a new mock instance.
+func NewMockGiftCardService(ctrl *gomock.Controller) *MockGiftCardService {
+	mock := &MockGiftCardService{ctrl: ctrl}
+	mock.recorder = &MockGiftCardServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGiftCardService) EXPECT() *MockGiftCardServiceMockRecorder {
+	return m.recorder
+}
+
+// GetGiftCard mocks base method.
+func (m *MockGiftCardService) GetGiftCard(ctx context.Context, code string) (*domaingiftcard.GiftCard, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGiftCard", ctx, code)
+	ret0, _ := ret[0].(*domaingiftcard.GiftCard)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetGiftCard indicates an expected call of GetGiftCard.
+func (mr *MockGiftCardServiceMockRecorder) GetGiftCard(ctx, code any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGiftCard", reflect.TypeOf((*MockGiftCardService)(nil).GetGiftCard), ctx, code)
+}
+
+// IssueGiftCard mocks base method.
+func (m *MockGiftCardService) IssueGiftCard(ctx context.Context, giftCard *domaingiftcard.GiftCard, order *domainorder.Order) (*domaingiftcard.GiftCard, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IssueGiftCard", ctx, giftCard, order)
+	ret0, _ := ret[0].(*domaingiftcard.GiftCard)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IssueGiftCard indicates an expected call of IssueGiftCard.
+func (mr *MockGiftCardServiceMockRecorder) IssueGiftCard(ctx, giftCard, order any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IssueGiftCard", reflect.TypeOf((*MockGiftCardService)(nil).IssueGiftCard), ctx, giftCard, order)
+}
+
+// TopUpGiftCard mocks base method.
+func (m *MockGiftCardService) TopUpGiftCard(ctx context.Context, code string, amount float64, order *domainorder.Order) (*domaingiftcard.GiftCard, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TopUpGiftCard", ctx, code, amount, order)
+	ret0, _ := ret[0].(*domaingiftcard.GiftCard)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TopUpGiftCard indicates an expected call of TopUpGiftCard.
+func (mr *MockGiftCardServiceMockRecorder) TopUpGiftCard(ctx, code, amount, order any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TopUpGiftCard", reflect.TypeOf((*MockGiftCardService)(nil).TopUpGiftCard), ctx, code, amount, order)
+}
@@ -0,0 +1,88 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/TienMinh25/go-hexagonal-architecture/internal/application/port (interfaces: InvitationService)
+//
+// Generated by this command:
+//
+//	mockgen -destination=../mock/invitation-service.go -package=mock github.com/TienMinh25/go-hexagonal-architecture/internal/application/port InvitationService
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	domainuser "github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain/user"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockInvitationService is a mock of InvitationService interface.
+type MockInvitationService struct {
+	ctrl     *gomock.Controller
+	recorder *MockInvitationServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockInvitationServiceMockRecorder is the mock recorder for MockInvitationService.
+type MockInvitationServiceMockRecorder struct {
+	mock *MockInvitationService
+}
+
+// NewMockInvitationService creates a new mock instance.
+func NewMockInvitationService(ctrl *gomock.Controller) *MockInvitationService {
+	mock := &MockInvitationService{ctrl: ctrl}
+	mock.recorder = &MockInvitationServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockInvitationService) EXPECT() *MockInvitationServiceMockRecorder {
+	return m.recorder
+}
+
+// CreateInvitation mocks base method.
+func (m *MockInvitationService) CreateInvitation(ctx context.Context, invitation *domainuser.Invitation) (*domainuser.Invitation, string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateInvitation", ctx, invitation)
+	ret0, _ := ret[0].(*domainuser.Invitation)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// CreateInvitation indicates an expected call of CreateInvitation.
+func (mr *MockInvitationServiceMockRecorder) CreateInvitation(ctx, invitation any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateInvitation", reflect.TypeOf((*MockInvitationService)(nil).CreateInvitation), ctx, invitation)
+}
+
+// ListInvitations mocks base method.
+func (m *MockInvitationService) ListInvitations(ctx context.Context, skip, limit uint64) ([]domainuser.Invitation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListInvitations", ctx, skip, limit)
+	ret0, _ := ret[0].([]domainuser.Invitation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListInvitations indicates an expected call of ListInvitations.
+func (mr *MockInvitationServiceMockRecorder) ListInvitations(ctx, skip, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListInvitations", reflect.TypeOf((*MockInvitationService)(nil).ListInvitations), ctx, skip, limit)
+}
+
+// RevokeInvitation mocks base method.
+func (m *MockInvitationService) RevokeInvitation(ctx context.Context, id uint64) (*domainuser.Invitation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeInvitation", ctx, id)
+	ret0, _ := ret[0].(*domainuser.Invitation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RevokeInvitation indicates an expected call of RevokeInvitation.
+func (mr *MockInvitationServiceMockRecorder) RevokeInvitation(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeInvitation", reflect.TypeOf((*MockInvitationService)(nil).RevokeInvitation), ctx, id)
+}
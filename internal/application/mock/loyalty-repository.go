@@ -0,0 +1,56 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/TienMinh25/go-hexagonal-architecture/internal/application/port (interfaces: LoyaltyRepository)
+//
+// Generated by this command:
+//
+//	mockgen -destination=../mock/loyalty-repository.go -package=mock github.com/TienMinh25/go-hexagonal-architecture/internal/application/port LoyaltyRepository
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockLoyaltyRepository is a mock of LoyaltyRepository interface.
+type MockLoyaltyRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockLoyaltyRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockLoyaltyRepositoryMockRecorder is the mock recorder for MockLoyaltyRepository.
+type MockLoyaltyRepositoryMockRecorder struct {
+	mock *MockLoyaltyRepository
+}
+
+// NewMockLoyaltyRepository creates a new mock instance.
+func NewMockLoyaltyRepository(ctrl *gomock.Controller) *MockLoyaltyRepository {
+	mock := &MockLoyaltyRepository{ctrl: ctrl}
+	mock.recorder = &MockLoyaltyRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLoyaltyRepository) EXPECT() *MockLoyaltyRepositoryMockRecorder {
+	return m.recorder
+}
+
+// GetBalance mocks base method.
+func (m *MockLoyaltyRepository) GetBalance(ctx context.Context, customerID uint64) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBalance", ctx, customerID)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBalance indicates an expected call of GetBalance.
+func (mr *MockLoyaltyRepositoryMockRecorder) GetBalance(ctx, customerID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBalance", reflect.TypeOf((*MockLoyaltyRepository)(nil).GetBalance), ctx, customerID)
+}
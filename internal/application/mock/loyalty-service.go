@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/TienMinh25/go-hexagonal-architecture/internal/application/port (interfaces: LoyaltyService)
+//
+// Generated by this command:
+//
+//	mockgen -destination=../mock/loyalty-service.go -package=mock github.com/TienMinh25/go-hexagonal-architecture/internal/application/port LoyaltyService
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	domainloyalty "github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain/loyalty"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockLoyaltyService is a mock of LoyaltyService interface.
+type MockLoyaltyService struct {
+	ctrl     *gomock.Controller
+	recorder *MockLoyaltyServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockLoyaltyServiceMockRecorder is the mock recorder for MockLoyaltyService.
+type MockLoyaltyServiceMockRecorder struct {
+	mock *MockLoyaltyService
+}
+
+// NewMockLoyaltyService creates a new mock instance.
+func NewMockLoyaltyService(ctrl *gomock.Controller) *MockLoyaltyService {
+	mock := &MockLoyaltyService{ctrl: ctrl}
+	mock.recorder = &MockLoyaltyServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLoyaltyService) EXPECT() *MockLoyaltyServiceMockRecorder {
+	return m.recorder
+}
+
+// GetBalance mocks base method.
+func (m *MockLoyaltyService) GetBalance(ctx context.Context, customerID uint64) (*domainloyalty.Balance, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBalance", ctx, customerID)
+	ret0, _ := ret[0].(*domainloyalty.Balance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBalance indicates an expected call of GetBalance.
+func (mr *MockLoyaltyServiceMockRecorder) GetBalance(ctx, customerID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBalance", reflect.TypeOf((*MockLoyaltyService)(nil).GetBalance), ctx, customerID)
+}
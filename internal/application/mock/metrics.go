@@ -0,0 +1,90 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: metrics.go
+//
+// Generated by this command:
+//
+//	mockgen -source=metrics.go -destination=../mock/metrics.go -package=mock
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	reflect "reflect"
+	time "time"
+
+	domainorder "github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain/order"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockMetrics is a mock of Metrics interface.
+type MockMetrics struct {
+	ctrl     *gomock.Controller
+	recorder *MockMetricsMockRecorder
+	isgomock struct{}
+}
+
+// MockMetricsMockRecorder is the mock recorder for MockMetrics.
+type MockMetricsMockRecorder struct {
+	mock *MockMetrics
+}
+
+// NewMockMetrics creates a new mock instance.
+func NewMockMetrics(ctrl *gomock.Controller) *MockMetrics {
+	mock := &MockMetrics{ctrl: ctrl}
+	mock.recorder = &MockMetricsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMetrics) EXPECT() *MockMetricsMockRecorder {
+	return m.recorder
+}
+
+// ObserveCacheLookup mocks base method.
+func (m *MockMetrics) ObserveCacheLookup(hit bool) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "ObserveCacheLookup", hit)
+}
+
+// ObserveCacheLookup indicates an expected call of ObserveCacheLookup.
+func (mr *MockMetricsMockRecorder) ObserveCacheLookup(hit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ObserveCacheLookup", reflect.TypeOf((*MockMetrics)(nil).ObserveCacheLookup), hit)
+}
+
+// ObserveRequest mocks base method.
+func (m *MockMetrics) ObserveRequest(method, route string, status int, duration time.Duration) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "ObserveRequest", method, route, status, duration)
+}
+
+// ObserveRequest indicates an expected call of ObserveRequest.
+func (mr *MockMetricsMockRecorder) ObserveRequest(method, route, status, duration any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ObserveRequest", reflect.TypeOf((*MockMetrics)(nil).ObserveRequest), method, route, status, duration)
+}
+
+// OrderCreated mocks base method.
+func (m *MockMetrics) OrderCreated(totalPrice float64) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "OrderCreated", totalPrice)
+}
+
+// OrderCreated indicates an expected call of OrderCreated.
+func (mr *MockMetricsMockRecorder) OrderCreated(totalPrice any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OrderCreated", reflect.TypeOf((*MockMetrics)(nil).OrderCreated), totalPrice)
+}
+
+// OrderRejected mocks base method.
+func (m *MockMetrics) OrderRejected(reason domainorder.Rejection) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "OrderRejected", reason)
+}
+
+// OrderRejected indicates an expected call of OrderRejected.
+func (mr *MockMetricsMockRecorder) OrderRejected(reason any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OrderRejected", reflect.TypeOf((*MockMetrics)(nil).OrderRejected), reason)
+}
@@ -72,16 +72,31 @@ func (mr *MockOrderRepositoryMockRecorder) GetOrderByID(ctx, id any) *gomock.Cal
 }
 
 // ListOrders mocks base method.
-func (m *MockOrderRepository) ListOrders(ctx context.Context, skip, limit uint64) ([]domainorder.Order, error) {
+func (m *MockOrderRepository) ListOrders(ctx context.Context, customerID, skip, limit uint64) ([]domainorder.Order, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListOrders", ctx, skip, limit)
+	ret := m.ctrl.Call(m, "ListOrders", ctx, customerID, skip, limit)
 	ret0, _ := ret[0].([]domainorder.Order)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListOrders indicates an expected call of ListOrders.
-func (mr *MockOrderRepositoryMockRecorder) ListOrders(ctx, skip, limit any) *gomock.Call {
+func (mr *MockOrderRepositoryMockRecorder) ListOrders(ctx, customerID, skip, limit any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOrders", reflect.TypeOf((*MockOrderRepository)(nil).ListOrders), ctx, skip, limit)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOrders", reflect.TypeOf((*MockOrderRepository)(nil).ListOrders), ctx, customerID, skip, limit)
+}
+
+// RefundOrder mocks base method.
+func (m *MockOrderRepository) RefundOrder(ctx context.Context, id uint64, points int64) (*domainorder.Order, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RefundOrder", ctx, id, points)
+	ret0, _ := ret[0].(*domainorder.Order)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RefundOrder indicates an expected call of RefundOrder.
+func (mr *MockOrderRepositoryMockRecorder) RefundOrder(ctx, id, points any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefundOrder", reflect.TypeOf((*MockOrderRepository)(nil).RefundOrder), ctx, id, points)
 }
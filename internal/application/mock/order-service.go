@@ -72,16 +72,31 @@ func (mr *MockOrderServiceMockRecorder) GetOrder(ctx, id any) *gomock.Call {
 }
 
 // ListOrders mocks base method.
-func (m *MockOrderService) ListOrders(ctx context.Context, skip, limit uint64) ([]domainorder.Order, error) {
+func (m *MockOrderService) ListOrders(ctx context.Context, customerID, skip, limit uint64) ([]domainorder.Order, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListOrders", ctx, skip, limit)
+	ret := m.ctrl.Call(m, "ListOrders", ctx, customerID, skip, limit)
 	ret0, _ := ret[0].([]domainorder.Order)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListOrders indicates an expected call of ListOrders.
-func (mr *MockOrderServiceMockRecorder) ListOrders(ctx, skip, limit any) *gomock.Call {
+func (mr *MockOrderServiceMockRecorder) ListOrders(ctx, customerID, skip, limit any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOrders", reflect.TypeOf((*MockOrderService)(nil).ListOrders), ctx, skip, limit)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOrders", reflect.TypeOf((*MockOrderService)(nil).ListOrders), ctx, customerID, skip, limit)
+}
+
+// RefundOrder mocks base method.
+func (m *MockOrderService) RefundOrder(ctx context.Context, id uint64) (*domainorder.Order, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RefundOrder", ctx, id)
+	ret0, _ := ret[0].(*domainorder.Order)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RefundOrder indicates an expected call of RefundOrder.
+func (mr *MockOrderServiceMockRecorder) RefundOrder(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefundOrder", reflect.TypeOf((*MockOrderService)(nil).RefundOrder), ctx, id)
 }
@@ -57,17 +57,17 @@ func (mr *MockPaymentRepositoryMockRecorder) CreatePayment(ctx, payment any) *go
 }
 
 // DeletePayment mocks base method.
-func (m *MockPaymentRepository) DeletePayment(ctx context.Context, id uint64) error {
+func (m *MockPaymentRepository) DeletePayment(ctx context.Context, id, version uint64) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeletePayment", ctx, id)
+	ret := m.ctrl.Call(m, "DeletePayment", ctx, id, version)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // DeletePayment indicates an expected call of DeletePayment.
-func (mr *MockPaymentRepositoryMockRecorder) DeletePayment(ctx, id any) *gomock.Call {
+func (mr *MockPaymentRepositoryMockRecorder) DeletePayment(ctx, id, version any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePayment", reflect.TypeOf((*MockPaymentRepository)(nil).DeletePayment), ctx, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePayment", reflect.TypeOf((*MockPaymentRepository)(nil).DeletePayment), ctx, id, version)
 }
 
 // GetPaymentByID mocks base method.
@@ -86,18 +86,33 @@ func (mr *MockPaymentRepositoryMockRecorder) GetPaymentByID(ctx, id any) *gomock
 }
 
 // ListPayments mocks base method.
-func (m *MockPaymentRepository) ListPayments(ctx context.Context, skip, limit uint64) ([]domainpayment.Payment, error) {
+func (m *MockPaymentRepository) ListPayments(ctx context.Context, skip, limit uint64, includeArchived bool) ([]domainpayment.Payment, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListPayments", ctx, skip, limit)
+	ret := m.ctrl.Call(m, "ListPayments", ctx, skip, limit, includeArchived)
 	ret0, _ := ret[0].([]domainpayment.Payment)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListPayments indicates an expected call of ListPayments.
-func (mr *MockPaymentRepositoryMockRecorder) ListPayments(ctx, skip, limit any) *gomock.Call {
+func (mr *MockPaymentRepositoryMockRecorder) ListPayments(ctx, skip, limit, includeArchived any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPayments", reflect.TypeOf((*MockPaymentRepository)(nil).ListPayments), ctx, skip, limit)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPayments", reflect.TypeOf((*MockPaymentRepository)(nil).ListPayments), ctx, skip, limit, includeArchived)
+}
+
+// RestorePayment mocks base method.
+func (m *MockPaymentRepository) RestorePayment(ctx context.Context, id uint64) (*domainpayment.Payment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestorePayment", ctx, id)
+	ret0, _ := ret[0].(*domainpayment.Payment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RestorePayment indicates an expected call of RestorePayment.
+func (mr *MockPaymentRepositoryMockRecorder) RestorePayment(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestorePayment", reflect.TypeOf((*MockPaymentRepository)(nil).RestorePayment), ctx, id)
 }
 
 // UpdatePayment mocks base method.
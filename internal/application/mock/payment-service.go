@@ -57,17 +57,17 @@ func (mr *MockPaymentServiceMockRecorder) CreatePayment(ctx, payment any) *gomoc
 }
 
 // DeletePayment mocks base method.
-func (m *MockPaymentService) DeletePayment(ctx context.Context, id uint64) error {
+func (m *MockPaymentService) DeletePayment(ctx context.Context, id, version uint64) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeletePayment", ctx, id)
+	ret := m.ctrl.Call(m, "DeletePayment", ctx, id, version)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // DeletePayment indicates an expected call of DeletePayment.
-func (mr *MockPaymentServiceMockRecorder) DeletePayment(ctx, id any) *gomock.Call {
+func (mr *MockPaymentServiceMockRecorder) DeletePayment(ctx, id, version any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePayment", reflect.TypeOf((*MockPaymentService)(nil).DeletePayment), ctx, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePayment", reflect.TypeOf((*MockPaymentService)(nil).DeletePayment), ctx, id, version)
 }
 
 // GetPayment mocks base method.
@@ -86,18 +86,33 @@ func (mr *MockPaymentServiceMockRecorder) GetPayment(ctx, id any) *gomock.Call {
 }
 
 // ListPayments mocks base method.
-func (m *MockPaymentService) ListPayments(ctx context.Context, skip, limit uint64) ([]domainpayment.Payment, error) {
+func (m *MockPaymentService) ListPayments(ctx context.Context, skip, limit uint64, includeArchived bool) ([]domainpayment.Payment, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListPayments", ctx, skip, limit)
+	ret := m.ctrl.Call(m, "ListPayments", ctx, skip, limit, includeArchived)
 	ret0, _ := ret[0].([]domainpayment.Payment)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListPayments indicates an expected call of ListPayments.
-func (mr *MockPaymentServiceMockRecorder) ListPayments(ctx, skip, limit any) *gomock.Call {
+func (mr *MockPaymentServiceMockRecorder) ListPayments(ctx, skip, limit, includeArchived any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPayments", reflect.TypeOf((*MockPaymentService)(nil).ListPayments), ctx, skip, limit)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPayments", reflect.TypeOf((*MockPaymentService)(nil).ListPayments), ctx, skip, limit, includeArchived)
+}
+
+// RestorePayment mocks base method.
+func (m *MockPaymentService) RestorePayment(ctx context.Context, id uint64) (*domainpayment.Payment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestorePayment", ctx, id)
+	ret0, _ := ret[0].(*domainpayment.Payment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RestorePayment indicates an expected call of RestorePayment.
+func (mr *MockPaymentServiceMockRecorder) RestorePayment(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestorePayment", reflect.TypeOf((*MockPaymentService)(nil).RestorePayment), ctx, id)
 }
 
 // UpdatePayment mocks base method.
@@ -57,17 +57,17 @@ func (mr *MockProductRepositoryMockRecorder) CreateProduct(ctx, product any) *go
 }
 
 // DeleteProduct mocks base method.
-func (m *MockProductRepository) DeleteProduct(ctx context.Context, id uint64) error {
+func (m *MockProductRepository) DeleteProduct(ctx context.Context, id, version uint64) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteProduct", ctx, id)
+	ret := m.ctrl.Call(m, "DeleteProduct", ctx, id, version)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // DeleteProduct indicates an expected call of DeleteProduct.
-func (mr *MockProductRepositoryMockRecorder) DeleteProduct(ctx, id any) *gomock.Call {
+func (mr *MockProductRepositoryMockRecorder) DeleteProduct(ctx, id, version any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteProduct", reflect.TypeOf((*MockProductRepository)(nil).DeleteProduct), ctx, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteProduct", reflect.TypeOf((*MockProductRepository)(nil).DeleteProduct), ctx, id, version)
 }
 
 // GetProductByID mocks base method.
@@ -86,18 +86,33 @@ func (mr *MockProductRepositoryMockRecorder) GetProductByID(ctx, id any) *gomock
 }
 
 // ListProducts mocks base method.
-func (m *MockProductRepository) ListProducts(ctx context.Context, search string, categoryId, skip, limit uint64) ([]domainproduct.Product, error) {
+func (m *MockProductRepository) ListProducts(ctx context.Context, search string, categoryId, skip, limit uint64, includeArchived bool) ([]domainproduct.Product, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListProducts", ctx, search, categoryId, skip, limit)
+	ret := m.ctrl.Call(m, "ListProducts", ctx, search, categoryId, skip, limit, includeArchived)
 	ret0, _ := ret[0].([]domainproduct.Product)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListProducts indicates an expected call of ListProducts.
-func (mr *MockProductRepositoryMockRecorder) ListProducts(ctx, search, categoryId, skip, limit any) *gomock.Call {
+func (mr *MockProductRepositoryMockRecorder) ListProducts(ctx, search, categoryId, skip, limit, includeArchived any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListProducts", reflect.TypeOf((*MockProductRepository)(nil).ListProducts), ctx, search, categoryId, skip, limit)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListProducts", reflect.TypeOf((*MockProductRepository)(nil).ListProducts), ctx, search, categoryId, skip, limit, includeArchived)
+}
+
+// RestoreProduct mocks base method.
+func (m *MockProductRepository) RestoreProduct(ctx context.Context, id uint64) (*domainproduct.Product, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreProduct", ctx, id)
+	ret0, _ := ret[0].(*domainproduct.Product)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RestoreProduct indicates an expected call of RestoreProduct.
+func (mr *MockProductRepositoryMockRecorder) RestoreProduct(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreProduct", reflect.TypeOf((*MockProductRepository)(nil).RestoreProduct), ctx, id)
 }
 
 // UpdateProduct mocks base method.
@@ -57,17 +57,17 @@ func (mr *MockProductServiceMockRecorder) CreateProduct(ctx, product any) *gomoc
 }
 
 // DeleteProduct mocks base method.
-func (m *MockProductService) DeleteProduct(ctx context.Context, id uint64) error {
+func (m *MockProductService) DeleteProduct(ctx context.Context, id, version uint64) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteProduct", ctx, id)
+	ret := m.ctrl.Call(m, "DeleteProduct", ctx, id, version)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // DeleteProduct indicates an expected call of DeleteProduct.
-func (mr *MockProductServiceMockRecorder) DeleteProduct(ctx, id any) *gomock.Call {
+func (mr *MockProductServiceMockRecorder) DeleteProduct(ctx, id, version any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteProduct", reflect.TypeOf((*MockProductService)(nil).DeleteProduct), ctx, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteProduct", reflect.TypeOf((*MockProductService)(nil).DeleteProduct), ctx, id, version)
 }
 
 // GetProduct mocks base method.
@@ -86,18 +86,33 @@ func (mr *MockProductServiceMockRecorder) GetProduct(ctx, id any) *gomock.Call {
 }
 
 // ListProducts mocks base method.
-func (m *MockProductService) ListProducts(ctx context.Context, search string, categoryId, skip, limit uint64) ([]domainproduct.Product, error) {
+func (m *MockProductService) ListProducts(ctx context.Context, search string, categoryId, skip, limit uint64, includeArchived bool) ([]domainproduct.Product, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListProducts", ctx, search, categoryId, skip, limit)
+	ret := m.ctrl.Call(m, "ListProducts", ctx, search, categoryId, skip, limit, includeArchived)
 	ret0, _ := ret[0].([]domainproduct.Product)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListProducts indicates an expected call of ListProducts.
-func (mr *MockProductServiceMockRecorder) ListProducts(ctx, search, categoryId, skip, limit any) *gomock.Call {
+func (mr *MockProductServiceMockRecorder) ListProducts(ctx, search, categoryId, skip, limit, includeArchived any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListProducts", reflect.TypeOf((*MockProductService)(nil).ListProducts), ctx, search, categoryId, skip, limit)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListProducts", reflect.TypeOf((*MockProductService)(nil).ListProducts), ctx, search, categoryId, skip, limit, includeArchived)
+}
+
+// RestoreProduct mocks base method.
+func (m *MockProductService) RestoreProduct(ctx context.Context, id uint64) (*domainproduct.Product, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreProduct", ctx, id)
+	ret0, _ := ret[0].(*domainproduct.Product)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RestoreProduct indicates an expected call of RestoreProduct.
+func (mr *MockProductServiceMockRecorder) RestoreProduct(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreProduct", reflect.TypeOf((*MockProductService)(nil).RestoreProduct), ctx, id)
 }
 
 // UpdateProduct mocks base method.
@@ -0,0 +1,102 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/TienMinh25/go-hexagonal-architecture/internal/application/port (interfaces: TerminalRepository)
+//
+// Generated by this command:
+//
+//	mockgen -destination=../mock/terminal-repository.go -package=mock github.com/TienMinh25/go-hexagonal-architecture/internal/application/port TerminalRepository
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	domainterminal "github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain/terminal"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockTerminalRepository is a mock of TerminalRepository interface.
+type MockTerminalRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockTerminalRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockTerminalRepositoryMockRecorder is the mock recorder for MockTerminalRepository.
+type MockTerminalRepositoryMockRecorder struct {
+	mock *MockTerminalRepository
+}
+
+// NewMockTerminalRepository creates a new mock instance.
+func NewMockTerminalRepository(ctrl *gomock.Controller) *MockTerminalRepository {
+	mock := &MockTerminalRepository{ctrl: ctrl}
+	mock.recorder = &MockTerminalRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTerminalRepository) EXPECT() *MockTerminalRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CreateTerminal mocks base method.
+func (m *MockTerminalRepository) CreateTerminal(ctx context.Context, terminal *domainterminal.Terminal) (*domainterminal.Terminal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTerminal", ctx, terminal)
+	ret0, _ := ret[0].(*domainterminal.Terminal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTerminal indicates an expected call of CreateTerminal.
+func (mr *MockTerminalRepositoryMockRecorder) CreateTerminal(ctx, terminal any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTerminal", reflect.TypeOf((*MockTerminalRepository)(nil).CreateTerminal), ctx, terminal)
+}
+
+// GetTerminalByID mocks base method.
+func (m *MockTerminalRepository) GetTerminalByID(ctx context.Context, id uint64) (*domainterminal.Terminal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTerminalByID", ctx, id)
+	ret0, _ := ret[0].(*domainterminal.Terminal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTerminalByID indicates an expected call of GetTerminalByID.
+func (mr *MockTerminalRepositoryMockRecorder) GetTerminalByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTerminalByID", reflect.TypeOf((*MockTerminalRepository)(nil).GetTerminalByID), ctx, id)
+}
+
+// ListTerminals mocks base method.
+func (m *MockTerminalRepository) ListTerminals(ctx context.Context, skip, limit uint64) ([]domainterminal.Terminal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTerminals", ctx, skip, limit)
+	ret0, _ := ret[0].([]domainterminal.Terminal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTerminals indicates an expected call of ListTerminals.
+func (mr *MockTerminalRepositoryMockRecorder) ListTerminals(ctx, skip, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTerminals", reflect.TypeOf((*MockTerminalRepository)(nil).ListTerminals), ctx, skip, limit)
+}
+
+// RevokeTerminal mocks base method.
+func (m *MockTerminalRepository) RevokeTerminal(ctx context.Context, id uint64) (*domainterminal.Terminal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeTerminal", ctx, id)
+	ret0, _ := ret[0].(*domainterminal.Terminal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RevokeTerminal indicates an expected call of RevokeTerminal.
+func (mr *MockTerminalRepositoryMockRecorder) RevokeTerminal(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeTerminal", reflect.TypeOf((*MockTerminalRepository)(nil).RevokeTerminal), ctx, id)
+}
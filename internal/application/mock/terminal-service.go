@@ -0,0 +1,103 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/TienMinh25/go-hexagonal-architecture/internal/application/port (interfaces: TerminalService)
+//
+// Generated by this command:
+//
+//	mockgen -destination=../mock/terminal-service.go -package=mock github.com/TienMinh25/go-hexagonal-architecture/internal/application/port TerminalService
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	domainterminal "github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain/terminal"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockTerminalService is a mock of TerminalService interface.
+type MockTerminalService struct {
+	ctrl     *gomock.Controller
+	recorder *MockTerminalServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockTerminalServiceMockRecorder is the mock recorder for MockTerminalService.
+type MockTerminalServiceMockRecorder struct {
+	mock *MockTerminalService
+}
+
+// NewMockTerminalService creates a new mock instance.
+func NewMockTerminalService(ctrl *gomock.Controller) *MockTerminalService {
+	mock := &MockTerminalService{ctrl: ctrl}
+	mock.recorder = &MockTerminalServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTerminalService) EXPECT() *MockTerminalServiceMockRecorder {
+	return m.recorder
+}
+
+// GetTerminal mocks base method.
+func (m *MockTerminalService) GetTerminal(ctx context.Context, id uint64) (*domainterminal.Terminal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTerminal", ctx, id)
+	ret0, _ := ret[0].(*domainterminal.Terminal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTerminal indicates an expected call of GetTerminal.
+func (mr *MockTerminalServiceMockRecorder) GetTerminal(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTerminal", reflect.TypeOf((*MockTerminalService)(nil).GetTerminal), ctx, id)
+}
+
+// ListTerminals mocks base method.
+func (m *MockTerminalService) ListTerminals(ctx context.Context, skip, limit uint64) ([]domainterminal.Terminal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTerminals", ctx, skip, limit)
+	ret0, _ := ret[0].([]domainterminal.Terminal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTerminals indicates an expected call of ListTerminals.
+func (mr *MockTerminalServiceMockRecorder) ListTerminals(ctx, skip, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTerminals", reflect.TypeOf((*MockTerminalService)(nil).ListTerminals), ctx, skip, limit)
+}
+
+// RegisterTerminal mocks base method.
+func (m *MockTerminalService) RegisterTerminal(ctx context.Context, terminal *domainterminal.Terminal) (*domainterminal.Terminal, string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RegisterTerminal", ctx, terminal)
+	ret0, _ := ret[0].(*domainterminal.Terminal)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// RegisterTerminal indicates an expected call of RegisterTerminal.
+func (mr *MockTerminalServiceMockRecorder) RegisterTerminal(ctx, terminal any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterTerminal", reflect.TypeOf((*MockTerminalService)(nil).RegisterTerminal), ctx, terminal)
+}
+
+// RevokeTerminal mocks base method.
+func (m *MockTerminalService) RevokeTerminal(ctx context.Context, id uint64) (*domainterminal.Terminal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeTerminal", ctx, id)
+	ret0, _ := ret[0].(*domainterminal.Terminal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RevokeTerminal indicates an expected call of RevokeTerminal.
+func (mr *MockTerminalServiceMockRecorder) RevokeTerminal(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeTerminal", reflect.TypeOf((*MockTerminalService)(nil).RevokeTerminal), ctx, id)
+}
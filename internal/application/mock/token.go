@@ -10,6 +10,7 @@
 package mock
 
 import (
+	context "context"
 	reflect "reflect"
 
 	domainauth "github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain/auth"
@@ -41,32 +42,75 @@ func (m *MockTokenService) EXPECT() *MockTokenServiceMockRecorder {
 	return m.recorder
 }
 
+// CreateTerminalToken mocks base method.
+func (m *MockTokenService) CreateTerminalToken(user *domainuser.User, permissions []string, terminalID uint64) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTerminalToken", user, permissions, terminalID)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTerminalToken indicates an expected call of CreateTerminalToken.
+func (mr *MockTokenServiceMockRecorder) CreateTerminalToken(user, permissions, terminalID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTerminalToken", reflect.TypeOf((*MockTokenService)(nil).CreateTerminalToken), user, permissions, terminalID)
+}
+
 // CreateToken mocks base method.
-func (m *MockTokenService) CreateToken(user *domainuser.User) (string, error) {
+func (m *MockTokenService) CreateToken(user *domainuser.User, permissions []string) (string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateToken", user)
+	ret := m.ctrl.Call(m, "CreateToken", user, permissions)
 	ret0, _ := ret[0].(string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CreateToken indicates an expected call of CreateToken.
-func (mr *MockTokenServiceMockRecorder) CreateToken(user any) *gomock.Call {
+func (mr *MockTokenServiceMockRecorder) CreateToken(user, permissions any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateToken", reflect.TypeOf((*MockTokenService)(nil).CreateToken), user, permissions)
+}
+
+// PublicKeys mocks base method.
+func (m *MockTokenService) PublicKeys() []domainauth.PublicKey {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PublicKeys")
+	ret0, _ := ret[0].([]domainauth.PublicKey)
+	return ret0
+}
+
+// PublicKeys indicates an expected call of PublicKeys.
+func (mr *MockTokenServiceMockRecorder) PublicKeys() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublicKeys", reflect.TypeOf((*MockTokenService)(nil).PublicKeys))
+}
+
+// RevokeToken mocks base method.
+func (m *MockTokenService) RevokeToken(ctx context.Context, payload *domainauth.TokenPayload) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeToken", ctx, payload)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeToken indicates an expected call of RevokeToken.
+func (mr *MockTokenServiceMockRecorder) RevokeToken(ctx, payload any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateToken", reflect.TypeOf((*MockTokenService)(nil).CreateToken), user)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeToken", reflect.TypeOf((*MockTokenService)(nil).RevokeToken), ctx, payload)
 }
 
 // VerifyToken mocks base method.
-func (m *MockTokenService) VerifyToken(token string) (*domainauth.TokenPayload, error) {
+func (m *MockTokenService) VerifyToken(ctx context.Context, token string) (*domainauth.TokenPayload, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "VerifyToken", token)
+	ret := m.ctrl.Call(m, "VerifyToken", ctx, token)
 	ret0, _ := ret[0].(*domainauth.TokenPayload)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // VerifyToken indicates an expected call of VerifyToken.
-func (mr *MockTokenServiceMockRecorder) VerifyToken(token any) *gomock.Call {
+func (mr *MockTokenServiceMockRecorder) VerifyToken(ctx, token any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyToken", reflect.TypeOf((*MockTokenService)(nil).VerifyToken), token)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyToken", reflect.TypeOf((*MockTokenService)(nil).VerifyToken), ctx, token)
 }
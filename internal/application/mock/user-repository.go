@@ -57,17 +57,17 @@ func (mr *MockUserRepositoryMockRecorder) CreateUser(ctx, user any) *gomock.Call
 }
 
 // DeleteUser mocks base method.
-func (m *MockUserRepository) DeleteUser(ctx context.Context, id uint64) error {
+func (m *MockUserRepository) DeleteUser(ctx context.Context, id, version uint64) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteUser", ctx, id)
+	ret := m.ctrl.Call(m, "DeleteUser", ctx, id, version)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // DeleteUser indicates an expected call of DeleteUser.
-func (mr *MockUserRepositoryMockRecorder) DeleteUser(ctx, id any) *gomock.Call {
+func (mr *MockUserRepositoryMockRecorder) DeleteUser(ctx, id, version any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUser", reflect.TypeOf((*MockUserRepository)(nil).DeleteUser), ctx, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUser", reflect.TypeOf((*MockUserRepository)(nil).DeleteUser), ctx, id, version)
 }
 
 // GetUserByEmail mocks base method.
@@ -100,19 +100,63 @@ func (mr *MockUserRepositoryMockRecorder) GetUserByID(ctx, id any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserByID", reflect.TypeOf((*MockUserRepository)(nil).GetUserByID), ctx, id)
 }
 
+// GetUserPIN mocks base method.
+func (m *MockUserRepository) GetUserPIN(ctx context.Context, id uint64) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserPIN", ctx, id)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserPIN indicates an expected call of GetUserPIN.
+func (mr *MockUserRepositoryMockRecorder) GetUserPIN(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserPIN", reflect.TypeOf((*MockUserRepository)(nil).GetUserPIN), ctx, id)
+}
+
 // ListUsers mocks base method.
-func (m *MockUserRepository) ListUsers(ctx context.Context, skip, limit uint64) ([]domainuser.User, error) {
+func (m *MockUserRepository) ListUsers(ctx context.Context, skip, limit uint64, includeArchived bool) ([]domainuser.User, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListUsers", ctx, skip, limit)
+	ret := m.ctrl.Call(m, "ListUsers", ctx, skip, limit, includeArchived)
 	ret0, _ := ret[0].([]domainuser.User)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListUsers indicates an expected call of ListUsers.
-func (mr *MockUserRepositoryMockRecorder) ListUsers(ctx, skip, limit any) *gomock.Call {
+func (mr *MockUserRepositoryMockRecorder) ListUsers(ctx, skip, limit, includeArchived any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUsers", reflect.TypeOf((*MockUserRepository)(nil).ListUsers), ctx, skip, limit, includeArchived)
+}
+
+// RestoreUser mocks base method.
+func (m *MockUserRepository) RestoreUser(ctx context.Context, id uint64) (*domainuser.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreUser", ctx, id)
+	ret0, _ := ret[0].(*domainuser.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RestoreUser indicates an expected call of RestoreUser.
+func (mr *MockUserRepositoryMockRecorder) RestoreUser(ctx, id any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUsers", reflect.TypeOf((*MockUserRepository)(nil).ListUsers), ctx, skip, limit)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreUser", reflect.TypeOf((*MockUserRepository)(nil).RestoreUser), ctx, id)
+}
+
+// SetUserPIN mocks base method.
+func (m *MockUserRepository) SetUserPIN(ctx context.Context, id uint64, pinHash string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetUserPIN", ctx, id, pinHash)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetUserPIN indicates an expected call of SetUserPIN.
+func (mr *MockUserRepositoryMockRecorder) SetUserPIN(ctx, id, pinHash any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetUserPIN", reflect.TypeOf((*MockUserRepository)(nil).SetUserPIN), ctx, id, pinHash)
 }
 
 // UpdateUser mocks base method.
@@ -129,3 +173,17 @@ func (mr *MockUserRepositoryMockRecorder) UpdateUser(ctx, user any) *gomock.Call
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUser", reflect.TypeOf((*MockUserRepository)(nil).UpdateUser), ctx, user)
 }
+
+// UpdateUserPassword mocks base method.
+func (m *MockUserRepository) UpdateUserPassword(ctx context.Context, id uint64, passwordHash string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateUserPassword", ctx, id, passwordHash)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateUserPassword indicates an expected call of UpdateUserPassword.
+func (mr *MockUserRepositoryMockRecorder) UpdateUserPassword(ctx, id, passwordHash any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUserPassword", reflect.TypeOf((*MockUserRepository)(nil).UpdateUserPassword), ctx, id, passwordHash)
+}
@@ -0,0 +1,41 @@
+package port
+
+import (
+	"context"
+	"time"
+
+	domainauth "github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain/auth"
+)
+
+// APIKeyRepository is an interface for interacting with API key-related data
+//
+//go:generate mockgen -destination=../mock/api-key-repository.go -package=mock github.com/TienMinh25/go-hexagonal-architecture/internal/application/port APIKeyRepository
+type APIKeyRepository interface {
+	// CreateAPIKey inserts a new API key into the database
+	CreateAPIKey(ctx context.Context, key *domainauth.APIKey) (*domainauth.APIKey, error)
+	// GetAPIKeyByID selects an API key by id
+	GetAPIKeyByID(ctx context.Context, id uint64) (*domainauth.APIKey, error)
+	// GetAPIKeyByHash selects an API key by the hash of its value
+	GetAPIKeyByHash(ctx context.Context, keyHash string) (*domainauth.APIKey, error)
+	// ListAPIKeys selects a list of API keys with pagination
+	ListAPIKeys(ctx context.Context, skip, limit uint64) ([]domainauth.APIKey, error)
+	// RevokeAPIKey revokes an API key by setting its revoked_at
+	RevokeAPIKey(ctx context.Context, id uint64) (*domainauth.APIKey, error)
+	// TouchAPIKey sets the time an API key was last used
+	TouchAPIKey(ctx context.Context, id uint64, usedAt time.Time) error
+}
+
+// APIKeyService is an interface for interacting with API key-related business logic
+//
+//go:generate mockgen -destination=../mock/api-key-service.go -package=mock github.com/TienMinh25/go-hexagonal-architecture/internal/application/port APIKeyService
+type APIKeyService interface {
+	// CreateAPIKey creates a new API key scoped to permissions the creator holds
+	// and returns it with its value, which is only shown once
+	CreateAPIKey(ctx context.Context, key *domainauth.APIKey, creatorPermissions []string) (*domainauth.APIKey, string, error)
+	// ListAPIKeys returns a list of API keys with pagination
+	ListAPIKeys(ctx context.Context, skip, limit uint64) ([]domainauth.APIKey, error)
+	// RevokeAPIKey revokes an API key so that it can no longer be used
+	RevokeAPIKey(ctx context.Context, id uint64) (*domainauth.APIKey, error)
+	// VerifyAPIKey checks an API key and returns a payload acting as its creator with only its scopes
+	VerifyAPIKey(ctx context.Context, key string) (*domainauth.TokenPayload, error)
+}
@@ -0,0 +1,32 @@
+package port
+
+import (
+	"context"
+
+	domainaudit "github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain/audit"
+)
+
+// AuditRepository is an interface for interacting with audit log-related data
+//
+//go:generate mockgen -destination=../mock/audit-repository.go -package=mock github.com/TienMinh25/go-hexagonal-architecture/internal/application/port AuditRepository
+type AuditRepository interface {
+	// AppendAuditLog inserts a new audit log chained to the last one into the database
+	AppendAuditLog(ctx context.Context, log *domainaudit.Log) (*domainaudit.Log, error)
+	// ListAuditLogs selects a list of audit logs matching the filter with pagination, newest first
+	ListAuditLogs(ctx context.Context, filter domainaudit.Filter, skip, limit uint64) ([]domainaudit.Log, error)
+	// ListAuditLogsAfter selects audit logs in chain order, starting after the given id
+	ListAuditLogsAfter(ctx context.Context, afterID, limit uint64) ([]domainaudit.Log, error)
+}
+
+// AuditService is an interface for interacting with audit log-related business logic
+//
+//go:generate mockgen -destination=../mock/audit-service.go -package=mock github.com/TienMinh25/go-hexagonal-architecture/internal/application/port AuditService
+type AuditService interface {
+	// Record logs an action on an entity by the actor of the context with the entity's state before and after it.
+	// A log that cannot be written does not undo the action but fails the request, so that no change goes unnoticed
+	Record(ctx context.Context, action domainaudit.Action, entity string, entityID uint64, before, after any) error
+	// ListAuditLogs returns a list of audit logs matching the filter with pagination
+	ListAuditLogs(ctx context.Context, filter domainaudit.Filter, skip, limit uint64) ([]domainaudit.Log, error)
+	// VerifyAuditLogs checks that no audit log has been changed or removed
+	VerifyAuditLogs(ctx context.Context) (*domainaudit.Verification, error)
+}
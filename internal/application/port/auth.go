@@ -3,10 +3,62 @@ package port
 
 import (
 	"context"
+
+	domainauth "github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain/auth"
+	"github.com/google/uuid"
 )
 
+// RefreshTokenRepository is an interface for interacting with refresh token-related data
+type RefreshTokenRepository interface {
+	// CreateRefreshToken inserts a new refresh token into the database
+	CreateRefreshToken(ctx context.Context, refreshToken *domainauth.RefreshToken) (*domainauth.RefreshToken, error)
+	// GetRefreshTokenByHash selects a refresh token by the hash of its value
+	GetRefreshTokenByHash(ctx context.Context, tokenHash string) (*domainauth.RefreshToken, error)
+	// RotateRefreshToken marks a refresh token as used and inserts its replacement, failing if it was already used
+	RotateRefreshToken(ctx context.Context, id uint64, refreshToken *domainauth.RefreshToken) (*domainauth.RefreshToken, error)
+	// RevokeRefreshTokenFamily revokes all refresh tokens of a family
+	RevokeRefreshTokenFamily(ctx context.Context, familyID uuid.UUID) error
+	// RevokeUserRefreshTokens revokes all refresh tokens of a user
+	RevokeUserRefreshTokens(ctx context.Context, userID uint64) error
+}
+
+// TwoFactorRepository is an interface for interacting with two-factor authentication-related data
+type TwoFactorRepository interface {
+	// SaveTOTP inserts or replaces the unconfirmed TOTP enrollment of a user
+	SaveTOTP(ctx context.Context, totp *domainauth.TOTP) (*domainauth.TOTP, error)
+	// GetTOTP selects the TOTP enrollment of a user
+	GetTOTP(ctx context.Context, userID uint64) (*domainauth.TOTP, error)
+	// ConfirmTOTP confirms the TOTP enrollment of a user and replaces the hashes of its recovery codes
+	ConfirmTOTP(ctx context.Context, userID uint64, recoveryCodeHashes []string) error
+	// UseRecoveryCode marks an unused recovery code of a user as used, failing if there is none
+	UseRecoveryCode(ctx context.Context, userID uint64, codeHash string) error
+	// DeleteTOTP deletes the TOTP enrollment of a user along with its recovery codes
+	DeleteTOTP(ctx context.Context, userID uint64) error
+}
+
 // UserService is an interface for interacting with user authentication-related business logic
 type AuthService interface {
-	// Login authenticates a user by email and password and returns a token
-	Login(ctx context.Context, email, password string) (string, error)
+	// Login authenticates a user by email and password and returns an access and a refresh token,
+	// or a challenge when the user has two-factor authentication enabled,
+	// throttling failed attempts by email and by the client ip
+	Login(ctx context.Context, email, password, ip string) (*domainauth.LoginResult, error)
+	// VerifyTwoFactor completes a login challenge with a TOTP or recovery code and returns an access and a refresh token
+	VerifyTwoFactor(ctx context.Context, challenge, code, ip string) (*domainauth.TokenPair, error)
+	// EnrollTwoFactor starts the TOTP enrollment of a user
+	EnrollTwoFactor(ctx context.Context, userID uint64) (*domainauth.TOTPEnrollment, error)
+	// ConfirmTwoFactor enables two-factor authentication of a user with a valid TOTP code and returns new recovery codes
+	ConfirmTwoFactor(ctx context.Context, userID uint64, code string) ([]string, error)
+	// DisableTwoFactor disables two-factor authentication of a user with a valid TOTP or recovery code
+	DisableTwoFactor(ctx context.Context, userID uint64, code string) error
+	// PINLogin authenticates a user by PIN on a registered terminal and returns a short-lived access token
+	// carrying the terminal id, sharing the failed login throttling of the user's email
+	PINLogin(ctx context.Context, terminalID uint64, terminalSecret string, userID uint64, pin, ip string) (string, error)
+	// SetPIN sets the terminal login PIN of a user
+	SetPIN(ctx context.Context, userID uint64, pin string) error
+	// UnlockUser clears the lockout and failed login attempts of a user
+	UnlockUser(ctx context.Context, id uint64) error
+	// Refresh exchanges a refresh token for a new access and refresh token
+	Refresh(ctx context.Context, refreshToken string) (*domainauth.TokenPair, error)
+	// Logout revokes the access token of the payload and the refresh token family, if given
+	Logout(ctx context.Context, payload *domainauth.TokenPayload, refreshToken string) error
 }
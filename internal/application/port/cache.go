@@ -10,12 +10,16 @@ import (
 type CacheRepository interface {
 	// Set stores the value in the cache
 	Set(ctx context.Context, key string, value []byte, ttl time.Duration) error
-	// Get retrieves the value from the cache
+	// Get retrieves the value from the cache, a missing key is domain.ErrDataNotFound
 	Get(ctx context.Context, key string) ([]byte, error)
+	// Increment atomically increments the counter of the key, the ttl is set when the counter is created
+	Increment(ctx context.Context, key string, ttl time.Duration) (int64, error)
 	// Delete removes the value from the cache
 	Delete(ctx context.Context, key string) error
 	// DeleteByPrefix removes the value from the cache with the given prefix
 	DeleteByPrefix(ctx context.Context, prefix string) error
+	// Ping checks that the cache server accepts connections
+	Ping(ctx context.Context) error
 	// Close closes the connection to the cache server
 	Close() error
 }
@@ -14,12 +14,14 @@ type CategoryService interface {
 	CreateCategory(ctx context.Context, category *domaincategory.Category) (*domaincategory.Category, error)
 	// GetCategory returns a category by id
 	GetCategory(ctx context.Context, id uint64) (*domaincategory.Category, error)
-	// ListCategories returns a list of categories with pagination
-	ListCategories(ctx context.Context, skip, limit uint64) ([]domaincategory.Category, error)
-	// UpdateCategory updates a category
+	// ListCategories returns a list of categories with pagination, excluding archived ones unless requested
+	ListCategories(ctx context.Context, skip, limit uint64, includeArchived bool) ([]domaincategory.Category, error)
+	// UpdateCategory updates a category at the version it was read
 	UpdateCategory(ctx context.Context, category *domaincategory.Category) (*domaincategory.Category, error)
-	// DeleteCategory deletes a category
-	DeleteCategory(ctx context.Context, id uint64) error
+	// DeleteCategory archives a category at the version it was read
+	DeleteCategory(ctx context.Context, id, version uint64) error
+	// RestoreCategory restores an archived category
+	RestoreCategory(ctx context.Context, id uint64) (*domaincategory.Category, error)
 }
 
 // CategoryRepository is an interface for interacting with category-related data
@@ -30,10 +32,12 @@ type CategoryRepository interface {
 	CreateCategory(ctx context.Context, category *domaincategory.Category) (*domaincategory.Category, error)
 	// GetCategoryByID selects a category by id
 	GetCategoryByID(ctx context.Context, id uint64) (*domaincategory.Category, error)
-	// ListCategories selects a list of categories with pagination
-	ListCategories(ctx context.Context, skip, limit uint64) ([]domaincategory.Category, error)
-	// UpdateCategory updates a category
+	// ListCategories selects a list of categories with pagination, excluding archived ones unless requested
+	ListCategories(ctx context.Context, skip, limit uint64, includeArchived bool) ([]domaincategory.Category, error)
+	// UpdateCategory updates a category at the version it was read
 	UpdateCategory(ctx context.Context, category *domaincategory.Category) (*domaincategory.Category, error)
-	// DeleteCategory deletes a category
-	DeleteCategory(ctx context.Context, id uint64) error
+	// DeleteCategory archives a category by setting its deleted_at, if its version still matches
+	DeleteCategory(ctx context.Context, id, version uint64) error
+	// RestoreCategory clears the deleted_at of an archived category
+	RestoreCategory(ctx context.Context, id uint64) (*domaincategory.Category, error)
 }
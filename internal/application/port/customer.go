@@ -0,0 +1,47 @@
+package port
+
+import (
+	"context"
+
+	domaincustomer "github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain/customer"
+)
+
+// CustomerRepository is an interface for interacting with customer-related data
+//
+//go:generate mockgen -destination=../mock/customer-repository.go -package=mock github.com/TienMinh25/go-hexagonal-architecture/internal/application/port CustomerRepository
+type CustomerRepository interface {
+	// CreateCustomer inserts a new customer into the database
+	CreateCustomer(ctx context.Context, customer *domaincustomer.Customer) (*domaincustomer.Customer, error)
+	// GetCustomerByID selects a customer by id
+	GetCustomerByID(ctx context.Context, id uint64) (*domaincustomer.Customer, error)
+	// ListCustomers selects a list of customers matching a name, phone or email search with pagination, excluding archived ones unless requested
+	ListCustomers(ctx context.Context, search string, skip, limit uint64, includeArchived bool) ([]domaincustomer.Customer, error)
+	// UpdateCustomer updates a customer at the version it was read
+	UpdateCustomer(ctx context.Context, customer *domaincustomer.Customer) (*domaincustomer.Customer, error)
+	// DeleteCustomer archives a customer by setting its deleted_at, if its version still matches
+	DeleteCustomer(ctx context.Context, id, version uint64) error
+	// RestoreCustomer clears the deleted_at of an archived customer
+	RestoreCustomer(ctx context.Context, id uint64) (*domaincustomer.Customer, error)
+	// GetPurchaseSummary aggregates the order count and total spend of a customer
+	GetPurchaseSummary(ctx context.Context, id uint64) (*domaincustomer.PurchaseSummary, error)
+}
+
+// CustomerService is an interface for interacting with customer-related business logic
+//
+//go:generate mockgen -destination=../mock/customer-service.go -package=mock github.com/TienMinh25/go-hexagonal-architecture/internal/application/port CustomerService
+type CustomerService interface {
+	// CreateCustomer creates a new customer
+	CreateCustomer(ctx context.Context, customer *domaincustomer.Customer) (*domaincustomer.Customer, error)
+	// GetCustomer returns a customer by id
+	GetCustomer(ctx context.Context, id uint64) (*domaincustomer.Customer, error)
+	// ListCustomers returns a list of customers matching a search with pagination, excluding archived ones unless requested
+	ListCustomers(ctx context.Context, search string, skip, limit uint64, includeArchived bool) ([]domaincustomer.Customer, error)
+	// UpdateCustomer updates a customer at the version it was read
+	UpdateCustomer(ctx context.Context, customer *domaincustomer.Customer) (*domaincustomer.Customer, error)
+	// DeleteCustomer archives a customer at the version it was read
+	DeleteCustomer(ctx context.Context, id, version uint64) error
+	// RestoreCustomer restores an archived customer
+	RestoreCustomer(ctx context.Context, id uint64) (*domaincustomer.Customer, error)
+	// GetPurchaseSummary returns the lifetime spend and visit count of a customer
+	GetPurchaseSummary(ctx context.Context, id uint64) (*domaincustomer.PurchaseSummary, error)
+}
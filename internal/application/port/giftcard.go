@@ -0,0 +1,32 @@
+package port
+
+import (
+	"context"
+
+	domaingiftcard "github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain/giftcard"
+	domainorder "github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain/order"
+)
+
+// GiftCardRepository is an interface for interacting with gift card-related data
+//
+//go:generate mockgen -destination=../mock/giftcard-repository.go -package=mock github.com/TienMinh25/go-hexagonal-architecture/internal/application/port GiftCardRepository
+type GiftCardRepository interface {
+	// CreateGiftCard inserts a new gift card and the order selling it into the database
+	CreateGiftCard(ctx context.Context, giftCard *domaingiftcard.GiftCard, order *domainorder.Order) (*domaingiftcard.GiftCard, error)
+	// GetGiftCardByCode selects a gift card by code
+	GetGiftCardByCode(ctx context.Context, code string) (*domaingiftcard.GiftCard, error)
+	// TopUpGiftCard adds an amount to the balance of a gift card and inserts the order selling it
+	TopUpGiftCard(ctx context.Context, id uint64, amount float64, order *domainorder.Order) (*domaingiftcard.GiftCard, error)
+}
+
+// GiftCardService is an interface for interacting with gift card-related business logic
+//
+//go:generate mockgen -destination=../mock/giftcard-service.go -package=mock github.com/TienMinh25/go-hexagonal-architecture/internal/application/port GiftCardService
+type GiftCardService interface {
+	// IssueGiftCard sells a new gift card with an initial balance through an order paid with a payment
+	IssueGiftCard(ctx context.Context, giftCard *domaingiftcard.GiftCard, order *domainorder.Order) (*domaingiftcard.GiftCard, error)
+	// GetGiftCard returns a gift card by code
+	GetGiftCard(ctx context.Context, code string) (*domaingiftcard.GiftCard, error)
+	// TopUpGiftCard sells an amount added to the balance of a gift card through an order paid with a payment
+	TopUpGiftCard(ctx context.Context, code string, amount float64, order *domainorder.Order) (*domaingiftcard.GiftCard, error)
+}
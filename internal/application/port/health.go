@@ -0,0 +1,24 @@
+//go:generate mockgen -source=health.go -destination=../mock/health.go -package=mock
+package port
+
+import (
+	"context"
+
+	domainhealth "github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain/health"
+)
+
+// HealthRepository is an interface for checking the health of the database
+type HealthRepository interface {
+	// Ping checks that the database accepts connections
+	Ping(ctx context.Context) error
+	// CheckMigrations checks that the database schema is at the latest migration and not dirty
+	CheckMigrations(ctx context.Context) error
+}
+
+// HealthService is an interface for checking the health of the application
+type HealthService interface {
+	// Readiness checks the dependencies needed to serve requests, the application is never ready while draining
+	Readiness(ctx context.Context) *domainhealth.Report
+	// Drain marks the application as not ready so that no new traffic is routed to it before it shuts down
+	Drain()
+}
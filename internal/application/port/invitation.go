@@ -0,0 +1,37 @@
+package port
+
+import (
+	"context"
+
+	domainuser "github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain/user"
+)
+
+// InvitationRepository is an interface for interacting with invitation-related data
+//
+//go:generate mockgen -destination=../mock/invitation-repository.go -package=mock github.com/TienMinh25/go-hexagonal-architecture/internal/application/port InvitationRepository
+type InvitationRepository interface {
+	// CreateInvitation inserts a new invitation into the database
+	CreateInvitation(ctx context.Context, invitation *domainuser.Invitation) (*domainuser.Invitation, error)
+	// GetInvitationByID selects an invitation by id
+	GetInvitationByID(ctx context.Context, id uint64) (*domainuser.Invitation, error)
+	// ListInvitations selects a list of invitations with pagination
+	ListInvitations(ctx context.Context, skip, limit uint64) ([]domainuser.Invitation, error)
+	// RevokeInvitation revokes a pending invitation by setting its revoked_at
+	RevokeInvitation(ctx context.Context, id uint64) (*domainuser.Invitation, error)
+	// AcceptInvitation marks a pending invitation for the user's email as accepted
+	// and inserts the user with the invited role
+	AcceptInvitation(ctx context.Context, tokenHash string, user *domainuser.User) (*domainuser.User, error)
+}
+
+// InvitationService is an interface for interacting with invitation-related business logic
+//
+//go:generate mockgen -destination=../mock/invitation-service.go -package=mock github.com/TienMinh25/go-hexagonal-architecture/internal/application/port InvitationService
+type InvitationService interface {
+	// CreateInvitation invites an email to register with a role and returns the invitation with its token,
+	// which is mailed to the email and only shown once
+	CreateInvitation(ctx context.Context, invitation *domainuser.Invitation) (*domainuser.Invitation, string, error)
+	// ListInvitations returns a list of invitations with pagination
+	ListInvitations(ctx context.Context, skip, limit uint64) ([]domainuser.Invitation, error)
+	// RevokeInvitation revokes a pending invitation so that it can no longer be accepted
+	RevokeInvitation(ctx context.Context, id uint64) (*domainuser.Invitation, error)
+}
@@ -0,0 +1,23 @@
+package port
+
+import (
+	"context"
+
+	domainloyalty "github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain/loyalty"
+)
+
+// LoyaltyRepository is an interface for interacting with loyalty points-related data
+//
+//go:generate mockgen -destination=../mock/loyalty-repository.go -package=mock github.com/TienMinh25/go-hexagonal-architecture/internal/application/port LoyaltyRepository
+type LoyaltyRepository interface {
+	// GetBalance sums the ledger entries of a customer
+	GetBalance(ctx context.Context, customerID uint64) (int64, error)
+}
+
+// LoyaltyService is an interface for interacting with loyalty points-related business logic
+//
+//go:generate mockgen -destination=../mock/loyalty-service.go -package=mock github.com/TienMinh25/go-hexagonal-architecture/internal/application/port LoyaltyService
+type LoyaltyService interface {
+	// GetBalance returns the points balance of a customer
+	GetBalance(ctx context.Context, customerID uint64) (*domainloyalty.Balance, error)
+}
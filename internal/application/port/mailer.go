@@ -0,0 +1,14 @@
+//go:generate mockgen -source=mailer.go -destination=../mock/mailer.go -package=mock
+package port
+
+import (
+	"context"
+
+	domainmail "github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain/mail"
+)
+
+// Mailer is an interface for sending emails
+type Mailer interface {
+	// Send sends the message to its recipient
+	Send(ctx context.Context, message *domainmail.Message) error
+}
@@ -0,0 +1,20 @@
+//go:generate mockgen -source=metrics.go -destination=../mock/metrics.go -package=mock
+package port
+
+import (
+	"time"
+
+	domainorder "github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain/order"
+)
+
+// Metrics is an interface for recording the metrics of the application
+type Metrics interface {
+	// ObserveRequest records an HTTP request served by the route with its status and duration
+	ObserveRequest(method, route string, status int, duration time.Duration)
+	// ObserveCacheLookup records a lookup of the cache, a hit when the key was found
+	ObserveCacheLookup(hit bool)
+	// OrderCreated records a created order and the revenue it brings
+	OrderCreated(totalPrice float64)
+	// OrderRejected records an order rejected for the reason
+	OrderRejected(reason domainorder.Rejection)
+}
@@ -14,8 +14,11 @@ type OrderRepository interface {
 	CreateOrder(ctx context.Context, order *domainorder.Order) (*domainorder.Order, error)
 	// GetOrderByID selects an order by id
 	GetOrderByID(ctx context.Context, id uint64) (*domainorder.Order, error)
-	// ListOrders selects a list of orders with pagination
-	ListOrders(ctx context.Context, skip, limit uint64) ([]domainorder.Order, error)
+	// ListOrders selects a list of orders with pagination, filtered by customer when customerID is not zero
+	ListOrders(ctx context.Context, customerID, skip, limit uint64) ([]domainorder.Order, error)
+	// RefundOrder marks an order as refunded, restocks its products, reverses its gift card tender
+	// and adds the given points, negative when they are taken back, to the customer's balance
+	RefundOrder(ctx context.Context, id uint64, points int64) (*domainorder.Order, error)
 }
 
 // OrderService is an interface for interacting with order-related business logic
@@ -26,6 +29,8 @@ type OrderService interface {
 	CreateOrder(ctx context.Context, order *domainorder.Order) (*domainorder.Order, error)
 	// GetOrder returns an order by id
 	GetOrder(ctx context.Context, id uint64) (*domainorder.Order, error)
-	// ListOrders returns a list of orders with pagination
-	ListOrders(ctx context.Context, skip, limit uint64) ([]domainorder.Order, error)
+	// ListOrders returns a list of orders with pagination, filtered by customer when customerID is not zero
+	ListOrders(ctx context.Context, customerID, skip, limit uint64) ([]domainorder.Order, error)
+	// RefundOrder refunds an order
+	RefundOrder(ctx context.Context, id uint64) (*domainorder.Order, error)
 }
@@ -0,0 +1,32 @@
+package port
+
+import (
+	"context"
+
+	domainauth "github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain/auth"
+)
+
+// PasswordResetRepository is an interface for interacting with password reset token-related data
+//
+//go:generate mockgen -destination=../mock/password-reset-repository.go -package=mock github.com/TienMinh25/go-hexagonal-architecture/internal/application/port PasswordResetRepository
+type PasswordResetRepository interface {
+	// CreatePasswordResetToken inserts a new password reset token into the database
+	CreatePasswordResetToken(ctx context.Context, token *domainauth.PasswordResetToken) (*domainauth.PasswordResetToken, error)
+	// ResetPassword marks an unused and unexpired reset token as used, replaces the password of its user
+	// and discards the user's other reset tokens, returning the user id
+	ResetPassword(ctx context.Context, tokenHash, passwordHash string) (uint64, error)
+}
+
+// PasswordService is an interface for interacting with password-related business logic
+//
+//go:generate mockgen -destination=../mock/password-service.go -package=mock github.com/TienMinh25/go-hexagonal-architecture/internal/application/port PasswordService
+type PasswordService interface {
+	// ChangePassword changes the password of a user who knows the current one
+	ChangePassword(ctx context.Context, userID uint64, currentPassword, newPassword string) error
+	// RequestPasswordReset mails a reset link to the user with the email, if there is one, throttled per email and client ip
+	RequestPasswordReset(ctx context.Context, email, ip string) error
+	// ResetPassword sets a new password with a reset token
+	ResetPassword(ctx context.Context, token, newPassword string) error
+	// Close waits for the reset mails still being sent in the background, giving up when the context is done
+	Close(ctx context.Context) error
+}
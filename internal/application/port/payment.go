@@ -14,12 +14,14 @@ type PaymentRepository interface {
 	CreatePayment(ctx context.Context, payment *domainpayment.Payment) (*domainpayment.Payment, error)
 	// GetPaymentByID selects a payment by id
 	GetPaymentByID(ctx context.Context, id uint64) (*domainpayment.Payment, error)
-	// ListPayments selects a list of payments with pagination
-	ListPayments(ctx context.Context, skip, limit uint64) ([]domainpayment.Payment, error)
-	// UpdatePayment updates a payment
+	// ListPayments selects a list of payments with pagination, excluding archived ones unless requested
+	ListPayments(ctx context.Context, skip, limit uint64, includeArchived bool) ([]domainpayment.Payment, error)
+	// UpdatePayment updates a payment at the version it was read
 	UpdatePayment(ctx context.Context, payment *domainpayment.Payment) (*domainpayment.Payment, error)
-	// DeletePayment deletes a payment
-	DeletePayment(ctx context.Context, id uint64) error
+	// DeletePayment archives a payment by setting its deleted_at, if its version still matches
+	DeletePayment(ctx context.Context, id, version uint64) error
+	// RestorePayment clears the deleted_at of an archived payment
+	RestorePayment(ctx context.Context, id uint64) (*domainpayment.Payment, error)
 }
 
 // PaymentService is an interface for interacting with payment-related business logic
@@ -30,10 +32,12 @@ type PaymentService interface {
 	CreatePayment(ctx context.Context, payment *domainpayment.Payment) (*domainpayment.Payment, error)
 	// GetPayment returns a payment by id
 	GetPayment(ctx context.Context, id uint64) (*domainpayment.Payment, error)
-	// ListPayments returns a list of payments with pagination
-	ListPayments(ctx context.Context, skip, limit uint64) ([]domainpayment.Payment, error)
-	// UpdatePayment updates a payment
+	// ListPayments returns a list of payments with pagination, excluding archived ones unless requested
+	ListPayments(ctx context.Context, skip, limit uint64, includeArchived bool) ([]domainpayment.Payment, error)
+	// UpdatePayment updates a payment at the version it was read
 	UpdatePayment(ctx context.Context, payment *domainpayment.Payment) (*domainpayment.Payment, error)
-	// DeletePayment deletes a payment
-	DeletePayment(ctx context.Context, id uint64) error
+	// DeletePayment archives a payment at the version it was read
+	DeletePayment(ctx context.Context, id, version uint64) error
+	// RestorePayment restores an archived payment
+	RestorePayment(ctx context.Context, id uint64) (*domainpayment.Payment, error)
 }
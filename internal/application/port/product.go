@@ -14,12 +14,14 @@ type ProductRepository interface {
 	CreateProduct(ctx context.Context, product *domainproduct.Product) (*domainproduct.Product, error)
 	// GetProductByID selects a product by id
 	GetProductByID(ctx context.Context, id uint64) (*domainproduct.Product, error)
-	// ListProducts selects a list of products with pagination
-	ListProducts(ctx context.Context, search string, categoryId, skip, limit uint64) ([]domainproduct.Product, error)
-	// UpdateProduct updates a product
+	// ListProducts selects a list of products with pagination, excluding archived ones unless requested
+	ListProducts(ctx context.Context, search string, categoryId, skip, limit uint64, includeArchived bool) ([]domainproduct.Product, error)
+	// UpdateProduct updates a product at the version it was read
 	UpdateProduct(ctx context.Context, product *domainproduct.Product) (*domainproduct.Product, error)
-	// DeleteProduct deletes a product
-	DeleteProduct(ctx context.Context, id uint64) error
+	// DeleteProduct archives a product by setting its deleted_at, if its version still matches
+	DeleteProduct(ctx context.Context, id, version uint64) error
+	// RestoreProduct clears the deleted_at of an archived product
+	RestoreProduct(ctx context.Context, id uint64) (*domainproduct.Product, error)
 }
 
 // ProductService is an interface for interacting with product-related business logic
@@ -30,10 +32,12 @@ type ProductService interface {
 	CreateProduct(ctx context.Context, product *domainproduct.Product) (*domainproduct.Product, error)
 	// GetProduct returns a product by id
 	GetProduct(ctx context.Context, id uint64) (*domainproduct.Product, error)
-	// ListProducts returns a list of products with pagination
-	ListProducts(ctx context.Context, search string, categoryId, skip, limit uint64) ([]domainproduct.Product, error)
-	// UpdateProduct updates a product
+	// ListProducts returns a list of products with pagination, excluding archived ones unless requested
+	ListProducts(ctx context.Context, search string, categoryId, skip, limit uint64, includeArchived bool) ([]domainproduct.Product, error)
+	// UpdateProduct updates a product at the version it was read
 	UpdateProduct(ctx context.Context, product *domainproduct.Product) (*domainproduct.Product, error)
-	// DeleteProduct deletes a product
-	DeleteProduct(ctx context.Context, id uint64) error
+	// DeleteProduct archives a product at the version it was read
+	DeleteProduct(ctx context.Context, id, version uint64) error
+	// RestoreProduct restores an archived product
+	RestoreProduct(ctx context.Context, id uint64) (*domainproduct.Product, error)
 }
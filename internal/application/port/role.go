@@ -0,0 +1,46 @@
+package port
+
+import (
+	"context"
+
+	domainrole "github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain/role"
+	domainuser "github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain/user"
+)
+
+// RoleRepository is an interface for interacting with role-related data
+//
+//go:generate mockgen -destination=../mock/role-repository.go -package=mock github.com/TienMinh25/go-hexagonal-architecture/internal/application/port RoleRepository
+type RoleRepository interface {
+	// CreateRole inserts a new role with its permissions into the database
+	CreateRole(ctx context.Context, role *domainrole.Role) (*domainrole.Role, error)
+	// GetRoleByID selects a role with its permissions by id
+	GetRoleByID(ctx context.Context, id uint64) (*domainrole.Role, error)
+	// ListRoles selects all roles with their permissions
+	ListRoles(ctx context.Context) ([]domainrole.Role, error)
+	// ListPermissions selects all permissions
+	ListPermissions(ctx context.Context) ([]domainrole.Permission, error)
+	// ListRolePermissions selects the permission names granted to a role by name
+	ListRolePermissions(ctx context.Context, role domainuser.UserRole) ([]string, error)
+	// SetRolePermissions replaces the permissions granted to a role
+	SetRolePermissions(ctx context.Context, id uint64, permissions []string) (*domainrole.Role, error)
+	// DeleteRole deletes a role that is not assigned to any user
+	DeleteRole(ctx context.Context, id uint64) error
+}
+
+// RoleService is an interface for interacting with role-related business logic
+//
+//go:generate mockgen -destination=../mock/role-service.go -package=mock github.com/TienMinh25/go-hexagonal-architecture/internal/application/port RoleService
+type RoleService interface {
+	// CreateRole creates a new custom role
+	CreateRole(ctx context.Context, role *domainrole.Role) (*domainrole.Role, error)
+	// GetRole returns a role by id
+	GetRole(ctx context.Context, id uint64) (*domainrole.Role, error)
+	// ListRoles returns all roles
+	ListRoles(ctx context.Context) ([]domainrole.Role, error)
+	// ListPermissions returns all permissions that can be granted
+	ListPermissions(ctx context.Context) ([]domainrole.Permission, error)
+	// SetRolePermissions replaces the permissions granted to a role
+	SetRolePermissions(ctx context.Context, id uint64, permissions []string) (*domainrole.Role, error)
+	// DeleteRole deletes a custom role
+	DeleteRole(ctx context.Context, id uint64) error
+}
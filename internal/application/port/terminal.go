@@ -0,0 +1,35 @@
+package port
+
+import (
+	"context"
+
+	domainterminal "github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain/terminal"
+)
+
+// TerminalRepository is an interface for interacting with terminal-related data
+//
+//go:generate mockgen -destination=../mock/terminal-repository.go -package=mock github.com/TienMinh25/go-hexagonal-architecture/internal/application/port TerminalRepository
+type TerminalRepository interface {
+	// CreateTerminal inserts a new terminal into the database
+	CreateTerminal(ctx context.Context, terminal *domainterminal.Terminal) (*domainterminal.Terminal, error)
+	// GetTerminalByID selects a terminal by id
+	GetTerminalByID(ctx context.Context, id uint64) (*domainterminal.Terminal, error)
+	// ListTerminals selects a list of terminals with pagination
+	ListTerminals(ctx context.Context, skip, limit uint64) ([]domainterminal.Terminal, error)
+	// RevokeTerminal revokes a terminal by setting its revoked_at
+	RevokeTerminal(ctx context.Context, id uint64) (*domainterminal.Terminal, error)
+}
+
+// TerminalService is an interface for interacting with terminal-related business logic
+//
+//go:generate mockgen -destination=../mock/terminal-service.go -package=mock github.com/TienMinh25/go-hexagonal-architecture/internal/application/port TerminalService
+type TerminalService interface {
+	// RegisterTerminal registers a new terminal and returns it with its secret, which is only shown once
+	RegisterTerminal(ctx context.Context, terminal *domainterminal.Terminal) (*domainterminal.Terminal, string, error)
+	// GetTerminal returns a terminal by id
+	GetTerminal(ctx context.Context, id uint64) (*domainterminal.Terminal, error)
+	// ListTerminals returns a list of terminals with pagination
+	ListTerminals(ctx context.Context, skip, limit uint64) ([]domainterminal.Terminal, error)
+	// RevokeTerminal revokes a terminal so that it can no longer be used to log in
+	RevokeTerminal(ctx context.Context, id uint64) (*domainterminal.Terminal, error)
+}
@@ -2,15 +2,22 @@
 package port
 
 import (
+	"context"
+
 	domainauth "github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain/auth"
 	domainuser "github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain/user"
 )
 
-
 // TokenService is an interface for interacting with token-related business logic
 type TokenService interface {
-	// CreateToken creates a new token for a given user
-	CreateToken(user *domainuser.User) (string, error)
-	// VerifyToken verifies the token and returns the payload
-	VerifyToken(token string) (*domainauth.TokenPayload, error)
+	// CreateToken creates a new token for a given user carrying the permissions of the user's role
+	CreateToken(user *domainuser.User, permissions []string) (string, error)
+	// CreateTerminalToken creates a new short-lived token for a user logged in on a terminal, carrying the terminal id
+	CreateTerminalToken(user *domainuser.User, permissions []string, terminalID uint64) (string, error)
+	// VerifyToken verifies the token is valid and not revoked, and returns the payload
+	VerifyToken(ctx context.Context, token string) (*domainauth.TokenPayload, error)
+	// RevokeToken revokes the token of the payload until it expires
+	RevokeToken(ctx context.Context, payload *domainauth.TokenPayload) error
+	// PublicKeys returns the keys that verify the tokens, none when tokens are symmetrically encrypted
+	PublicKeys() []domainauth.PublicKey
 }
@@ -16,26 +16,40 @@ type UserRepository interface {
 	GetUserByID(ctx context.Context, id uint64) (*domainuser.User, error)
 	// GetUserByEmail selects a user by email
 	GetUserByEmail(ctx context.Context, email string) (*domainuser.User, error)
-	// ListUsers selects a list of users with pagination
-	ListUsers(ctx context.Context, skip, limit uint64) ([]domainuser.User, error)
-	// UpdateUser updates a user
+	// ListUsers selects a list of users with pagination, excluding archived ones unless requested
+	ListUsers(ctx context.Context, skip, limit uint64, includeArchived bool) ([]domainuser.User, error)
+	// UpdateUser updates a user at the version it was read
 	UpdateUser(ctx context.Context, user *domainuser.User) (*domainuser.User, error)
-	// DeleteUser deletes a user
-	DeleteUser(ctx context.Context, id uint64) error
+	// UpdateUserPassword replaces the password hash of an active user
+	UpdateUserPassword(ctx context.Context, id uint64, passwordHash string) error
+	// DeleteUser archives a user by setting its deleted_at, if its version still matches
+	DeleteUser(ctx context.Context, id, version uint64) error
+	// RestoreUser clears the deleted_at of an archived user
+	RestoreUser(ctx context.Context, id uint64) (*domainuser.User, error)
+	// SetUserPIN inserts or replaces the hash of a user's terminal login PIN
+	SetUserPIN(ctx context.Context, id uint64, pinHash string) error
+	// GetUserPIN selects the hash of a user's terminal login PIN
+	GetUserPIN(ctx context.Context, id uint64) (string, error)
 }
 
 // UserService is an interface for interacting with user-related business logic
 //
 //go:generate mockgen -destination=../mock/user-service.go -package=mock github.com/TienMinh25/go-hexagonal-architecture/internal/application/port UserService
 type UserService interface {
-	// Register registers a new user
-	Register(ctx context.Context, user *domainuser.User) (*domainuser.User, error)
+	// Register registers a new user as allowed by the registration policy,
+	// with the role of the invitation when its token is given
+	Register(ctx context.Context, user *domainuser.User, invitationToken string) (*domainuser.User, error)
 	// GetUser returns a user by id
 	GetUser(ctx context.Context, id uint64) (*domainuser.User, error)
-	// ListUsers returns a list of users with pagination
-	ListUsers(ctx context.Context, skip, limit uint64) ([]domainuser.User, error)
-	// UpdateUser updates a user
+	// ListUsers returns a list of users with pagination, excluding archived ones unless requested
+	ListUsers(ctx context.Context, skip, limit uint64, includeArchived bool) ([]domainuser.User, error)
+	// UpdateUser updates a user at the version it was read
 	UpdateUser(ctx context.Context, user *domainuser.User) (*domainuser.User, error)
-	// DeleteUser deletes a user
-	DeleteUser(ctx context.Context, id uint64) error
+	// UpdateMe updates the profile of the logged in user at the version it was read,
+	// its current password is required when the email changes
+	UpdateMe(ctx context.Context, user *domainuser.User, currentPassword string) (*domainuser.User, error)
+	// DeleteUser archives a user at the version it was read
+	DeleteUser(ctx context.Context, id, version uint64) error
+	// RestoreUser restores an archived user
+	RestoreUser(ctx context.Context, id uint64) (*domainuser.User, error)
 }
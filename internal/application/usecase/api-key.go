@@ -0,0 +1,178 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"time"
+
+	"github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain"
+	domainaudit "github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain/audit"
+	domainauth "github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain/auth"
+	"github.com/TienMinh25/go-hexagonal-architecture/internal/application/port"
+	"github.com/TienMinh25/go-hexagonal-architecture/internal/application/util"
+)
+
+/**
+ * apiKeyUsecase implements port.APIKeyService interface
+ * and provides an access to the API key repository,
+ * user repository, role repository and audit service
+ */
+type apiKeyUsecase struct {
+	repo     port.APIKeyRepository
+	userRepo port.UserRepository
+	roleRepo port.RoleRepository
+	audit    port.AuditService
+}
+
+// NewAPIKeyUsecase creates a new API key service instance
+func NewAPIKeyUsecase(repo port.APIKeyRepository, userRepo port.UserRepository, roleRepo port.RoleRepository, audit port.AuditService) port.APIKeyService {
+	return &apiKeyUsecase{
+		repo,
+		userRepo,
+		roleRepo,
+		audit,
+	}
+}
+
+// apiKeyPrefix starts every API key so that leaked keys are easy to recognize
+const apiKeyPrefix = "pk_"
+
+// apiKeyPrefixLength is how much of the key is stored in clear to tell keys apart
+const apiKeyPrefixLength = 10
+
+// apiKeyTouchInterval is how stale the last use of a key can get before it is recorded again
+const apiKeyTouchInterval = time.Minute
+
+// CreateAPIKey creates a new API key with a generated value, only the hash of the value is stored.
+// The key can only be scoped to permissions its creator holds
+func (as *apiKeyUsecase) CreateAPIKey(ctx context.Context, key *domainauth.APIKey, creatorPermissions []string) (*domainauth.APIKey, string, error) {
+	if key.ExpiresAt != nil && !key.ExpiresAt.After(time.Now()) {
+		return nil, "", domain.ErrInvalidAPIKeyExpiry
+	}
+
+	for _, scope := range key.Scopes {
+		if !slices.Contains(creatorPermissions, scope) {
+			return nil, "", domain.ErrForbidden
+		}
+	}
+
+	token, err := util.GenerateToken()
+	if err != nil {
+		return nil, "", domain.ErrInternal.Wrap(err)
+	}
+
+	value := apiKeyPrefix + token
+	key.Prefix = value[:apiKeyPrefixLength]
+	key.KeyHash = util.HashToken(value)
+
+	key, err = as.repo.CreateAPIKey(ctx, key)
+	if err != nil {
+		return nil, "", domain.ErrInternal.Wrap(err)
+	}
+
+	err = as.audit.Record(ctx, domainaudit.Create, domainaudit.APIKey, key.ID, nil, key)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return key, value, nil
+}
+
+// ListAPIKeys returns a list of API keys with pagination
+func (as *apiKeyUsecase) ListAPIKeys(ctx context.Context, skip, limit uint64) ([]domainauth.APIKey, error) {
+	keys, err := as.repo.ListAPIKeys(ctx, skip, limit)
+	if err != nil {
+		return nil, domain.ErrInternal.Wrap(err)
+	}
+
+	return keys, nil
+}
+
+// RevokeAPIKey revokes an API key, it is rejected from the next request on
+func (as *apiKeyUsecase) RevokeAPIKey(ctx context.Context, id uint64) (*domainauth.APIKey, error) {
+	existingKey, err := as.repo.GetAPIKeyByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, domain.ErrDataNotFound) {
+			return nil, err
+		}
+		return nil, domain.ErrInternal.Wrap(err)
+	}
+
+	if existingKey.RevokedAt != nil {
+		return nil, domain.ErrDataArchived
+	}
+
+	key, err := as.repo.RevokeAPIKey(ctx, id)
+	if err != nil {
+		if errors.Is(err, domain.ErrDataNotFound) {
+			return nil, domain.ErrDataArchived
+		}
+		return nil, domain.ErrInternal.Wrap(err)
+	}
+
+	err = as.audit.Record(ctx, domainaudit.Revoke, domainaudit.APIKey, id, existingKey, key)
+	if err != nil {
+		return nil, err
+	}
+
+	return key, nil
+}
+
+// VerifyAPIKey checks that an API key is neither revoked nor expired and that its creator is still active.
+// The payload only carries the scopes of the key the creator's role still grants
+func (as *apiKeyUsecase) VerifyAPIKey(ctx context.Context, value string) (*domainauth.TokenPayload, error) {
+	key, err := as.repo.GetAPIKeyByHash(ctx, util.HashToken(value))
+	if err != nil {
+		if errors.Is(err, domain.ErrDataNotFound) {
+			return nil, domain.ErrInvalidAPIKey
+		}
+		return nil, domain.ErrInternal.Wrap(err)
+	}
+
+	now := time.Now()
+	if key.RevokedAt != nil || (key.ExpiresAt != nil && !key.ExpiresAt.After(now)) {
+		return nil, domain.ErrInvalidAPIKey
+	}
+
+	user, err := as.userRepo.GetUserByID(ctx, key.CreatedBy)
+	if err != nil {
+		if errors.Is(err, domain.ErrDataNotFound) {
+			return nil, domain.ErrInvalidAPIKey
+		}
+		return nil, domain.ErrInternal.Wrap(err)
+	}
+
+	if user.DeletedAt != nil {
+		return nil, domain.ErrInvalidAPIKey
+	}
+
+	granted, err := as.roleRepo.ListRolePermissions(ctx, user.Role)
+	if err != nil {
+		return nil, domain.ErrInternal.Wrap(err)
+	}
+
+	permissions := []string{}
+	for _, scope := range key.Scopes {
+		if slices.Contains(granted, scope) {
+			permissions = append(permissions, scope)
+		}
+	}
+
+	if key.LastUsedAt == nil || now.Sub(*key.LastUsedAt) > apiKeyTouchInterval {
+		// the last use is only informative, a failure to record it does not reject the request
+		_ = as.repo.TouchAPIKey(ctx, key.ID, now)
+	}
+
+	payload := &domainauth.TokenPayload{
+		UserID:      user.ID,
+		Role:        user.Role,
+		Permissions: permissions,
+		APIKeyID:    key.ID,
+	}
+	if key.ExpiresAt != nil {
+		payload.ExpiredAt = *key.ExpiresAt
+	}
+
+	return payload, nil
+}
@@ -0,0 +1,340 @@
+package usecase
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain"
+	domainauth "github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain/auth"
+	domainrole "github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain/role"
+	domainuser "github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain/user"
+	"github.com/TienMinh25/go-hexagonal-architecture/internal/application/mock"
+	"github.com/TienMinh25/go-hexagonal-architecture/internal/application/util"
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+type createAPIKeyTestedInput struct {
+	key                *domainauth.APIKey
+	creatorPermissions []string
+}
+
+type createAPIKeyExpectedOutput struct {
+	err error
+}
+
+func TestAPIKeyService_CreateAPIKey(t *testing.T) {
+	ctx := context.Background()
+	name := gofakeit.Word()
+	creatorPermissions := []string{domainrole.ProductsWrite, domainrole.CategoriesWrite, domainrole.APIKeysManage}
+	future := time.Now().Add(time.Hour)
+	past := time.Now().Add(-time.Hour)
+
+	testCases := []struct {
+		desc     string
+		mocks    func(apiKeyRepo *mock.MockAPIKeyRepository)
+		input    createAPIKeyTestedInput
+		expected createAPIKeyExpectedOutput
+	}{
+		{
+			desc: "Success",
+			mocks: func(apiKeyRepo *mock.MockAPIKeyRepository) {
+				apiKeyRepo.EXPECT().
+					CreateAPIKey(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, key *domainauth.APIKey) (*domainauth.APIKey, error) {
+						key.ID = gofakeit.Uint64()
+						return key, nil
+					})
+			},
+			input: createAPIKeyTestedInput{
+				key: &domainauth.APIKey{
+					Name:      name,
+					Scopes:    []string{domainrole.ProductsWrite},
+					ExpiresAt: &future,
+				},
+				creatorPermissions: creatorPermissions,
+			},
+			expected: createAPIKeyExpectedOutput{
+				err: nil,
+			},
+		},
+		{
+			desc:  "Fail_ExpiryInThePast",
+			mocks: func(apiKeyRepo *mock.MockAPIKeyRepository) {},
+			input: createAPIKeyTestedInput{
+				key: &domainauth.APIKey{
+					Name:      name,
+					Scopes:    []string{domainrole.ProductsWrite},
+					ExpiresAt: &past,
+				},
+				creatorPermissions: creatorPermissions,
+			},
+			expected: createAPIKeyExpectedOutput{
+				err: domain.ErrInvalidAPIKeyExpiry,
+			},
+		},
+		{
+			desc:  "Fail_ScopeNotHeldByCreator",
+			mocks: func(apiKeyRepo *mock.MockAPIKeyRepository) {},
+			input: createAPIKeyTestedInput{
+				key: &domainauth.APIKey{
+					Name:   name,
+					Scopes: []string{domainrole.OrdersRefund},
+				},
+				creatorPermissions: creatorPermissions,
+			},
+			expected: createAPIKeyExpectedOutput{
+				err: domain.ErrForbidden,
+			},
+		},
+		{
+			desc: "Fail_InternalError",
+			mocks: func(apiKeyRepo *mock.MockAPIKeyRepository) {
+				apiKeyRepo.EXPECT().
+					CreateAPIKey(gomock.Any(), gomock.Any()).
+					Return(nil, domain.ErrInternal)
+			},
+			input: createAPIKeyTestedInput{
+				key: &domainauth.APIKey{
+					Name:   name,
+					Scopes: []string{domainrole.ProductsWrite},
+				},
+				creatorPermissions: creatorPermissions,
+			},
+			expected: createAPIKeyExpectedOutput{
+				err: domain.ErrInternal,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.desc, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			apiKeyRepo := mock.NewMockAPIKeyRepository(ctrl)
+			userRepo := mock.NewMockUserRepository(ctrl)
+			roleRepo := mock.NewMockRoleRepository(ctrl)
+			auditService := newAuditStub(ctrl)
+
+			tc.mocks(apiKeyRepo)
+
+			apiKeyService := NewAPIKeyUsecase(apiKeyRepo, userRepo, roleRepo, auditService)
+
+			key, value, err := apiKeyService.CreateAPIKey(ctx, tc.input.key, tc.input.creatorPermissions)
+			assert.ErrorIs(t, err, tc.expected.err, "Error mismatch")
+
+			if tc.expected.err != nil {
+				assert.Nil(t, key, "API key mismatch")
+				assert.Empty(t, value, "Key value mismatch")
+				return
+			}
+
+			assert.True(t, strings.HasPrefix(value, key.Prefix), "Prefix mismatch")
+			assert.Equal(t, util.HashToken(value), key.KeyHash, "Key hash mismatch")
+		})
+	}
+}
+
+type verifyAPIKeyTestedInput struct {
+	value string
+}
+
+type verifyAPIKeyExpectedOutput struct {
+	payload *domainauth.TokenPayload
+	err     error
+}
+
+func TestAPIKeyService_VerifyAPIKey(t *testing.T) {
+	ctx := context.Background()
+	value := "pk_" + gofakeit.UUID()
+	keyHash := util.HashToken(value)
+	past := time.Now().Add(-time.Hour)
+	recent := time.Now()
+
+	creator := &domainuser.User{
+		ID:   gofakeit.Uint64(),
+		Role: domainuser.Manager,
+	}
+	deletedCreator := &domainuser.User{
+		ID:        creator.ID,
+		Role:      domainuser.Manager,
+		DeletedAt: &past,
+	}
+	key := &domainauth.APIKey{
+		ID:         gofakeit.Uint64(),
+		KeyHash:    keyHash,
+		Scopes:     []string{domainrole.ProductsWrite, domainrole.CategoriesWrite},
+		CreatedBy:  creator.ID,
+		LastUsedAt: &recent,
+	}
+	staleKey := &domainauth.APIKey{
+		ID:        key.ID,
+		KeyHash:   keyHash,
+		Scopes:    key.Scopes,
+		CreatedBy: creator.ID,
+	}
+	revokedKey := &domainauth.APIKey{
+		ID:        key.ID,
+		KeyHash:   keyHash,
+		Scopes:    key.Scopes,
+		CreatedBy: creator.ID,
+		RevokedAt: &past,
+	}
+	expiredKey := &domainauth.APIKey{
+		ID:        key.ID,
+		KeyHash:   keyHash,
+		Scopes:    key.Scopes,
+		CreatedBy: creator.ID,
+		ExpiresAt: &past,
+	}
+	payload := &domainauth.TokenPayload{
+		UserID:      creator.ID,
+		Role:        creator.Role,
+		Permissions: []string{domainrole.ProductsWrite},
+		APIKeyID:    key.ID,
+	}
+
+	testCases := []struct {
+		desc     string
+		mocks    func(apiKeyRepo *mock.MockAPIKeyRepository, userRepo *mock.MockUserRepository, roleRepo *mock.MockRoleRepository)
+		input    verifyAPIKeyTestedInput
+		expected verifyAPIKeyExpectedOutput
+	}{
+		{
+			desc: "Success_OnlyScopesStillGranted",
+			mocks: func(apiKeyRepo *mock.MockAPIKeyRepository, userRepo *mock.MockUserRepository, roleRepo *mock.MockRoleRepository) {
+				apiKeyRepo.EXPECT().
+					GetAPIKeyByHash(gomock.Any(), gomock.Eq(keyHash)).
+					Return(key, nil)
+				userRepo.EXPECT().
+					GetUserByID(gomock.Any(), gomock.Eq(creator.ID)).
+					Return(creator, nil)
+				roleRepo.EXPECT().
+					ListRolePermissions(gomock.Any(), gomock.Eq(creator.Role)).
+					Return([]string{domainrole.ProductsWrite, domainrole.OrdersRefund}, nil)
+			},
+			input: verifyAPIKeyTestedInput{
+				value: value,
+			},
+			expected: verifyAPIKeyExpectedOutput{
+				payload: payload,
+				err:     nil,
+			},
+		},
+		{
+			desc: "Success_TouchesStaleKey",
+			mocks: func(apiKeyRepo *mock.MockAPIKeyRepository, userRepo *mock.MockUserRepository, roleRepo *mock.MockRoleRepository) {
+				apiKeyRepo.EXPECT().
+					GetAPIKeyByHash(gomock.Any(), gomock.Eq(keyHash)).
+					Return(staleKey, nil)
+				userRepo.EXPECT().
+					GetUserByID(gomock.Any(), gomock.Eq(creator.ID)).
+					Return(creator, nil)
+				roleRepo.EXPECT().
+					ListRolePermissions(gomock.Any(), gomock.Eq(creator.Role)).
+					Return([]string{domainrole.ProductsWrite}, nil)
+				apiKeyRepo.EXPECT().
+					TouchAPIKey(gomock.Any(), gomock.Eq(key.ID), gomock.Any()).
+					Return(nil)
+			},
+			input: verifyAPIKeyTestedInput{
+				value: value,
+			},
+			expected: verifyAPIKeyExpectedOutput{
+				payload: payload,
+				err:     nil,
+			},
+		},
+		{
+			desc: "Fail_UnknownKey",
+			mocks: func(apiKeyRepo *mock.MockAPIKeyRepository, userRepo *mock.MockUserRepository, roleRepo *mock.MockRoleRepository) {
+				apiKeyRepo.EXPECT().
+					GetAPIKeyByHash(gomock.Any(), gomock.Eq(keyHash)).
+					Return(nil, domain.ErrDataNotFound)
+			},
+			input: verifyAPIKeyTestedInput{
+				value: value,
+			},
+			expected: verifyAPIKeyExpectedOutput{
+				payload: nil,
+				err:     domain.ErrInvalidAPIKey,
+			},
+		},
+		{
+			desc: "Fail_Revoked",
+			mocks: func(apiKeyRepo *mock.MockAPIKeyRepository, userRepo *mock.MockUserRepository, roleRepo *mock.MockRoleRepository) {
+				apiKeyRepo.EXPECT().
+					GetAPIKeyByHash(gomock.Any(), gomock.Eq(keyHash)).
+					Return(revokedKey, nil)
+			},
+			input: verifyAPIKeyTestedInput{
+				value: value,
+			},
+			expected: verifyAPIKeyExpectedOutput{
+				payload: nil,
+				err:     domain.ErrInvalidAPIKey,
+			},
+		},
+		{
+			desc: "Fail_Expired",
+			mocks: func(apiKeyRepo *mock.MockAPIKeyRepository, userRepo *mock.MockUserRepository, roleRepo *mock.MockRoleRepository) {
+				apiKeyRepo.EXPECT().
+					GetAPIKeyByHash(gomock.Any(), gomock.Eq(keyHash)).
+					Return(expiredKey, nil)
+			},
+			input: verifyAPIKeyTestedInput{
+				value: value,
+			},
+			expected: verifyAPIKeyExpectedOutput{
+				payload: nil,
+				err:     domain.ErrInvalidAPIKey,
+			},
+		},
+		{
+			desc: "Fail_CreatorDeleted",
+			mocks: func(apiKeyRepo *mock.MockAPIKeyRepository, userRepo *mock.MockUserRepository, roleRepo *mock.MockRoleRepository) {
+				apiKeyRepo.EXPECT().
+					GetAPIKeyByHash(gomock.Any(), gomock.Eq(keyHash)).
+					Return(key, nil)
+				userRepo.EXPECT().
+					GetUserByID(gomock.Any(), gomock.Eq(creator.ID)).
+					Return(deletedCreator, nil)
+			},
+			input: verifyAPIKeyTestedInput{
+				value: value,
+			},
+			expected: verifyAPIKeyExpectedOutput{
+				payload: nil,
+				err:     domain.ErrInvalidAPIKey,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.desc, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			apiKeyRepo := mock.NewMockAPIKeyRepository(ctrl)
+			userRepo := mock.NewMockUserRepository(ctrl)
+			roleRepo := mock.NewMockRoleRepository(ctrl)
+			auditService := newAuditStub(ctrl)
+
+			tc.mocks(apiKeyRepo, userRepo, roleRepo)
+
+			apiKeyService := NewAPIKeyUsecase(apiKeyRepo, userRepo, roleRepo, auditService)
+
+			payload, err := apiKeyService.VerifyAPIKey(ctx, tc.input.value)
+			assert.ErrorIs(t, err, tc.expected.err, "Error mismatch")
+			assert.Equal(t, tc.expected.payload, payload, "Payload mismatch")
+		})
+	}
+}
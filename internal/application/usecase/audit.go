@@ -0,0 +1,94 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain"
+	domainaudit "github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain/audit"
+	"github.com/TienMinh25/go-hexagonal-architecture/internal/application/port"
+)
+
+/**
+ * auditUsecase implements port.AuditService interface
+ * and provides an access to the audit repository
+ */
+type auditUsecase struct {
+	repo port.AuditRepository
+}
+
+// NewAuditUsecase creates a new audit service instance
+func NewAuditUsecase(repo port.AuditRepository) port.AuditService {
+	return &auditUsecase{
+		repo,
+	}
+}
+
+// auditVerifyBatchSize is the number of audit logs read at once while verifying the chain
+const auditVerifyBatchSize = 500
+
+// Record logs an action by the actor of the context. The action has already been done,
+// so a log that cannot be written fails the request without undoing the action
+func (as *auditUsecase) Record(ctx context.Context, action domainaudit.Action, entity string, entityID uint64, before, after any) error {
+	log, err := domainaudit.NewLog(action, entity, entityID, before, after)
+	if err != nil {
+		return domain.ErrInternal.Wrap(err)
+	}
+
+	actor := domainaudit.ActorFromContext(ctx)
+	log.ActorID = actor.UserID
+	log.APIKeyID = actor.APIKeyID
+	log.IP = actor.IP
+	log.RequestID = actor.RequestID
+
+	// the log is written even when the client has gone away after the action
+	_, err = as.repo.AppendAuditLog(context.WithoutCancel(ctx), log)
+	if err != nil {
+		return domain.ErrInternal.Wrap(err)
+	}
+
+	return nil
+}
+
+// ListAuditLogs returns a list of audit logs matching the filter with pagination
+func (as *auditUsecase) ListAuditLogs(ctx context.Context, filter domainaudit.Filter, skip, limit uint64) ([]domainaudit.Log, error) {
+	logs, err := as.repo.ListAuditLogs(ctx, filter, skip, limit)
+	if err != nil {
+		return nil, domain.ErrInternal.Wrap(err)
+	}
+
+	return logs, nil
+}
+
+// VerifyAuditLogs walks the whole chain, checking that every log matches its hash
+// and covers the hash of the log before it
+func (as *auditUsecase) VerifyAuditLogs(ctx context.Context) (*domainaudit.Verification, error) {
+	verification := &domainaudit.Verification{
+		Valid: true,
+	}
+
+	var lastID uint64
+	var prevHash string
+
+	for {
+		logs, err := as.repo.ListAuditLogsAfter(ctx, lastID, auditVerifyBatchSize)
+		if err != nil {
+			return nil, domain.ErrInternal.Wrap(err)
+		}
+
+		for _, log := range logs {
+			if log.PrevHash != prevHash || log.ComputeHash() != log.Hash {
+				verification.Valid = false
+				verification.BrokenAt = log.ID
+				return verification, nil
+			}
+
+			verification.Checked++
+			lastID = log.ID
+			prevHash = log.Hash
+		}
+
+		if len(logs) < auditVerifyBatchSize {
+			return verification, nil
+		}
+	}
+}
@@ -0,0 +1,315 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain"
+	domainaudit "github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain/audit"
+	domaingiftcard "github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain/giftcard"
+	domainorder "github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain/order"
+	domainproduct "github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain/product"
+	domainuser "github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain/user"
+	"github.com/TienMinh25/go-hexagonal-architecture/internal/application/mock"
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+// newAuditStub creates an audit service mock accepting any record, for use cases whose tests do not check their audit logs
+func newAuditStub(ctrl *gomock.Controller) *mock.MockAuditService {
+	auditService := mock.NewMockAuditService(ctrl)
+	auditService.EXPECT().
+		Record(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(nil).
+		AnyTimes()
+
+	return auditService
+}
+
+// newChainedLogs creates audit logs chained the way the repository appends them
+func newChainedLogs(count int) []domainaudit.Log {
+	var logs []domainaudit.Log
+	var prevHash string
+
+	for i := range count {
+		log := domainaudit.Log{
+			ID:        uint64(i + 1),
+			ActorID:   gofakeit.Uint64(),
+			Action:    domainaudit.Update,
+			Entity:    domainaudit.Product,
+			EntityID:  gofakeit.Uint64(),
+			Changes:   json.RawMessage(`{}`),
+			PrevHash:  prevHash,
+			CreatedAt: time.Now().UTC().Truncate(time.Microsecond),
+		}
+		log.Hash = log.ComputeHash()
+		prevHash = log.Hash
+
+		logs = append(logs, log)
+	}
+
+	return logs
+}
+
+type recordTestedInput struct {
+	actor     domainaudit.Actor
+	before    any
+	after     any
+	appendErr error
+}
+
+type recordExpectedOutput struct {
+	changes map[string]domainaudit.Change
+	secrets []string
+	err     error
+}
+
+func TestAuditService_Record(t *testing.T) {
+	actor := domainaudit.Actor{
+		UserID:    gofakeit.Uint64(),
+		IP:        gofakeit.IPv4Address(),
+		RequestID: gofakeit.UUID(),
+	}
+
+	product := &domainproduct.Product{
+		ID:    gofakeit.Uint64(),
+		Name:  gofakeit.ProductName(),
+		Price: 10,
+	}
+	repricedProduct := &domainproduct.Product{
+		ID:    product.ID,
+		Name:  product.Name,
+		Price: 12.5,
+	}
+	user := &domainuser.User{
+		ID:       gofakeit.Uint64(),
+		Name:     gofakeit.Name(),
+		Password: gofakeit.Password(true, true, true, true, false, 8),
+	}
+	giftCardCode := gofakeit.Regex(`[A-Z]{4}-[A-Z]{4}-[A-Z]{4}-[A-Z]{4}`)
+	order := &domainorder.Order{
+		ID:   gofakeit.Uint64(),
+		User: user,
+		GiftCard: &domaingiftcard.GiftCard{
+			ID:   gofakeit.Uint64(),
+			Code: giftCardCode,
+		},
+		Products: []domainorder.OrderProduct{
+			{
+				ProductID: product.ID,
+				Product:   product,
+			},
+		},
+	}
+
+	testCases := []struct {
+		desc     string
+		input    recordTestedInput
+		expected recordExpectedOutput
+	}{
+		{
+			desc: "Success_OnlyChangedFields",
+			input: recordTestedInput{
+				actor:  actor,
+				before: product,
+				after:  repricedProduct,
+			},
+			expected: recordExpectedOutput{
+				changes: map[string]domainaudit.Change{
+					"Price": {From: 10.0, To: 12.5},
+				},
+			},
+		},
+		{
+			desc: "Success_SecretsRedacted",
+			input: recordTestedInput{
+				actor:  actor,
+				before: nil,
+				after:  user,
+			},
+			expected: recordExpectedOutput{
+				secrets: []string{"Password", user.Password},
+				changes: map[string]domainaudit.Change{
+					"ID":        {From: nil, To: float64(user.ID)},
+					"Name":      {From: nil, To: user.Name},
+					"Email":     {From: nil, To: ""},
+					"Role":      {From: nil, To: ""},
+					"Locale":    {From: nil, To: ""},
+					"CreatedAt": {From: nil, To: "0001-01-01T00:00:00Z"},
+					"UpdatedAt": {From: nil, To: "0001-01-01T00:00:00Z"},
+					"DeletedAt": {From: nil, To: nil},
+					"Version":   {From: nil, To: 0.0},
+				},
+			},
+		},
+		{
+			desc: "Success_NestedSecretsRedacted",
+			input: recordTestedInput{
+				actor:  actor,
+				before: nil,
+				after:  order,
+			},
+			expected: recordExpectedOutput{
+				secrets: []string{user.Password, giftCardCode},
+			},
+		},
+		{
+			desc: "Fail_RepositoryError",
+			input: recordTestedInput{
+				actor:     actor,
+				before:    product,
+				after:     repricedProduct,
+				appendErr: domain.ErrInternal,
+			},
+			expected: recordExpectedOutput{
+				err: domain.ErrInternal,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.desc, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			auditRepo := mock.NewMockAuditRepository(ctrl)
+
+			var recorded *domainaudit.Log
+			auditRepo.EXPECT().
+				AppendAuditLog(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(_ context.Context, log *domainaudit.Log) (*domainaudit.Log, error) {
+					recorded = log
+					return log, tc.input.appendErr
+				})
+
+			auditService := NewAuditUsecase(auditRepo)
+
+			ctx := domainaudit.WithActor(context.Background(), tc.input.actor)
+			err := auditService.Record(ctx, domainaudit.Update, domainaudit.Product, 1, tc.input.before, tc.input.after)
+			assert.ErrorIs(t, err, tc.expected.err, "Error mismatch")
+
+			assert.NotNil(t, recorded, "Log mismatch")
+			assert.Equal(t, tc.input.actor.UserID, recorded.ActorID, "Actor mismatch")
+			assert.Equal(t, tc.input.actor.IP, recorded.IP, "IP mismatch")
+			assert.Equal(t, tc.input.actor.RequestID, recorded.RequestID, "Request ID mismatch")
+
+			for _, secret := range tc.expected.secrets {
+				assert.NotContains(t, string(recorded.After), secret, "Secret mismatch")
+				assert.NotContains(t, string(recorded.Changes), secret, "Secret mismatch")
+			}
+
+			if tc.expected.changes != nil {
+				var changes map[string]domainaudit.Change
+				assert.NoError(t, json.Unmarshal(recorded.Changes, &changes), "Changes mismatch")
+				assert.Equal(t, tc.expected.changes, changes, "Changes mismatch")
+			}
+		})
+	}
+}
+
+type verifyAuditLogsExpectedOutput struct {
+	verification *domainaudit.Verification
+	err          error
+}
+
+func TestAuditService_VerifyAuditLogs(t *testing.T) {
+	ctx := context.Background()
+
+	logs := newChainedLogs(3)
+
+	editedLogs := newChainedLogs(3)
+	editedLogs[1].EntityID++
+
+	removedLogs := newChainedLogs(3)
+	removedLogs = append(removedLogs[:1], removedLogs[2])
+
+	testCases := []struct {
+		desc     string
+		mocks    func(auditRepo *mock.MockAuditRepository)
+		expected verifyAuditLogsExpectedOutput
+	}{
+		{
+			desc: "Success_Valid",
+			mocks: func(auditRepo *mock.MockAuditRepository) {
+				auditRepo.EXPECT().
+					ListAuditLogsAfter(gomock.Any(), gomock.Eq(uint64(0)), gomock.Any()).
+					Return(logs, nil)
+			},
+			expected: verifyAuditLogsExpectedOutput{
+				verification: &domainaudit.Verification{
+					Valid:   true,
+					Checked: 3,
+				},
+				err: nil,
+			},
+		},
+		{
+			desc: "Success_EditedLog",
+			mocks: func(auditRepo *mock.MockAuditRepository) {
+				auditRepo.EXPECT().
+					ListAuditLogsAfter(gomock.Any(), gomock.Eq(uint64(0)), gomock.Any()).
+					Return(editedLogs, nil)
+			},
+			expected: verifyAuditLogsExpectedOutput{
+				verification: &domainaudit.Verification{
+					Valid:    false,
+					Checked:  1,
+					BrokenAt: editedLogs[1].ID,
+				},
+				err: nil,
+			},
+		},
+		{
+			desc: "Success_RemovedLog",
+			mocks: func(auditRepo *mock.MockAuditRepository) {
+				auditRepo.EXPECT().
+					ListAuditLogsAfter(gomock.Any(), gomock.Eq(uint64(0)), gomock.Any()).
+					Return(removedLogs, nil)
+			},
+			expected: verifyAuditLogsExpectedOutput{
+				verification: &domainaudit.Verification{
+					Valid:    false,
+					Checked:  1,
+					BrokenAt: removedLogs[1].ID,
+				},
+				err: nil,
+			},
+		},
+		{
+			desc: "Fail_InternalError",
+			mocks: func(auditRepo *mock.MockAuditRepository) {
+				auditRepo.EXPECT().
+					ListAuditLogsAfter(gomock.Any(), gomock.Eq(uint64(0)), gomock.Any()).
+					Return(nil, domain.ErrInternal)
+			},
+			expected: verifyAuditLogsExpectedOutput{
+				verification: nil,
+				err:          domain.ErrInternal,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.desc, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			auditRepo := mock.NewMockAuditRepository(ctrl)
+
+			tc.mocks(auditRepo)
+
+			auditService := NewAuditUsecase(auditRepo)
+
+			verification, err := auditService.VerifyAuditLogs(ctx)
+			assert.ErrorIs(t, err, tc.expected.err, "Error mismatch")
+			assert.Equal(t, tc.expected.verification, verification, "Verification mismatch")
+		})
+	}
+}
@@ -2,52 +2,747 @@ package usecase
 
 import (
 	"context"
+	"crypto/subtle"
+	"errors"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
 
 	"github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain"
+	domainaudit "github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain/audit"
+	domainauth "github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain/auth"
+	domainuser "github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain/user"
 	"github.com/TienMinh25/go-hexagonal-architecture/internal/application/port"
 	"github.com/TienMinh25/go-hexagonal-architecture/internal/application/util"
+	"github.com/google/uuid"
 )
 
 /**
  * authUsecase implements port.AuthService interface
- * and provides an access to the user repository
- * and token service
+ * and provides an access to the user repository,
+ * refresh token repository, role repository, terminal repository,
+ * two-factor repository, token service, cache service and audit service
  */
 type authUsecase struct {
-	repo port.UserRepository
-	ts   port.TokenService
+	repo            port.UserRepository
+	refreshRepo     port.RefreshTokenRepository
+	roleRepo        port.RoleRepository
+	terminalRepo    port.TerminalRepository
+	twoFactorRepo   port.TwoFactorRepository
+	ts              port.TokenService
+	cache           port.CacheRepository
+	refreshDuration time.Duration
+	lockout         domainauth.LockoutPolicy
+	twoFactor       domainauth.TwoFactorPolicy
+	audit           port.AuditService
 }
 
 // NewAuthUsecase creates a new auth service instance
-func NewAuthUsecase(repo port.UserRepository, ts port.TokenService) port.AuthService {
+func NewAuthUsecase(
+	repo port.UserRepository,
+	refreshRepo port.RefreshTokenRepository,
+	roleRepo port.RoleRepository,
+	terminalRepo port.TerminalRepository,
+	twoFactorRepo port.TwoFactorRepository,
+	ts port.TokenService,
+	cache port.CacheRepository,
+	refreshDuration time.Duration,
+	lockout domainauth.LockoutPolicy,
+	twoFactor domainauth.TwoFactorPolicy,
+	audit port.AuditService,
+) port.AuthService {
 	return &authUsecase{
-		repo: repo,
-		ts:   ts,
+		repo:            repo,
+		refreshRepo:     refreshRepo,
+		roleRepo:        roleRepo,
+		terminalRepo:    terminalRepo,
+		twoFactorRepo:   twoFactorRepo,
+		ts:              ts,
+		cache:           cache,
+		refreshDuration: refreshDuration,
+		lockout:         lockout,
+		twoFactor:       twoFactor,
+		audit:           audit,
 	}
 }
 
-// Login gives a registered user an access token if the credentials are valid
-func (as *authUsecase) Login(ctx context.Context, email, password string) (string, error) {
+// challengeDuration is how long a two-factor login challenge can be completed
+const challengeDuration = 5 * time.Minute
+
+// recoveryCodeCount is the number of recovery codes given when two-factor authentication is enabled
+const recoveryCodeCount = 10
+
+// dummyPasswordHash is compared against when the email is unknown,
+// so that a login takes as long whether the account exists or not
+var dummyPasswordHash = sync.OnceValue(func() string {
+	hashedPassword, _ := util.HashPassword(uuid.NewString())
+	return hashedPassword
+})
+
+// Login gives a registered user an access token and a refresh token if the credentials are valid,
+// or a challenge to complete with a second factor when the user has enabled it.
+// Failed logins are counted per email and per client ip, backing off and eventually locking the account
+func (as *authUsecase) Login(ctx context.Context, email, password, ip string) (*domainauth.LoginResult, error) {
+	err := as.checkLoginThrottle(ctx, email, ip)
+	if err != nil {
+		return nil, err
+	}
+
 	user, err := as.repo.GetUserByEmail(ctx, email)
+	if err != nil && !errors.Is(err, domain.ErrDataNotFound) {
+		return nil, domain.ErrInternal.Wrap(err)
+	}
+
+	hashedPassword := dummyPasswordHash()
+	if user != nil {
+		hashedPassword = user.Password
+	}
+
+	err = util.ComparePassword(password, hashedPassword)
 
+	if user == nil || user.DeletedAt != nil || err != nil {
+		as.recordLoginFailure(ctx, email, ip)
+		return nil, domain.ErrInvalidCredentials
+	}
+
+	enabled, err := as.twoFactorEnabled(ctx, user.ID)
 	if err != nil {
-		if err == domain.ErrDataNotFound {
-			return "", domain.ErrInvalidCredentials
+		return nil, err
+	}
+
+	// failures are only cleared once the second factor is passed too,
+	// so that knowing the password does not reset the throttling of code guesses
+	if enabled {
+		challenge, err := as.createChallenge(ctx, user.ID)
+		if err != nil {
+			return nil, err
 		}
-		return "", domain.ErrInternal
+
+		return &domainauth.LoginResult{
+			Challenge: challenge,
+		}, nil
 	}
 
-	err = util.ComparePassword(password, user.Password)
+	_ = as.cache.Delete(ctx, loginKey("login_failures", "email", email))
 
+	tokens, err := as.issueTokens(ctx, user, uuid.New(), 0)
 	if err != nil {
-		return "", domain.ErrInvalidCredentials
+		return nil, err
 	}
 
-	accessToken, err := as.ts.CreateToken(user)
+	return &domainauth.LoginResult{
+		Tokens: tokens,
+	}, nil
+}
+
+// VerifyTwoFactor gives the user of a login challenge an access token and a refresh token if the TOTP or recovery code is valid.
+// Failed codes count towards the same backoff and lockout as failed passwords of the user
+func (as *authUsecase) VerifyTwoFactor(ctx context.Context, challenge, code, ip string) (*domainauth.TokenPair, error) {
+	challengeKey := util.GenerateCacheKey("login_challenge", util.HashToken(challenge))
 
+	value, err := as.cache.Get(ctx, challengeKey)
+	if err != nil {
+		return nil, domain.ErrInvalidChallenge
+	}
+
+	userID, err := strconv.ParseUint(string(value), 10, 64)
+	if err != nil {
+		return nil, domain.ErrInvalidChallenge
+	}
+
+	user, err := as.repo.GetUserByID(ctx, userID)
+	if err != nil {
+		if errors.Is(err, domain.ErrDataNotFound) {
+			return nil, domain.ErrInvalidChallenge
+		}
+		return nil, domain.ErrInternal.Wrap(err)
+	}
+
+	if user.DeletedAt != nil {
+		return nil, domain.ErrInvalidChallenge
+	}
+
+	err = as.checkLoginThrottle(ctx, user.Email, ip)
+	if err != nil {
+		return nil, err
+	}
+
+	totp, err := as.twoFactorRepo.GetTOTP(ctx, user.ID)
+	if err != nil {
+		if errors.Is(err, domain.ErrDataNotFound) {
+			return nil, domain.ErrInvalidChallenge
+		}
+		return nil, domain.ErrInternal.Wrap(err)
+	}
+
+	if totp.ConfirmedAt == nil {
+		return nil, domain.ErrInvalidChallenge
+	}
+
+	err = as.verifyTwoFactorCode(ctx, totp, code, true)
+	if err != nil {
+		if errors.Is(err, domain.ErrInvalidTwoFactorCode) {
+			as.recordLoginFailure(ctx, user.Email, ip)
+		}
+		return nil, err
+	}
+
+	_ = as.cache.Delete(ctx, challengeKey)
+	_ = as.cache.Delete(ctx, loginKey("login_failures", "email", user.Email))
+
+	return as.issueTokens(ctx, user, uuid.New(), 0)
+}
+
+// EnrollTwoFactor starts the TOTP enrollment of a user with a new secret, replacing an unconfirmed one
+func (as *authUsecase) EnrollTwoFactor(ctx context.Context, userID uint64) (*domainauth.TOTPEnrollment, error) {
+	user, err := as.repo.GetUserByID(ctx, userID)
+	if err != nil {
+		if errors.Is(err, domain.ErrDataNotFound) {
+			return nil, err
+		}
+		return nil, domain.ErrInternal.Wrap(err)
+	}
+
+	enabled, err := as.twoFactorEnabled(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if enabled {
+		return nil, domain.ErrTwoFactorEnabled
+	}
+
+	secret, err := util.GenerateTOTPSecret()
+	if err != nil {
+		return nil, domain.ErrInternal.Wrap(err)
+	}
+
+	_, err = as.twoFactorRepo.SaveTOTP(ctx, &domainauth.TOTP{
+		UserID: user.ID,
+		Secret: secret,
+	})
+	if err != nil {
+		if errors.Is(err, domain.ErrDataNotFound) {
+			return nil, err
+		}
+		return nil, domain.ErrInternal.Wrap(err)
+	}
+
+	return &domainauth.TOTPEnrollment{
+		Secret:          secret,
+		ProvisioningURI: util.TOTPProvisioningURI(as.twoFactor.Issuer, user.Email, secret),
+	}, nil
+}
+
+// ConfirmTwoFactor enables two-factor authentication of a user once a TOTP code proves the authenticator is set up,
+// the recovery codes are only returned here and stored hashed
+func (as *authUsecase) ConfirmTwoFactor(ctx context.Context, userID uint64, code string) ([]string, error) {
+	totp, err := as.twoFactorRepo.GetTOTP(ctx, userID)
+	if err != nil {
+		if errors.Is(err, domain.ErrDataNotFound) {
+			return nil, domain.ErrTwoFactorNotEnabled
+		}
+		return nil, domain.ErrInternal.Wrap(err)
+	}
+
+	if totp.ConfirmedAt != nil {
+		return nil, domain.ErrTwoFactorEnabled
+	}
+
+	err = as.verifyTwoFactorCode(ctx, totp, code, false)
+	if err != nil {
+		return nil, err
+	}
+
+	codes := make([]string, recoveryCodeCount)
+	codeHashes := make([]string, recoveryCodeCount)
+	for i := range codes {
+		codes[i], err = util.GenerateCode(2, 5)
+		if err != nil {
+			return nil, domain.ErrInternal.Wrap(err)
+		}
+		codeHashes[i] = hashRecoveryCode(codes[i])
+	}
+
+	err = as.twoFactorRepo.ConfirmTOTP(ctx, userID, codeHashes)
+	if err != nil {
+		if errors.Is(err, domain.ErrTwoFactorNotEnabled) {
+			return nil, err
+		}
+		return nil, domain.ErrInternal.Wrap(err)
+	}
+
+	err = as.audit.Record(ctx, domainaudit.Enable2FA, domainaudit.User, userID, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return codes, nil
+}
+
+// DisableTwoFactor disables two-factor authentication of a user with a valid TOTP or recovery code,
+// an unconfirmed enrollment is dropped without one. Users whose role requires it cannot disable it
+func (as *authUsecase) DisableTwoFactor(ctx context.Context, userID uint64, code string) error {
+	user, err := as.repo.GetUserByID(ctx, userID)
+	if err != nil {
+		if errors.Is(err, domain.ErrDataNotFound) {
+			return err
+		}
+		return domain.ErrInternal.Wrap(err)
+	}
+
+	totp, err := as.twoFactorRepo.GetTOTP(ctx, user.ID)
+	if err != nil {
+		if errors.Is(err, domain.ErrDataNotFound) {
+			return domain.ErrTwoFactorNotEnabled
+		}
+		return domain.ErrInternal.Wrap(err)
+	}
+
+	if totp.ConfirmedAt != nil {
+		if as.twoFactorRequired(user) {
+			return domain.ErrTwoFactorRequired
+		}
+
+		err = as.verifyTwoFactorCode(ctx, totp, code, true)
+		if err != nil {
+			return err
+		}
+	}
+
+	err = as.twoFactorRepo.DeleteTOTP(ctx, user.ID)
+	if err != nil {
+		return domain.ErrInternal.Wrap(err)
+	}
+
+	err = as.audit.Record(ctx, domainaudit.Disable2FA, domainaudit.User, user.ID, nil, nil)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// PINLogin gives a user a short-lived access token carrying the terminal id if the terminal credentials and the user's PIN are valid.
+// Failed PINs count towards the same backoff and lockout as failed passwords of the user
+func (as *authUsecase) PINLogin(ctx context.Context, terminalID uint64, terminalSecret string, userID uint64, pin, ip string) (string, error) {
+	err := as.verifyTerminal(ctx, terminalID, terminalSecret)
+	if err != nil {
+		return "", err
+	}
+
+	// PINs are guessed by trying user ids too, so the terminal and ip are throttled before any user is looked up
+	terminal := strconv.FormatUint(terminalID, 10)
+
+	err = as.checkSourceThrottle(ctx, "terminal", terminal)
+	if err != nil {
+		return "", err
+	}
+
+	err = as.checkSourceThrottle(ctx, "ip", ip)
+	if err != nil {
+		return "", err
+	}
+
+	user, err := as.repo.GetUserByID(ctx, userID)
+	if err != nil {
+		if errors.Is(err, domain.ErrDataNotFound) {
+			as.recordSourceFailure(ctx, "terminal", terminal)
+			as.recordSourceFailure(ctx, "ip", ip)
+			return "", domain.ErrInvalidPIN
+		}
+		return "", domain.ErrInternal.Wrap(err)
+	}
+
+	err = as.checkLoginThrottle(ctx, user.Email, "")
+	if err != nil {
+		return "", err
+	}
+
+	pinHash, err := as.repo.GetUserPIN(ctx, user.ID)
+	if err != nil && !errors.Is(err, domain.ErrDataNotFound) {
+		return "", domain.ErrInternal.Wrap(err)
+	}
+
+	hasPIN := err == nil
+	if !hasPIN {
+		pinHash = dummyPasswordHash()
+	}
+
+	err = util.ComparePassword(pin, pinHash)
+
+	if !hasPIN || user.DeletedAt != nil || err != nil {
+		as.recordSourceFailure(ctx, "terminal", terminal)
+		as.recordLoginFailure(ctx, user.Email, ip)
+		return "", domain.ErrInvalidPIN
+	}
+
+	_ = as.cache.Delete(ctx, loginKey("login_failures", "email", user.Email))
+
+	permissions, err := as.grantedPermissions(ctx, user)
+	if err != nil {
+		return "", err
+	}
+
+	accessToken, err := as.ts.CreateTerminalToken(user, permissions, terminalID)
 	if err != nil {
 		return "", domain.ErrTokenCreation
 	}
 
 	return accessToken, nil
 }
+
+// SetPIN sets the terminal login PIN of a user, replacing the previous one
+func (as *authUsecase) SetPIN(ctx context.Context, userID uint64, pin string) error {
+	pinHash, err := util.HashPassword(pin)
+	if err != nil {
+		return domain.ErrInternal.Wrap(err)
+	}
+
+	err = as.repo.SetUserPIN(ctx, userID, pinHash)
+	if err != nil {
+		if errors.Is(err, domain.ErrDataNotFound) {
+			return err
+		}
+		return domain.ErrInternal.Wrap(err)
+	}
+
+	err = as.audit.Record(ctx, domainaudit.SetPIN, domainaudit.User, userID, nil, nil)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// UnlockUser clears the lockout and failed login attempts of a user
+func (as *authUsecase) UnlockUser(ctx context.Context, id uint64) error {
+	user, err := as.repo.GetUserByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, domain.ErrDataNotFound) {
+			return err
+		}
+		return domain.ErrInternal.Wrap(err)
+	}
+
+	for _, prefix := range []string{"login_locked", "login_backoff", "login_failures"} {
+		err = as.cache.Delete(ctx, loginKey(prefix, "email", user.Email))
+		if err != nil {
+			return domain.ErrInternal.Wrap(err)
+		}
+	}
+
+	err = as.audit.Record(ctx, domainaudit.Unlock, domainaudit.User, id, nil, nil)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Refresh rotates a refresh token, a token used twice revokes its whole family
+func (as *authUsecase) Refresh(ctx context.Context, refreshToken string) (*domainauth.TokenPair, error) {
+	existingToken, err := as.refreshRepo.GetRefreshTokenByHash(ctx, util.HashToken(refreshToken))
+	if err != nil {
+		if errors.Is(err, domain.ErrDataNotFound) {
+			return nil, domain.ErrInvalidRefreshToken
+		}
+		return nil, domain.ErrInternal.Wrap(err)
+	}
+
+	if existingToken.UsedAt != nil || existingToken.RevokedAt != nil {
+		return nil, as.revokeReusedFamily(ctx, existingToken.FamilyID)
+	}
+
+	if existingToken.ExpiresAt.Before(time.Now()) {
+		return nil, domain.ErrExpiredRefreshToken
+	}
+
+	user, err := as.repo.GetUserByID(ctx, existingToken.UserID)
+	if err != nil {
+		if errors.Is(err, domain.ErrDataNotFound) {
+			return nil, domain.ErrInvalidRefreshToken
+		}
+		return nil, domain.ErrInternal.Wrap(err)
+	}
+
+	if user.DeletedAt != nil {
+		return nil, domain.ErrInvalidRefreshToken
+	}
+
+	return as.issueTokens(ctx, user, existingToken.FamilyID, existingToken.ID)
+}
+
+// Logout revokes the access token and, when given, the refresh token family of the same user
+func (as *authUsecase) Logout(ctx context.Context, payload *domainauth.TokenPayload, refreshToken string) error {
+	err := as.ts.RevokeToken(ctx, payload)
+	if err != nil {
+		return domain.ErrInternal.Wrap(err)
+	}
+
+	if refreshToken == "" {
+		return nil
+	}
+
+	existingToken, err := as.refreshRepo.GetRefreshTokenByHash(ctx, util.HashToken(refreshToken))
+	if err != nil {
+		if errors.Is(err, domain.ErrDataNotFound) {
+			return domain.ErrInvalidRefreshToken
+		}
+		return domain.ErrInternal.Wrap(err)
+	}
+
+	if existingToken.UserID != payload.UserID {
+		return domain.ErrInvalidRefreshToken
+	}
+
+	err = as.refreshRepo.RevokeRefreshTokenFamily(ctx, existingToken.FamilyID)
+	if err != nil {
+		return domain.ErrInternal.Wrap(err)
+	}
+
+	return nil
+}
+
+// twoFactorEnabled tells whether the user has confirmed a TOTP enrollment
+func (as *authUsecase) twoFactorEnabled(ctx context.Context, userID uint64) (bool, error) {
+	totp, err := as.twoFactorRepo.GetTOTP(ctx, userID)
+	if err != nil {
+		if errors.Is(err, domain.ErrDataNotFound) {
+			return false, nil
+		}
+		return false, domain.ErrInternal.Wrap(err)
+	}
+
+	return totp.ConfirmedAt != nil, nil
+}
+
+// twoFactorRequired tells whether the role of the user must use two-factor authentication
+func (as *authUsecase) twoFactorRequired(user *domainuser.User) bool {
+	return as.twoFactor.RequireAdmin && user.Role == domainuser.Admin
+}
+
+// createChallenge stores a single-use login challenge of the user, only its hash is used as the cache key
+func (as *authUsecase) createChallenge(ctx context.Context, userID uint64) (string, error) {
+	challenge, err := util.GenerateToken()
+	if err != nil {
+		return "", domain.ErrInternal.Wrap(err)
+	}
+
+	challengeKey := util.GenerateCacheKey("login_challenge", util.HashToken(challenge))
+
+	err = as.cache.Set(ctx, challengeKey, []byte(strconv.FormatUint(userID, 10)), challengeDuration)
+	if err != nil {
+		return "", domain.ErrInternal.Wrap(err)
+	}
+
+	return challenge, nil
+}
+
+// verifyTwoFactorCode checks a TOTP code, refusing a code already used in its time step,
+// or a recovery code when allowed, which can only be used once
+func (as *authUsecase) verifyTwoFactorCode(ctx context.Context, totp *domainauth.TOTP, code string, allowRecovery bool) error {
+	step, ok := util.ValidateTOTP(totp.Secret, code, time.Now())
+	if ok {
+		stepKey := util.GenerateCacheKey("totp_step", totp.UserID)
+
+		value, err := as.cache.Get(ctx, stepKey)
+		if err == nil {
+			lastStep, _ := strconv.ParseInt(string(value), 10, 64)
+			if step <= lastStep {
+				return domain.ErrInvalidTwoFactorCode
+			}
+		}
+
+		_ = as.cache.Set(ctx, stepKey, []byte(strconv.FormatInt(step, 10)), challengeDuration)
+		return nil
+	}
+
+	if !allowRecovery {
+		return domain.ErrInvalidTwoFactorCode
+	}
+
+	err := as.twoFactorRepo.UseRecoveryCode(ctx, totp.UserID, hashRecoveryCode(code))
+	if err != nil {
+		if errors.Is(err, domain.ErrDataNotFound) {
+			return domain.ErrInvalidTwoFactorCode
+		}
+		return domain.ErrInternal.Wrap(err)
+	}
+
+	return nil
+}
+
+// hashRecoveryCode hashes a recovery code ignoring case and dashes, so that it can be typed either way
+func hashRecoveryCode(code string) string {
+	return util.HashToken(strings.ToUpper(strings.ReplaceAll(code, "-", "")))
+}
+
+// verifyTerminal checks the terminal is registered, not revoked and the secret matches
+func (as *authUsecase) verifyTerminal(ctx context.Context, id uint64, secret string) error {
+	terminal, err := as.terminalRepo.GetTerminalByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, domain.ErrDataNotFound) {
+			return domain.ErrInvalidTerminal
+		}
+		return domain.ErrInternal.Wrap(err)
+	}
+
+	secretHash := util.HashToken(secret)
+	if terminal.RevokedAt != nil || subtle.ConstantTimeCompare([]byte(terminal.SecretHash), []byte(secretHash)) != 1 {
+		return domain.ErrInvalidTerminal
+	}
+
+	return nil
+}
+
+// checkLoginThrottle fails when the account is locked or the email or ip is backing off.
+// An unreachable cache lets logins through rather than locking everyone out
+func (as *authUsecase) checkLoginThrottle(ctx context.Context, email, ip string) error {
+	_, err := as.cache.Get(ctx, loginKey("login_locked", "email", email))
+	if err == nil {
+		return domain.ErrAccountLocked
+	}
+
+	_, err = as.cache.Get(ctx, loginKey("login_backoff", "email", email))
+	if err == nil {
+		return domain.ErrTooManyLoginAttempts
+	}
+
+	return as.checkSourceThrottle(ctx, "ip", ip)
+}
+
+// checkSourceThrottle rejects logins from an ip or terminal that is backing off after failed logins
+func (as *authUsecase) checkSourceThrottle(ctx context.Context, kind, value string) error {
+	if value == "" {
+		return nil
+	}
+
+	_, err := as.cache.Get(ctx, loginKey("login_backoff", kind, value))
+	if err == nil {
+		return domain.ErrTooManyLoginAttempts
+	}
+
+	return nil
+}
+
+// recordLoginFailure counts a failed login of the email and ip, locking the account once it reaches
+// the maximum attempts and otherwise backing off exponentially. Unknown emails are counted alike
+// so that the responses do not tell which accounts exist
+func (as *authUsecase) recordLoginFailure(ctx context.Context, email, ip string) {
+	failures, err := as.cache.Increment(ctx, loginKey("login_failures", "email", email), as.lockout.LockoutDuration)
+	if err == nil {
+		if failures >= as.lockout.MaxAttempts {
+			_ = as.cache.Set(ctx, loginKey("login_locked", "email", email), []byte{1}, as.lockout.LockoutDuration)
+		} else {
+			_ = as.cache.Set(ctx, loginKey("login_backoff", "email", email), []byte{1}, as.backoffDelay(failures))
+		}
+	}
+
+	as.recordSourceFailure(ctx, "ip", ip)
+}
+
+// recordSourceFailure counts a failed login from an ip or terminal, which is shared by many users
+// and so is only backed off once it reaches the maximum attempts of an address
+func (as *authUsecase) recordSourceFailure(ctx context.Context, kind, value string) {
+	if value == "" {
+		return
+	}
+
+	failures, err := as.cache.Increment(ctx, loginKey("login_failures", kind, value), as.lockout.LockoutDuration)
+	if err == nil && failures >= as.lockout.IPMaxAttempts {
+		_ = as.cache.Set(ctx, loginKey("login_backoff", kind, value), []byte{1}, as.backoffDelay(failures-as.lockout.IPMaxAttempts+1))
+	}
+}
+
+// backoffDelay returns the wait after the given number of throttled failures, doubling from the base delay up to the max delay
+func (as *authUsecase) backoffDelay(failures int64) time.Duration {
+	delay := as.lockout.BaseDelay
+	for i := int64(1); i < failures && delay < as.lockout.MaxDelay; i++ {
+		delay *= 2
+	}
+
+	return min(delay, as.lockout.MaxDelay)
+}
+
+// loginKey returns the cache key of a login throttling record of an email, ip or terminal
+func loginKey(prefix, kind, value string) string {
+	return util.GenerateCacheKey(prefix, util.GenerateCacheKeyParams(kind, strings.ToLower(value)))
+}
+
+// issueTokens creates an access token carrying the current permissions of the user's role and a refresh token of the family,
+// rotating out the refresh token of the given id when it is not zero
+func (as *authUsecase) issueTokens(ctx context.Context, user *domainuser.User, familyID uuid.UUID, rotatedID uint64) (*domainauth.TokenPair, error) {
+	permissions, err := as.grantedPermissions(ctx, user)
+	if err != nil {
+		return nil, err
+	}
+
+	accessToken, err := as.ts.CreateToken(user, permissions)
+	if err != nil {
+		return nil, domain.ErrTokenCreation
+	}
+
+	refreshToken, err := util.GenerateToken()
+	if err != nil {
+		return nil, domain.ErrTokenCreation
+	}
+
+	newToken := &domainauth.RefreshToken{
+		UserID:    user.ID,
+		TokenHash: util.HashToken(refreshToken),
+		FamilyID:  familyID,
+		ExpiresAt: time.Now().Add(as.refreshDuration),
+	}
+
+	if rotatedID == 0 {
+		_, err = as.refreshRepo.CreateRefreshToken(ctx, newToken)
+	} else {
+		_, err = as.refreshRepo.RotateRefreshToken(ctx, rotatedID, newToken)
+	}
+	if err != nil {
+		if errors.Is(err, domain.ErrRefreshTokenReused) {
+			return nil, as.revokeReusedFamily(ctx, familyID)
+		}
+		return nil, domain.ErrInternal.Wrap(err)
+	}
+
+	return &domainauth.TokenPair{
+		AccessToken:  accessToken,
+		RefreshToken: refreshToken,
+	}, nil
+}
+
+// grantedPermissions returns the permissions of the user's role, none while the role requires
+// two-factor authentication that the user has not enabled yet so that the token only allows enrolling
+func (as *authUsecase) grantedPermissions(ctx context.Context, user *domainuser.User) ([]string, error) {
+	if as.twoFactorRequired(user) {
+		enabled, err := as.twoFactorEnabled(ctx, user.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		if !enabled {
+			return []string{}, nil
+		}
+	}
+
+	permissions, err := as.roleRepo.ListRolePermissions(ctx, user.Role)
+	if err != nil {
+		return nil, domain.ErrInternal.Wrap(err)
+	}
+
+	return permissions, nil
+}
+
+// revokeReusedFamily revokes a family whose refresh token was presented after rotation,
+// since either the user or an attacker holds a stolen copy
+func (as *authUsecase) revokeReusedFamily(ctx context.Context, familyID uuid.UUID) error {
+	err := as.refreshRepo.RevokeRefreshTokenFamily(ctx, familyID)
+	if err != nil {
+		return domain.ErrInternal.Wrap(err)
+	}
+
+	return domain.ErrRefreshTokenReused
+}
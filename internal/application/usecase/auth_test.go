@@ -2,46 +2,125 @@ package usecase
 
 import (
 	"context"
+	"errors"
+	"strconv"
 	"testing"
+	"time"
 
 	"github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain"
+	domainauth "github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain/auth"
+	domainrole "github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain/role"
+	domainterminal "github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain/terminal"
 	domainuser "github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain/user"
 	"github.com/TienMinh25/go-hexagonal-architecture/internal/application/mock"
 	"github.com/TienMinh25/go-hexagonal-architecture/internal/application/util"
 	"github.com/brianvoe/gofakeit/v6"
+	"github.com/google/uuid"
 	"go.uber.org/mock/gomock"
 )
 
+// lockout is the failed login throttling policy of the auth service under test
+var lockout = domainauth.LockoutPolicy{
+	MaxAttempts:     5,
+	IPMaxAttempts:   20,
+	BaseDelay:       time.Second,
+	MaxDelay:        time.Minute,
+	LockoutDuration: 15 * time.Minute,
+}
+
+// twoFactor is the two-factor authentication policy of the auth service under test
+var twoFactor = domainauth.TwoFactorPolicy{
+	Issuer:       "hexagonal-demo",
+	RequireAdmin: true,
+}
+
 type loginTestedInput struct {
 	email    string
 	password string
+	ip       string
 }
 
 type loginExpectedOutput struct {
-	token string
-	err   error
+	token     string
+	challenge bool
+	err       error
 }
 
 func TestAuthService_Login(t *testing.T) {
 	ctx := context.Background()
 	email := gofakeit.Email()
+	ip := gofakeit.IPv4Address()
 	password := gofakeit.Password(true, true, true, true, false, 8)
 	hashedPassword, _ := util.HashPassword(password)
 	user := &domainuser.User{
+		ID:       gofakeit.Uint64(),
 		Email:    email,
 		Password: hashedPassword,
+		Role:     domainuser.Manager,
 	}
+	permissions := []string{domainrole.OrdersRefund, domainrole.ProductsWrite}
 	failUser := &domainuser.User{
 		Email:    email,
 		Password: "wrong password",
 	}
+	deletedAt := gofakeit.Date()
+	archivedUser := &domainuser.User{
+		Email:     email,
+		Password:  hashedPassword,
+		DeletedAt: &deletedAt,
+	}
 	token := gofakeit.UUID()
+	confirmedAt := gofakeit.Date()
+	confirmedTOTP := &domainauth.TOTP{
+		UserID:      user.ID,
+		Secret:      gofakeit.LetterN(32),
+		ConfirmedAt: &confirmedAt,
+	}
+
+	lockedKey := loginKey("login_locked", "email", email)
+	emailBackoffKey := loginKey("login_backoff", "email", email)
+	ipBackoffKey := loginKey("login_backoff", "ip", ip)
+	emailFailuresKey := loginKey("login_failures", "email", email)
+	ipFailuresKey := loginKey("login_failures", "ip", ip)
+
+	expectNotThrottled := func(cache *mock.MockCacheRepository) {
+		cache.EXPECT().
+			Get(gomock.Any(), gomock.Eq(lockedKey)).
+			Times(1).
+			Return(nil, domain.ErrDataNotFound)
+		cache.EXPECT().
+			Get(gomock.Any(), gomock.Eq(emailBackoffKey)).
+			Times(1).
+			Return(nil, domain.ErrDataNotFound)
+		cache.EXPECT().
+			Get(gomock.Any(), gomock.Eq(ipBackoffKey)).
+			Times(1).
+			Return(nil, domain.ErrDataNotFound)
+	}
+	expectFailureRecorded := func(cache *mock.MockCacheRepository) {
+		cache.EXPECT().
+			Increment(gomock.Any(), gomock.Eq(emailFailuresKey), gomock.Eq(lockout.LockoutDuration)).
+			Times(1).
+			Return(int64(1), nil)
+		cache.EXPECT().
+			Set(gomock.Any(), gomock.Eq(emailBackoffKey), gomock.Any(), gomock.Eq(lockout.BaseDelay)).
+			Times(1).
+			Return(nil)
+		cache.EXPECT().
+			Increment(gomock.Any(), gomock.Eq(ipFailuresKey), gomock.Eq(lockout.LockoutDuration)).
+			Times(1).
+			Return(int64(1), nil)
+	}
 
 	testCases := []struct {
 		desc  string
 		mocks func(
 			userRepo *mock.MockUserRepository,
+			refreshRepo *mock.MockRefreshTokenRepository,
+			roleRepo *mock.MockRoleRepository,
 			tokenService *mock.MockTokenService,
+			cache *mock.MockCacheRepository,
+			twoFactorRepo *mock.MockTwoFactorRepository,
 		)
 		input    loginTestedInput
 		expected loginExpectedOutput
@@ -50,40 +129,106 @@ func TestAuthService_Login(t *testing.T) {
 			desc: "Success",
 			mocks: func(
 				userRepo *mock.MockUserRepository,
+				refreshRepo *mock.MockRefreshTokenRepository,
+				roleRepo *mock.MockRoleRepository,
 				tokenService *mock.MockTokenService,
+				cache *mock.MockCacheRepository,
+				twoFactorRepo *mock.MockTwoFactorRepository,
 			) {
+				expectNotThrottled(cache)
 				userRepo.EXPECT().
 					GetUserByEmail(gomock.Any(), gomock.Eq(email)).
 					Times(1).
 					Return(user, nil)
+				twoFactorRepo.EXPECT().
+					GetTOTP(gomock.Any(), gomock.Eq(user.ID)).
+					Times(1).
+					Return(nil, domain.ErrDataNotFound)
+				cache.EXPECT().
+					Delete(gomock.Any(), gomock.Eq(emailFailuresKey)).
+					Times(1).
+					Return(nil)
+				roleRepo.EXPECT().
+					ListRolePermissions(gomock.Any(), gomock.Eq(user.Role)).
+					Times(1).
+					Return(permissions, nil)
 				tokenService.EXPECT().
-					CreateToken(gomock.Eq(user)).
+					CreateToken(gomock.Eq(user), gomock.Eq(permissions)).
 					Times(1).
 					Return(token, nil)
+				refreshRepo.EXPECT().
+					CreateRefreshToken(gomock.Any(), gomock.Any()).
+					Times(1).
+					DoAndReturn(func(_ context.Context, refreshToken *domainauth.RefreshToken) (*domainauth.RefreshToken, error) {
+						return refreshToken, nil
+					})
 			},
 			input: loginTestedInput{
 				email:    email,
 				password: password,
+				ip:       ip,
 			},
 			expected: loginExpectedOutput{
 				token: token,
 				err:   nil,
 			},
 		},
+		{
+			desc: "Success_TwoFactorChallenge",
+			mocks: func(
+				userRepo *mock.MockUserRepository,
+				refreshRepo *mock.MockRefreshTokenRepository,
+				roleRepo *mock.MockRoleRepository,
+				tokenService *mock.MockTokenService,
+				cache *mock.MockCacheRepository,
+				twoFactorRepo *mock.MockTwoFactorRepository,
+			) {
+				expectNotThrottled(cache)
+				userRepo.EXPECT().
+					GetUserByEmail(gomock.Any(), gomock.Eq(email)).
+					Times(1).
+					Return(user, nil)
+				twoFactorRepo.EXPECT().
+					GetTOTP(gomock.Any(), gomock.Eq(user.ID)).
+					Times(1).
+					Return(confirmedTOTP, nil)
+				cache.EXPECT().
+					Set(gomock.Any(), gomock.Any(), gomock.Eq([]byte(strconv.FormatUint(user.ID, 10))), gomock.Eq(challengeDuration)).
+					Times(1).
+					Return(nil)
+			},
+			input: loginTestedInput{
+				email:    email,
+				password: password,
+				ip:       ip,
+			},
+			expected: loginExpectedOutput{
+				token:     "",
+				challenge: true,
+				err:       nil,
+			},
+		},
 		{
 			desc: "Fail_UserNotFound",
 			mocks: func(
 				userRepo *mock.MockUserRepository,
+				refreshRepo *mock.MockRefreshTokenRepository,
+				roleRepo *mock.MockRoleRepository,
 				tokenService *mock.MockTokenService,
+				cache *mock.MockCacheRepository,
+				twoFactorRepo *mock.MockTwoFactorRepository,
 			) {
+				expectNotThrottled(cache)
 				userRepo.EXPECT().
 					GetUserByEmail(gomock.Any(), gomock.Eq(email)).
 					Times(1).
 					Return(nil, domain.ErrDataNotFound)
+				expectFailureRecorded(cache)
 			},
 			input: loginTestedInput{
 				email:    email,
 				password: password,
+				ip:       ip,
 			},
 			expected: loginExpectedOutput{
 				token: "",
@@ -94,40 +239,192 @@ func TestAuthService_Login(t *testing.T) {
 			desc: "Fail_PasswordMismatch",
 			mocks: func(
 				userRepo *mock.MockUserRepository,
+				refreshRepo *mock.MockRefreshTokenRepository,
+				roleRepo *mock.MockRoleRepository,
+				tokenService *mock.MockTokenService,
+				cache *mock.MockCacheRepository,
+				twoFactorRepo *mock.MockTwoFactorRepository,
+			) {
+				expectNotThrottled(cache)
+				userRepo.EXPECT().
+					GetUserByEmail(gomock.Any(), gomock.Eq(email)).
+					Times(1).
+					Return(failUser, nil)
+				expectFailureRecorded(cache)
+			},
+			input: loginTestedInput{
+				email:    email,
+				password: password,
+				ip:       ip,
+			},
+			expected: loginExpectedOutput{
+				token: "",
+				err:   domain.ErrInvalidCredentials,
+			},
+		},
+		{
+			desc: "Fail_ArchivedUser",
+			mocks: func(
+				userRepo *mock.MockUserRepository,
+				refreshRepo *mock.MockRefreshTokenRepository,
+				roleRepo *mock.MockRoleRepository,
+				tokenService *mock.MockTokenService,
+				cache *mock.MockCacheRepository,
+				twoFactorRepo *mock.MockTwoFactorRepository,
+			) {
+				expectNotThrottled(cache)
+				userRepo.EXPECT().
+					GetUserByEmail(gomock.Any(), gomock.Eq(email)).
+					Times(1).
+					Return(archivedUser, nil)
+				expectFailureRecorded(cache)
+			},
+			input: loginTestedInput{
+				email:    email,
+				password: password,
+				ip:       ip,
+			},
+			expected: loginExpectedOutput{
+				token: "",
+				err:   domain.ErrInvalidCredentials,
+			},
+		},
+		{
+			desc: "Fail_LockedAfterMaxAttempts",
+			mocks: func(
+				userRepo *mock.MockUserRepository,
+				refreshRepo *mock.MockRefreshTokenRepository,
+				roleRepo *mock.MockRoleRepository,
 				tokenService *mock.MockTokenService,
+				cache *mock.MockCacheRepository,
+				twoFactorRepo *mock.MockTwoFactorRepository,
 			) {
+				expectNotThrottled(cache)
 				userRepo.EXPECT().
 					GetUserByEmail(gomock.Any(), gomock.Eq(email)).
 					Times(1).
 					Return(failUser, nil)
+				cache.EXPECT().
+					Increment(gomock.Any(), gomock.Eq(emailFailuresKey), gomock.Eq(lockout.LockoutDuration)).
+					Times(1).
+					Return(lockout.MaxAttempts, nil)
+				cache.EXPECT().
+					Set(gomock.Any(), gomock.Eq(lockedKey), gomock.Any(), gomock.Eq(lockout.LockoutDuration)).
+					Times(1).
+					Return(nil)
+				cache.EXPECT().
+					Increment(gomock.Any(), gomock.Eq(ipFailuresKey), gomock.Eq(lockout.LockoutDuration)).
+					Times(1).
+					Return(lockout.IPMaxAttempts+1, nil)
+				cache.EXPECT().
+					Set(gomock.Any(), gomock.Eq(ipBackoffKey), gomock.Any(), gomock.Eq(2*lockout.BaseDelay)).
+					Times(1).
+					Return(nil)
 			},
 			input: loginTestedInput{
 				email:    email,
 				password: password,
+				ip:       ip,
 			},
 			expected: loginExpectedOutput{
 				token: "",
 				err:   domain.ErrInvalidCredentials,
 			},
 		},
+		{
+			desc: "Fail_AccountLocked",
+			mocks: func(
+				userRepo *mock.MockUserRepository,
+				refreshRepo *mock.MockRefreshTokenRepository,
+				roleRepo *mock.MockRoleRepository,
+				tokenService *mock.MockTokenService,
+				cache *mock.MockCacheRepository,
+				twoFactorRepo *mock.MockTwoFactorRepository,
+			) {
+				cache.EXPECT().
+					Get(gomock.Any(), gomock.Eq(lockedKey)).
+					Times(1).
+					Return([]byte{1}, nil)
+			},
+			input: loginTestedInput{
+				email:    email,
+				password: password,
+				ip:       ip,
+			},
+			expected: loginExpectedOutput{
+				token: "",
+				err:   domain.ErrAccountLocked,
+			},
+		},
+		{
+			desc: "Fail_IPBackingOff",
+			mocks: func(
+				userRepo *mock.MockUserRepository,
+				refreshRepo *mock.MockRefreshTokenRepository,
+				roleRepo *mock.MockRoleRepository,
+				tokenService *mock.MockTokenService,
+				cache *mock.MockCacheRepository,
+				twoFactorRepo *mock.MockTwoFactorRepository,
+			) {
+				cache.EXPECT().
+					Get(gomock.Any(), gomock.Eq(lockedKey)).
+					Times(1).
+					Return(nil, domain.ErrDataNotFound)
+				cache.EXPECT().
+					Get(gomock.Any(), gomock.Eq(emailBackoffKey)).
+					Times(1).
+					Return(nil, domain.ErrDataNotFound)
+				cache.EXPECT().
+					Get(gomock.Any(), gomock.Eq(ipBackoffKey)).
+					Times(1).
+					Return([]byte{1}, nil)
+			},
+			input: loginTestedInput{
+				email:    email,
+				password: password,
+				ip:       ip,
+			},
+			expected: loginExpectedOutput{
+				token: "",
+				err:   domain.ErrTooManyLoginAttempts,
+			},
+		},
 		{
 			desc: "Fail_TokenCreation",
 			mocks: func(
 				userRepo *mock.MockUserRepository,
+				refreshRepo *mock.MockRefreshTokenRepository,
+				roleRepo *mock.MockRoleRepository,
 				tokenService *mock.MockTokenService,
+				cache *mock.MockCacheRepository,
+				twoFactorRepo *mock.MockTwoFactorRepository,
 			) {
+				expectNotThrottled(cache)
 				userRepo.EXPECT().
 					GetUserByEmail(gomock.Any(), gomock.Eq(email)).
 					Times(1).
 					Return(user, nil)
+				twoFactorRepo.EXPECT().
+					GetTOTP(gomock.Any(), gomock.Eq(user.ID)).
+					Times(1).
+					Return(nil, domain.ErrDataNotFound)
+				cache.EXPECT().
+					Delete(gomock.Any(), gomock.Eq(emailFailuresKey)).
+					Times(1).
+					Return(nil)
+				roleRepo.EXPECT().
+					ListRolePermissions(gomock.Any(), gomock.Eq(user.Role)).
+					Times(1).
+					Return(permissions, nil)
 				tokenService.EXPECT().
-					CreateToken(gomock.Eq(user)).
+					CreateToken(gomock.Eq(user), gomock.Eq(permissions)).
 					Times(1).
 					Return("", domain.ErrTokenCreation)
 			},
 			input: loginTestedInput{
 				email:    email,
 				password: password,
+				ip:       ip,
 			},
 			expected: loginExpectedOutput{
 				token: "",
@@ -138,8 +435,13 @@ func TestAuthService_Login(t *testing.T) {
 			desc: "Fail_InternalError",
 			mocks: func(
 				userRepo *mock.MockUserRepository,
+				refreshRepo *mock.MockRefreshTokenRepository,
+				roleRepo *mock.MockRoleRepository,
 				tokenService *mock.MockTokenService,
+				cache *mock.MockCacheRepository,
+				twoFactorRepo *mock.MockTwoFactorRepository,
 			) {
+				expectNotThrottled(cache)
 				userRepo.EXPECT().
 					GetUserByEmail(gomock.Any(), gomock.Eq(email)).
 					Times(1).
@@ -148,6 +450,7 @@ func TestAuthService_Login(t *testing.T) {
 			input: loginTestedInput{
 				email:    email,
 				password: password,
+				ip:       ip,
 			},
 			expected: loginExpectedOutput{
 				token: "",
@@ -164,19 +467,1739 @@ func TestAuthService_Login(t *testing.T) {
 			defer ctrl.Finish()
 
 			userRepo := mock.NewMockUserRepository(ctrl)
+			refreshRepo := mock.NewMockRefreshTokenRepository(ctrl)
+			roleRepo := mock.NewMockRoleRepository(ctrl)
 			tokenService := mock.NewMockTokenService(ctrl)
+			terminalRepo := mock.NewMockTerminalRepository(ctrl)
+			twoFactorRepo := mock.NewMockTwoFactorRepository(ctrl)
+			cache := mock.NewMockCacheRepository(ctrl)
+			auditService := newAuditStub(ctrl)
 
-			tc.mocks(userRepo, tokenService)
+			tc.mocks(userRepo, refreshRepo, roleRepo, tokenService, cache, twoFactorRepo)
 
-			authService := NewAuthUsecase(userRepo, tokenService)
+			authService := NewAuthUsecase(userRepo, refreshRepo, roleRepo, terminalRepo, twoFactorRepo, tokenService, cache, time.Hour, lockout, twoFactor, auditService)
 
-			token, err := authService.Login(ctx, tc.input.email, tc.input.password)
-			if err != tc.expected.err {
+			result, err := authService.Login(ctx, tc.input.email, tc.input.password, tc.input.ip)
+			if !errors.Is(err, tc.expected.err) {
 				t.Errorf("[case: %s] expected to get %q; got %q", tc.desc, tc.expected.err, err)
 			}
+
+			var token string
+			var challenge bool
+			if result != nil && result.Tokens != nil {
+				token = result.Tokens.AccessToken
+				if result.Tokens.RefreshToken == "" {
+					t.Errorf("[case: %s] expected to get a refresh token", tc.desc)
+				}
+			}
+			if result != nil {
+				challenge = result.Challenge != ""
+			}
+			if challenge != tc.expected.challenge {
+				t.Errorf("[case: %s] expected to get a challenge %t; got %t", tc.desc, tc.expected.challenge, challenge)
+			}
 			if token != tc.expected.token {
 				t.Errorf("[case: %s] expected to get %q; got %q", tc.desc, tc.expected.token, token)
 			}
 		})
 	}
 }
+
+type unlockUserTestedInput struct {
+	id uint64
+}
+
+type unlockUserExpectedOutput struct {
+	err error
+}
+
+func TestAuthService_UnlockUser(t *testing.T) {
+	ctx := context.Background()
+	user := &domainuser.User{
+		ID:    gofakeit.Uint64(),
+		Email: gofakeit.Email(),
+	}
+
+	testCases := []struct {
+		desc  string
+		mocks func(
+			userRepo *mock.MockUserRepository,
+			cache *mock.MockCacheRepository,
+		)
+		input    unlockUserTestedInput
+		expected unlockUserExpectedOutput
+	}{
+		{
+			desc: "Success",
+			mocks: func(
+				userRepo *mock.MockUserRepository,
+				cache *mock.MockCacheRepository,
+			) {
+				userRepo.EXPECT().
+					GetUserByID(gomock.Any(), gomock.Eq(user.ID)).
+					Times(1).
+					Return(user, nil)
+				cache.EXPECT().
+					Delete(gomock.Any(), gomock.Eq(loginKey("login_locked", "email", user.Email))).
+					Times(1).
+					Return(nil)
+				cache.EXPECT().
+					Delete(gomock.Any(), gomock.Eq(loginKey("login_backoff", "email", user.Email))).
+					Times(1).
+					Return(nil)
+				cache.EXPECT().
+					Delete(gomock.Any(), gomock.Eq(loginKey("login_failures", "email", user.Email))).
+					Times(1).
+					Return(nil)
+			},
+			input: unlockUserTestedInput{
+				id: user.ID,
+			},
+			expected: unlockUserExpectedOutput{
+				err: nil,
+			},
+		},
+		{
+			desc: "Fail_UserNotFound",
+			mocks: func(
+				userRepo *mock.MockUserRepository,
+				cache *mock.MockCacheRepository,
+			) {
+				userRepo.EXPECT().
+					GetUserByID(gomock.Any(), gomock.Eq(user.ID)).
+					Times(1).
+					Return(nil, domain.ErrDataNotFound)
+			},
+			input: unlockUserTestedInput{
+				id: user.ID,
+			},
+			expected: unlockUserExpectedOutput{
+				err: domain.ErrDataNotFound,
+			},
+		},
+		{
+			desc: "Fail_CacheError",
+			mocks: func(
+				userRepo *mock.MockUserRepository,
+				cache *mock.MockCacheRepository,
+			) {
+				userRepo.EXPECT().
+					GetUserByID(gomock.Any(), gomock.Eq(user.ID)).
+					Times(1).
+					Return(user, nil)
+				cache.EXPECT().
+					Delete(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(domain.ErrInternal)
+			},
+			input: unlockUserTestedInput{
+				id: user.ID,
+			},
+			expected: unlockUserExpectedOutput{
+				err: domain.ErrInternal,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.desc, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			userRepo := mock.NewMockUserRepository(ctrl)
+			refreshRepo := mock.NewMockRefreshTokenRepository(ctrl)
+			roleRepo := mock.NewMockRoleRepository(ctrl)
+			tokenService := mock.NewMockTokenService(ctrl)
+			terminalRepo := mock.NewMockTerminalRepository(ctrl)
+			twoFactorRepo := mock.NewMockTwoFactorRepository(ctrl)
+			cache := mock.NewMockCacheRepository(ctrl)
+			auditService := newAuditStub(ctrl)
+
+			tc.mocks(userRepo, cache)
+
+			authService := NewAuthUsecase(userRepo, refreshRepo, roleRepo, terminalRepo, twoFactorRepo, tokenService, cache, time.Hour, lockout, twoFactor, auditService)
+
+			err := authService.UnlockUser(ctx, tc.input.id)
+			if !errors.Is(err, tc.expected.err) {
+				t.Errorf("[case: %s] expected to get %q; got %q", tc.desc, tc.expected.err, err)
+			}
+		})
+	}
+}
+
+type pinLoginTestedInput struct {
+	terminalID     uint64
+	terminalSecret string
+	userID         uint64
+	pin            string
+	ip             string
+}
+
+type pinLoginExpectedOutput struct {
+	token string
+	err   error
+}
+
+func TestAuthService_PINLogin(t *testing.T) {
+	ctx := context.Background()
+	ip := gofakeit.IPv4Address()
+	pin := gofakeit.DigitN(4)
+	hashedPIN, _ := util.HashPassword(pin)
+	secret := gofakeit.UUID()
+	terminal := &domainterminal.Terminal{
+		ID:         gofakeit.Uint64(),
+		Name:       gofakeit.Word(),
+		SecretHash: util.HashToken(secret),
+	}
+	revokedAt := gofakeit.Date()
+	revokedTerminal := &domainterminal.Terminal{
+		ID:         terminal.ID,
+		Name:       terminal.Name,
+		SecretHash: terminal.SecretHash,
+		RevokedAt:  &revokedAt,
+	}
+	user := &domainuser.User{
+		ID:    gofakeit.Uint64(),
+		Email: gofakeit.Email(),
+		Role:  domainuser.Cashier,
+	}
+	permissions := []string{}
+	token := gofakeit.UUID()
+
+	lockedKey := loginKey("login_locked", "email", user.Email)
+	emailBackoffKey := loginKey("login_backoff", "email", user.Email)
+	ipBackoffKey := loginKey("login_backoff", "ip", ip)
+	emailFailuresKey := loginKey("login_failures", "email", user.Email)
+	ipFailuresKey := loginKey("login_failures", "ip", ip)
+	terminalBackoffKey := loginKey("login_backoff", "terminal", strconv.FormatUint(terminal.ID, 10))
+	terminalFailuresKey := loginKey("login_failures", "terminal", strconv.FormatUint(terminal.ID, 10))
+
+	expectSourceNotThrottled := func(cache *mock.MockCacheRepository) {
+		cache.EXPECT().
+			Get(gomock.Any(), gomock.Eq(terminalBackoffKey)).
+			Times(1).
+			Return(nil, domain.ErrDataNotFound)
+		cache.EXPECT().
+			Get(gomock.Any(), gomock.Eq(ipBackoffKey)).
+			Times(1).
+			Return(nil, domain.ErrDataNotFound)
+	}
+	expectNotThrottled := func(cache *mock.MockCacheRepository) {
+		expectSourceNotThrottled(cache)
+		cache.EXPECT().
+			Get(gomock.Any(), gomock.Eq(lockedKey)).
+			Times(1).
+			Return(nil, domain.ErrDataNotFound)
+		cache.EXPECT().
+			Get(gomock.Any(), gomock.Eq(emailBackoffKey)).
+			Times(1).
+			Return(nil, domain.ErrDataNotFound)
+	}
+	expectSourceFailureRecorded := func(cache *mock.MockCacheRepository) {
+		cache.EXPECT().
+			Increment(gomock.Any(), gomock.Eq(terminalFailuresKey), gomock.Eq(lockout.LockoutDuration)).
+			Times(1).
+			Return(int64(1), nil)
+		cache.EXPECT().
+			Increment(gomock.Any(), gomock.Eq(ipFailuresKey), gomock.Eq(lockout.LockoutDuration)).
+			Times(1).
+			Return(int64(1), nil)
+	}
+	expectFailureRecorded := func(cache *mock.MockCacheRepository) {
+		expectSourceFailureRecorded(cache)
+		cache.EXPECT().
+			Increment(gomock.Any(), gomock.Eq(emailFailuresKey), gomock.Eq(lockout.LockoutDuration)).
+			Times(1).
+			Return(int64(1), nil)
+		cache.EXPECT().
+			Set(gomock.Any(), gomock.Eq(emailBackoffKey), gomock.Any(), gomock.Eq(lockout.BaseDelay)).
+			Times(1).
+			Return(nil)
+	}
+
+	validInput := pinLoginTestedInput{
+		terminalID:     terminal.ID,
+		terminalSecret: secret,
+		userID:         user.ID,
+		pin:            pin,
+		ip:             ip,
+	}
+
+	testCases := []struct {
+		desc  string
+		mocks func(
+			userRepo *mock.MockUserRepository,
+			roleRepo *mock.MockRoleRepository,
+			terminalRepo *mock.MockTerminalRepository,
+			tokenService *mock.MockTokenService,
+			cache *mock.MockCacheRepository,
+		)
+		input    pinLoginTestedInput
+		expected pinLoginExpectedOutput
+	}{
+		{
+			desc: "Success",
+			mocks: func(
+				userRepo *mock.MockUserRepository,
+				roleRepo *mock.MockRoleRepository,
+				terminalRepo *mock.MockTerminalRepository,
+				tokenService *mock.MockTokenService,
+				cache *mock.MockCacheRepository,
+			) {
+				terminalRepo.EXPECT().
+					GetTerminalByID(gomock.Any(), gomock.Eq(terminal.ID)).
+					Times(1).
+					Return(terminal, nil)
+				userRepo.EXPECT().
+					GetUserByID(gomock.Any(), gomock.Eq(user.ID)).
+					Times(1).
+					Return(user, nil)
+				expectNotThrottled(cache)
+				userRepo.EXPECT().
+					GetUserPIN(gomock.Any(), gomock.Eq(user.ID)).
+					Times(1).
+					Return(hashedPIN, nil)
+				cache.EXPECT().
+					Delete(gomock.Any(), gomock.Eq(emailFailuresKey)).
+					Times(1).
+					Return(nil)
+				roleRepo.EXPECT().
+					ListRolePermissions(gomock.Any(), gomock.Eq(user.Role)).
+					Times(1).
+					Return(permissions, nil)
+				tokenService.EXPECT().
+					CreateTerminalToken(gomock.Eq(user), gomock.Eq(permissions), gomock.Eq(terminal.ID)).
+					Times(1).
+					Return(token, nil)
+			},
+			input: validInput,
+			expected: pinLoginExpectedOutput{
+				token: token,
+				err:   nil,
+			},
+		},
+		{
+			desc: "Fail_TerminalNotFound",
+			mocks: func(
+				userRepo *mock.MockUserRepository,
+				roleRepo *mock.MockRoleRepository,
+				terminalRepo *mock.MockTerminalRepository,
+				tokenService *mock.MockTokenService,
+				cache *mock.MockCacheRepository,
+			) {
+				terminalRepo.EXPECT().
+					GetTerminalByID(gomock.Any(), gomock.Eq(terminal.ID)).
+					Times(1).
+					Return(nil, domain.ErrDataNotFound)
+			},
+			input: validInput,
+			expected: pinLoginExpectedOutput{
+				token: "",
+				err:   domain.ErrInvalidTerminal,
+			},
+		},
+		{
+			desc: "Fail_TerminalRevoked",
+			mocks: func(
+				userRepo *mock.MockUserRepository,
+				roleRepo *mock.MockRoleRepository,
+				terminalRepo *mock.MockTerminalRepository,
+				tokenService *mock.MockTokenService,
+				cache *mock.MockCacheRepository,
+			) {
+				terminalRepo.EXPECT().
+					GetTerminalByID(gomock.Any(), gomock.Eq(terminal.ID)).
+					Times(1).
+					Return(revokedTerminal, nil)
+			},
+			input: validInput,
+			expected: pinLoginExpectedOutput{
+				token: "",
+				err:   domain.ErrInvalidTerminal,
+			},
+		},
+		{
+			desc: "Fail_WrongTerminalSecret",
+			mocks: func(
+				userRepo *mock.MockUserRepository,
+				roleRepo *mock.MockRoleRepository,
+				terminalRepo *mock.MockTerminalRepository,
+				tokenService *mock.MockTokenService,
+				cache *mock.MockCacheRepository,
+			) {
+				terminalRepo.EXPECT().
+					GetTerminalByID(gomock.Any(), gomock.Eq(terminal.ID)).
+					Times(1).
+					Return(terminal, nil)
+			},
+			input: pinLoginTestedInput{
+				terminalID:     terminal.ID,
+				terminalSecret: "wrong secret",
+				userID:         user.ID,
+				pin:            pin,
+				ip:             ip,
+			},
+			expected: pinLoginExpectedOutput{
+				token: "",
+				err:   domain.ErrInvalidTerminal,
+			},
+		},
+		{
+			desc: "Fail_UserNotFound",
+			mocks: func(
+				userRepo *mock.MockUserRepository,
+				roleRepo *mock.MockRoleRepository,
+				terminalRepo *mock.MockTerminalRepository,
+				tokenService *mock.MockTokenService,
+				cache *mock.MockCacheRepository,
+			) {
+				terminalRepo.EXPECT().
+					GetTerminalByID(gomock.Any(), gomock.Eq(terminal.ID)).
+					Times(1).
+					Return(terminal, nil)
+				expectSourceNotThrottled(cache)
+				userRepo.EXPECT().
+					GetUserByID(gomock.Any(), gomock.Eq(user.ID)).
+					Times(1).
+					Return(nil, domain.ErrDataNotFound)
+				expectSourceFailureRecorded(cache)
+			},
+			input: validInput,
+			expected: pinLoginExpectedOutput{
+				token: "",
+				err:   domain.ErrInvalidPIN,
+			},
+		},
+		{
+			desc: "Fail_TerminalBackingOff",
+			mocks: func(
+				userRepo *mock.MockUserRepository,
+				roleRepo *mock.MockRoleRepository,
+				terminalRepo *mock.MockTerminalRepository,
+				tokenService *mock.MockTokenService,
+				cache *mock.MockCacheRepository,
+			) {
+				terminalRepo.EXPECT().
+					GetTerminalByID(gomock.Any(), gomock.Eq(terminal.ID)).
+					Times(1).
+					Return(terminal, nil)
+				cache.EXPECT().
+					Get(gomock.Any(), gomock.Eq(terminalBackoffKey)).
+					Times(1).
+					Return([]byte{1}, nil)
+			},
+			input: validInput,
+			expected: pinLoginExpectedOutput{
+				token: "",
+				err:   domain.ErrTooManyLoginAttempts,
+			},
+		},
+		{
+			desc: "Fail_IPBackingOff",
+			mocks: func(
+				userRepo *mock.MockUserRepository,
+				roleRepo *mock.MockRoleRepository,
+				terminalRepo *mock.MockTerminalRepository,
+				tokenService *mock.MockTokenService,
+				cache *mock.MockCacheRepository,
+			) {
+				terminalRepo.EXPECT().
+					GetTerminalByID(gomock.Any(), gomock.Eq(terminal.ID)).
+					Times(1).
+					Return(terminal, nil)
+				cache.EXPECT().
+					Get(gomock.Any(), gomock.Eq(terminalBackoffKey)).
+					Times(1).
+					Return(nil, domain.ErrDataNotFound)
+				cache.EXPECT().
+					Get(gomock.Any(), gomock.Eq(ipBackoffKey)).
+					Times(1).
+					Return([]byte{1}, nil)
+			},
+			input: validInput,
+			expected: pinLoginExpectedOutput{
+				token: "",
+				err:   domain.ErrTooManyLoginAttempts,
+			},
+		},
+		{
+			desc: "Fail_AccountLocked",
+			mocks: func(
+				userRepo *mock.MockUserRepository,
+				roleRepo *mock.MockRoleRepository,
+				terminalRepo *mock.MockTerminalRepository,
+				tokenService *mock.MockTokenService,
+				cache *mock.MockCacheRepository,
+			) {
+				terminalRepo.EXPECT().
+					GetTerminalByID(gomock.Any(), gomock.Eq(terminal.ID)).
+					Times(1).
+					Return(terminal, nil)
+				expectSourceNotThrottled(cache)
+				userRepo.EXPECT().
+					GetUserByID(gomock.Any(), gomock.Eq(user.ID)).
+					Times(1).
+					Return(user, nil)
+				cache.EXPECT().
+					Get(gomock.Any(), gomock.Eq(lockedKey)).
+					Times(1).
+					Return([]byte{1}, nil)
+			},
+			input: validInput,
+			expected: pinLoginExpectedOutput{
+				token: "",
+				err:   domain.ErrAccountLocked,
+			},
+		},
+		{
+			desc: "Fail_WrongPIN",
+			mocks: func(
+				userRepo *mock.MockUserRepository,
+				roleRepo *mock.MockRoleRepository,
+				terminalRepo *mock.MockTerminalRepository,
+				tokenService *mock.MockTokenService,
+				cache *mock.MockCacheRepository,
+			) {
+				terminalRepo.EXPECT().
+					GetTerminalByID(gomock.Any(), gomock.Eq(terminal.ID)).
+					Times(1).
+					Return(terminal, nil)
+				userRepo.EXPECT().
+					GetUserByID(gomock.Any(), gomock.Eq(user.ID)).
+					Times(1).
+					Return(user, nil)
+				expectNotThrottled(cache)
+				userRepo.EXPECT().
+					GetUserPIN(gomock.Any(), gomock.Eq(user.ID)).
+					Times(1).
+					Return(hashedPIN, nil)
+				expectFailureRecorded(cache)
+			},
+			input: pinLoginTestedInput{
+				terminalID:     terminal.ID,
+				terminalSecret: secret,
+				userID:         user.ID,
+				pin:            pin + "0",
+				ip:             ip,
+			},
+			expected: pinLoginExpectedOutput{
+				token: "",
+				err:   domain.ErrInvalidPIN,
+			},
+		},
+		{
+			desc: "Fail_PINNotSet",
+			mocks: func(
+				userRepo *mock.MockUserRepository,
+				roleRepo *mock.MockRoleRepository,
+				terminalRepo *mock.MockTerminalRepository,
+				tokenService *mock.MockTokenService,
+				cache *mock.MockCacheRepository,
+			) {
+				terminalRepo.EXPECT().
+					GetTerminalByID(gomock.Any(), gomock.Eq(terminal.ID)).
+					Times(1).
+					Return(terminal, nil)
+				userRepo.EXPECT().
+					GetUserByID(gomock.Any(), gomock.Eq(user.ID)).
+					Times(1).
+					Return(user, nil)
+				expectNotThrottled(cache)
+				userRepo.EXPECT().
+					GetUserPIN(gomock.Any(), gomock.Eq(user.ID)).
+					Times(1).
+					Return("", domain.ErrDataNotFound)
+				expectFailureRecorded(cache)
+			},
+			input: validInput,
+			expected: pinLoginExpectedOutput{
+				token: "",
+				err:   domain.ErrInvalidPIN,
+			},
+		},
+		{
+			desc: "Fail_TokenCreation",
+			mocks: func(
+				userRepo *mock.MockUserRepository,
+				roleRepo *mock.MockRoleRepository,
+				terminalRepo *mock.MockTerminalRepository,
+				tokenService *mock.MockTokenService,
+				cache *mock.MockCacheRepository,
+			) {
+				terminalRepo.EXPECT().
+					GetTerminalByID(gomock.Any(), gomock.Eq(terminal.ID)).
+					Times(1).
+					Return(terminal, nil)
+				userRepo.EXPECT().
+					GetUserByID(gomock.Any(), gomock.Eq(user.ID)).
+					Times(1).
+					Return(user, nil)
+				expectNotThrottled(cache)
+				userRepo.EXPECT().
+					GetUserPIN(gomock.Any(), gomock.Eq(user.ID)).
+					Times(1).
+					Return(hashedPIN, nil)
+				cache.EXPECT().
+					Delete(gomock.Any(), gomock.Eq(emailFailuresKey)).
+					Times(1).
+					Return(nil)
+				roleRepo.EXPECT().
+					ListRolePermissions(gomock.Any(), gomock.Eq(user.Role)).
+					Times(1).
+					Return(permissions, nil)
+				tokenService.EXPECT().
+					CreateTerminalToken(gomock.Eq(user), gomock.Eq(permissions), gomock.Eq(terminal.ID)).
+					Times(1).
+					Return("", domain.ErrTokenCreation)
+			},
+			input: validInput,
+			expected: pinLoginExpectedOutput{
+				token: "",
+				err:   domain.ErrTokenCreation,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.desc, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			userRepo := mock.NewMockUserRepository(ctrl)
+			refreshRepo := mock.NewMockRefreshTokenRepository(ctrl)
+			roleRepo := mock.NewMockRoleRepository(ctrl)
+			terminalRepo := mock.NewMockTerminalRepository(ctrl)
+			twoFactorRepo := mock.NewMockTwoFactorRepository(ctrl)
+			tokenService := mock.NewMockTokenService(ctrl)
+			cache := mock.NewMockCacheRepository(ctrl)
+			auditService := newAuditStub(ctrl)
+
+			tc.mocks(userRepo, roleRepo, terminalRepo, tokenService, cache)
+
+			authService := NewAuthUsecase(userRepo, refreshRepo, roleRepo, terminalRepo, twoFactorRepo, tokenService, cache, time.Hour, lockout, twoFactor, auditService)
+
+			token, err := authService.PINLogin(ctx, tc.input.terminalID, tc.input.terminalSecret, tc.input.userID, tc.input.pin, tc.input.ip)
+			if !errors.Is(err, tc.expected.err) {
+				t.Errorf("[case: %s] expected to get %q; got %q", tc.desc, tc.expected.err, err)
+			}
+
+			if token != tc.expected.token {
+				t.Errorf("[case: %s] expected to get %q; got %q", tc.desc, tc.expected.token, token)
+			}
+		})
+	}
+}
+
+type setPINTestedInput struct {
+	userID uint64
+	pin    string
+}
+
+type setPINExpectedOutput struct {
+	err error
+}
+
+func TestAuthService_SetPIN(t *testing.T) {
+	ctx := context.Background()
+	userID := gofakeit.Uint64()
+	pin := gofakeit.DigitN(6)
+
+	testCases := []struct {
+		desc     string
+		mocks    func(userRepo *mock.MockUserRepository)
+		input    setPINTestedInput
+		expected setPINExpectedOutput
+	}{
+		{
+			desc: "Success",
+			mocks: func(userRepo *mock.MockUserRepository) {
+				userRepo.EXPECT().
+					SetUserPIN(gomock.Any(), gomock.Eq(userID), gomock.Any()).
+					Times(1).
+					DoAndReturn(func(_ context.Context, _ uint64, pinHash string) error {
+						if util.ComparePassword(pin, pinHash) != nil {
+							t.Errorf("expected the PIN to be stored hashed")
+						}
+						return nil
+					})
+			},
+			input: setPINTestedInput{
+				userID: userID,
+				pin:    pin,
+			},
+			expected: setPINExpectedOutput{
+				err: nil,
+			},
+		},
+		{
+			desc: "Fail_UserNotFound",
+			mocks: func(userRepo *mock.MockUserRepository) {
+				userRepo.EXPECT().
+					SetUserPIN(gomock.Any(), gomock.Eq(userID), gomock.Any()).
+					Times(1).
+					Return(domain.ErrDataNotFound)
+			},
+			input: setPINTestedInput{
+				userID: userID,
+				pin:    pin,
+			},
+			expected: setPINExpectedOutput{
+				err: domain.ErrDataNotFound,
+			},
+		},
+		{
+			desc: "Fail_InternalError",
+			mocks: func(userRepo *mock.MockUserRepository) {
+				userRepo.EXPECT().
+					SetUserPIN(gomock.Any(), gomock.Eq(userID), gomock.Any()).
+					Times(1).
+					Return(domain.ErrInternal)
+			},
+			input: setPINTestedInput{
+				userID: userID,
+				pin:    pin,
+			},
+			expected: setPINExpectedOutput{
+				err: domain.ErrInternal,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.desc, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			userRepo := mock.NewMockUserRepository(ctrl)
+			refreshRepo := mock.NewMockRefreshTokenRepository(ctrl)
+			roleRepo := mock.NewMockRoleRepository(ctrl)
+			terminalRepo := mock.NewMockTerminalRepository(ctrl)
+			twoFactorRepo := mock.NewMockTwoFactorRepository(ctrl)
+			tokenService := mock.NewMockTokenService(ctrl)
+			cache := mock.NewMockCacheRepository(ctrl)
+			auditService := newAuditStub(ctrl)
+
+			tc.mocks(userRepo)
+
+			authService := NewAuthUsecase(userRepo, refreshRepo, roleRepo, terminalRepo, twoFactorRepo, tokenService, cache, time.Hour, lockout, twoFactor, auditService)
+
+			err := authService.SetPIN(ctx, tc.input.userID, tc.input.pin)
+			if !errors.Is(err, tc.expected.err) {
+				t.Errorf("[case: %s] expected to get %q; got %q", tc.desc, tc.expected.err, err)
+			}
+		})
+	}
+}
+
+type verifyTwoFactorTestedInput struct {
+	challenge string
+	code      string
+}
+
+type verifyTwoFactorExpectedOutput struct {
+	token string
+	err   error
+}
+
+func TestAuthService_VerifyTwoFactor(t *testing.T) {
+	ctx := context.Background()
+	ip := gofakeit.IPv4Address()
+	challenge := gofakeit.UUID()
+	secret, _ := util.GenerateTOTPSecret()
+	code, _ := util.GenerateTOTP(secret, time.Now())
+	recoveryCode := "abcde-fghjk"
+	confirmedAt := gofakeit.Date()
+	user := &domainuser.User{
+		ID:    gofakeit.Uint64(),
+		Email: gofakeit.Email(),
+		Role:  domainuser.Admin,
+	}
+	totp := &domainauth.TOTP{
+		UserID:      user.ID,
+		Secret:      secret,
+		ConfirmedAt: &confirmedAt,
+	}
+	permissions := []string{domainrole.UsersWrite}
+	token := gofakeit.UUID()
+
+	challengeKey := util.GenerateCacheKey("login_challenge", util.HashToken(challenge))
+	stepKey := util.GenerateCacheKey("totp_step", user.ID)
+	emailFailuresKey := loginKey("login_failures", "email", user.Email)
+
+	expectChallenge := func(userRepo *mock.MockUserRepository, cache *mock.MockCacheRepository, twoFactorRepo *mock.MockTwoFactorRepository) {
+		cache.EXPECT().
+			Get(gomock.Any(), gomock.Eq(challengeKey)).
+			Times(1).
+			Return([]byte(strconv.FormatUint(user.ID, 10)), nil)
+		userRepo.EXPECT().
+			GetUserByID(gomock.Any(), gomock.Eq(user.ID)).
+			Times(1).
+			Return(user, nil)
+		cache.EXPECT().
+			Get(gomock.Any(), gomock.Not(gomock.Eq(stepKey))).
+			Times(3).
+			Return(nil, domain.ErrDataNotFound)
+		twoFactorRepo.EXPECT().
+			GetTOTP(gomock.Any(), gomock.Eq(user.ID)).
+			Times(1).
+			Return(totp, nil)
+	}
+	expectTokensIssued := func(
+		refreshRepo *mock.MockRefreshTokenRepository,
+		roleRepo *mock.MockRoleRepository,
+		tokenService *mock.MockTokenService,
+		cache *mock.MockCacheRepository,
+		twoFactorRepo *mock.MockTwoFactorRepository,
+	) {
+		cache.EXPECT().
+			Delete(gomock.Any(), gomock.Eq(challengeKey)).
+			Times(1).
+			Return(nil)
+		cache.EXPECT().
+			Delete(gomock.Any(), gomock.Eq(emailFailuresKey)).
+			Times(1).
+			Return(nil)
+		twoFactorRepo.EXPECT().
+			GetTOTP(gomock.Any(), gomock.Eq(user.ID)).
+			Times(1).
+			Return(totp, nil)
+		roleRepo.EXPECT().
+			ListRolePermissions(gomock.Any(), gomock.Eq(user.Role)).
+			Times(1).
+			Return(permissions, nil)
+		tokenService.EXPECT().
+			CreateToken(gomock.Eq(user), gomock.Eq(permissions)).
+			Times(1).
+			Return(token, nil)
+		refreshRepo.EXPECT().
+			CreateRefreshToken(gomock.Any(), gomock.Any()).
+			Times(1).
+			DoAndReturn(func(_ context.Context, refreshToken *domainauth.RefreshToken) (*domainauth.RefreshToken, error) {
+				return refreshToken, nil
+			})
+	}
+
+	testCases := []struct {
+		desc  string
+		mocks func(
+			userRepo *mock.MockUserRepository,
+			refreshRepo *mock.MockRefreshTokenRepository,
+			roleRepo *mock.MockRoleRepository,
+			tokenService *mock.MockTokenService,
+			cache *mock.MockCacheRepository,
+			twoFactorRepo *mock.MockTwoFactorRepository,
+		)
+		input    verifyTwoFactorTestedInput
+		expected verifyTwoFactorExpectedOutput
+	}{
+		{
+			desc: "Success_TOTP",
+			mocks: func(
+				userRepo *mock.MockUserRepository,
+				refreshRepo *mock.MockRefreshTokenRepository,
+				roleRepo *mock.MockRoleRepository,
+				tokenService *mock.MockTokenService,
+				cache *mock.MockCacheRepository,
+				twoFactorRepo *mock.MockTwoFactorRepository,
+			) {
+				expectChallenge(userRepo, cache, twoFactorRepo)
+				cache.EXPECT().
+					Get(gomock.Any(), gomock.Eq(stepKey)).
+					Times(1).
+					Return(nil, domain.ErrDataNotFound)
+				cache.EXPECT().
+					Set(gomock.Any(), gomock.Eq(stepKey), gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(nil)
+				expectTokensIssued(refreshRepo, roleRepo, tokenService, cache, twoFactorRepo)
+			},
+			input: verifyTwoFactorTestedInput{
+				challenge: challenge,
+				code:      code,
+			},
+			expected: verifyTwoFactorExpectedOutput{
+				token: token,
+				err:   nil,
+			},
+		},
+		{
+			desc: "Success_RecoveryCode",
+			mocks: func(
+				userRepo *mock.MockUserRepository,
+				refreshRepo *mock.MockRefreshTokenRepository,
+				roleRepo *mock.MockRoleRepository,
+				tokenService *mock.MockTokenService,
+				cache *mock.MockCacheRepository,
+				twoFactorRepo *mock.MockTwoFactorRepository,
+			) {
+				expectChallenge(userRepo, cache, twoFactorRepo)
+				twoFactorRepo.EXPECT().
+					UseRecoveryCode(gomock.Any(), gomock.Eq(user.ID), gomock.Eq(util.HashToken("ABCDEFGHJK"))).
+					Times(1).
+					Return(nil)
+				expectTokensIssued(refreshRepo, roleRepo, tokenService, cache, twoFactorRepo)
+			},
+			input: verifyTwoFactorTestedInput{
+				challenge: challenge,
+				code:      recoveryCode,
+			},
+			expected: verifyTwoFactorExpectedOutput{
+				token: token,
+				err:   nil,
+			},
+		},
+		{
+			desc: "Fail_InvalidChallenge",
+			mocks: func(
+				userRepo *mock.MockUserRepository,
+				refreshRepo *mock.MockRefreshTokenRepository,
+				roleRepo *mock.MockRoleRepository,
+				tokenService *mock.MockTokenService,
+				cache *mock.MockCacheRepository,
+				twoFactorRepo *mock.MockTwoFactorRepository,
+			) {
+				cache.EXPECT().
+					Get(gomock.Any(), gomock.Eq(challengeKey)).
+					Times(1).
+					Return(nil, domain.ErrDataNotFound)
+			},
+			input: verifyTwoFactorTestedInput{
+				challenge: challenge,
+				code:      code,
+			},
+			expected: verifyTwoFactorExpectedOutput{
+				token: "",
+				err:   domain.ErrInvalidChallenge,
+			},
+		},
+		{
+			desc: "Fail_CodeReused",
+			mocks: func(
+				userRepo *mock.MockUserRepository,
+				refreshRepo *mock.MockRefreshTokenRepository,
+				roleRepo *mock.MockRoleRepository,
+				tokenService *mock.MockTokenService,
+				cache *mock.MockCacheRepository,
+				twoFactorRepo *mock.MockTwoFactorRepository,
+			) {
+				expectChallenge(userRepo, cache, twoFactorRepo)
+				cache.EXPECT().
+					Get(gomock.Any(), gomock.Eq(stepKey)).
+					Times(1).
+					Return([]byte(strconv.FormatInt(time.Now().Unix()/30+1, 10)), nil)
+				cache.EXPECT().
+					Increment(gomock.Any(), gomock.Eq(emailFailuresKey), gomock.Any()).
+					Times(1).
+					Return(int64(1), nil)
+				cache.EXPECT().
+					Set(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(nil)
+				cache.EXPECT().
+					Increment(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(int64(1), nil)
+			},
+			input: verifyTwoFactorTestedInput{
+				challenge: challenge,
+				code:      code,
+			},
+			expected: verifyTwoFactorExpectedOutput{
+				token: "",
+				err:   domain.ErrInvalidTwoFactorCode,
+			},
+		},
+		{
+			desc: "Fail_InvalidCode",
+			mocks: func(
+				userRepo *mock.MockUserRepository,
+				refreshRepo *mock.MockRefreshTokenRepository,
+				roleRepo *mock.MockRoleRepository,
+				tokenService *mock.MockTokenService,
+				cache *mock.MockCacheRepository,
+				twoFactorRepo *mock.MockTwoFactorRepository,
+			) {
+				expectChallenge(userRepo, cache, twoFactorRepo)
+				twoFactorRepo.EXPECT().
+					UseRecoveryCode(gomock.Any(), gomock.Eq(user.ID), gomock.Any()).
+					Times(1).
+					Return(domain.ErrDataNotFound)
+				cache.EXPECT().
+					Increment(gomock.Any(), gomock.Eq(emailFailuresKey), gomock.Any()).
+					Times(1).
+					Return(int64(1), nil)
+				cache.EXPECT().
+					Set(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(nil)
+				cache.EXPECT().
+					Increment(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(int64(1), nil)
+			},
+			input: verifyTwoFactorTestedInput{
+				challenge: challenge,
+				code:      "wrong",
+			},
+			expected: verifyTwoFactorExpectedOutput{
+				token: "",
+				err:   domain.ErrInvalidTwoFactorCode,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.desc, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			userRepo := mock.NewMockUserRepository(ctrl)
+			refreshRepo := mock.NewMockRefreshTokenRepository(ctrl)
+			roleRepo := mock.NewMockRoleRepository(ctrl)
+			tokenService := mock.NewMockTokenService(ctrl)
+			terminalRepo := mock.NewMockTerminalRepository(ctrl)
+			twoFactorRepo := mock.NewMockTwoFactorRepository(ctrl)
+			cache := mock.NewMockCacheRepository(ctrl)
+			auditService := newAuditStub(ctrl)
+
+			tc.mocks(userRepo, refreshRepo, roleRepo, tokenService, cache, twoFactorRepo)
+
+			authService := NewAuthUsecase(userRepo, refreshRepo, roleRepo, terminalRepo, twoFactorRepo, tokenService, cache, time.Hour, lockout, twoFactor, auditService)
+
+			tokens, err := authService.VerifyTwoFactor(ctx, tc.input.challenge, tc.input.code, ip)
+			if !errors.Is(err, tc.expected.err) {
+				t.Errorf("[case: %s] expected to get %q; got %q", tc.desc, tc.expected.err, err)
+			}
+
+			var token string
+			if tokens != nil {
+				token = tokens.AccessToken
+			}
+			if token != tc.expected.token {
+				t.Errorf("[case: %s] expected to get %q; got %q", tc.desc, tc.expected.token, token)
+			}
+		})
+	}
+}
+
+type confirmTwoFactorTestedInput struct {
+	code string
+}
+
+type confirmTwoFactorExpectedOutput struct {
+	codes int
+	err   error
+}
+
+func TestAuthService_ConfirmTwoFactor(t *testing.T) {
+	ctx := context.Background()
+	userID := gofakeit.Uint64()
+	secret, _ := util.GenerateTOTPSecret()
+	code, _ := util.GenerateTOTP(secret, time.Now())
+	confirmedAt := gofakeit.Date()
+	pendingTOTP := &domainauth.TOTP{
+		UserID: userID,
+		Secret: secret,
+	}
+	confirmedTOTP := &domainauth.TOTP{
+		UserID:      userID,
+		Secret:      secret,
+		ConfirmedAt: &confirmedAt,
+	}
+
+	testCases := []struct {
+		desc     string
+		mocks    func(cache *mock.MockCacheRepository, twoFactorRepo *mock.MockTwoFactorRepository)
+		input    confirmTwoFactorTestedInput
+		expected confirmTwoFactorExpectedOutput
+	}{
+		{
+			desc: "Success",
+			mocks: func(cache *mock.MockCacheRepository, twoFactorRepo *mock.MockTwoFactorRepository) {
+				twoFactorRepo.EXPECT().
+					GetTOTP(gomock.Any(), gomock.Eq(userID)).
+					Times(1).
+					Return(pendingTOTP, nil)
+				cache.EXPECT().
+					Get(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(nil, domain.ErrDataNotFound)
+				cache.EXPECT().
+					Set(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(nil)
+				twoFactorRepo.EXPECT().
+					ConfirmTOTP(gomock.Any(), gomock.Eq(userID), gomock.Len(recoveryCodeCount)).
+					Times(1).
+					Return(nil)
+			},
+			input: confirmTwoFactorTestedInput{
+				code: code,
+			},
+			expected: confirmTwoFactorExpectedOutput{
+				codes: recoveryCodeCount,
+				err:   nil,
+			},
+		},
+		{
+			desc: "Fail_NotEnrolled",
+			mocks: func(cache *mock.MockCacheRepository, twoFactorRepo *mock.MockTwoFactorRepository) {
+				twoFactorRepo.EXPECT().
+					GetTOTP(gomock.Any(), gomock.Eq(userID)).
+					Times(1).
+					Return(nil, domain.ErrDataNotFound)
+			},
+			input: confirmTwoFactorTestedInput{
+				code: code,
+			},
+			expected: confirmTwoFactorExpectedOutput{
+				codes: 0,
+				err:   domain.ErrTwoFactorNotEnabled,
+			},
+		},
+		{
+			desc: "Fail_AlreadyEnabled",
+			mocks: func(cache *mock.MockCacheRepository, twoFactorRepo *mock.MockTwoFactorRepository) {
+				twoFactorRepo.EXPECT().
+					GetTOTP(gomock.Any(), gomock.Eq(userID)).
+					Times(1).
+					Return(confirmedTOTP, nil)
+			},
+			input: confirmTwoFactorTestedInput{
+				code: code,
+			},
+			expected: confirmTwoFactorExpectedOutput{
+				codes: 0,
+				err:   domain.ErrTwoFactorEnabled,
+			},
+		},
+		{
+			desc: "Fail_RecoveryCodeNotAccepted",
+			mocks: func(cache *mock.MockCacheRepository, twoFactorRepo *mock.MockTwoFactorRepository) {
+				twoFactorRepo.EXPECT().
+					GetTOTP(gomock.Any(), gomock.Eq(userID)).
+					Times(1).
+					Return(pendingTOTP, nil)
+			},
+			input: confirmTwoFactorTestedInput{
+				code: "ABCDE-FGHJK",
+			},
+			expected: confirmTwoFactorExpectedOutput{
+				codes: 0,
+				err:   domain.ErrInvalidTwoFactorCode,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.desc, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			userRepo := mock.NewMockUserRepository(ctrl)
+			refreshRepo := mock.NewMockRefreshTokenRepository(ctrl)
+			roleRepo := mock.NewMockRoleRepository(ctrl)
+			tokenService := mock.NewMockTokenService(ctrl)
+			terminalRepo := mock.NewMockTerminalRepository(ctrl)
+			twoFactorRepo := mock.NewMockTwoFactorRepository(ctrl)
+			cache := mock.NewMockCacheRepository(ctrl)
+			auditService := newAuditStub(ctrl)
+
+			tc.mocks(cache, twoFactorRepo)
+
+			authService := NewAuthUsecase(userRepo, refreshRepo, roleRepo, terminalRepo, twoFactorRepo, tokenService, cache, time.Hour, lockout, twoFactor, auditService)
+
+			codes, err := authService.ConfirmTwoFactor(ctx, userID, tc.input.code)
+			if !errors.Is(err, tc.expected.err) {
+				t.Errorf("[case: %s] expected to get %q; got %q", tc.desc, tc.expected.err, err)
+			}
+
+			if len(codes) != tc.expected.codes {
+				t.Errorf("[case: %s] expected to get %d recovery codes; got %d", tc.desc, tc.expected.codes, len(codes))
+			}
+		})
+	}
+}
+
+type disableTwoFactorTestedInput struct {
+	user *domainuser.User
+	code string
+}
+
+type disableTwoFactorExpectedOutput struct {
+	err error
+}
+
+func TestAuthService_DisableTwoFactor(t *testing.T) {
+	ctx := context.Background()
+	secret, _ := util.GenerateTOTPSecret()
+	confirmedAt := gofakeit.Date()
+	manager := &domainuser.User{
+		ID:   gofakeit.Uint64(),
+		Role: domainuser.Manager,
+	}
+	admin := &domainuser.User{
+		ID:   gofakeit.Uint64(),
+		Role: domainuser.Admin,
+	}
+
+	testCases := []struct {
+		desc     string
+		mocks    func(userRepo *mock.MockUserRepository, twoFactorRepo *mock.MockTwoFactorRepository)
+		input    disableTwoFactorTestedInput
+		expected disableTwoFactorExpectedOutput
+	}{
+		{
+			desc: "Success_RecoveryCode",
+			mocks: func(userRepo *mock.MockUserRepository, twoFactorRepo *mock.MockTwoFactorRepository) {
+				userRepo.EXPECT().
+					GetUserByID(gomock.Any(), gomock.Eq(manager.ID)).
+					Times(1).
+					Return(manager, nil)
+				twoFactorRepo.EXPECT().
+					GetTOTP(gomock.Any(), gomock.Eq(manager.ID)).
+					Times(1).
+					Return(&domainauth.TOTP{UserID: manager.ID, Secret: secret, ConfirmedAt: &confirmedAt}, nil)
+				twoFactorRepo.EXPECT().
+					UseRecoveryCode(gomock.Any(), gomock.Eq(manager.ID), gomock.Eq(util.HashToken("ABCDEFGHJK"))).
+					Times(1).
+					Return(nil)
+				twoFactorRepo.EXPECT().
+					DeleteTOTP(gomock.Any(), gomock.Eq(manager.ID)).
+					Times(1).
+					Return(nil)
+			},
+			input: disableTwoFactorTestedInput{
+				user: manager,
+				code: "ABCDE-FGHJK",
+			},
+			expected: disableTwoFactorExpectedOutput{
+				err: nil,
+			},
+		},
+		{
+			desc: "Success_Unconfirmed",
+			mocks: func(userRepo *mock.MockUserRepository, twoFactorRepo *mock.MockTwoFactorRepository) {
+				userRepo.EXPECT().
+					GetUserByID(gomock.Any(), gomock.Eq(admin.ID)).
+					Times(1).
+					Return(admin, nil)
+				twoFactorRepo.EXPECT().
+					GetTOTP(gomock.Any(), gomock.Eq(admin.ID)).
+					Times(1).
+					Return(&domainauth.TOTP{UserID: admin.ID, Secret: secret}, nil)
+				twoFactorRepo.EXPECT().
+					DeleteTOTP(gomock.Any(), gomock.Eq(admin.ID)).
+					Times(1).
+					Return(nil)
+			},
+			input: disableTwoFactorTestedInput{
+				user: admin,
+				code: "",
+			},
+			expected: disableTwoFactorExpectedOutput{
+				err: nil,
+			},
+		},
+		{
+			desc: "Fail_RequiredForAdmin",
+			mocks: func(userRepo *mock.MockUserRepository, twoFactorRepo *mock.MockTwoFactorRepository) {
+				userRepo.EXPECT().
+					GetUserByID(gomock.Any(), gomock.Eq(admin.ID)).
+					Times(1).
+					Return(admin, nil)
+				twoFactorRepo.EXPECT().
+					GetTOTP(gomock.Any(), gomock.Eq(admin.ID)).
+					Times(1).
+					Return(&domainauth.TOTP{UserID: admin.ID, Secret: secret, ConfirmedAt: &confirmedAt}, nil)
+			},
+			input: disableTwoFactorTestedInput{
+				user: admin,
+				code: "ABCDE-FGHJK",
+			},
+			expected: disableTwoFactorExpectedOutput{
+				err: domain.ErrTwoFactorRequired,
+			},
+		},
+		{
+			desc: "Fail_NotEnabled",
+			mocks: func(userRepo *mock.MockUserRepository, twoFactorRepo *mock.MockTwoFactorRepository) {
+				userRepo.EXPECT().
+					GetUserByID(gomock.Any(), gomock.Eq(manager.ID)).
+					Times(1).
+					Return(manager, nil)
+				twoFactorRepo.EXPECT().
+					GetTOTP(gomock.Any(), gomock.Eq(manager.ID)).
+					Times(1).
+					Return(nil, domain.ErrDataNotFound)
+			},
+			input: disableTwoFactorTestedInput{
+				user: manager,
+				code: "ABCDE-FGHJK",
+			},
+			expected: disableTwoFactorExpectedOutput{
+				err: domain.ErrTwoFactorNotEnabled,
+			},
+		},
+		{
+			desc: "Fail_InvalidCode",
+			mocks: func(userRepo *mock.MockUserRepository, twoFactorRepo *mock.MockTwoFactorRepository) {
+				userRepo.EXPECT().
+					GetUserByID(gomock.Any(), gomock.Eq(manager.ID)).
+					Times(1).
+					Return(manager, nil)
+				twoFactorRepo.EXPECT().
+					GetTOTP(gomock.Any(), gomock.Eq(manager.ID)).
+					Times(1).
+					Return(&domainauth.TOTP{UserID: manager.ID, Secret: secret, ConfirmedAt: &confirmedAt}, nil)
+				twoFactorRepo.EXPECT().
+					UseRecoveryCode(gomock.Any(), gomock.Eq(manager.ID), gomock.Any()).
+					Times(1).
+					Return(domain.ErrDataNotFound)
+			},
+			input: disableTwoFactorTestedInput{
+				user: manager,
+				code: "ZZZZZ-ZZZZZ",
+			},
+			expected: disableTwoFactorExpectedOutput{
+				err: domain.ErrInvalidTwoFactorCode,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.desc, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			userRepo := mock.NewMockUserRepository(ctrl)
+			refreshRepo := mock.NewMockRefreshTokenRepository(ctrl)
+			roleRepo := mock.NewMockRoleRepository(ctrl)
+			tokenService := mock.NewMockTokenService(ctrl)
+			terminalRepo := mock.NewMockTerminalRepository(ctrl)
+			twoFactorRepo := mock.NewMockTwoFactorRepository(ctrl)
+			cache := mock.NewMockCacheRepository(ctrl)
+			auditService := newAuditStub(ctrl)
+
+			tc.mocks(userRepo, twoFactorRepo)
+
+			authService := NewAuthUsecase(userRepo, refreshRepo, roleRepo, terminalRepo, twoFactorRepo, tokenService, cache, time.Hour, lockout, twoFactor, auditService)
+
+			err := authService.DisableTwoFactor(ctx, tc.input.user.ID, tc.input.code)
+			if !errors.Is(err, tc.expected.err) {
+				t.Errorf("[case: %s] expected to get %q; got %q", tc.desc, tc.expected.err, err)
+			}
+		})
+	}
+}
+
+type refreshTestedInput struct {
+	refreshToken string
+}
+
+type refreshExpectedOutput struct {
+	token string
+	err   error
+}
+
+func TestAuthService_Refresh(t *testing.T) {
+	ctx := context.Background()
+	refreshToken := gofakeit.UUID()
+	tokenHash := util.HashToken(refreshToken)
+	familyID := uuid.New()
+	usedAt := gofakeit.Date()
+	user := &domainuser.User{
+		ID:    gofakeit.Uint64(),
+		Email: gofakeit.Email(),
+		Role:  domainuser.Cashier,
+	}
+	permissions := []string{}
+	existingToken := &domainauth.RefreshToken{
+		ID:        gofakeit.Uint64(),
+		UserID:    user.ID,
+		TokenHash: tokenHash,
+		FamilyID:  familyID,
+		ExpiresAt: time.Now().Add(time.Hour),
+	}
+	usedToken := &domainauth.RefreshToken{
+		ID:        existingToken.ID,
+		UserID:    user.ID,
+		TokenHash: tokenHash,
+		FamilyID:  familyID,
+		ExpiresAt: time.Now().Add(time.Hour),
+		UsedAt:    &usedAt,
+	}
+	expiredToken := &domainauth.RefreshToken{
+		ID:        existingToken.ID,
+		UserID:    user.ID,
+		TokenHash: tokenHash,
+		FamilyID:  familyID,
+		ExpiresAt: time.Now().Add(-time.Hour),
+	}
+	token := gofakeit.UUID()
+
+	testCases := []struct {
+		desc  string
+		mocks func(
+			userRepo *mock.MockUserRepository,
+			refreshRepo *mock.MockRefreshTokenRepository,
+			roleRepo *mock.MockRoleRepository,
+			tokenService *mock.MockTokenService,
+		)
+		input    refreshTestedInput
+		expected refreshExpectedOutput
+	}{
+		{
+			desc: "Success",
+			mocks: func(
+				userRepo *mock.MockUserRepository,
+				refreshRepo *mock.MockRefreshTokenRepository,
+				roleRepo *mock.MockRoleRepository,
+				tokenService *mock.MockTokenService,
+			) {
+				refreshRepo.EXPECT().
+					GetRefreshTokenByHash(gomock.Any(), gomock.Eq(tokenHash)).
+					Times(1).
+					Return(existingToken, nil)
+				userRepo.EXPECT().
+					GetUserByID(gomock.Any(), gomock.Eq(user.ID)).
+					Times(1).
+					Return(user, nil)
+				roleRepo.EXPECT().
+					ListRolePermissions(gomock.Any(), gomock.Eq(user.Role)).
+					Times(1).
+					Return(permissions, nil)
+				tokenService.EXPECT().
+					CreateToken(gomock.Eq(user), gomock.Eq(permissions)).
+					Times(1).
+					Return(token, nil)
+				refreshRepo.EXPECT().
+					RotateRefreshToken(gomock.Any(), gomock.Eq(existingToken.ID), gomock.Any()).
+					Times(1).
+					DoAndReturn(func(_ context.Context, _ uint64, refreshToken *domainauth.RefreshToken) (*domainauth.RefreshToken, error) {
+						if refreshToken.FamilyID != familyID {
+							t.Errorf("expected the rotated token to stay in family %s; got %s", familyID, refreshToken.FamilyID)
+						}
+						return refreshToken, nil
+					})
+			},
+			input: refreshTestedInput{
+				refreshToken: refreshToken,
+			},
+			expected: refreshExpectedOutput{
+				token: token,
+				err:   nil,
+			},
+		},
+		{
+			desc: "Fail_TokenNotFound",
+			mocks: func(
+				userRepo *mock.MockUserRepository,
+				refreshRepo *mock.MockRefreshTokenRepository,
+				roleRepo *mock.MockRoleRepository,
+				tokenService *mock.MockTokenService,
+			) {
+				refreshRepo.EXPECT().
+					GetRefreshTokenByHash(gomock.Any(), gomock.Eq(tokenHash)).
+					Times(1).
+					Return(nil, domain.ErrDataNotFound)
+			},
+			input: refreshTestedInput{
+				refreshToken: refreshToken,
+			},
+			expected: refreshExpectedOutput{
+				token: "",
+				err:   domain.ErrInvalidRefreshToken,
+			},
+		},
+		{
+			desc: "Fail_TokenReused",
+			mocks: func(
+				userRepo *mock.MockUserRepository,
+				refreshRepo *mock.MockRefreshTokenRepository,
+				roleRepo *mock.MockRoleRepository,
+				tokenService *mock.MockTokenService,
+			) {
+				refreshRepo.EXPECT().
+					GetRefreshTokenByHash(gomock.Any(), gomock.Eq(tokenHash)).
+					Times(1).
+					Return(usedToken, nil)
+				refreshRepo.EXPECT().
+					RevokeRefreshTokenFamily(gomock.Any(), gomock.Eq(familyID)).
+					Times(1).
+					Return(nil)
+			},
+			input: refreshTestedInput{
+				refreshToken: refreshToken,
+			},
+			expected: refreshExpectedOutput{
+				token: "",
+				err:   domain.ErrRefreshTokenReused,
+			},
+		},
+		{
+			desc: "Fail_TokenReusedConcurrently",
+			mocks: func(
+				userRepo *mock.MockUserRepository,
+				refreshRepo *mock.MockRefreshTokenRepository,
+				roleRepo *mock.MockRoleRepository,
+				tokenService *mock.MockTokenService,
+			) {
+				refreshRepo.EXPECT().
+					GetRefreshTokenByHash(gomock.Any(), gomock.Eq(tokenHash)).
+					Times(1).
+					Return(existingToken, nil)
+				userRepo.EXPECT().
+					GetUserByID(gomock.Any(), gomock.Eq(user.ID)).
+					Times(1).
+					Return(user, nil)
+				roleRepo.EXPECT().
+					ListRolePermissions(gomock.Any(), gomock.Eq(user.Role)).
+					Times(1).
+					Return(permissions, nil)
+				tokenService.EXPECT().
+					CreateToken(gomock.Eq(user), gomock.Eq(permissions)).
+					Times(1).
+					Return(token, nil)
+				refreshRepo.EXPECT().
+					RotateRefreshToken(gomock.Any(), gomock.Eq(existingToken.ID), gomock.Any()).
+					Times(1).
+					Return(nil, domain.ErrRefreshTokenReused)
+				refreshRepo.EXPECT().
+					RevokeRefreshTokenFamily(gomock.Any(), gomock.Eq(familyID)).
+					Times(1).
+					Return(nil)
+			},
+			input: refreshTestedInput{
+				refreshToken: refreshToken,
+			},
+			expected: refreshExpectedOutput{
+				token: "",
+				err:   domain.ErrRefreshTokenReused,
+			},
+		},
+		{
+			desc: "Fail_TokenExpired",
+			mocks: func(
+				userRepo *mock.MockUserRepository,
+				refreshRepo *mock.MockRefreshTokenRepository,
+				roleRepo *mock.MockRoleRepository,
+				tokenService *mock.MockTokenService,
+			) {
+				refreshRepo.EXPECT().
+					GetRefreshTokenByHash(gomock.Any(), gomock.Eq(tokenHash)).
+					Times(1).
+					Return(expiredToken, nil)
+			},
+			input: refreshTestedInput{
+				refreshToken: refreshToken,
+			},
+			expected: refreshExpectedOutput{
+				token: "",
+				err:   domain.ErrExpiredRefreshToken,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.desc, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			userRepo := mock.NewMockUserRepository(ctrl)
+			refreshRepo := mock.NewMockRefreshTokenRepository(ctrl)
+			roleRepo := mock.NewMockRoleRepository(ctrl)
+			tokenService := mock.NewMockTokenService(ctrl)
+			terminalRepo := mock.NewMockTerminalRepository(ctrl)
+			twoFactorRepo := mock.NewMockTwoFactorRepository(ctrl)
+			cache := mock.NewMockCacheRepository(ctrl)
+			auditService := newAuditStub(ctrl)
+
+			tc.mocks(userRepo, refreshRepo, roleRepo, tokenService)
+
+			authService := NewAuthUsecase(userRepo, refreshRepo, roleRepo, terminalRepo, twoFactorRepo, tokenService, cache, time.Hour, lockout, twoFactor, auditService)
+
+			tokens, err := authService.Refresh(ctx, tc.input.refreshToken)
+			if !errors.Is(err, tc.expected.err) {
+				t.Errorf("[case: %s] expected to get %q; got %q", tc.desc, tc.expected.err, err)
+			}
+
+			var token string
+			if tokens != nil {
+				token = tokens.AccessToken
+			}
+			if token != tc.expected.token {
+				t.Errorf("[case: %s] expected to get %q; got %q", tc.desc, tc.expected.token, token)
+			}
+		})
+	}
+}
+
+type logoutTestedInput struct {
+	payload      *domainauth.TokenPayload
+	refreshToken string
+}
+
+type logoutExpectedOutput struct {
+	err error
+}
+
+func TestAuthService_Logout(t *testing.T) {
+	ctx := context.Background()
+	refreshToken := gofakeit.UUID()
+	tokenHash := util.HashToken(refreshToken)
+	familyID := uuid.New()
+	payload := &domainauth.TokenPayload{
+		ID:        uuid.New(),
+		UserID:    gofakeit.Uint64(),
+		ExpiredAt: time.Now().Add(time.Hour),
+	}
+	ownToken := &domainauth.RefreshToken{
+		UserID:   payload.UserID,
+		FamilyID: familyID,
+	}
+	otherToken := &domainauth.RefreshToken{
+		UserID:   payload.UserID + 1,
+		FamilyID: familyID,
+	}
+
+	testCases := []struct {
+		desc  string
+		mocks func(
+			refreshRepo *mock.MockRefreshTokenRepository,
+			tokenService *mock.MockTokenService,
+		)
+		input    logoutTestedInput
+		expected logoutExpectedOutput
+	}{
+		{
+			desc: "Success",
+			mocks: func(
+				refreshRepo *mock.MockRefreshTokenRepository,
+				tokenService *mock.MockTokenService,
+			) {
+				tokenService.EXPECT().
+					RevokeToken(gomock.Any(), gomock.Eq(payload)).
+					Times(1).
+					Return(nil)
+				refreshRepo.EXPECT().
+					GetRefreshTokenByHash(gomock.Any(), gomock.Eq(tokenHash)).
+					Times(1).
+					Return(ownToken, nil)
+				refreshRepo.EXPECT().
+					RevokeRefreshTokenFamily(gomock.Any(), gomock.Eq(familyID)).
+					Times(1).
+					Return(nil)
+			},
+			input: logoutTestedInput{
+				payload:      payload,
+				refreshToken: refreshToken,
+			},
+			expected: logoutExpectedOutput{
+				err: nil,
+			},
+		},
+		{
+			desc: "Success_AccessTokenOnly",
+			mocks: func(
+				refreshRepo *mock.MockRefreshTokenRepository,
+				tokenService *mock.MockTokenService,
+			) {
+				tokenService.EXPECT().
+					RevokeToken(gomock.Any(), gomock.Eq(payload)).
+					Times(1).
+					Return(nil)
+			},
+			input: logoutTestedInput{
+				payload:      payload,
+				refreshToken: "",
+			},
+			expected: logoutExpectedOutput{
+				err: nil,
+			},
+		},
+		{
+			desc: "Fail_RefreshTokenOfAnotherUser",
+			mocks: func(
+				refreshRepo *mock.MockRefreshTokenRepository,
+				tokenService *mock.MockTokenService,
+			) {
+				tokenService.EXPECT().
+					RevokeToken(gomock.Any(), gomock.Eq(payload)).
+					Times(1).
+					Return(nil)
+				refreshRepo.EXPECT().
+					GetRefreshTokenByHash(gomock.Any(), gomock.Eq(tokenHash)).
+					Times(1).
+					Return(otherToken, nil)
+			},
+			input: logoutTestedInput{
+				payload:      payload,
+				refreshToken: refreshToken,
+			},
+			expected: logoutExpectedOutput{
+				err: domain.ErrInvalidRefreshToken,
+			},
+		},
+		{
+			desc: "Fail_RevokeError",
+			mocks: func(
+				refreshRepo *mock.MockRefreshTokenRepository,
+				tokenService *mock.MockTokenService,
+			) {
+				tokenService.EXPECT().
+					RevokeToken(gomock.Any(), gomock.Eq(payload)).
+					Times(1).
+					Return(domain.ErrInternal)
+			},
+			input: logoutTestedInput{
+				payload:      payload,
+				refreshToken: refreshToken,
+			},
+			expected: logoutExpectedOutput{
+				err: domain.ErrInternal,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.desc, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			userRepo := mock.NewMockUserRepository(ctrl)
+			refreshRepo := mock.NewMockRefreshTokenRepository(ctrl)
+			roleRepo := mock.NewMockRoleRepository(ctrl)
+			tokenService := mock.NewMockTokenService(ctrl)
+			terminalRepo := mock.NewMockTerminalRepository(ctrl)
+			twoFactorRepo := mock.NewMockTwoFactorRepository(ctrl)
+			cache := mock.NewMockCacheRepository(ctrl)
+			auditService := newAuditStub(ctrl)
+
+			tc.mocks(refreshRepo, tokenService)
+
+			authService := NewAuthUsecase(userRepo, refreshRepo, roleRepo, terminalRepo, twoFactorRepo, tokenService, cache, time.Hour, lockout, twoFactor, auditService)
+
+			err := authService.Logout(ctx, tc.input.payload, tc.input.refreshToken)
+			if !errors.Is(err, tc.expected.err) {
+				t.Errorf("[case: %s] expected to get %q; got %q", tc.desc, tc.expected.err, err)
+			}
+		})
+	}
+}
@@ -2,8 +2,10 @@ package usecase
 
 import (
 	"context"
+	"errors"
 
 	"github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain"
+	domainaudit "github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain/audit"
 	domaincategory "github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain/category"
 	"github.com/TienMinh25/go-hexagonal-architecture/internal/application/port"
 	"github.com/TienMinh25/go-hexagonal-architecture/internal/application/util"
@@ -11,19 +13,21 @@ import (
 
 /**
  * categoryUsecase implements port.CategoryService interface
- * and provides an access to the category repository
- * and cache service
+ * and provides an access to the category repository,
+ * cache service and audit service
  */
 type categoryUsecase struct {
 	repo  port.CategoryRepository
 	cache port.CacheRepository
+	audit port.AuditService
 }
 
 // NewCategoryUsecase creates a new category service instance
-func NewCategoryUsecase(repo port.CategoryRepository, cache port.CacheRepository) *categoryUsecase {
+func NewCategoryUsecase(repo port.CategoryRepository, cache port.CacheRepository, audit port.AuditService) *categoryUsecase {
 	return &categoryUsecase{
 		repo,
 		cache,
+		audit,
 	}
 }
 
@@ -31,26 +35,31 @@ func NewCategoryUsecase(repo port.CategoryRepository, cache port.CacheRepository
 func (cs *categoryUsecase) CreateCategory(ctx context.Context, category *domaincategory.Category) (*domaincategory.Category, error) {
 	category, err := cs.repo.CreateCategory(ctx, category)
 	if err != nil {
-		if err == domain.ErrConflictingData {
+		if errors.Is(err, domain.ErrConflictingData) {
 			return nil, err
 		}
-		return nil, domain.ErrInternal
+		return nil, domain.ErrInternal.Wrap(err)
 	}
 
 	cacheKey := util.GenerateCacheKey("category", category.ID)
 	categorySerialized, err := util.Serialize(category)
 	if err != nil {
-		return nil, domain.ErrInternal
+		return nil, domain.ErrInternal.Wrap(err)
 	}
 
 	err = cs.cache.Set(ctx, cacheKey, categorySerialized, 0)
 	if err != nil {
-		return nil, domain.ErrInternal
+		return nil, domain.ErrInternal.Wrap(err)
 	}
 
 	err = cs.cache.DeleteByPrefix(ctx, "categories:*")
 	if err != nil {
-		return nil, domain.ErrInternal
+		return nil, domain.ErrInternal.Wrap(err)
+	}
+
+	err = cs.audit.Record(ctx, domainaudit.Create, domainaudit.Category, category.ID, nil, category)
+	if err != nil {
+		return nil, err
 	}
 
 	return category, nil
@@ -65,62 +74,62 @@ func (cs *categoryUsecase) GetCategory(ctx context.Context, id uint64) (*domainc
 	if err == nil {
 		err := util.Deserialize(cachedCategory, &category)
 		if err != nil {
-			return nil, domain.ErrInternal
+			return nil, domain.ErrInternal.Wrap(err)
 		}
 		return category, nil
 	}
 
 	category, err = cs.repo.GetCategoryByID(ctx, id)
 	if err != nil {
-		if err == domain.ErrDataNotFound {
+		if errors.Is(err, domain.ErrDataNotFound) {
 			return nil, err
 		}
-		return nil, domain.ErrInternal
+		return nil, domain.ErrInternal.Wrap(err)
 	}
 
 	categorySerialized, err := util.Serialize(category)
 	if err != nil {
-		return nil, domain.ErrInternal
+		return nil, domain.ErrInternal.Wrap(err)
 	}
 
 	err = cs.cache.Set(ctx, cacheKey, categorySerialized, 0)
 	if err != nil {
-		return nil, domain.ErrInternal
+		return nil, domain.ErrInternal.Wrap(err)
 	}
 
 	return category, nil
 }
 
 // ListCategories retrieves a list of categories
-func (cs *categoryUsecase) ListCategories(ctx context.Context, skip, limit uint64) ([]domaincategory.Category, error) {
+func (cs *categoryUsecase) ListCategories(ctx context.Context, skip, limit uint64, includeArchived bool) ([]domaincategory.Category, error) {
 	var categories []domaincategory.Category
 
-	params := util.GenerateCacheKeyParams(skip, limit)
+	params := util.GenerateCacheKeyParams(skip, limit, includeArchived)
 	cacheKey := util.GenerateCacheKey("categories", params)
 
 	cachedCategories, err := cs.cache.Get(ctx, cacheKey)
 	if err == nil {
 		err := util.Deserialize(cachedCategories, &categories)
 		if err != nil {
-			return nil, domain.ErrInternal
+			return nil, domain.ErrInternal.Wrap(err)
 		}
 
 		return categories, nil
 	}
 
-	categories, err = cs.repo.ListCategories(ctx, skip, limit)
+	categories, err = cs.repo.ListCategories(ctx, skip, limit, includeArchived)
 	if err != nil {
-		return nil, domain.ErrInternal
+		return nil, domain.ErrInternal.Wrap(err)
 	}
 
 	categoriesSerialized, err := util.Serialize(categories)
 	if err != nil {
-		return nil, domain.ErrInternal
+		return nil, domain.ErrInternal.Wrap(err)
 	}
 
 	err = cs.cache.Set(ctx, cacheKey, categoriesSerialized, 0)
 	if err != nil {
-		return nil, domain.ErrInternal
+		return nil, domain.ErrInternal.Wrap(err)
 	}
 
 	return categories, nil
@@ -130,10 +139,18 @@ func (cs *categoryUsecase) ListCategories(ctx context.Context, skip, limit uint6
 func (cs *categoryUsecase) UpdateCategory(ctx context.Context, category *domaincategory.Category) (*domaincategory.Category, error) {
 	existingCategory, err := cs.repo.GetCategoryByID(ctx, category.ID)
 	if err != nil {
-		if err == domain.ErrDataNotFound {
+		if errors.Is(err, domain.ErrDataNotFound) {
 			return nil, err
 		}
-		return nil, domain.ErrInternal
+		return nil, domain.ErrInternal.Wrap(err)
+	}
+
+	if existingCategory.DeletedAt != nil {
+		return nil, domain.ErrDataArchived
+	}
+
+	if existingCategory.Version != category.Version {
+		return nil, domain.ErrVersionMismatch
 	}
 
 	emptyData := category.Name == ""
@@ -142,60 +159,129 @@ func (cs *categoryUsecase) UpdateCategory(ctx context.Context, category *domainc
 		return nil, domain.ErrNoUpdatedData
 	}
 
-	_, err = cs.repo.UpdateCategory(ctx, category)
+	category, err = cs.repo.UpdateCategory(ctx, category)
 	if err != nil {
-		if err == domain.ErrConflictingData {
+		if errors.Is(err, domain.ErrConflictingData) || errors.Is(err, domain.ErrVersionMismatch) {
 			return nil, err
 		}
-		return nil, domain.ErrInternal
+		return nil, domain.ErrInternal.Wrap(err)
 	}
 
 	cacheKey := util.GenerateCacheKey("category", category.ID)
 
 	err = cs.cache.Delete(ctx, cacheKey)
 	if err != nil {
-		return nil, domain.ErrInternal
+		return nil, domain.ErrInternal.Wrap(err)
 	}
 
 	categorySerialized, err := util.Serialize(category)
 	if err != nil {
-		return nil, domain.ErrInternal
+		return nil, domain.ErrInternal.Wrap(err)
 	}
 
 	err = cs.cache.Set(ctx, cacheKey, categorySerialized, 0)
 	if err != nil {
-		return nil, domain.ErrInternal
+		return nil, domain.ErrInternal.Wrap(err)
 	}
 
 	err = cs.cache.DeleteByPrefix(ctx, "categories:*")
 	if err != nil {
-		return nil, domain.ErrInternal
+		return nil, domain.ErrInternal.Wrap(err)
+	}
+
+	err = cs.audit.Record(ctx, domainaudit.Update, domainaudit.Category, category.ID, existingCategory, category)
+	if err != nil {
+		return nil, err
 	}
 
 	return category, nil
 }
 
-// DeleteCategory deletes a category
-func (cs *categoryUsecase) DeleteCategory(ctx context.Context, id uint64) error {
-	_, err := cs.repo.GetCategoryByID(ctx, id)
+// DeleteCategory archives a category
+func (cs *categoryUsecase) DeleteCategory(ctx context.Context, id, version uint64) error {
+	category, err := cs.repo.GetCategoryByID(ctx, id)
 	if err != nil {
-		if err == domain.ErrDataNotFound {
+		if errors.Is(err, domain.ErrDataNotFound) {
 			return err
 		}
-		return domain.ErrInternal
+		return domain.ErrInternal.Wrap(err)
+	}
+
+	if category.DeletedAt != nil {
+		return domain.ErrDataArchived
+	}
+
+	if category.Version != version {
+		return domain.ErrVersionMismatch
 	}
 
 	cacheKey := util.GenerateCacheKey("category", id)
 
 	err = cs.cache.Delete(ctx, cacheKey)
 	if err != nil {
-		return domain.ErrInternal
+		return domain.ErrInternal.Wrap(err)
 	}
 
 	err = cs.cache.DeleteByPrefix(ctx, "categories:*")
 	if err != nil {
-		return domain.ErrInternal
+		return domain.ErrInternal.Wrap(err)
+	}
+
+	err = cs.repo.DeleteCategory(ctx, id, version)
+	if err != nil {
+		return err
+	}
+
+	err = cs.audit.Record(ctx, domainaudit.Delete, domainaudit.Category, id, category, nil)
+	if err != nil {
+		return err
 	}
 
-	return cs.repo.DeleteCategory(ctx, id)
+	return nil
+}
+
+// RestoreCategory restores an archived category
+func (cs *categoryUsecase) RestoreCategory(ctx context.Context, id uint64) (*domaincategory.Category, error) {
+	existingCategory, err := cs.repo.GetCategoryByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, domain.ErrDataNotFound) {
+			return nil, err
+		}
+		return nil, domain.ErrInternal.Wrap(err)
+	}
+
+	if existingCategory.DeletedAt == nil {
+		return nil, domain.ErrDataNotArchived
+	}
+
+	category, err := cs.repo.RestoreCategory(ctx, id)
+	if err != nil {
+		if errors.Is(err, domain.ErrDataNotFound) {
+			return nil, err
+		}
+		return nil, domain.ErrInternal.Wrap(err)
+	}
+
+	cacheKey := util.GenerateCacheKey("category", category.ID)
+	categorySerialized, err := util.Serialize(category)
+	if err != nil {
+		return nil, domain.ErrInternal.Wrap(err)
+	}
+
+	err = cs.cache.Set(ctx, cacheKey, categorySerialized, 0)
+	if err != nil {
+		return nil, domain.ErrInternal.Wrap(err)
+	}
+
+	err = cs.cache.DeleteByPrefix(ctx, "categories:*")
+	if err != nil {
+		return nil, domain.ErrInternal.Wrap(err)
+	}
+
+	err = cs.audit.Record(ctx, domainaudit.Restore, domainaudit.Category, id, existingCategory, category)
+	if err != nil {
+		return nil, err
+	}
+
+	return category, nil
 }
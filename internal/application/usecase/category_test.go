@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain"
+	domainaudit "github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain/audit"
 	domaincategory "github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain/category"
 	"github.com/TienMinh25/go-hexagonal-architecture/internal/application/mock"
 	"github.com/TienMinh25/go-hexagonal-architecture/internal/application/util"
@@ -46,6 +47,7 @@ func TestCategoryService_CreateCategory(t *testing.T) {
 		mocks func(
 			categoryRepo *mock.MockCategoryRepository,
 			cache *mock.MockCacheRepository,
+			auditService *mock.MockAuditService,
 		)
 		input    createCategoryTestedInput
 		expected createCategoryExpectedOutput
@@ -55,6 +57,7 @@ func TestCategoryService_CreateCategory(t *testing.T) {
 			mocks: func(
 				categoryRepo *mock.MockCategoryRepository,
 				cache *mock.MockCacheRepository,
+				auditService *mock.MockAuditService,
 			) {
 				categoryRepo.EXPECT().
 					CreateCategory(gomock.Any(), gomock.Eq(categoryInput)).
@@ -68,6 +71,10 @@ func TestCategoryService_CreateCategory(t *testing.T) {
 					DeleteByPrefix(gomock.Any(), gomock.Eq("categories:*")).
 					Times(1).
 					Return(nil)
+				auditService.EXPECT().
+					Record(gomock.Any(), gomock.Eq(domainaudit.Create), gomock.Eq(domainaudit.Category), gomock.Eq(categoryID), gomock.Nil(), gomock.Eq(categoryOutput)).
+					Times(1).
+					Return(nil)
 			},
 			input: createCategoryTestedInput{
 				category: categoryInput,
@@ -77,11 +84,44 @@ func TestCategoryService_CreateCategory(t *testing.T) {
 				err:      nil,
 			},
 		},
+		{
+			desc: "Fail_AuditLog",
+			mocks: func(
+				categoryRepo *mock.MockCategoryRepository,
+				cache *mock.MockCacheRepository,
+				auditService *mock.MockAuditService,
+			) {
+				categoryRepo.EXPECT().
+					CreateCategory(gomock.Any(), gomock.Eq(categoryInput)).
+					Times(1).
+					Return(categoryOutput, nil)
+				cache.EXPECT().
+					Set(gomock.Any(), gomock.Eq(cacheKey), gomock.Eq(categorySerialized), gomock.Eq(ttl)).
+					Times(1).
+					Return(nil)
+				cache.EXPECT().
+					DeleteByPrefix(gomock.Any(), gomock.Eq("categories:*")).
+					Times(1).
+					Return(nil)
+				auditService.EXPECT().
+					Record(gomock.Any(), gomock.Eq(domainaudit.Create), gomock.Eq(domainaudit.Category), gomock.Eq(categoryID), gomock.Nil(), gomock.Eq(categoryOutput)).
+					Times(1).
+					Return(domain.ErrInternal)
+			},
+			input: createCategoryTestedInput{
+				category: categoryInput,
+			},
+			expected: createCategoryExpectedOutput{
+				category: nil,
+				err:      domain.ErrInternal,
+			},
+		},
 		{
 			desc: "Fail_DuplicateData",
 			mocks: func(
 				categoryRepo *mock.MockCategoryRepository,
 				cache *mock.MockCacheRepository,
+				auditService *mock.MockAuditService,
 			) {
 				categoryRepo.EXPECT().
 					CreateCategory(gomock.Any(), gomock.Eq(categoryInput)).
@@ -101,6 +141,7 @@ func TestCategoryService_CreateCategory(t *testing.T) {
 			mocks: func(
 				categoryRepo *mock.MockCategoryRepository,
 				cache *mock.MockCacheRepository,
+				auditService *mock.MockAuditService,
 			) {
 				categoryRepo.EXPECT().
 					CreateCategory(gomock.Any(), gomock.Eq(categoryInput)).
@@ -120,6 +161,7 @@ func TestCategoryService_CreateCategory(t *testing.T) {
 			mocks: func(
 				categoryRepo *mock.MockCategoryRepository,
 				cache *mock.MockCacheRepository,
+				auditService *mock.MockAuditService,
 			) {
 				categoryRepo.EXPECT().
 					CreateCategory(gomock.Any(), gomock.Eq(categoryInput)).
@@ -143,6 +185,7 @@ func TestCategoryService_CreateCategory(t *testing.T) {
 			mocks: func(
 				categoryRepo *mock.MockCategoryRepository,
 				cache *mock.MockCacheRepository,
+				auditService *mock.MockAuditService,
 			) {
 				categoryRepo.EXPECT().
 					CreateCategory(gomock.Any(), gomock.Eq(categoryInput)).
@@ -176,13 +219,14 @@ func TestCategoryService_CreateCategory(t *testing.T) {
 
 			categoryRepo := mock.NewMockCategoryRepository(ctrl)
 			cache := mock.NewMockCacheRepository(ctrl)
+			auditService := mock.NewMockAuditService(ctrl)
 
-			tc.mocks(categoryRepo, cache)
+			tc.mocks(categoryRepo, cache, auditService)
 
-			categoryService := NewCategoryUsecase(categoryRepo, cache)
+			categoryService := NewCategoryUsecase(categoryRepo, cache, auditService)
 
 			category, err := categoryService.CreateCategory(ctx, tc.input.category)
-			assert.Equal(t, tc.expected.err, err, "Error mismatch")
+			assert.ErrorIs(t, err, tc.expected.err, "Error mismatch")
 			assert.Equal(t, tc.expected.category, category, "Category mismatch")
 		})
 	}
@@ -367,21 +411,23 @@ func TestCategoryService_GetCategory(t *testing.T) {
 
 			categoryRepo := mock.NewMockCategoryRepository(ctrl)
 			cache := mock.NewMockCacheRepository(ctrl)
+			auditService := newAuditStub(ctrl)
 
 			tc.mocks(categoryRepo, cache)
 
-			categoryService := NewCategoryUsecase(categoryRepo, cache)
+			categoryService := NewCategoryUsecase(categoryRepo, cache, auditService)
 
 			category, err := categoryService.GetCategory(ctx, tc.input.id)
-			assert.Equal(t, tc.expected.err, err, "Error mismatch")
+			assert.ErrorIs(t, err, tc.expected.err, "Error mismatch")
 			assert.Equal(t, tc.expected.category, category, "Category mismatch")
 		})
 	}
 }
 
 type listCategoriesTestedInput struct {
-	skip  uint64
-	limit uint64
+	skip            uint64
+	limit           uint64
+	includeArchived bool
 }
 
 type listCategoriesExpectedOutput struct {
@@ -402,8 +448,9 @@ func TestCategoryService_ListCategories(t *testing.T) {
 	ctx := context.Background()
 	skip := gofakeit.Uint64()
 	limit := gofakeit.Uint64()
+	includeArchived := gofakeit.Bool()
 
-	params := util.GenerateCacheKeyParams(skip, limit)
+	params := util.GenerateCacheKeyParams(skip, limit, includeArchived)
 	cacheKey := util.GenerateCacheKey("categories", params)
 	categoriesSerialized, _ := util.Serialize(categories)
 
@@ -428,8 +475,9 @@ func TestCategoryService_ListCategories(t *testing.T) {
 					Return(categoriesSerialized, nil)
 			},
 			input: listCategoriesTestedInput{
-				skip:  skip,
-				limit: limit,
+				skip:            skip,
+				limit:           limit,
+				includeArchived: includeArchived,
 			},
 			expected: listCategoriesExpectedOutput{
 				categories: categories,
@@ -447,7 +495,7 @@ func TestCategoryService_ListCategories(t *testing.T) {
 					Times(1).
 					Return(nil, domain.ErrInternal)
 				categoryRepo.EXPECT().
-					ListCategories(gomock.Any(), gomock.Eq(skip), gomock.Eq(limit)).
+					ListCategories(gomock.Any(), gomock.Eq(skip), gomock.Eq(limit), gomock.Eq(includeArchived)).
 					Times(1).
 					Return(categories, nil)
 				cache.EXPECT().
@@ -456,8 +504,9 @@ func TestCategoryService_ListCategories(t *testing.T) {
 					Return(nil)
 			},
 			input: listCategoriesTestedInput{
-				skip:  skip,
-				limit: limit,
+				skip:            skip,
+				limit:           limit,
+				includeArchived: includeArchived,
 			},
 			expected: listCategoriesExpectedOutput{
 				categories: categories,
@@ -475,13 +524,14 @@ func TestCategoryService_ListCategories(t *testing.T) {
 					Times(1).
 					Return(nil, domain.ErrInternal)
 				categoryRepo.EXPECT().
-					ListCategories(gomock.Any(), gomock.Eq(skip), gomock.Eq(limit)).
+					ListCategories(gomock.Any(), gomock.Eq(skip), gomock.Eq(limit), gomock.Eq(includeArchived)).
 					Times(1).
 					Return(nil, domain.ErrInternal)
 			},
 			input: listCategoriesTestedInput{
-				skip:  skip,
-				limit: limit,
+				skip:            skip,
+				limit:           limit,
+				includeArchived: includeArchived,
 			},
 			expected: listCategoriesExpectedOutput{
 				categories: nil,
@@ -499,7 +549,7 @@ func TestCategoryService_ListCategories(t *testing.T) {
 					Times(1).
 					Return(nil, domain.ErrInternal)
 				categoryRepo.EXPECT().
-					ListCategories(gomock.Any(), gomock.Eq(skip), gomock.Eq(limit)).
+					ListCategories(gomock.Any(), gomock.Eq(skip), gomock.Eq(limit), gomock.Eq(includeArchived)).
 					Times(1).
 					Return(categories, nil)
 				cache.EXPECT().
@@ -508,8 +558,9 @@ func TestCategoryService_ListCategories(t *testing.T) {
 					Return(domain.ErrInternal)
 			},
 			input: listCategoriesTestedInput{
-				skip:  skip,
-				limit: limit,
+				skip:            skip,
+				limit:           limit,
+				includeArchived: includeArchived,
 			},
 			expected: listCategoriesExpectedOutput{
 				categories: nil,
@@ -528,8 +579,9 @@ func TestCategoryService_ListCategories(t *testing.T) {
 					Return([]byte("invalid"), nil)
 			},
 			input: listCategoriesTestedInput{
-				skip:  skip,
-				limit: limit,
+				skip:            skip,
+				limit:           limit,
+				includeArchived: includeArchived,
 			},
 			expected: listCategoriesExpectedOutput{
 				categories: nil,
@@ -547,13 +599,14 @@ func TestCategoryService_ListCategories(t *testing.T) {
 
 			categoryRepo := mock.NewMockCategoryRepository(ctrl)
 			cache := mock.NewMockCacheRepository(ctrl)
+			auditService := newAuditStub(ctrl)
 
 			tc.mocks(categoryRepo, cache)
 
-			categoryService := NewCategoryUsecase(categoryRepo, cache)
+			categoryService := NewCategoryUsecase(categoryRepo, cache, auditService)
 
-			categories, err := categoryService.ListCategories(ctx, tc.input.skip, tc.input.limit)
-			assert.Equal(t, tc.expected.err, err, "Error mismatch")
+			categories, err := categoryService.ListCategories(ctx, tc.input.skip, tc.input.limit, tc.input.includeArchived)
+			assert.ErrorIs(t, err, tc.expected.err, "Error mismatch")
 			assert.Equal(t, tc.expected.categories, categories, "Categories mismatch")
 		})
 	}
@@ -583,6 +636,10 @@ func TestCategoryService_UpdateCategory(t *testing.T) {
 		ID:   categoryID,
 		Name: gofakeit.ProductCategory(),
 	}
+	staleCategory := &domaincategory.Category{
+		ID:      categoryInput.ID,
+		Version: categoryInput.Version + 1,
+	}
 
 	cacheKey := util.GenerateCacheKey("category", categoryOutput.ID)
 	categorySerialized, _ := util.Serialize(categoryOutput)
@@ -651,6 +708,25 @@ func TestCategoryService_UpdateCategory(t *testing.T) {
 				err:      domain.ErrDataNotFound,
 			},
 		},
+		{
+			desc: "Fail_VersionMismatch",
+			mocks: func(
+				categoryRepo *mock.MockCategoryRepository,
+				cache *mock.MockCacheRepository,
+			) {
+				categoryRepo.EXPECT().
+					GetCategoryByID(gomock.Any(), gomock.Eq(categoryInput.ID)).
+					Times(1).
+					Return(staleCategory, nil)
+			},
+			input: updateCategoryTestedInput{
+				category: categoryInput,
+			},
+			expected: updateCategoryExpectedOutput{
+				category: nil,
+				err:      domain.ErrVersionMismatch,
+			},
+		},
 		{
 			desc: "Fail_InternalErrorGetByID",
 			mocks: func(
@@ -733,6 +809,29 @@ func TestCategoryService_UpdateCategory(t *testing.T) {
 				err:      domain.ErrConflictingData,
 			},
 		},
+		{
+			desc: "Fail_ConcurrentUpdate",
+			mocks: func(
+				categoryRepo *mock.MockCategoryRepository,
+				cache *mock.MockCacheRepository,
+			) {
+				categoryRepo.EXPECT().
+					GetCategoryByID(gomock.Any(), gomock.Eq(categoryInput.ID)).
+					Times(1).
+					Return(existingCategory, nil)
+				categoryRepo.EXPECT().
+					UpdateCategory(gomock.Any(), gomock.Eq(categoryInput)).
+					Times(1).
+					Return(nil, domain.ErrVersionMismatch)
+			},
+			input: updateCategoryTestedInput{
+				category: categoryInput,
+			},
+			expected: updateCategoryExpectedOutput{
+				category: nil,
+				err:      domain.ErrVersionMismatch,
+			},
+		},
 		{
 			desc: "Fail_InternalErrorUpdate",
 			mocks: func(
@@ -860,20 +959,22 @@ func TestCategoryService_UpdateCategory(t *testing.T) {
 
 			categoryRepo := mock.NewMockCategoryRepository(ctrl)
 			cache := mock.NewMockCacheRepository(ctrl)
+			auditService := newAuditStub(ctrl)
 
 			tc.mocks(categoryRepo, cache)
 
-			categoryService := NewCategoryUsecase(categoryRepo, cache)
+			categoryService := NewCategoryUsecase(categoryRepo, cache, auditService)
 
 			category, err := categoryService.UpdateCategory(ctx, tc.input.category)
-			assert.Equal(t, tc.expected.err, err, "Error mismatch")
+			assert.ErrorIs(t, err, tc.expected.err, "Error mismatch")
 			assert.Equal(t, tc.expected.category, category, "Category mismatch")
 		})
 	}
 }
 
 type deleteCategoryTestedInput struct {
-	id uint64
+	id      uint64
+	version uint64
 }
 
 type deleteCategoryExpectedOutput struct {
@@ -883,6 +984,7 @@ type deleteCategoryExpectedOutput struct {
 func TestCategoryService_DeleteCategory(t *testing.T) {
 	ctx := context.Background()
 	categoryID := gofakeit.Uint64()
+	version := gofakeit.Uint64()
 
 	cacheKey := util.GenerateCacheKey("category", categoryID)
 
@@ -904,7 +1006,7 @@ func TestCategoryService_DeleteCategory(t *testing.T) {
 				categoryRepo.EXPECT().
 					GetCategoryByID(gomock.Any(), gomock.Eq(categoryID)).
 					Times(1).
-					Return(&domaincategory.Category{}, nil)
+					Return(&domaincategory.Category{Version: version}, nil)
 				cache.EXPECT().
 					Delete(gomock.Any(), gomock.Eq(cacheKey)).
 					Times(1).
@@ -914,12 +1016,13 @@ func TestCategoryService_DeleteCategory(t *testing.T) {
 					Times(1).
 					Return(nil)
 				categoryRepo.EXPECT().
-					DeleteCategory(gomock.Any(), gomock.Eq(categoryID)).
+					DeleteCategory(gomock.Any(), gomock.Eq(categoryID), gomock.Eq(version)).
 					Times(1).
 					Return(nil)
 			},
 			input: deleteCategoryTestedInput{
-				id: categoryID,
+				id:      categoryID,
+				version: version,
 			},
 			expected: deleteCategoryExpectedOutput{
 				err: nil,
@@ -937,7 +1040,8 @@ func TestCategoryService_DeleteCategory(t *testing.T) {
 					Return(nil, domain.ErrDataNotFound)
 			},
 			input: deleteCategoryTestedInput{
-				id: categoryID,
+				id:      categoryID,
+				version: version,
 			},
 			expected: deleteCategoryExpectedOutput{
 				err: domain.ErrDataNotFound,
@@ -955,7 +1059,8 @@ func TestCategoryService_DeleteCategory(t *testing.T) {
 					Return(nil, domain.ErrInternal)
 			},
 			input: deleteCategoryTestedInput{
-				id: categoryID,
+				id:      categoryID,
+				version: version,
 			},
 			expected: deleteCategoryExpectedOutput{
 				err: domain.ErrInternal,
@@ -970,14 +1075,15 @@ func TestCategoryService_DeleteCategory(t *testing.T) {
 				categoryRepo.EXPECT().
 					GetCategoryByID(gomock.Any(), gomock.Eq(categoryID)).
 					Times(1).
-					Return(&domaincategory.Category{}, nil)
+					Return(&domaincategory.Category{Version: version}, nil)
 				cache.EXPECT().
 					Delete(gomock.Any(), gomock.Eq(cacheKey)).
 					Times(1).
 					Return(domain.ErrInternal)
 			},
 			input: deleteCategoryTestedInput{
-				id: categoryID,
+				id:      categoryID,
+				version: version,
 			},
 			expected: deleteCategoryExpectedOutput{
 				err: domain.ErrInternal,
@@ -992,7 +1098,7 @@ func TestCategoryService_DeleteCategory(t *testing.T) {
 				categoryRepo.EXPECT().
 					GetCategoryByID(gomock.Any(), gomock.Eq(categoryID)).
 					Times(1).
-					Return(&domaincategory.Category{}, nil)
+					Return(&domaincategory.Category{Version: version}, nil)
 				cache.EXPECT().
 					Delete(gomock.Any(), gomock.Eq(cacheKey)).
 					Times(1).
@@ -1003,7 +1109,8 @@ func TestCategoryService_DeleteCategory(t *testing.T) {
 					Return(domain.ErrInternal)
 			},
 			input: deleteCategoryTestedInput{
-				id: categoryID,
+				id:      categoryID,
+				version: version,
 			},
 			expected: deleteCategoryExpectedOutput{
 				err: domain.ErrInternal,
@@ -1018,7 +1125,7 @@ func TestCategoryService_DeleteCategory(t *testing.T) {
 				categoryRepo.EXPECT().
 					GetCategoryByID(gomock.Any(), gomock.Eq(categoryID)).
 					Times(1).
-					Return(&domaincategory.Category{}, nil)
+					Return(&domaincategory.Category{Version: version}, nil)
 				cache.EXPECT().
 					Delete(gomock.Any(), gomock.Eq(cacheKey)).
 					Times(1).
@@ -1028,17 +1135,236 @@ func TestCategoryService_DeleteCategory(t *testing.T) {
 					Times(1).
 					Return(nil)
 				categoryRepo.EXPECT().
-					DeleteCategory(gomock.Any(), gomock.Eq(categoryID)).
+					DeleteCategory(gomock.Any(), gomock.Eq(categoryID), gomock.Eq(version)).
 					Times(1).
 					Return(domain.ErrInternal)
 			},
 			input: deleteCategoryTestedInput{
-				id: categoryID,
+				id:      categoryID,
+				version: version,
 			},
 			expected: deleteCategoryExpectedOutput{
 				err: domain.ErrInternal,
 			},
 		},
+		{
+			desc: "Fail_Archived",
+			mocks: func(
+				categoryRepo *mock.MockCategoryRepository,
+				cache *mock.MockCacheRepository,
+			) {
+				deletedAt := gofakeit.Date()
+				categoryRepo.EXPECT().
+					GetCategoryByID(gomock.Any(), gomock.Eq(categoryID)).
+					Times(1).
+					Return(&domaincategory.Category{DeletedAt: &deletedAt}, nil)
+			},
+			input: deleteCategoryTestedInput{
+				id:      categoryID,
+				version: version,
+			},
+			expected: deleteCategoryExpectedOutput{
+				err: domain.ErrDataArchived,
+			},
+		},
+		{
+			desc: "Fail_VersionMismatch",
+			mocks: func(
+				categoryRepo *mock.MockCategoryRepository,
+				cache *mock.MockCacheRepository,
+			) {
+				categoryRepo.EXPECT().
+					GetCategoryByID(gomock.Any(), gomock.Eq(categoryID)).
+					Times(1).
+					Return(&domaincategory.Category{Version: version + 1}, nil)
+			},
+			input: deleteCategoryTestedInput{
+				id:      categoryID,
+				version: version,
+			},
+			expected: deleteCategoryExpectedOutput{
+				err: domain.ErrVersionMismatch,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.desc, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			categoryRepo := mock.NewMockCategoryRepository(ctrl)
+			cache := mock.NewMockCacheRepository(ctrl)
+			auditService := newAuditStub(ctrl)
+
+			tc.mocks(categoryRepo, cache)
+
+			categoryService := NewCategoryUsecase(categoryRepo, cache, auditService)
+
+			err := categoryService.DeleteCategory(ctx, tc.input.id, tc.input.version)
+			assert.ErrorIs(t, err, tc.expected.err, "Error mismatch")
+		})
+	}
+}
+
+type restoreCategoryTestedInput struct {
+	id uint64
+}
+
+type restoreCategoryExpectedOutput struct {
+	category *domaincategory.Category
+	err      error
+}
+
+func TestCategoryService_RestoreCategory(t *testing.T) {
+	ctx := context.Background()
+	categoryID := gofakeit.Uint64()
+	categoryName := gofakeit.ProductCategory()
+	deletedAt := gofakeit.Date()
+	archivedCategory := &domaincategory.Category{
+		ID:        categoryID,
+		Name:      categoryName,
+		DeletedAt: &deletedAt,
+	}
+	restoredCategory := &domaincategory.Category{
+		ID:   categoryID,
+		Name: categoryName,
+	}
+
+	cacheKey := util.GenerateCacheKey("category", categoryID)
+	categorySerialized, _ := util.Serialize(restoredCategory)
+	ttl := time.Duration(0)
+
+	testCases := []struct {
+		desc  string
+		mocks func(
+			categoryRepo *mock.MockCategoryRepository,
+			cache *mock.MockCacheRepository,
+		)
+		input    restoreCategoryTestedInput
+		expected restoreCategoryExpectedOutput
+	}{
+		{
+			desc: "Success",
+			mocks: func(
+				categoryRepo *mock.MockCategoryRepository,
+				cache *mock.MockCacheRepository,
+			) {
+				categoryRepo.EXPECT().
+					GetCategoryByID(gomock.Any(), gomock.Eq(categoryID)).
+					Times(1).
+					Return(archivedCategory, nil)
+				categoryRepo.EXPECT().
+					RestoreCategory(gomock.Any(), gomock.Eq(categoryID)).
+					Times(1).
+					Return(restoredCategory, nil)
+				cache.EXPECT().
+					Set(gomock.Any(), gomock.Eq(cacheKey), gomock.Eq(categorySerialized), gomock.Eq(ttl)).
+					Times(1).
+					Return(nil)
+				cache.EXPECT().
+					DeleteByPrefix(gomock.Any(), gomock.Eq("categories:*")).
+					Times(1).
+					Return(nil)
+			},
+			input: restoreCategoryTestedInput{
+				id: categoryID,
+			},
+			expected: restoreCategoryExpectedOutput{
+				category: restoredCategory,
+				err:      nil,
+			},
+		},
+		{
+			desc: "Fail_NotFound",
+			mocks: func(
+				categoryRepo *mock.MockCategoryRepository,
+				cache *mock.MockCacheRepository,
+			) {
+				categoryRepo.EXPECT().
+					GetCategoryByID(gomock.Any(), gomock.Eq(categoryID)).
+					Times(1).
+					Return(nil, domain.ErrDataNotFound)
+			},
+			input: restoreCategoryTestedInput{
+				id: categoryID,
+			},
+			expected: restoreCategoryExpectedOutput{
+				category: nil,
+				err:      domain.ErrDataNotFound,
+			},
+		},
+		{
+			desc: "Fail_NotArchived",
+			mocks: func(
+				categoryRepo *mock.MockCategoryRepository,
+				cache *mock.MockCacheRepository,
+			) {
+				categoryRepo.EXPECT().
+					GetCategoryByID(gomock.Any(), gomock.Eq(categoryID)).
+					Times(1).
+					Return(restoredCategory, nil)
+			},
+			input: restoreCategoryTestedInput{
+				id: categoryID,
+			},
+			expected: restoreCategoryExpectedOutput{
+				category: nil,
+				err:      domain.ErrDataNotArchived,
+			},
+		},
+		{
+			desc: "Fail_InternalErrorRestore",
+			mocks: func(
+				categoryRepo *mock.MockCategoryRepository,
+				cache *mock.MockCacheRepository,
+			) {
+				categoryRepo.EXPECT().
+					GetCategoryByID(gomock.Any(), gomock.Eq(categoryID)).
+					Times(1).
+					Return(archivedCategory, nil)
+				categoryRepo.EXPECT().
+					RestoreCategory(gomock.Any(), gomock.Eq(categoryID)).
+					Times(1).
+					Return(nil, domain.ErrInternal)
+			},
+			input: restoreCategoryTestedInput{
+				id: categoryID,
+			},
+			expected: restoreCategoryExpectedOutput{
+				category: nil,
+				err:      domain.ErrInternal,
+			},
+		},
+		{
+			desc: "Fail_SetCache",
+			mocks: func(
+				categoryRepo *mock.MockCategoryRepository,
+				cache *mock.MockCacheRepository,
+			) {
+				categoryRepo.EXPECT().
+					GetCategoryByID(gomock.Any(), gomock.Eq(categoryID)).
+					Times(1).
+					Return(archivedCategory, nil)
+				categoryRepo.EXPECT().
+					RestoreCategory(gomock.Any(), gomock.Eq(categoryID)).
+					Times(1).
+					Return(restoredCategory, nil)
+				cache.EXPECT().
+					Set(gomock.Any(), gomock.Eq(cacheKey), gomock.Eq(categorySerialized), gomock.Eq(ttl)).
+					Times(1).
+					Return(domain.ErrInternal)
+			},
+			input: restoreCategoryTestedInput{
+				id: categoryID,
+			},
+			expected: restoreCategoryExpectedOutput{
+				category: nil,
+				err:      domain.ErrInternal,
+			},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -1050,13 +1376,15 @@ func TestCategoryService_DeleteCategory(t *testing.T) {
 
 			categoryRepo := mock.NewMockCategoryRepository(ctrl)
 			cache := mock.NewMockCacheRepository(ctrl)
+			auditService := newAuditStub(ctrl)
 
 			tc.mocks(categoryRepo, cache)
 
-			categoryService := NewCategoryUsecase(categoryRepo, cache)
+			categoryService := NewCategoryUsecase(categoryRepo, cache, auditService)
 
-			err := categoryService.DeleteCategory(ctx, tc.input.id)
-			assert.Equal(t, tc.expected.err, err, "Error mismatch")
+			category, err := categoryService.RestoreCategory(ctx, tc.input.id)
+			assert.ErrorIs(t, err, tc.expected.err, "Error mismatch")
+			assert.Equal(t, tc.expected.category, category, "Category mismatch")
 		})
 	}
 }
@@ -0,0 +1,311 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+
+	"github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain"
+	domainaudit "github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain/audit"
+	domaincustomer "github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain/customer"
+	"github.com/TienMinh25/go-hexagonal-architecture/internal/application/port"
+	"github.com/TienMinh25/go-hexagonal-architecture/internal/application/util"
+)
+
+/**
+ * customerUsecase implements port.CustomerService interface
+ * and provides an access to the customer repository,
+ * cache service and audit service
+ */
+type customerUsecase struct {
+	repo  port.CustomerRepository
+	cache port.CacheRepository
+	audit port.AuditService
+}
+
+// NewCustomerUsecase creates a new customer service instance
+func NewCustomerUsecase(repo port.CustomerRepository, cache port.CacheRepository, audit port.AuditService) port.CustomerService {
+	return &customerUsecase{
+		repo,
+		cache,
+		audit,
+	}
+}
+
+// CreateCustomer creates a new customer
+func (cs *customerUsecase) CreateCustomer(ctx context.Context, customer *domaincustomer.Customer) (*domaincustomer.Customer, error) {
+	customer, err := cs.repo.CreateCustomer(ctx, customer)
+	if err != nil {
+		if errors.Is(err, domain.ErrConflictingData) {
+			return nil, err
+		}
+		return nil, domain.ErrInternal.Wrap(err)
+	}
+
+	cacheKey := util.GenerateCacheKey("customer", customer.ID)
+	customerSerialized, err := util.Serialize(customer)
+	if err != nil {
+		return nil, domain.ErrInternal.Wrap(err)
+	}
+
+	err = cs.cache.Set(ctx, cacheKey, customerSerialized, 0)
+	if err != nil {
+		return nil, domain.ErrInternal.Wrap(err)
+	}
+
+	err = cs.cache.DeleteByPrefix(ctx, "customers:*")
+	if err != nil {
+		return nil, domain.ErrInternal.Wrap(err)
+	}
+
+	err = cs.audit.Record(ctx, domainaudit.Create, domainaudit.Customer, customer.ID, nil, customer)
+	if err != nil {
+		return nil, err
+	}
+
+	return customer, nil
+}
+
+// GetCustomer retrieves a customer by id
+func (cs *customerUsecase) GetCustomer(ctx context.Context, id uint64) (*domaincustomer.Customer, error) {
+	var customer *domaincustomer.Customer
+
+	cacheKey := util.GenerateCacheKey("customer", id)
+	cachedCustomer, err := cs.cache.Get(ctx, cacheKey)
+	if err == nil {
+		err := util.Deserialize(cachedCustomer, &customer)
+		if err != nil {
+			return nil, domain.ErrInternal.Wrap(err)
+		}
+		return customer, nil
+	}
+
+	customer, err = cs.repo.GetCustomerByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, domain.ErrDataNotFound) {
+			return nil, err
+		}
+		return nil, domain.ErrInternal.Wrap(err)
+	}
+
+	customerSerialized, err := util.Serialize(customer)
+	if err != nil {
+		return nil, domain.ErrInternal.Wrap(err)
+	}
+
+	err = cs.cache.Set(ctx, cacheKey, customerSerialized, 0)
+	if err != nil {
+		return nil, domain.ErrInternal.Wrap(err)
+	}
+
+	return customer, nil
+}
+
+// ListCustomers retrieves a list of customers
+func (cs *customerUsecase) ListCustomers(ctx context.Context, search string, skip, limit uint64, includeArchived bool) ([]domaincustomer.Customer, error) {
+	var customers []domaincustomer.Customer
+
+	params := util.GenerateCacheKeyParams(skip, limit, search, includeArchived)
+	cacheKey := util.GenerateCacheKey("customers", params)
+
+	cachedCustomers, err := cs.cache.Get(ctx, cacheKey)
+	if err == nil {
+		err := util.Deserialize(cachedCustomers, &customers)
+		if err != nil {
+			return nil, domain.ErrInternal.Wrap(err)
+		}
+		return customers, nil
+	}
+
+	customers, err = cs.repo.ListCustomers(ctx, search, skip, limit, includeArchived)
+	if err != nil {
+		return nil, domain.ErrInternal.Wrap(err)
+	}
+
+	customersSerialized, err := util.Serialize(customers)
+	if err != nil {
+		return nil, domain.ErrInternal.Wrap(err)
+	}
+
+	err = cs.cache.Set(ctx, cacheKey, customersSerialized, 0)
+	if err != nil {
+		return nil, domain.ErrInternal.Wrap(err)
+	}
+
+	return customers, nil
+}
+
+// UpdateCustomer updates a customer's name, phone, email, or notes
+func (cs *customerUsecase) UpdateCustomer(ctx context.Context, customer *domaincustomer.Customer) (*domaincustomer.Customer, error) {
+	existingCustomer, err := cs.repo.GetCustomerByID(ctx, customer.ID)
+	if err != nil {
+		if errors.Is(err, domain.ErrDataNotFound) {
+			return nil, err
+		}
+		return nil, domain.ErrInternal.Wrap(err)
+	}
+
+	if existingCustomer.DeletedAt != nil {
+		return nil, domain.ErrDataArchived
+	}
+
+	if existingCustomer.Version != customer.Version {
+		return nil, domain.ErrVersionMismatch
+	}
+
+	emptyData := customer.Name == "" &&
+		customer.Phone == "" &&
+		customer.Email == "" &&
+		customer.Notes == ""
+	sameData := existingCustomer.Name == customer.Name &&
+		existingCustomer.Phone == customer.Phone &&
+		existingCustomer.Email == customer.Email &&
+		existingCustomer.Notes == customer.Notes
+	if emptyData || sameData {
+		return nil, domain.ErrNoUpdatedData
+	}
+
+	updatedCustomer, err := cs.repo.UpdateCustomer(ctx, customer)
+	if err != nil {
+		if errors.Is(err, domain.ErrConflictingData) || errors.Is(err, domain.ErrVersionMismatch) {
+			return nil, err
+		}
+		return nil, domain.ErrInternal.Wrap(err)
+	}
+
+	cacheKey := util.GenerateCacheKey("customer", customer.ID)
+
+	err = cs.cache.Delete(ctx, cacheKey)
+	if err != nil {
+		return nil, domain.ErrInternal.Wrap(err)
+	}
+
+	customerSerialized, err := util.Serialize(customer)
+	if err != nil {
+		return nil, domain.ErrInternal.Wrap(err)
+	}
+
+	err = cs.cache.Set(ctx, cacheKey, customerSerialized, 0)
+	if err != nil {
+		return nil, domain.ErrInternal.Wrap(err)
+	}
+
+	err = cs.cache.DeleteByPrefix(ctx, "customers:*")
+	if err != nil {
+		return nil, domain.ErrInternal.Wrap(err)
+	}
+
+	err = cs.audit.Record(ctx, domainaudit.Update, domainaudit.Customer, customer.ID, existingCustomer, updatedCustomer)
+	if err != nil {
+		return nil, err
+	}
+
+	return customer, nil
+}
+
+// DeleteCustomer archives a customer
+func (cs *customerUsecase) DeleteCustomer(ctx context.Context, id, version uint64) error {
+	customer, err := cs.repo.GetCustomerByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, domain.ErrDataNotFound) {
+			return err
+		}
+		return domain.ErrInternal.Wrap(err)
+	}
+
+	if customer.DeletedAt != nil {
+		return domain.ErrDataArchived
+	}
+
+	if customer.Version != version {
+		return domain.ErrVersionMismatch
+	}
+
+	cacheKey := util.GenerateCacheKey("customer", id)
+
+	err = cs.cache.Delete(ctx, cacheKey)
+	if err != nil {
+		return domain.ErrInternal.Wrap(err)
+	}
+
+	err = cs.cache.DeleteByPrefix(ctx, "customers:*")
+	if err != nil {
+		return domain.ErrInternal.Wrap(err)
+	}
+
+	err = cs.repo.DeleteCustomer(ctx, id, version)
+	if err != nil {
+		return err
+	}
+
+	err = cs.audit.Record(ctx, domainaudit.Delete, domainaudit.Customer, id, customer, nil)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// RestoreCustomer restores an archived customer
+func (cs *customerUsecase) RestoreCustomer(ctx context.Context, id uint64) (*domaincustomer.Customer, error) {
+	existingCustomer, err := cs.repo.GetCustomerByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, domain.ErrDataNotFound) {
+			return nil, err
+		}
+		return nil, domain.ErrInternal.Wrap(err)
+	}
+
+	if existingCustomer.DeletedAt == nil {
+		return nil, domain.ErrDataNotArchived
+	}
+
+	customer, err := cs.repo.RestoreCustomer(ctx, id)
+	if err != nil {
+		if errors.Is(err, domain.ErrDataNotFound) {
+			return nil, err
+		}
+		return nil, domain.ErrInternal.Wrap(err)
+	}
+
+	cacheKey := util.GenerateCacheKey("customer", customer.ID)
+	customerSerialized, err := util.Serialize(customer)
+	if err != nil {
+		return nil, domain.ErrInternal.Wrap(err)
+	}
+
+	err = cs.cache.Set(ctx, cacheKey, customerSerialized, 0)
+	if err != nil {
+		return nil, domain.ErrInternal.Wrap(err)
+	}
+
+	err = cs.cache.DeleteByPrefix(ctx, "customers:*")
+	if err != nil {
+		return nil, domain.ErrInternal.Wrap(err)
+	}
+
+	err = cs.audit.Record(ctx, domainaudit.Restore, domainaudit.Customer, id, existingCustomer, customer)
+	if err != nil {
+		return nil, err
+	}
+
+	return customer, nil
+}
+
+// GetPurchaseSummary retrieves the lifetime spend and visit count of a customer,
+// archived customers included so their history stays available
+func (cs *customerUsecase) GetPurchaseSummary(ctx context.Context, id uint64) (*domaincustomer.PurchaseSummary, error) {
+	_, err := cs.repo.GetCustomerByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, domain.ErrDataNotFound) {
+			return nil, err
+		}
+		return nil, domain.ErrInternal.Wrap(err)
+	}
+
+	summary, err := cs.repo.GetPurchaseSummary(ctx, id)
+	if err != nil {
+		return nil, domain.ErrInternal.Wrap(err)
+	}
+
+	return summary, nil
+}
@@ -0,0 +1,1018 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain"
+	domaincustomer "github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain/customer"
+	"github.com/TienMinh25/go-hexagonal-architecture/internal/application/mock"
+	"github.com/TienMinh25/go-hexagonal-architecture/internal/application/util"
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+type createCustomerTestedInput struct {
+	customer *domaincustomer.Customer
+}
+
+type createCustomerExpectedOutput struct {
+	customer *domaincustomer.Customer
+	err      error
+}
+
+func TestCustomerService_CreateCustomer(t *testing.T) {
+	ctx := context.Background()
+	customerID := gofakeit.Uint64()
+	customerName := gofakeit.Name()
+	customerPhone := gofakeit.Phone()
+	customerEmail := gofakeit.Email()
+
+	customerInput := &domaincustomer.Customer{
+		Name:  customerName,
+		Phone: customerPhone,
+		Email: customerEmail,
+	}
+	customerOutput := &domaincustomer.Customer{
+		ID:        customerID,
+		Name:      customerName,
+		Phone:     customerPhone,
+		Email:     customerEmail,
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+		Version:   1,
+	}
+
+	cacheKey := util.GenerateCacheKey("customer", customerOutput.ID)
+	customerSerialized, _ := util.Serialize(customerOutput)
+	ttl := time.Duration(0)
+
+	testCases := []struct {
+		desc  string
+		mocks func(
+			customerRepo *mock.MockCustomerRepository,
+			cache *mock.MockCacheRepository,
+		)
+		input    createCustomerTestedInput
+		expected createCustomerExpectedOutput
+	}{
+		{
+			desc: "Success",
+			mocks: func(
+				customerRepo *mock.MockCustomerRepository,
+				cache *mock.MockCacheRepository,
+			) {
+				customerRepo.EXPECT().
+					CreateCustomer(gomock.Any(), gomock.Eq(customerInput)).
+					Return(customerOutput, nil)
+				cache.EXPECT().
+					Set(gomock.Any(), gomock.Eq(cacheKey), gomock.Eq(customerSerialized), gomock.Eq(ttl)).
+					Return(nil)
+				cache.EXPECT().
+					DeleteByPrefix(gomock.Any(), gomock.Eq("customers:*")).
+					Return(nil)
+			},
+			input: createCustomerTestedInput{
+				customer: customerInput,
+			},
+			expected: createCustomerExpectedOutput{
+				customer: customerOutput,
+				err:      nil,
+			},
+		},
+		{
+			desc: "Fail_DuplicateData",
+			mocks: func(
+				customerRepo *mock.MockCustomerRepository,
+				cache *mock.MockCacheRepository,
+			) {
+				customerRepo.EXPECT().
+					CreateCustomer(gomock.Any(), gomock.Eq(customerInput)).
+					Return(nil, domain.ErrConflictingData)
+			},
+			input: createCustomerTestedInput{
+				customer: customerInput,
+			},
+			expected: createCustomerExpectedOutput{
+				customer: nil,
+				err:      domain.ErrConflictingData,
+			},
+		},
+		{
+			desc: "Fail_InternalError",
+			mocks: func(
+				customerRepo *mock.MockCustomerRepository,
+				cache *mock.MockCacheRepository,
+			) {
+				customerRepo.EXPECT().
+					CreateCustomer(gomock.Any(), gomock.Eq(customerInput)).
+					Return(nil, domain.ErrInternal)
+			},
+			input: createCustomerTestedInput{
+				customer: customerInput,
+			},
+			expected: createCustomerExpectedOutput{
+				customer: nil,
+				err:      domain.ErrInternal,
+			},
+		},
+		{
+			desc: "Fail_SetCache",
+			mocks: func(
+				customerRepo *mock.MockCustomerRepository,
+				cache *mock.MockCacheRepository,
+			) {
+				customerRepo.EXPECT().
+					CreateCustomer(gomock.Any(), gomock.Eq(customerInput)).
+					Return(customerOutput, nil)
+				cache.EXPECT().
+					Set(gomock.Any(), gomock.Eq(cacheKey), gomock.Eq(customerSerialized), gomock.Eq(ttl)).
+					Return(domain.ErrInternal)
+			},
+			input: createCustomerTestedInput{
+				customer: customerInput,
+			},
+			expected: createCustomerExpectedOutput{
+				customer: nil,
+				err:      domain.ErrInternal,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.desc, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			customerRepo := mock.NewMockCustomerRepository(ctrl)
+			cache := mock.NewMockCacheRepository(ctrl)
+			auditService := newAuditStub(ctrl)
+
+			tc.mocks(customerRepo, cache)
+
+			customerService := NewCustomerUsecase(customerRepo, cache, auditService)
+
+			customer, err := customerService.CreateCustomer(ctx, tc.input.customer)
+			assert.ErrorIs(t, err, tc.expected.err, "Error mismatch")
+			assert.Equal(t, tc.expected.customer, customer, "Customer mismatch")
+		})
+	}
+}
+
+type getCustomerTestedInput struct {
+	id uint64
+}
+
+type getCustomerExpectedOutput struct {
+	customer *domaincustomer.Customer
+	err      error
+}
+
+func TestCustomerService_GetCustomer(t *testing.T) {
+	ctx := context.Background()
+	customerID := gofakeit.Uint64()
+
+	customerOutput := &domaincustomer.Customer{
+		ID:    customerID,
+		Name:  gofakeit.Name(),
+		Phone: gofakeit.Phone(),
+		Email: gofakeit.Email(),
+	}
+
+	cacheKey := util.GenerateCacheKey("customer", customerOutput.ID)
+	customerSerialized, _ := util.Serialize(customerOutput)
+	ttl := time.Duration(0)
+
+	testCases := []struct {
+		desc  string
+		mocks func(
+			customerRepo *mock.MockCustomerRepository,
+			cache *mock.MockCacheRepository,
+		)
+		input    getCustomerTestedInput
+		expected getCustomerExpectedOutput
+	}{
+		{
+			desc: "Success_FromCache",
+			mocks: func(
+				customerRepo *mock.MockCustomerRepository,
+				cache *mock.MockCacheRepository,
+			) {
+				cache.EXPECT().
+					Get(gomock.Any(), gomock.Eq(cacheKey)).
+					Return(customerSerialized, nil)
+			},
+			input: getCustomerTestedInput{
+				id: customerID,
+			},
+			expected: getCustomerExpectedOutput{
+				customer: customerOutput,
+				err:      nil,
+			},
+		},
+		{
+			desc: "Success_FromDB",
+			mocks: func(
+				customerRepo *mock.MockCustomerRepository,
+				cache *mock.MockCacheRepository,
+			) {
+				cache.EXPECT().
+					Get(gomock.Any(), gomock.Eq(cacheKey)).
+					Return(nil, domain.ErrDataNotFound)
+				customerRepo.EXPECT().
+					GetCustomerByID(gomock.Any(), gomock.Eq(customerID)).
+					Return(customerOutput, nil)
+				cache.EXPECT().
+					Set(gomock.Any(), gomock.Eq(cacheKey), gomock.Eq(customerSerialized), gomock.Eq(ttl)).
+					Return(nil)
+			},
+			input: getCustomerTestedInput{
+				id: customerID,
+			},
+			expected: getCustomerExpectedOutput{
+				customer: customerOutput,
+				err:      nil,
+			},
+		},
+		{
+			desc: "Fail_NotFound",
+			mocks: func(
+				customerRepo *mock.MockCustomerRepository,
+				cache *mock.MockCacheRepository,
+			) {
+				cache.EXPECT().
+					Get(gomock.Any(), gomock.Eq(cacheKey)).
+					Return(nil, domain.ErrDataNotFound)
+				customerRepo.EXPECT().
+					GetCustomerByID(gomock.Any(), gomock.Eq(customerID)).
+					Return(nil, domain.ErrDataNotFound)
+			},
+			input: getCustomerTestedInput{
+				id: customerID,
+			},
+			expected: getCustomerExpectedOutput{
+				customer: nil,
+				err:      domain.ErrDataNotFound,
+			},
+		},
+		{
+			desc: "Fail_Deserialize",
+			mocks: func(
+				customerRepo *mock.MockCustomerRepository,
+				cache *mock.MockCacheRepository,
+			) {
+				cache.EXPECT().
+					Get(gomock.Any(), gomock.Eq(cacheKey)).
+					Return([]byte("invalid"), nil)
+			},
+			input: getCustomerTestedInput{
+				id: customerID,
+			},
+			expected: getCustomerExpectedOutput{
+				customer: nil,
+				err:      domain.ErrInternal,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.desc, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			customerRepo := mock.NewMockCustomerRepository(ctrl)
+			cache := mock.NewMockCacheRepository(ctrl)
+			auditService := newAuditStub(ctrl)
+
+			tc.mocks(customerRepo, cache)
+
+			customerService := NewCustomerUsecase(customerRepo, cache, auditService)
+
+			customer, err := customerService.GetCustomer(ctx, tc.input.id)
+			assert.ErrorIs(t, err, tc.expected.err, "Error mismatch")
+			assert.Equal(t, tc.expected.customer, customer, "Customer mismatch")
+		})
+	}
+}
+
+type listCustomersTestedInput struct {
+	search          string
+	skip            uint64
+	limit           uint64
+	includeArchived bool
+}
+
+type listCustomersExpectedOutput struct {
+	customers []domaincustomer.Customer
+	err       error
+}
+
+func TestCustomerService_ListCustomers(t *testing.T) {
+	var customers []domaincustomer.Customer
+
+	for i := 0; i < 10; i++ {
+		customers = append(customers, domaincustomer.Customer{
+			ID:    gofakeit.Uint64(),
+			Name:  gofakeit.Name(),
+			Phone: gofakeit.Phone(),
+			Email: gofakeit.Email(),
+		})
+	}
+
+	ctx := context.Background()
+	search := gofakeit.FirstName()
+	skip := gofakeit.Uint64()
+	limit := gofakeit.Uint64()
+	includeArchived := gofakeit.Bool()
+
+	params := util.GenerateCacheKeyParams(skip, limit, search, includeArchived)
+	cacheKey := util.GenerateCacheKey("customers", params)
+	customersSerialized, _ := util.Serialize(customers)
+	ttl := time.Duration(0)
+
+	testCases := []struct {
+		desc  string
+		mocks func(
+			customerRepo *mock.MockCustomerRepository,
+			cache *mock.MockCacheRepository,
+		)
+		input    listCustomersTestedInput
+		expected listCustomersExpectedOutput
+	}{
+		{
+			desc: "Success_FromCache",
+			mocks: func(
+				customerRepo *mock.MockCustomerRepository,
+				cache *mock.MockCacheRepository,
+			) {
+				cache.EXPECT().
+					Get(gomock.Any(), gomock.Eq(cacheKey)).
+					Return(customersSerialized, nil)
+			},
+			input: listCustomersTestedInput{
+				search:          search,
+				skip:            skip,
+				limit:           limit,
+				includeArchived: includeArchived,
+			},
+			expected: listCustomersExpectedOutput{
+				customers: customers,
+				err:       nil,
+			},
+		},
+		{
+			desc: "Success_FromDB",
+			mocks: func(
+				customerRepo *mock.MockCustomerRepository,
+				cache *mock.MockCacheRepository,
+			) {
+				cache.EXPECT().
+					Get(gomock.Any(), gomock.Eq(cacheKey)).
+					Return(nil, domain.ErrDataNotFound)
+				customerRepo.EXPECT().
+					ListCustomers(gomock.Any(), gomock.Eq(search), gomock.Eq(skip), gomock.Eq(limit), gomock.Eq(includeArchived)).
+					Return(customers, nil)
+				cache.EXPECT().
+					Set(gomock.Any(), gomock.Eq(cacheKey), gomock.Eq(customersSerialized), gomock.Eq(ttl)).
+					Return(nil)
+			},
+			input: listCustomersTestedInput{
+				search:          search,
+				skip:            skip,
+				limit:           limit,
+				includeArchived: includeArchived,
+			},
+			expected: listCustomersExpectedOutput{
+				customers: customers,
+				err:       nil,
+			},
+		},
+		{
+			desc: "Fail_InternalError",
+			mocks: func(
+				customerRepo *mock.MockCustomerRepository,
+				cache *mock.MockCacheRepository,
+			) {
+				cache.EXPECT().
+					Get(gomock.Any(), gomock.Eq(cacheKey)).
+					Return(nil, domain.ErrDataNotFound)
+				customerRepo.EXPECT().
+					ListCustomers(gomock.Any(), gomock.Eq(search), gomock.Eq(skip), gomock.Eq(limit), gomock.Eq(includeArchived)).
+					Return(nil, domain.ErrInternal)
+			},
+			input: listCustomersTestedInput{
+				search:          search,
+				skip:            skip,
+				limit:           limit,
+				includeArchived: includeArchived,
+			},
+			expected: listCustomersExpectedOutput{
+				customers: nil,
+				err:       domain.ErrInternal,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.desc, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			customerRepo := mock.NewMockCustomerRepository(ctrl)
+			cache := mock.NewMockCacheRepository(ctrl)
+			auditService := newAuditStub(ctrl)
+
+			tc.mocks(customerRepo, cache)
+
+			customerService := NewCustomerUsecase(customerRepo, cache, auditService)
+
+			customers, err := customerService.ListCustomers(ctx, tc.input.search, tc.input.skip, tc.input.limit, tc.input.includeArchived)
+			assert.ErrorIs(t, err, tc.expected.err, "Error mismatch")
+			assert.Equal(t, tc.expected.customers, customers, "Customers mismatch")
+		})
+	}
+}
+
+type updateCustomerTestedInput struct {
+	customer *domaincustomer.Customer
+}
+
+type updateCustomerExpectedOutput struct {
+	customer *domaincustomer.Customer
+	err      error
+}
+
+func TestCustomerService_UpdateCustomer(t *testing.T) {
+	ctx := context.Background()
+	customerID := gofakeit.Uint64()
+	version := gofakeit.Uint64()
+	deletedAt := gofakeit.Date()
+
+	customerInput := &domaincustomer.Customer{
+		ID:      customerID,
+		Name:    gofakeit.Name(),
+		Phone:   gofakeit.Phone(),
+		Version: version,
+	}
+	existingCustomer := &domaincustomer.Customer{
+		ID:      customerID,
+		Name:    gofakeit.Name(),
+		Phone:   gofakeit.Phone(),
+		Version: version,
+	}
+	staleCustomer := &domaincustomer.Customer{
+		ID:      customerID,
+		Version: version + 1,
+	}
+	archivedCustomer := &domaincustomer.Customer{
+		ID:        customerID,
+		DeletedAt: &deletedAt,
+		Version:   version,
+	}
+	sameCustomer := &domaincustomer.Customer{
+		ID:      customerID,
+		Name:    customerInput.Name,
+		Phone:   customerInput.Phone,
+		Version: version,
+	}
+
+	cacheKey := util.GenerateCacheKey("customer", customerID)
+	customerSerialized, _ := util.Serialize(customerInput)
+	ttl := time.Duration(0)
+
+	testCases := []struct {
+		desc  string
+		mocks func(
+			customerRepo *mock.MockCustomerRepository,
+			cache *mock.MockCacheRepository,
+		)
+		input    updateCustomerTestedInput
+		expected updateCustomerExpectedOutput
+	}{
+		{
+			desc: "Success",
+			mocks: func(
+				customerRepo *mock.MockCustomerRepository,
+				cache *mock.MockCacheRepository,
+			) {
+				customerRepo.EXPECT().
+					GetCustomerByID(gomock.Any(), gomock.Eq(customerID)).
+					Return(existingCustomer, nil)
+				customerRepo.EXPECT().
+					UpdateCustomer(gomock.Any(), gomock.Eq(customerInput)).
+					Return(customerInput, nil)
+				cache.EXPECT().
+					Delete(gomock.Any(), gomock.Eq(cacheKey)).
+					Return(nil)
+				cache.EXPECT().
+					Set(gomock.Any(), gomock.Eq(cacheKey), gomock.Eq(customerSerialized), gomock.Eq(ttl)).
+					Return(nil)
+				cache.EXPECT().
+					DeleteByPrefix(gomock.Any(), gomock.Eq("customers:*")).
+					Return(nil)
+			},
+			input: updateCustomerTestedInput{
+				customer: customerInput,
+			},
+			expected: updateCustomerExpectedOutput{
+				customer: customerInput,
+				err:      nil,
+			},
+		},
+		{
+			desc: "Fail_NotFound",
+			mocks: func(
+				customerRepo *mock.MockCustomerRepository,
+				cache *mock.MockCacheRepository,
+			) {
+				customerRepo.EXPECT().
+					GetCustomerByID(gomock.Any(), gomock.Eq(customerID)).
+					Return(nil, domain.ErrDataNotFound)
+			},
+			input: updateCustomerTestedInput{
+				customer: customerInput,
+			},
+			expected: updateCustomerExpectedOutput{
+				customer: nil,
+				err:      domain.ErrDataNotFound,
+			},
+		},
+		{
+			desc: "Fail_Archived",
+			mocks: func(
+				customerRepo *mock.MockCustomerRepository,
+				cache *mock.MockCacheRepository,
+			) {
+				customerRepo.EXPECT().
+					GetCustomerByID(gomock.Any(), gomock.Eq(customerID)).
+					Return(archivedCustomer, nil)
+			},
+			input: updateCustomerTestedInput{
+				customer: customerInput,
+			},
+			expected: updateCustomerExpectedOutput{
+				customer: nil,
+				err:      domain.ErrDataArchived,
+			},
+		},
+		{
+			desc: "Fail_VersionMismatch",
+			mocks: func(
+				customerRepo *mock.MockCustomerRepository,
+				cache *mock.MockCacheRepository,
+			) {
+				customerRepo.EXPECT().
+					GetCustomerByID(gomock.Any(), gomock.Eq(customerID)).
+					Return(staleCustomer, nil)
+			},
+			input: updateCustomerTestedInput{
+				customer: customerInput,
+			},
+			expected: updateCustomerExpectedOutput{
+				customer: nil,
+				err:      domain.ErrVersionMismatch,
+			},
+		},
+		{
+			desc: "Fail_SameData",
+			mocks: func(
+				customerRepo *mock.MockCustomerRepository,
+				cache *mock.MockCacheRepository,
+			) {
+				customerRepo.EXPECT().
+					GetCustomerByID(gomock.Any(), gomock.Eq(customerID)).
+					Return(sameCustomer, nil)
+			},
+			input: updateCustomerTestedInput{
+				customer: customerInput,
+			},
+			expected: updateCustomerExpectedOutput{
+				customer: nil,
+				err:      domain.ErrNoUpdatedData,
+			},
+		},
+		{
+			desc: "Fail_DuplicateData",
+			mocks: func(
+				customerRepo *mock.MockCustomerRepository,
+				cache *mock.MockCacheRepository,
+			) {
+				customerRepo.EXPECT().
+					GetCustomerByID(gomock.Any(), gomock.Eq(customerID)).
+					Return(existingCustomer, nil)
+				customerRepo.EXPECT().
+					UpdateCustomer(gomock.Any(), gomock.Eq(customerInput)).
+					Return(nil, domain.ErrConflictingData)
+			},
+			input: updateCustomerTestedInput{
+				customer: customerInput,
+			},
+			expected: updateCustomerExpectedOutput{
+				customer: nil,
+				err:      domain.ErrConflictingData,
+			},
+		},
+		{
+			desc: "Fail_ConcurrentUpdate",
+			mocks: func(
+				customerRepo *mock.MockCustomerRepository,
+				cache *mock.MockCacheRepository,
+			) {
+				customerRepo.EXPECT().
+					GetCustomerByID(gomock.Any(), gomock.Eq(customerID)).
+					Return(existingCustomer, nil)
+				customerRepo.EXPECT().
+					UpdateCustomer(gomock.Any(), gomock.Eq(customerInput)).
+					Return(nil, domain.ErrVersionMismatch)
+			},
+			input: updateCustomerTestedInput{
+				customer: customerInput,
+			},
+			expected: updateCustomerExpectedOutput{
+				customer: nil,
+				err:      domain.ErrVersionMismatch,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.desc, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			customerRepo := mock.NewMockCustomerRepository(ctrl)
+			cache := mock.NewMockCacheRepository(ctrl)
+			auditService := newAuditStub(ctrl)
+
+			tc.mocks(customerRepo, cache)
+
+			customerService := NewCustomerUsecase(customerRepo, cache, auditService)
+
+			customer, err := customerService.UpdateCustomer(ctx, tc.input.customer)
+			assert.ErrorIs(t, err, tc.expected.err, "Error mismatch")
+			assert.Equal(t, tc.expected.customer, customer, "Customer mismatch")
+		})
+	}
+}
+
+type deleteCustomerTestedInput struct {
+	id      uint64
+	version uint64
+}
+
+type deleteCustomerExpectedOutput struct {
+	err error
+}
+
+func TestCustomerService_DeleteCustomer(t *testing.T) {
+	ctx := context.Background()
+	customerID := gofakeit.Uint64()
+	version := gofakeit.Uint64()
+	deletedAt := gofakeit.Date()
+
+	cacheKey := util.GenerateCacheKey("customer", customerID)
+
+	testCases := []struct {
+		desc  string
+		mocks func(
+			customerRepo *mock.MockCustomerRepository,
+			cache *mock.MockCacheRepository,
+		)
+		input    deleteCustomerTestedInput
+		expected deleteCustomerExpectedOutput
+	}{
+		{
+			desc: "Success",
+			mocks: func(
+				customerRepo *mock.MockCustomerRepository,
+				cache *mock.MockCacheRepository,
+			) {
+				customerRepo.EXPECT().
+					GetCustomerByID(gomock.Any(), gomock.Eq(customerID)).
+					Return(&domaincustomer.Customer{Version: version}, nil)
+				cache.EXPECT().
+					Delete(gomock.Any(), gomock.Eq(cacheKey)).
+					Return(nil)
+				cache.EXPECT().
+					DeleteByPrefix(gomock.Any(), gomock.Eq("customers:*")).
+					Return(nil)
+				customerRepo.EXPECT().
+					DeleteCustomer(gomock.Any(), gomock.Eq(customerID), gomock.Eq(version)).
+					Return(nil)
+			},
+			input: deleteCustomerTestedInput{
+				id:      customerID,
+				version: version,
+			},
+			expected: deleteCustomerExpectedOutput{
+				err: nil,
+			},
+		},
+		{
+			desc: "Fail_NotFound",
+			mocks: func(
+				customerRepo *mock.MockCustomerRepository,
+				cache *mock.MockCacheRepository,
+			) {
+				customerRepo.EXPECT().
+					GetCustomerByID(gomock.Any(), gomock.Eq(customerID)).
+					Return(nil, domain.ErrDataNotFound)
+			},
+			input: deleteCustomerTestedInput{
+				id:      customerID,
+				version: version,
+			},
+			expected: deleteCustomerExpectedOutput{
+				err: domain.ErrDataNotFound,
+			},
+		},
+		{
+			desc: "Fail_Archived",
+			mocks: func(
+				customerRepo *mock.MockCustomerRepository,
+				cache *mock.MockCacheRepository,
+			) {
+				customerRepo.EXPECT().
+					GetCustomerByID(gomock.Any(), gomock.Eq(customerID)).
+					Return(&domaincustomer.Customer{DeletedAt: &deletedAt, Version: version}, nil)
+			},
+			input: deleteCustomerTestedInput{
+				id:      customerID,
+				version: version,
+			},
+			expected: deleteCustomerExpectedOutput{
+				err: domain.ErrDataArchived,
+			},
+		},
+		{
+			desc: "Fail_VersionMismatch",
+			mocks: func(
+				customerRepo *mock.MockCustomerRepository,
+				cache *mock.MockCacheRepository,
+			) {
+				customerRepo.EXPECT().
+					GetCustomerByID(gomock.Any(), gomock.Eq(customerID)).
+					Return(&domaincustomer.Customer{Version: version + 1}, nil)
+			},
+			input: deleteCustomerTestedInput{
+				id:      customerID,
+				version: version,
+			},
+			expected: deleteCustomerExpectedOutput{
+				err: domain.ErrVersionMismatch,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.desc, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			customerRepo := mock.NewMockCustomerRepository(ctrl)
+			cache := mock.NewMockCacheRepository(ctrl)
+			auditService := newAuditStub(ctrl)
+
+			tc.mocks(customerRepo, cache)
+
+			customerService := NewCustomerUsecase(customerRepo, cache, auditService)
+
+			err := customerService.DeleteCustomer(ctx, tc.input.id, tc.input.version)
+			assert.ErrorIs(t, err, tc.expected.err, "Error mismatch")
+		})
+	}
+}
+
+type restoreCustomerTestedInput struct {
+	id uint64
+}
+
+type restoreCustomerExpectedOutput struct {
+	customer *domaincustomer.Customer
+	err      error
+}
+
+func TestCustomerService_RestoreCustomer(t *testing.T) {
+	ctx := context.Background()
+	customerID := gofakeit.Uint64()
+	deletedAt := gofakeit.Date()
+
+	archivedCustomer := &domaincustomer.Customer{
+		ID:        customerID,
+		Name:      gofakeit.Name(),
+		DeletedAt: &deletedAt,
+	}
+	restoredCustomer := &domaincustomer.Customer{
+		ID:   customerID,
+		Name: archivedCustomer.Name,
+	}
+
+	cacheKey := util.GenerateCacheKey("customer", customerID)
+	customerSerialized, _ := util.Serialize(restoredCustomer)
+	ttl := time.Duration(0)
+
+	testCases := []struct {
+		desc  string
+		mocks func(
+			customerRepo *mock.MockCustomerRepository,
+			cache *mock.MockCacheRepository,
+		)
+		input    restoreCustomerTestedInput
+		expected restoreCustomerExpectedOutput
+	}{
+		{
+			desc: "Success",
+			mocks: func(
+				customerRepo *mock.MockCustomerRepository,
+				cache *mock.MockCacheRepository,
+			) {
+				customerRepo.EXPECT().
+					GetCustomerByID(gomock.Any(), gomock.Eq(customerID)).
+					Return(archivedCustomer, nil)
+				customerRepo.EXPECT().
+					RestoreCustomer(gomock.Any(), gomock.Eq(customerID)).
+					Return(restoredCustomer, nil)
+				cache.EXPECT().
+					Set(gomock.Any(), gomock.Eq(cacheKey), gomock.Eq(customerSerialized), gomock.Eq(ttl)).
+					Return(nil)
+				cache.EXPECT().
+					DeleteByPrefix(gomock.Any(), gomock.Eq("customers:*")).
+					Return(nil)
+			},
+			input: restoreCustomerTestedInput{
+				id: customerID,
+			},
+			expected: restoreCustomerExpectedOutput{
+				customer: restoredCustomer,
+				err:      nil,
+			},
+		},
+		{
+			desc: "Fail_NotArchived",
+			mocks: func(
+				customerRepo *mock.MockCustomerRepository,
+				cache *mock.MockCacheRepository,
+			) {
+				customerRepo.EXPECT().
+					GetCustomerByID(gomock.Any(), gomock.Eq(customerID)).
+					Return(restoredCustomer, nil)
+			},
+			input: restoreCustomerTestedInput{
+				id: customerID,
+			},
+			expected: restoreCustomerExpectedOutput{
+				customer: nil,
+				err:      domain.ErrDataNotArchived,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.desc, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			customerRepo := mock.NewMockCustomerRepository(ctrl)
+			cache := mock.NewMockCacheRepository(ctrl)
+			auditService := newAuditStub(ctrl)
+
+			tc.mocks(customerRepo, cache)
+
+			customerService := NewCustomerUsecase(customerRepo, cache, auditService)
+
+			customer, err := customerService.RestoreCustomer(ctx, tc.input.id)
+			assert.ErrorIs(t, err, tc.expected.err, "Error mismatch")
+			assert.Equal(t, tc.expected.customer, customer, "Customer mismatch")
+		})
+	}
+}
+
+type getPurchaseSummaryTestedInput struct {
+	id uint64
+}
+
+type getPurchaseSummaryExpectedOutput struct {
+	summary *domaincustomer.PurchaseSummary
+	err     error
+}
+
+func TestCustomerService_GetPurchaseSummary(t *testing.T) {
+	ctx := context.Background()
+	customerID := gofakeit.Uint64()
+	firstVisitAt := gofakeit.Date()
+	lastVisitAt := firstVisitAt.Add(time.Duration(gofakeit.Uint32()) * time.Second)
+
+	summary := &domaincustomer.PurchaseSummary{
+		CustomerID:    customerID,
+		Visits:        uint64(gofakeit.Uint16()),
+		LifetimeSpend: gofakeit.Price(1000, 1000000),
+		FirstVisitAt:  &firstVisitAt,
+		LastVisitAt:   &lastVisitAt,
+	}
+
+	testCases := []struct {
+		desc  string
+		mocks func(
+			customerRepo *mock.MockCustomerRepository,
+			cache *mock.MockCacheRepository,
+		)
+		input    getPurchaseSummaryTestedInput
+		expected getPurchaseSummaryExpectedOutput
+	}{
+		{
+			desc: "Success",
+			mocks: func(
+				customerRepo *mock.MockCustomerRepository,
+				cache *mock.MockCacheRepository,
+			) {
+				customerRepo.EXPECT().
+					GetCustomerByID(gomock.Any(), gomock.Eq(customerID)).
+					Return(&domaincustomer.Customer{ID: customerID}, nil)
+				customerRepo.EXPECT().
+					GetPurchaseSummary(gomock.Any(), gomock.Eq(customerID)).
+					Return(summary, nil)
+			},
+			input: getPurchaseSummaryTestedInput{
+				id: customerID,
+			},
+			expected: getPurchaseSummaryExpectedOutput{
+				summary: summary,
+				err:     nil,
+			},
+		},
+		{
+			desc: "Fail_NotFound",
+			mocks: func(
+				customerRepo *mock.MockCustomerRepository,
+				cache *mock.MockCacheRepository,
+			) {
+				customerRepo.EXPECT().
+					GetCustomerByID(gomock.Any(), gomock.Eq(customerID)).
+					Return(nil, domain.ErrDataNotFound)
+			},
+			input: getPurchaseSummaryTestedInput{
+				id: customerID,
+			},
+			expected: getPurchaseSummaryExpectedOutput{
+				summary: nil,
+				err:     domain.ErrDataNotFound,
+			},
+		},
+		{
+			desc: "Fail_InternalError",
+			mocks: func(
+				customerRepo *mock.MockCustomerRepository,
+				cache *mock.MockCacheRepository,
+			) {
+				customerRepo.EXPECT().
+					GetCustomerByID(gomock.Any(), gomock.Eq(customerID)).
+					Return(&domaincustomer.Customer{ID: customerID}, nil)
+				customerRepo.EXPECT().
+					GetPurchaseSummary(gomock.Any(), gomock.Eq(customerID)).
+					Return(nil, domain.ErrInternal)
+			},
+			input: getPurchaseSummaryTestedInput{
+				id: customerID,
+			},
+			expected: getPurchaseSummaryExpectedOutput{
+				summary: nil,
+				err:     domain.ErrInternal,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.desc, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			customerRepo := mock.NewMockCustomerRepository(ctrl)
+			cache := mock.NewMockCacheRepository(ctrl)
+			auditService := newAuditStub(ctrl)
+
+			tc.mocks(customerRepo, cache)
+
+			customerService := NewCustomerUsecase(customerRepo, cache, auditService)
+
+			summary, err := customerService.GetPurchaseSummary(ctx, tc.input.id)
+			assert.ErrorIs(t, err, tc.expected.err, "Error mismatch")
+			assert.Equal(t, tc.expected.summary, summary, "Summary mismatch")
+		})
+	}
+}
@@ -0,0 +1,169 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain"
+	domainaudit "github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain/audit"
+	domaingiftcard "github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain/giftcard"
+	domainorder "github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain/order"
+	domainpayment "github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain/payment"
+	"github.com/TienMinh25/go-hexagonal-architecture/internal/application/port"
+	"github.com/TienMinh25/go-hexagonal-architecture/internal/application/util"
+)
+
+/**
+ * giftCardUsecase implements port.GiftCardService interface
+ * and provides an access to the gift card, payment and customer repositories,
+ * cache service and audit service
+ */
+type giftCardUsecase struct {
+	repo         port.GiftCardRepository
+	paymentRepo  port.PaymentRepository
+	customerRepo port.CustomerRepository
+	cache        port.CacheRepository
+	audit        port.AuditService
+}
+
+// NewGiftCardUsecase creates a new gift card service instance
+func NewGiftCardUsecase(repo port.GiftCardRepository, paymentRepo port.PaymentRepository,
+	customerRepo port.CustomerRepository, cache port.CacheRepository, audit port.AuditService) port.GiftCardService {
+	return &giftCardUsecase{
+		repo,
+		paymentRepo,
+		customerRepo,
+		cache,
+		audit,
+	}
+}
+
+// IssueGiftCard sells a new gift card with a generated code
+func (gs *giftCardUsecase) IssueGiftCard(ctx context.Context, giftCard *domaingiftcard.GiftCard, order *domainorder.Order) (*domaingiftcard.GiftCard, error) {
+	if giftCard.ExpiresAt != nil && !giftCard.ExpiresAt.After(time.Now()) {
+		return nil, domain.ErrInvalidGiftCardExpiry
+	}
+
+	err := gs.checkSale(ctx, order, giftCard.InitialBalance)
+	if err != nil {
+		return nil, err
+	}
+
+	code, err := util.GenerateCode(4, 4)
+	if err != nil {
+		return nil, domain.ErrInternal.Wrap(err)
+	}
+
+	giftCard.Code = code
+
+	giftCard, err = gs.repo.CreateGiftCard(ctx, giftCard, order)
+	if err != nil {
+		if errors.Is(err, domain.ErrConflictingData) {
+			return nil, err
+		}
+		return nil, domain.ErrInternal.Wrap(err)
+	}
+
+	err = gs.cache.DeleteByPrefix(ctx, "orders:*")
+	if err != nil {
+		return nil, domain.ErrInternal.Wrap(err)
+	}
+
+	err = gs.audit.Record(ctx, domainaudit.Create, domainaudit.GiftCard, giftCard.ID, nil, giftCard)
+	if err != nil {
+		return nil, err
+	}
+
+	err = gs.audit.Record(ctx, domainaudit.Create, domainaudit.Order, order.ID, nil, order)
+	if err != nil {
+		return nil, err
+	}
+
+	return giftCard, nil
+}
+
+// GetGiftCard returns a gift card by code, used to check its balance
+func (gs *giftCardUsecase) GetGiftCard(ctx context.Context, code string) (*domaingiftcard.GiftCard, error) {
+	giftCard, err := gs.repo.GetGiftCardByCode(ctx, code)
+	if err != nil {
+		if errors.Is(err, domain.ErrDataNotFound) {
+			return nil, err
+		}
+		return nil, domain.ErrInternal.Wrap(err)
+	}
+
+	return giftCard, nil
+}
+
+// TopUpGiftCard sells an amount added to the balance of an unexpired gift card
+func (gs *giftCardUsecase) TopUpGiftCard(ctx context.Context, code string, amount float64, order *domainorder.Order) (*domaingiftcard.GiftCard, error) {
+	existingGiftCard, err := gs.repo.GetGiftCardByCode(ctx, code)
+	if err != nil {
+		if errors.Is(err, domain.ErrDataNotFound) {
+			return nil, err
+		}
+		return nil, domain.ErrInternal.Wrap(err)
+	}
+
+	if existingGiftCard.ExpiresAt != nil && existingGiftCard.ExpiresAt.Before(time.Now()) {
+		return nil, domain.ErrGiftCardExpired
+	}
+
+	err = gs.checkSale(ctx, order, amount)
+	if err != nil {
+		return nil, err
+	}
+
+	giftCard, err := gs.repo.TopUpGiftCard(ctx, existingGiftCard.ID, amount, order)
+	if err != nil {
+		if errors.Is(err, domain.ErrGiftCardExpired) {
+			return nil, err
+		}
+		return nil, domain.ErrInternal.Wrap(err)
+	}
+
+	err = gs.cache.DeleteByPrefix(ctx, "orders:*")
+	if err != nil {
+		return nil, domain.ErrInternal.Wrap(err)
+	}
+
+	err = gs.audit.Record(ctx, domainaudit.TopUp, domainaudit.GiftCard, giftCard.ID, existingGiftCard, giftCard)
+	if err != nil {
+		return nil, err
+	}
+
+	err = gs.audit.Record(ctx, domainaudit.Create, domainaudit.Order, order.ID, nil, order)
+	if err != nil {
+		return nil, err
+	}
+
+	return giftCard, nil
+}
+
+// checkSale checks the order a gift card amount is sold with the way orders are checked and sets its totals,
+// a gift card cannot pay for another one since that would only move store credit around
+func (gs *giftCardUsecase) checkSale(ctx context.Context, order *domainorder.Order, amount float64) error {
+	payment, err := getActivePayment(ctx, gs.paymentRepo, order.PaymentID)
+	if err != nil {
+		return err
+	}
+
+	err = linkCustomer(ctx, gs.customerRepo, order)
+	if err != nil {
+		return err
+	}
+
+	if payment.Type == domainpayment.GiftCard {
+		return domain.ErrGiftCardPayment
+	}
+
+	if order.TotalPaid < amount {
+		return domain.ErrInsufficientPayment
+	}
+
+	order.TotalPrice = amount
+	order.TotalReturn = order.TotalPaid - amount
+
+	return nil
+}
@@ -0,0 +1,686 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain"
+	domaincustomer "github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain/customer"
+	domaingiftcard "github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain/giftcard"
+	domainorder "github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain/order"
+	domainpayment "github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain/payment"
+	"github.com/TienMinh25/go-hexagonal-architecture/internal/application/mock"
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+type issueGiftCardTestedInput struct {
+	giftCard *domaingiftcard.GiftCard
+	order    *domainorder.Order
+}
+
+type issueGiftCardExpectedOutput struct {
+	issued       bool
+	totalReturn  float64
+	customerName string
+	err          error
+}
+
+func TestGiftCardService_IssueGiftCard(t *testing.T) {
+	ctx := context.Background()
+	initialBalance := gofakeit.Price(10000, 1000000)
+	expiresAt := time.Now().Add(365 * 24 * time.Hour)
+	expiredAt := time.Now().Add(-time.Hour)
+
+	cash := &domainpayment.Payment{
+		ID:   gofakeit.Uint64(),
+		Name: "Cash",
+		Type: domainpayment.Cash,
+	}
+	giftCardPayment := &domainpayment.Payment{
+		ID:   gofakeit.Uint64(),
+		Name: "Gift card",
+		Type: domainpayment.GiftCard,
+	}
+	deletedAt := time.Now()
+	customer := &domaincustomer.Customer{
+		ID:   gofakeit.Uint64(),
+		Name: gofakeit.Name(),
+	}
+	archivedCustomer := &domaincustomer.Customer{
+		ID:        gofakeit.Uint64(),
+		Name:      gofakeit.Name(),
+		DeletedAt: &deletedAt,
+	}
+
+	testCases := []struct {
+		desc  string
+		mocks func(
+			giftCardRepo *mock.MockGiftCardRepository,
+			paymentRepo *mock.MockPaymentRepository,
+			customerRepo *mock.MockCustomerRepository,
+		)
+		input    issueGiftCardTestedInput
+		expected issueGiftCardExpectedOutput
+	}{
+		{
+			desc: "Success",
+			mocks: func(
+				giftCardRepo *mock.MockGiftCardRepository,
+				paymentRepo *mock.MockPaymentRepository,
+				customerRepo *mock.MockCustomerRepository,
+			) {
+				paymentRepo.EXPECT().
+					GetPaymentByID(gomock.Any(), gomock.Eq(cash.ID)).
+					Return(cash, nil)
+				giftCardRepo.EXPECT().
+					CreateGiftCard(gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, giftCard *domaingiftcard.GiftCard, order *domainorder.Order) (*domaingiftcard.GiftCard, error) {
+						giftCard.ID = gofakeit.Uint64()
+						giftCard.Balance = giftCard.InitialBalance
+						order.ID = gofakeit.Uint64()
+						order.SoldGiftCardID = &giftCard.ID
+						return giftCard, nil
+					})
+			},
+			input: issueGiftCardTestedInput{
+				giftCard: &domaingiftcard.GiftCard{
+					InitialBalance: initialBalance,
+					ExpiresAt:      &expiresAt,
+				},
+				order: &domainorder.Order{
+					PaymentID: cash.ID,
+					TotalPaid: initialBalance + 5000,
+				},
+			},
+			expected: issueGiftCardExpectedOutput{
+				issued:      true,
+				totalReturn: 5000,
+				err:         nil,
+			},
+		},
+		{
+			desc: "Success_WithCustomer",
+			mocks: func(
+				giftCardRepo *mock.MockGiftCardRepository,
+				paymentRepo *mock.MockPaymentRepository,
+				customerRepo *mock.MockCustomerRepository,
+			) {
+				paymentRepo.EXPECT().
+					GetPaymentByID(gomock.Any(), gomock.Eq(cash.ID)).
+					Return(cash, nil)
+				customerRepo.EXPECT().
+					GetCustomerByID(gomock.Any(), gomock.Eq(customer.ID)).
+					Return(customer, nil)
+				giftCardRepo.EXPECT().
+					CreateGiftCard(gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, giftCard *domaingiftcard.GiftCard, order *domainorder.Order) (*domaingiftcard.GiftCard, error) {
+						giftCard.ID = gofakeit.Uint64()
+						giftCard.Balance = giftCard.InitialBalance
+						order.ID = gofakeit.Uint64()
+						order.SoldGiftCardID = &giftCard.ID
+						return giftCard, nil
+					})
+			},
+			input: issueGiftCardTestedInput{
+				giftCard: &domaingiftcard.GiftCard{
+					InitialBalance: initialBalance,
+				},
+				order: &domainorder.Order{
+					PaymentID:  cash.ID,
+					CustomerID: &customer.ID,
+					TotalPaid:  initialBalance,
+				},
+			},
+			expected: issueGiftCardExpectedOutput{
+				issued:       true,
+				customerName: customer.Name,
+				err:          nil,
+			},
+		},
+		{
+			desc: "Fail_CustomerNotFound",
+			mocks: func(
+				giftCardRepo *mock.MockGiftCardRepository,
+				paymentRepo *mock.MockPaymentRepository,
+				customerRepo *mock.MockCustomerRepository,
+			) {
+				paymentRepo.EXPECT().
+					GetPaymentByID(gomock.Any(), gomock.Eq(cash.ID)).
+					Return(cash, nil)
+				customerRepo.EXPECT().
+					GetCustomerByID(gomock.Any(), gomock.Eq(customer.ID)).
+					Return(nil, domain.ErrDataNotFound)
+			},
+			input: issueGiftCardTestedInput{
+				giftCard: &domaingiftcard.GiftCard{
+					InitialBalance: initialBalance,
+				},
+				order: &domainorder.Order{
+					PaymentID:  cash.ID,
+					CustomerID: &customer.ID,
+					TotalPaid:  initialBalance,
+				},
+			},
+			expected: issueGiftCardExpectedOutput{
+				issued: false,
+				err:    domain.ErrDataNotFound,
+			},
+		},
+		{
+			desc: "Fail_CustomerArchived",
+			mocks: func(
+				giftCardRepo *mock.MockGiftCardRepository,
+				paymentRepo *mock.MockPaymentRepository,
+				customerRepo *mock.MockCustomerRepository,
+			) {
+				paymentRepo.EXPECT().
+					GetPaymentByID(gomock.Any(), gomock.Eq(cash.ID)).
+					Return(cash, nil)
+				customerRepo.EXPECT().
+					GetCustomerByID(gomock.Any(), gomock.Eq(archivedCustomer.ID)).
+					Return(archivedCustomer, nil)
+			},
+			input: issueGiftCardTestedInput{
+				giftCard: &domaingiftcard.GiftCard{
+					InitialBalance: initialBalance,
+				},
+				order: &domainorder.Order{
+					PaymentID:  cash.ID,
+					CustomerID: &archivedCustomer.ID,
+					TotalPaid:  initialBalance,
+				},
+			},
+			expected: issueGiftCardExpectedOutput{
+				issued: false,
+				err:    domain.ErrDataArchived,
+			},
+		},
+		{
+			desc: "Fail_ExpiryInPast",
+			mocks: func(
+				giftCardRepo *mock.MockGiftCardRepository,
+				paymentRepo *mock.MockPaymentRepository,
+				customerRepo *mock.MockCustomerRepository,
+			) {
+			},
+			input: issueGiftCardTestedInput{
+				giftCard: &domaingiftcard.GiftCard{
+					InitialBalance: initialBalance,
+					ExpiresAt:      &expiredAt,
+				},
+				order: &domainorder.Order{
+					PaymentID: cash.ID,
+					TotalPaid: initialBalance,
+				},
+			},
+			expected: issueGiftCardExpectedOutput{
+				issued: false,
+				err:    domain.ErrInvalidGiftCardExpiry,
+			},
+		},
+		{
+			desc: "Fail_PaidWithGiftCard",
+			mocks: func(
+				giftCardRepo *mock.MockGiftCardRepository,
+				paymentRepo *mock.MockPaymentRepository,
+				customerRepo *mock.MockCustomerRepository,
+			) {
+				paymentRepo.EXPECT().
+					GetPaymentByID(gomock.Any(), gomock.Eq(giftCardPayment.ID)).
+					Return(giftCardPayment, nil)
+			},
+			input: issueGiftCardTestedInput{
+				giftCard: &domaingiftcard.GiftCard{
+					InitialBalance: initialBalance,
+				},
+				order: &domainorder.Order{
+					PaymentID: giftCardPayment.ID,
+					TotalPaid: initialBalance,
+				},
+			},
+			expected: issueGiftCardExpectedOutput{
+				issued: false,
+				err:    domain.ErrGiftCardPayment,
+			},
+		},
+		{
+			desc: "Fail_InsufficientPayment",
+			mocks: func(
+				giftCardRepo *mock.MockGiftCardRepository,
+				paymentRepo *mock.MockPaymentRepository,
+				customerRepo *mock.MockCustomerRepository,
+			) {
+				paymentRepo.EXPECT().
+					GetPaymentByID(gomock.Any(), gomock.Eq(cash.ID)).
+					Return(cash, nil)
+			},
+			input: issueGiftCardTestedInput{
+				giftCard: &domaingiftcard.GiftCard{
+					InitialBalance: initialBalance,
+				},
+				order: &domainorder.Order{
+					PaymentID: cash.ID,
+					TotalPaid: initialBalance - 1,
+				},
+			},
+			expected: issueGiftCardExpectedOutput{
+				issued: false,
+				err:    domain.ErrInsufficientPayment,
+			},
+		},
+		{
+			desc: "Fail_PaymentNotFound",
+			mocks: func(
+				giftCardRepo *mock.MockGiftCardRepository,
+				paymentRepo *mock.MockPaymentRepository,
+				customerRepo *mock.MockCustomerRepository,
+			) {
+				paymentRepo.EXPECT().
+					GetPaymentByID(gomock.Any(), gomock.Eq(cash.ID)).
+					Return(nil, domain.ErrDataNotFound)
+			},
+			input: issueGiftCardTestedInput{
+				giftCard: &domaingiftcard.GiftCard{
+					InitialBalance: initialBalance,
+				},
+				order: &domainorder.Order{
+					PaymentID: cash.ID,
+					TotalPaid: initialBalance,
+				},
+			},
+			expected: issueGiftCardExpectedOutput{
+				issued: false,
+				err:    domain.ErrDataNotFound,
+			},
+		},
+		{
+			desc: "Fail_InternalError",
+			mocks: func(
+				giftCardRepo *mock.MockGiftCardRepository,
+				paymentRepo *mock.MockPaymentRepository,
+				customerRepo *mock.MockCustomerRepository,
+			) {
+				paymentRepo.EXPECT().
+					GetPaymentByID(gomock.Any(), gomock.Eq(cash.ID)).
+					Return(cash, nil)
+				giftCardRepo.EXPECT().
+					CreateGiftCard(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil, domain.ErrInternal)
+			},
+			input: issueGiftCardTestedInput{
+				giftCard: &domaingiftcard.GiftCard{
+					InitialBalance: initialBalance,
+				},
+				order: &domainorder.Order{
+					PaymentID: cash.ID,
+					TotalPaid: initialBalance,
+				},
+			},
+			expected: issueGiftCardExpectedOutput{
+				issued: false,
+				err:    domain.ErrInternal,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.desc, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			giftCardRepo := mock.NewMockGiftCardRepository(ctrl)
+			paymentRepo := mock.NewMockPaymentRepository(ctrl)
+			customerRepo := mock.NewMockCustomerRepository(ctrl)
+			cache := newGiftCardCacheStub(ctrl)
+			auditService := newAuditStub(ctrl)
+
+			tc.mocks(giftCardRepo, paymentRepo, customerRepo)
+
+			giftCardService := NewGiftCardUsecase(giftCardRepo, paymentRepo, customerRepo, cache, auditService)
+
+			giftCard, err := giftCardService.IssueGiftCard(ctx, tc.input.giftCard, tc.input.order)
+			assert.ErrorIs(t, err, tc.expected.err, "Error mismatch")
+			assert.Equal(t, tc.expected.issued, giftCard != nil, "Gift card mismatch")
+
+			if tc.expected.issued {
+				assert.Regexp(t, `^[A-Z2-9]{4}(-[A-Z2-9]{4}){3}$`, giftCard.Code, "Code mismatch")
+				assert.Equal(t, tc.input.giftCard.InitialBalance, giftCard.Balance, "Balance mismatch")
+				assert.Equal(t, tc.input.giftCard.InitialBalance, tc.input.order.TotalPrice, "Total price mismatch")
+				assert.InDelta(t, tc.expected.totalReturn, tc.input.order.TotalReturn, 0.001, "Total return mismatch")
+				assert.Equal(t, &giftCard.ID, tc.input.order.SoldGiftCardID, "Sold gift card mismatch")
+				assert.Equal(t, tc.expected.customerName, tc.input.order.CustomerName, "Customer name mismatch")
+			}
+		})
+	}
+}
+
+type getGiftCardTestedInput struct {
+	code string
+}
+
+type getGiftCardExpectedOutput struct {
+	giftCard *domaingiftcard.GiftCard
+	err      error
+}
+
+func TestGiftCardService_GetGiftCard(t *testing.T) {
+	ctx := context.Background()
+	code := gofakeit.Regex(`[A-Z]{4}-[A-Z]{4}-[A-Z]{4}-[A-Z]{4}`)
+
+	giftCard := &domaingiftcard.GiftCard{
+		ID:             gofakeit.Uint64(),
+		Code:           code,
+		InitialBalance: 500000,
+		Balance:        125000,
+	}
+
+	testCases := []struct {
+		desc     string
+		mocks    func(giftCardRepo *mock.MockGiftCardRepository)
+		input    getGiftCardTestedInput
+		expected getGiftCardExpectedOutput
+	}{
+		{
+			desc: "Success",
+			mocks: func(giftCardRepo *mock.MockGiftCardRepository) {
+				giftCardRepo.EXPECT().
+					GetGiftCardByCode(gomock.Any(), gomock.Eq(code)).
+					Return(giftCard, nil)
+			},
+			input: getGiftCardTestedInput{
+				code: code,
+			},
+			expected: getGiftCardExpectedOutput{
+				giftCard: giftCard,
+				err:      nil,
+			},
+		},
+		{
+			desc: "Fail_NotFound",
+			mocks: func(giftCardRepo *mock.MockGiftCardRepository) {
+				giftCardRepo.EXPECT().
+					GetGiftCardByCode(gomock.Any(), gomock.Eq(code)).
+					Return(nil, domain.ErrDataNotFound)
+			},
+			input: getGiftCardTestedInput{
+				code: code,
+			},
+			expected: getGiftCardExpectedOutput{
+				giftCard: nil,
+				err:      domain.ErrDataNotFound,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.desc, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			giftCardRepo := mock.NewMockGiftCardRepository(ctrl)
+			auditService := newAuditStub(ctrl)
+
+			tc.mocks(giftCardRepo)
+
+			giftCardService := NewGiftCardUsecase(giftCardRepo, nil, nil, nil, auditService)
+
+			giftCard, err := giftCardService.GetGiftCard(ctx, tc.input.code)
+			assert.ErrorIs(t, err, tc.expected.err, "Error mismatch")
+			assert.Equal(t, tc.expected.giftCard, giftCard, "Gift card mismatch")
+		})
+	}
+}
+
+type topUpGiftCardTestedInput struct {
+	code   string
+	amount float64
+	order  *domainorder.Order
+}
+
+type topUpGiftCardExpectedOutput struct {
+	giftCard *domaingiftcard.GiftCard
+	err      error
+}
+
+func TestGiftCardService_TopUpGiftCard(t *testing.T) {
+	ctx := context.Background()
+	code := gofakeit.Regex(`[A-Z]{4}-[A-Z]{4}-[A-Z]{4}-[A-Z]{4}`)
+	giftCardID := gofakeit.Uint64()
+	amount := gofakeit.Price(10000, 100000)
+	expiredAt := time.Now().Add(-time.Hour)
+
+	cash := &domainpayment.Payment{
+		ID:   gofakeit.Uint64(),
+		Name: "Cash",
+		Type: domainpayment.Cash,
+	}
+	giftCardPayment := &domainpayment.Payment{
+		ID:   gofakeit.Uint64(),
+		Name: "Gift card",
+		Type: domainpayment.GiftCard,
+	}
+
+	giftCard := &domaingiftcard.GiftCard{
+		ID:      giftCardID,
+		Code:    code,
+		Balance: 50000,
+	}
+	expiredGiftCard := &domaingiftcard.GiftCard{
+		ID:        giftCardID,
+		Code:      code,
+		Balance:   50000,
+		ExpiresAt: &expiredAt,
+	}
+	toppedUpGiftCard := &domaingiftcard.GiftCard{
+		ID:      giftCardID,
+		Code:    code,
+		Balance: 50000 + amount,
+	}
+
+	testCases := []struct {
+		desc  string
+		mocks func(
+			giftCardRepo *mock.MockGiftCardRepository,
+			paymentRepo *mock.MockPaymentRepository,
+		)
+		input    topUpGiftCardTestedInput
+		expected topUpGiftCardExpectedOutput
+	}{
+		{
+			desc: "Success",
+			mocks: func(
+				giftCardRepo *mock.MockGiftCardRepository,
+				paymentRepo *mock.MockPaymentRepository,
+			) {
+				giftCardRepo.EXPECT().
+					GetGiftCardByCode(gomock.Any(), gomock.Eq(code)).
+					Return(giftCard, nil)
+				paymentRepo.EXPECT().
+					GetPaymentByID(gomock.Any(), gomock.Eq(cash.ID)).
+					Return(cash, nil)
+				giftCardRepo.EXPECT().
+					TopUpGiftCard(gomock.Any(), gomock.Eq(giftCardID), gomock.Eq(amount), gomock.Any()).
+					Return(toppedUpGiftCard, nil)
+			},
+			input: topUpGiftCardTestedInput{
+				code:   code,
+				amount: amount,
+				order: &domainorder.Order{
+					PaymentID: cash.ID,
+					TotalPaid: amount,
+				},
+			},
+			expected: topUpGiftCardExpectedOutput{
+				giftCard: toppedUpGiftCard,
+				err:      nil,
+			},
+		},
+		{
+			desc: "Fail_NotFound",
+			mocks: func(
+				giftCardRepo *mock.MockGiftCardRepository,
+				paymentRepo *mock.MockPaymentRepository,
+			) {
+				giftCardRepo.EXPECT().
+					GetGiftCardByCode(gomock.Any(), gomock.Eq(code)).
+					Return(nil, domain.ErrDataNotFound)
+			},
+			input: topUpGiftCardTestedInput{
+				code:   code,
+				amount: amount,
+				order: &domainorder.Order{
+					PaymentID: cash.ID,
+					TotalPaid: amount,
+				},
+			},
+			expected: topUpGiftCardExpectedOutput{
+				giftCard: nil,
+				err:      domain.ErrDataNotFound,
+			},
+		},
+		{
+			desc: "Fail_Expired",
+			mocks: func(
+				giftCardRepo *mock.MockGiftCardRepository,
+				paymentRepo *mock.MockPaymentRepository,
+			) {
+				giftCardRepo.EXPECT().
+					GetGiftCardByCode(gomock.Any(), gomock.Eq(code)).
+					Return(expiredGiftCard, nil)
+			},
+			input: topUpGiftCardTestedInput{
+				code:   code,
+				amount: amount,
+				order: &domainorder.Order{
+					PaymentID: cash.ID,
+					TotalPaid: amount,
+				},
+			},
+			expected: topUpGiftCardExpectedOutput{
+				giftCard: nil,
+				err:      domain.ErrGiftCardExpired,
+			},
+		},
+		{
+			desc: "Fail_PaidWithGiftCard",
+			mocks: func(
+				giftCardRepo *mock.MockGiftCardRepository,
+				paymentRepo *mock.MockPaymentRepository,
+			) {
+				giftCardRepo.EXPECT().
+					GetGiftCardByCode(gomock.Any(), gomock.Eq(code)).
+					Return(giftCard, nil)
+				paymentRepo.EXPECT().
+					GetPaymentByID(gomock.Any(), gomock.Eq(giftCardPayment.ID)).
+					Return(giftCardPayment, nil)
+			},
+			input: topUpGiftCardTestedInput{
+				code:   code,
+				amount: amount,
+				order: &domainorder.Order{
+					PaymentID: giftCardPayment.ID,
+					TotalPaid: amount,
+				},
+			},
+			expected: topUpGiftCardExpectedOutput{
+				giftCard: nil,
+				err:      domain.ErrGiftCardPayment,
+			},
+		},
+		{
+			desc: "Fail_InsufficientPayment",
+			mocks: func(
+				giftCardRepo *mock.MockGiftCardRepository,
+				paymentRepo *mock.MockPaymentRepository,
+			) {
+				giftCardRepo.EXPECT().
+					GetGiftCardByCode(gomock.Any(), gomock.Eq(code)).
+					Return(giftCard, nil)
+				paymentRepo.EXPECT().
+					GetPaymentByID(gomock.Any(), gomock.Eq(cash.ID)).
+					Return(cash, nil)
+			},
+			input: topUpGiftCardTestedInput{
+				code:   code,
+				amount: amount,
+				order: &domainorder.Order{
+					PaymentID: cash.ID,
+					TotalPaid: amount - 1,
+				},
+			},
+			expected: topUpGiftCardExpectedOutput{
+				giftCard: nil,
+				err:      domain.ErrInsufficientPayment,
+			},
+		},
+		{
+			desc: "Fail_ExpiredConcurrently",
+			mocks: func(
+				giftCardRepo *mock.MockGiftCardRepository,
+				paymentRepo *mock.MockPaymentRepository,
+			) {
+				giftCardRepo.EXPECT().
+					GetGiftCardByCode(gomock.Any(), gomock.Eq(code)).
+					Return(giftCard, nil)
+				paymentRepo.EXPECT().
+					GetPaymentByID(gomock.Any(), gomock.Eq(cash.ID)).
+					Return(cash, nil)
+				giftCardRepo.EXPECT().
+					TopUpGiftCard(gomock.Any(), gomock.Eq(giftCardID), gomock.Eq(amount), gomock.Any()).
+					Return(nil, domain.ErrGiftCardExpired)
+			},
+			input: topUpGiftCardTestedInput{
+				code:   code,
+				amount: amount,
+				order: &domainorder.Order{
+					PaymentID: cash.ID,
+					TotalPaid: amount,
+				},
+			},
+			expected: topUpGiftCardExpectedOutput{
+				giftCard: nil,
+				err:      domain.ErrGiftCardExpired,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.desc, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			giftCardRepo := mock.NewMockGiftCardRepository(ctrl)
+			paymentRepo := mock.NewMockPaymentRepository(ctrl)
+			cache := newGiftCardCacheStub(ctrl)
+			auditService := newAuditStub(ctrl)
+
+			tc.mocks(giftCardRepo, paymentRepo)
+
+			giftCardService := NewGiftCardUsecase(giftCardRepo, paymentRepo, mock.NewMockCustomerRepository(ctrl), cache, auditService)
+
+			giftCard, err := giftCardService.TopUpGiftCard(ctx, tc.input.code, tc.input.amount, tc.input.order)
+			assert.ErrorIs(t, err, tc.expected.err, "Error mismatch")
+			assert.Equal(t, tc.expected.giftCard, giftCard, "Gift card mismatch")
+		})
+	}
+}
+
+// newGiftCardCacheStub creates a cache mock accepting the order list invalidation of a gift card sale
+func newGiftCardCacheStub(ctrl *gomock.Controller) *mock.MockCacheRepository {
+	cache := mock.NewMockCacheRepository(ctrl)
+	cache.EXPECT().
+		DeleteByPrefix(gomock.Any(), gomock.Eq("orders:*")).
+		Return(nil).
+		AnyTimes()
+
+	return cache
+}
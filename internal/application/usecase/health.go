@@ -0,0 +1,113 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain"
+	domainhealth "github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain/health"
+	"github.com/TienMinh25/go-hexagonal-architecture/internal/application/port"
+)
+
+/**
+ * healthUsecase implements port.HealthService interface
+ * and provides an access to the health repository and cache repository
+ */
+type healthUsecase struct {
+	repo     port.HealthRepository
+	cache    port.CacheRepository
+	draining atomic.Bool
+}
+
+// NewHealthUsecase creates a new health service instance
+func NewHealthUsecase(repo port.HealthRepository, cache port.CacheRepository) port.HealthService {
+	return &healthUsecase{
+		repo:  repo,
+		cache: cache,
+	}
+}
+
+// healthCheckTimeout is how long a single dependency may take to answer before it is reported down
+const healthCheckTimeout = 2 * time.Second
+
+// Readiness runs the checks of all dependencies concurrently and reports each of them with its latency
+func (hs *healthUsecase) Readiness(ctx context.Context) *domainhealth.Report {
+	report := &domainhealth.Report{
+		Status:   domainhealth.StatusUp,
+		Draining: hs.draining.Load(),
+	}
+
+	checks := []struct {
+		name  string
+		check func(ctx context.Context) error
+	}{
+		{domainhealth.Database, hs.repo.Ping},
+		{domainhealth.Migrations, hs.repo.CheckMigrations},
+		{domainhealth.Cache, hs.cache.Ping},
+	}
+
+	report.Components = make([]domainhealth.Component, len(checks))
+
+	var wg sync.WaitGroup
+	for i, c := range checks {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			report.Components[i] = runHealthCheck(ctx, c.name, c.check)
+		}()
+	}
+	wg.Wait()
+
+	if report.Draining {
+		report.Status = domainhealth.StatusDown
+	}
+
+	for _, component := range report.Components {
+		if component.Status != domainhealth.StatusUp {
+			report.Status = domainhealth.StatusDown
+		}
+	}
+
+	return report
+}
+
+// Drain marks the application as not ready, it stays so until the process exits
+func (hs *healthUsecase) Drain() {
+	hs.draining.Store(true)
+}
+
+// runHealthCheck checks one dependency within the health check timeout.
+// The cause of a failure is only logged, the report tells what failed without the internal details
+func runHealthCheck(ctx context.Context, name string, check func(ctx context.Context) error) domainhealth.Component {
+	ctx, cancel := context.WithTimeout(ctx, healthCheckTimeout)
+	defer cancel()
+
+	start := time.Now()
+	err := check(ctx)
+
+	component := domainhealth.Component{
+		Name:    name,
+		Status:  domainhealth.StatusUp,
+		Latency: time.Since(start),
+	}
+
+	if err != nil {
+		slog.WarnContext(ctx, "Health check failed", "component", name, "error", err)
+
+		component.Status = domainhealth.StatusDown
+		switch {
+		case errors.Is(err, domain.ErrMigrationOutdated), errors.Is(err, domain.ErrMigrationDirty):
+			component.Error = err.Error()
+		case errors.Is(err, context.DeadlineExceeded):
+			component.Error = "check timed out"
+		default:
+			component.Error = "check failed"
+		}
+	}
+
+	return component
+}
@@ -0,0 +1,159 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain"
+	domainhealth "github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain/health"
+	"github.com/TienMinh25/go-hexagonal-architecture/internal/application/mock"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+type readinessTestedInput struct {
+	draining bool
+}
+
+type readinessExpectedOutput struct {
+	status     domainhealth.Status
+	components map[string]string
+}
+
+func TestHealthService_Readiness(t *testing.T) {
+	ctx := context.Background()
+
+	testCases := []struct {
+		desc     string
+		mocks    func(healthRepo *mock.MockHealthRepository, cache *mock.MockCacheRepository)
+		input    readinessTestedInput
+		expected readinessExpectedOutput
+	}{
+		{
+			desc: "Success_AllUp",
+			mocks: func(healthRepo *mock.MockHealthRepository, cache *mock.MockCacheRepository) {
+				healthRepo.EXPECT().Ping(gomock.Any()).Return(nil)
+				healthRepo.EXPECT().CheckMigrations(gomock.Any()).Return(nil)
+				cache.EXPECT().Ping(gomock.Any()).Return(nil)
+			},
+			input: readinessTestedInput{
+				draining: false,
+			},
+			expected: readinessExpectedOutput{
+				status: domainhealth.StatusUp,
+				components: map[string]string{
+					domainhealth.Database:   "",
+					domainhealth.Migrations: "",
+					domainhealth.Cache:      "",
+				},
+			},
+		},
+		{
+			desc: "Fail_DatabaseDown",
+			mocks: func(healthRepo *mock.MockHealthRepository, cache *mock.MockCacheRepository) {
+				healthRepo.EXPECT().Ping(gomock.Any()).Return(errors.New("connection refused"))
+				healthRepo.EXPECT().CheckMigrations(gomock.Any()).Return(errors.New("connection refused"))
+				cache.EXPECT().Ping(gomock.Any()).Return(nil)
+			},
+			input: readinessTestedInput{
+				draining: false,
+			},
+			expected: readinessExpectedOutput{
+				status: domainhealth.StatusDown,
+				components: map[string]string{
+					domainhealth.Database:   "check failed",
+					domainhealth.Migrations: "check failed",
+					domainhealth.Cache:      "",
+				},
+			},
+		},
+		{
+			desc: "Fail_MigrationOutdated",
+			mocks: func(healthRepo *mock.MockHealthRepository, cache *mock.MockCacheRepository) {
+				healthRepo.EXPECT().Ping(gomock.Any()).Return(nil)
+				healthRepo.EXPECT().CheckMigrations(gomock.Any()).Return(domain.ErrMigrationOutdated)
+				cache.EXPECT().Ping(gomock.Any()).Return(nil)
+			},
+			input: readinessTestedInput{
+				draining: false,
+			},
+			expected: readinessExpectedOutput{
+				status: domainhealth.StatusDown,
+				components: map[string]string{
+					domainhealth.Database:   "",
+					domainhealth.Migrations: domain.ErrMigrationOutdated.Error(),
+					domainhealth.Cache:      "",
+				},
+			},
+		},
+		{
+			desc: "Fail_CacheTimeout",
+			mocks: func(healthRepo *mock.MockHealthRepository, cache *mock.MockCacheRepository) {
+				healthRepo.EXPECT().Ping(gomock.Any()).Return(nil)
+				healthRepo.EXPECT().CheckMigrations(gomock.Any()).Return(nil)
+				cache.EXPECT().Ping(gomock.Any()).Return(context.DeadlineExceeded)
+			},
+			input: readinessTestedInput{
+				draining: false,
+			},
+			expected: readinessExpectedOutput{
+				status: domainhealth.StatusDown,
+				components: map[string]string{
+					domainhealth.Database:   "",
+					domainhealth.Migrations: "",
+					domainhealth.Cache:      "check timed out",
+				},
+			},
+		},
+		{
+			desc: "Fail_Draining",
+			mocks: func(healthRepo *mock.MockHealthRepository, cache *mock.MockCacheRepository) {
+				healthRepo.EXPECT().Ping(gomock.Any()).Return(nil)
+				healthRepo.EXPECT().CheckMigrations(gomock.Any()).Return(nil)
+				cache.EXPECT().Ping(gomock.Any()).Return(nil)
+			},
+			input: readinessTestedInput{
+				draining: true,
+			},
+			expected: readinessExpectedOutput{
+				status: domainhealth.StatusDown,
+				components: map[string]string{
+					domainhealth.Database:   "",
+					domainhealth.Migrations: "",
+					domainhealth.Cache:      "",
+				},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.desc, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			healthRepo := mock.NewMockHealthRepository(ctrl)
+			cache := mock.NewMockCacheRepository(ctrl)
+
+			tc.mocks(healthRepo, cache)
+
+			healthService := NewHealthUsecase(healthRepo, cache)
+			if tc.input.draining {
+				healthService.Drain()
+			}
+
+			report := healthService.Readiness(ctx)
+
+			components := map[string]string{}
+			for _, component := range report.Components {
+				components[component.Name] = component.Error
+			}
+
+			assert.Equal(t, tc.expected.status, report.Status, "Status mismatch")
+			assert.Equal(t, tc.input.draining, report.Draining, "Draining mismatch")
+			assert.Equal(t, tc.expected.components, components, "Components mismatch")
+		})
+	}
+}
@@ -0,0 +1,156 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain"
+	domainaudit "github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain/audit"
+	domainmail "github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain/mail"
+	domainuser "github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain/user"
+	"github.com/TienMinh25/go-hexagonal-architecture/internal/application/port"
+	"github.com/TienMinh25/go-hexagonal-architecture/internal/application/util"
+)
+
+/**
+ * invitationUsecase implements port.InvitationService interface
+ * and provides an access to the invitation and user repositories,
+ * the mailer and audit service
+ */
+type invitationUsecase struct {
+	repo         port.InvitationRepository
+	userRepo     port.UserRepository
+	mailer       port.Mailer
+	registration domainuser.RegistrationPolicy
+	audit        port.AuditService
+}
+
+// NewInvitationUsecase creates a new invitation service instance
+func NewInvitationUsecase(
+	repo port.InvitationRepository,
+	userRepo port.UserRepository,
+	mailer port.Mailer,
+	registration domainuser.RegistrationPolicy,
+	audit port.AuditService,
+) port.InvitationService {
+	return &invitationUsecase{
+		repo,
+		userRepo,
+		mailer,
+		registration,
+		audit,
+	}
+}
+
+// CreateInvitation invites an email that has no account yet and mails it the link to register,
+// only the hash of the token is stored
+func (is *invitationUsecase) CreateInvitation(ctx context.Context, invitation *domainuser.Invitation) (*domainuser.Invitation, string, error) {
+	if is.registration.Mode == domainuser.RegistrationClosed {
+		return nil, "", domain.ErrRegistrationClosed
+	}
+
+	invitation.Email = strings.TrimSpace(invitation.Email)
+
+	_, err := is.userRepo.GetUserByEmail(ctx, invitation.Email)
+	if err == nil {
+		return nil, "", domain.ErrConflictingData
+	}
+	if !errors.Is(err, domain.ErrDataNotFound) {
+		return nil, "", domain.ErrInternal.Wrap(err)
+	}
+
+	token, err := util.GenerateToken()
+	if err != nil {
+		return nil, "", domain.ErrInternal.Wrap(err)
+	}
+
+	invitation.TokenHash = util.HashToken(token)
+	invitation.ExpiresAt = time.Now().Add(is.registration.InvitationDuration)
+
+	invitation, err = is.repo.CreateInvitation(ctx, invitation)
+	if err != nil {
+		if errors.Is(err, domain.ErrUnknownRole) {
+			return nil, "", err
+		}
+		return nil, "", domain.ErrInternal.Wrap(err)
+	}
+
+	err = is.mailer.Send(ctx, &domainmail.Message{
+		To:      invitation.Email,
+		Subject: "You have been invited",
+		Body: fmt.Sprintf(
+			"Hi,\n\nYou have been invited to join as %s. Use the link below to create your account, it expires in %s.\n\n%s\n",
+			invitation.Role,
+			is.registration.InvitationDuration,
+			is.invitationLink(token),
+		),
+	})
+	if err != nil {
+		return nil, "", domain.ErrInternal.Wrap(err)
+	}
+
+	err = is.audit.Record(ctx, domainaudit.Create, domainaudit.Invitation, invitation.ID, nil, invitation)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return invitation, token, nil
+}
+
+// ListInvitations returns a list of invitations with pagination
+func (is *invitationUsecase) ListInvitations(ctx context.Context, skip, limit uint64) ([]domainuser.Invitation, error) {
+	invitations, err := is.repo.ListInvitations(ctx, skip, limit)
+	if err != nil {
+		return nil, domain.ErrInternal.Wrap(err)
+	}
+
+	return invitations, nil
+}
+
+// RevokeInvitation revokes a pending invitation, accepted and revoked ones cannot be revoked
+func (is *invitationUsecase) RevokeInvitation(ctx context.Context, id uint64) (*domainuser.Invitation, error) {
+	existingInvitation, err := is.repo.GetInvitationByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, domain.ErrDataNotFound) {
+			return nil, err
+		}
+		return nil, domain.ErrInternal.Wrap(err)
+	}
+
+	if existingInvitation.AcceptedAt != nil || existingInvitation.RevokedAt != nil {
+		return nil, domain.ErrDataArchived
+	}
+
+	invitation, err := is.repo.RevokeInvitation(ctx, id)
+	if err != nil {
+		if errors.Is(err, domain.ErrDataNotFound) {
+			return nil, domain.ErrDataArchived
+		}
+		return nil, domain.ErrInternal.Wrap(err)
+	}
+
+	err = is.audit.Record(ctx, domainaudit.Revoke, domainaudit.Invitation, id, existingInvitation, invitation)
+	if err != nil {
+		return nil, err
+	}
+
+	return invitation, nil
+}
+
+// invitationLink adds the token to the configured invitation URL, or returns the bare token when none is configured
+func (is *invitationUsecase) invitationLink(token string) string {
+	link, err := url.Parse(is.registration.InvitationURL)
+	if err != nil || is.registration.InvitationURL == "" {
+		return token
+	}
+
+	query := link.Query()
+	query.Set("token", token)
+	link.RawQuery = query.Encode()
+
+	return link.String()
+}
@@ -0,0 +1,275 @@
+package usecase
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain"
+	domainmail "github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain/mail"
+	domainuser "github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain/user"
+	"github.com/TienMinh25/go-hexagonal-architecture/internal/application/mock"
+	"github.com/TienMinh25/go-hexagonal-architecture/internal/application/util"
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+type createInvitationTestedInput struct {
+	mode domainuser.RegistrationMode
+}
+
+type createInvitationExpectedOutput struct {
+	err error
+}
+
+func TestInvitationService_CreateInvitation(t *testing.T) {
+	ctx := context.Background()
+	email := gofakeit.Email()
+	inviterID := gofakeit.Uint64()
+
+	testCases := []struct {
+		desc  string
+		mocks func(
+			invitationRepo *mock.MockInvitationRepository,
+			userRepo *mock.MockUserRepository,
+			mailer *mock.MockMailer,
+		)
+		input    createInvitationTestedInput
+		expected createInvitationExpectedOutput
+	}{
+		{
+			desc: "Success",
+			mocks: func(
+				invitationRepo *mock.MockInvitationRepository,
+				userRepo *mock.MockUserRepository,
+				mailer *mock.MockMailer,
+			) {
+				var tokenHash string
+
+				userRepo.EXPECT().
+					GetUserByEmail(gomock.Any(), gomock.Eq(email)).
+					Return(nil, domain.ErrDataNotFound)
+				invitationRepo.EXPECT().
+					CreateInvitation(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, invitation *domainuser.Invitation) (*domainuser.Invitation, error) {
+						assert.Equal(t, inviterID, invitation.InvitedBy)
+						assert.WithinDuration(t, time.Now().Add(registration.InvitationDuration), invitation.ExpiresAt, time.Minute)
+						invitation.ID = gofakeit.Uint64()
+						tokenHash = invitation.TokenHash
+						return invitation, nil
+					})
+				mailer.EXPECT().
+					Send(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, message *domainmail.Message) error {
+						assert.Equal(t, email, message.To)
+
+						_, link, _ := strings.Cut(message.Body, registration.InvitationURL+"?token=")
+						token, _, _ := strings.Cut(link, "\n")
+						assert.Equal(t, tokenHash, util.HashToken(token), "Mailed token does not match the stored hash")
+						return nil
+					})
+			},
+			input: createInvitationTestedInput{
+				mode: domainuser.RegistrationInvite,
+			},
+			expected: createInvitationExpectedOutput{
+				err: nil,
+			},
+		},
+		{
+			desc: "Fail_ExistingUser",
+			mocks: func(
+				invitationRepo *mock.MockInvitationRepository,
+				userRepo *mock.MockUserRepository,
+				mailer *mock.MockMailer,
+			) {
+				userRepo.EXPECT().
+					GetUserByEmail(gomock.Any(), gomock.Eq(email)).
+					Return(&domainuser.User{Email: email}, nil)
+			},
+			input: createInvitationTestedInput{
+				mode: domainuser.RegistrationInvite,
+			},
+			expected: createInvitationExpectedOutput{
+				err: domain.ErrConflictingData,
+			},
+		},
+		{
+			desc: "Fail_UnknownRole",
+			mocks: func(
+				invitationRepo *mock.MockInvitationRepository,
+				userRepo *mock.MockUserRepository,
+				mailer *mock.MockMailer,
+			) {
+				userRepo.EXPECT().
+					GetUserByEmail(gomock.Any(), gomock.Eq(email)).
+					Return(nil, domain.ErrDataNotFound)
+				invitationRepo.EXPECT().
+					CreateInvitation(gomock.Any(), gomock.Any()).
+					Return(nil, domain.ErrUnknownRole)
+			},
+			input: createInvitationTestedInput{
+				mode: domainuser.RegistrationOpen,
+			},
+			expected: createInvitationExpectedOutput{
+				err: domain.ErrUnknownRole,
+			},
+		},
+		{
+			desc: "Fail_RegistrationClosed",
+			mocks: func(
+				invitationRepo *mock.MockInvitationRepository,
+				userRepo *mock.MockUserRepository,
+				mailer *mock.MockMailer,
+			) {
+			},
+			input: createInvitationTestedInput{
+				mode: domainuser.RegistrationClosed,
+			},
+			expected: createInvitationExpectedOutput{
+				err: domain.ErrRegistrationClosed,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.desc, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			invitationRepo := mock.NewMockInvitationRepository(ctrl)
+			userRepo := mock.NewMockUserRepository(ctrl)
+			mailer := mock.NewMockMailer(ctrl)
+			auditService := newAuditStub(ctrl)
+
+			tc.mocks(invitationRepo, userRepo, mailer)
+
+			policy := registration
+			policy.Mode = tc.input.mode
+			invitationService := NewInvitationUsecase(invitationRepo, userRepo, mailer, policy, auditService)
+
+			invitation := &domainuser.Invitation{
+				Email:     email,
+				Role:      domainuser.Manager,
+				InvitedBy: inviterID,
+			}
+
+			invitation, token, err := invitationService.CreateInvitation(ctx, invitation)
+			assert.ErrorIs(t, err, tc.expected.err, "Error mismatch")
+			if tc.expected.err == nil {
+				assert.NotEmpty(t, token, "Token is empty")
+				assert.Equal(t, util.HashToken(token), invitation.TokenHash, "Token hash mismatch")
+			}
+		})
+	}
+}
+
+type revokeInvitationTestedInput struct {
+	id uint64
+}
+
+type revokeInvitationExpectedOutput struct {
+	err error
+}
+
+func TestInvitationService_RevokeInvitation(t *testing.T) {
+	ctx := context.Background()
+	acceptedAt := gofakeit.Date()
+	revokedAt := time.Now()
+
+	pending := &domainuser.Invitation{
+		ID:    gofakeit.Uint64(),
+		Email: gofakeit.Email(),
+		Role:  domainuser.Cashier,
+	}
+	accepted := &domainuser.Invitation{
+		ID:         gofakeit.Uint64(),
+		Email:      gofakeit.Email(),
+		Role:       domainuser.Cashier,
+		AcceptedAt: &acceptedAt,
+	}
+	revoked := &domainuser.Invitation{
+		ID:        pending.ID,
+		Email:     pending.Email,
+		Role:      pending.Role,
+		RevokedAt: &revokedAt,
+	}
+
+	testCases := []struct {
+		desc     string
+		mocks    func(invitationRepo *mock.MockInvitationRepository)
+		input    revokeInvitationTestedInput
+		expected revokeInvitationExpectedOutput
+	}{
+		{
+			desc: "Success",
+			mocks: func(invitationRepo *mock.MockInvitationRepository) {
+				invitationRepo.EXPECT().
+					GetInvitationByID(gomock.Any(), gomock.Eq(pending.ID)).
+					Return(pending, nil)
+				invitationRepo.EXPECT().
+					RevokeInvitation(gomock.Any(), gomock.Eq(pending.ID)).
+					Return(revoked, nil)
+			},
+			input: revokeInvitationTestedInput{
+				id: pending.ID,
+			},
+			expected: revokeInvitationExpectedOutput{
+				err: nil,
+			},
+		},
+		{
+			desc: "Fail_AlreadyAccepted",
+			mocks: func(invitationRepo *mock.MockInvitationRepository) {
+				invitationRepo.EXPECT().
+					GetInvitationByID(gomock.Any(), gomock.Eq(accepted.ID)).
+					Return(accepted, nil)
+			},
+			input: revokeInvitationTestedInput{
+				id: accepted.ID,
+			},
+			expected: revokeInvitationExpectedOutput{
+				err: domain.ErrDataArchived,
+			},
+		},
+		{
+			desc: "Fail_NotFound",
+			mocks: func(invitationRepo *mock.MockInvitationRepository) {
+				invitationRepo.EXPECT().
+					GetInvitationByID(gomock.Any(), gomock.Eq(pending.ID)).
+					Return(nil, domain.ErrDataNotFound)
+			},
+			input: revokeInvitationTestedInput{
+				id: pending.ID,
+			},
+			expected: revokeInvitationExpectedOutput{
+				err: domain.ErrDataNotFound,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.desc, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			invitationRepo := mock.NewMockInvitationRepository(ctrl)
+			userRepo := mock.NewMockUserRepository(ctrl)
+			mailer := mock.NewMockMailer(ctrl)
+			auditService := newAuditStub(ctrl)
+
+			tc.mocks(invitationRepo)
+
+			invitationService := NewInvitationUsecase(invitationRepo, userRepo, mailer, registration, auditService)
+
+			_, err := invitationService.RevokeInvitation(ctx, tc.input.id)
+			assert.ErrorIs(t, err, tc.expected.err, "Error mismatch")
+		})
+	}
+}
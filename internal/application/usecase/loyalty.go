@@ -0,0 +1,59 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+
+	"github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain"
+	domainloyalty "github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain/loyalty"
+	"github.com/TienMinh25/go-hexagonal-architecture/internal/application/port"
+)
+
+/**
+ * loyaltyUsecase implements port.LoyaltyService interface
+ */
+type loyaltyUsecase struct {
+	repo         port.LoyaltyRepository
+	customerRepo port.CustomerRepository
+	policy       domainloyalty.Policy
+}
+
+// NewLoyaltyUsecase creates a new loyalty service instance
+func NewLoyaltyUsecase(repo port.LoyaltyRepository, customerRepo port.CustomerRepository, policy domainloyalty.Policy) port.LoyaltyService {
+	return &loyaltyUsecase{
+		repo,
+		customerRepo,
+		policy,
+	}
+}
+
+// GetBalance returns the points balance of a customer and what it is worth
+func (ls *loyaltyUsecase) GetBalance(ctx context.Context, customerID uint64) (*domainloyalty.Balance, error) {
+	_, err := ls.customerRepo.GetCustomerByID(ctx, customerID)
+	if err != nil {
+		if errors.Is(err, domain.ErrDataNotFound) {
+			return nil, err
+		}
+		return nil, domain.ErrInternal.Wrap(err)
+	}
+
+	points, err := ls.repo.GetBalance(ctx, customerID)
+	if err != nil {
+		return nil, domain.ErrInternal.Wrap(err)
+	}
+
+	return &domainloyalty.Balance{
+		CustomerID: customerID,
+		Points:     points,
+		Value:      float64(points) * ls.policy.PointValue,
+	}, nil
+}
+
+// earnRate returns the points earned per currency unit spent on a product of the category
+func earnRate(policy domainloyalty.Policy, categoryID uint64) float64 {
+	if rate, ok := policy.CategoryEarnRates[categoryID]; ok {
+		return rate
+	}
+
+	return policy.EarnRate
+}
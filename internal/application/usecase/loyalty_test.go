@@ -0,0 +1,134 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain"
+	domaincustomer "github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain/customer"
+	domainloyalty "github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain/loyalty"
+	"github.com/TienMinh25/go-hexagonal-architecture/internal/application/mock"
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+type getLoyaltyBalanceTestedInput struct {
+	customerID uint64
+}
+
+type getLoyaltyBalanceExpectedOutput struct {
+	balance *domainloyalty.Balance
+	err     error
+}
+
+func TestLoyaltyService_GetBalance(t *testing.T) {
+	ctx := context.Background()
+	customerID := gofakeit.Uint64()
+	points := int64(gofakeit.Uint16())
+	policy := domainloyalty.Policy{
+		EarnRate:   0.001,
+		PointValue: 10,
+	}
+
+	customer := &domaincustomer.Customer{
+		ID:   customerID,
+		Name: gofakeit.Name(),
+	}
+	balance := &domainloyalty.Balance{
+		CustomerID: customerID,
+		Points:     points,
+		Value:      float64(points) * policy.PointValue,
+	}
+
+	testCases := []struct {
+		desc  string
+		mocks func(
+			loyaltyRepo *mock.MockLoyaltyRepository,
+			customerRepo *mock.MockCustomerRepository,
+		)
+		input    getLoyaltyBalanceTestedInput
+		expected getLoyaltyBalanceExpectedOutput
+	}{
+		{
+			desc: "Success",
+			mocks: func(
+				loyaltyRepo *mock.MockLoyaltyRepository,
+				customerRepo *mock.MockCustomerRepository,
+			) {
+				customerRepo.EXPECT().
+					GetCustomerByID(gomock.Any(), gomock.Eq(customerID)).
+					Return(customer, nil)
+				loyaltyRepo.EXPECT().
+					GetBalance(gomock.Any(), gomock.Eq(customerID)).
+					Return(points, nil)
+			},
+			input: getLoyaltyBalanceTestedInput{
+				customerID: customerID,
+			},
+			expected: getLoyaltyBalanceExpectedOutput{
+				balance: balance,
+				err:     nil,
+			},
+		},
+		{
+			desc: "Fail_CustomerNotFound",
+			mocks: func(
+				loyaltyRepo *mock.MockLoyaltyRepository,
+				customerRepo *mock.MockCustomerRepository,
+			) {
+				customerRepo.EXPECT().
+					GetCustomerByID(gomock.Any(), gomock.Eq(customerID)).
+					Return(nil, domain.ErrDataNotFound)
+			},
+			input: getLoyaltyBalanceTestedInput{
+				customerID: customerID,
+			},
+			expected: getLoyaltyBalanceExpectedOutput{
+				balance: nil,
+				err:     domain.ErrDataNotFound,
+			},
+		},
+		{
+			desc: "Fail_InternalError",
+			mocks: func(
+				loyaltyRepo *mock.MockLoyaltyRepository,
+				customerRepo *mock.MockCustomerRepository,
+			) {
+				customerRepo.EXPECT().
+					GetCustomerByID(gomock.Any(), gomock.Eq(customerID)).
+					Return(customer, nil)
+				loyaltyRepo.EXPECT().
+					GetBalance(gomock.Any(), gomock.Eq(customerID)).
+					Return(int64(0), domain.ErrInternal)
+			},
+			input: getLoyaltyBalanceTestedInput{
+				customerID: customerID,
+			},
+			expected: getLoyaltyBalanceExpectedOutput{
+				balance: nil,
+				err:     domain.ErrInternal,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.desc, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			loyaltyRepo := mock.NewMockLoyaltyRepository(ctrl)
+			customerRepo := mock.NewMockCustomerRepository(ctrl)
+
+			tc.mocks(loyaltyRepo, customerRepo)
+
+			loyaltyService := NewLoyaltyUsecase(loyaltyRepo, customerRepo, policy)
+
+			balance, err := loyaltyService.GetBalance(ctx, tc.input.customerID)
+			assert.ErrorIs(t, err, tc.expected.err, "Error mismatch")
+			assert.Equal(t, tc.expected.balance, balance, "Balance mismatch")
+		})
+	}
+}
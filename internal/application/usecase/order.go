@@ -2,9 +2,15 @@ package usecase
 
 import (
 	"context"
+	"errors"
+	"math"
+	"time"
 
 	"github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain"
+	domainaudit "github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain/audit"
+	domainloyalty "github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain/loyalty"
 	domainorder "github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain/order"
+	domainpayment "github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain/payment"
 	"github.com/TienMinh25/go-hexagonal-architecture/internal/application/port"
 	"github.com/TienMinh25/go-hexagonal-architecture/internal/application/util"
 )
@@ -18,69 +24,150 @@ type orderUsecase struct {
 	categoryRepo port.CategoryRepository
 	userRepo     port.UserRepository
 	paymentRepo  port.PaymentRepository
+	customerRepo port.CustomerRepository
+	giftCardRepo port.GiftCardRepository
+	loyaltyRepo  port.LoyaltyRepository
 	cache        port.CacheRepository
+	loyalty      domainloyalty.Policy
+	metrics      port.Metrics
+	audit        port.AuditService
 }
 
 // NewOrderUsecase creates a new order service instance
 func NewOrderUsecase(orderRepo port.OrderRepository, productRepo port.ProductRepository,
 	categoryRepo port.CategoryRepository, userRepo port.UserRepository,
-	paymentRepo port.PaymentRepository, cache port.CacheRepository) port.OrderService {
+	paymentRepo port.PaymentRepository, customerRepo port.CustomerRepository,
+	giftCardRepo port.GiftCardRepository, loyaltyRepo port.LoyaltyRepository,
+	cache port.CacheRepository, loyalty domainloyalty.Policy, metrics port.Metrics, audit port.AuditService) port.OrderService {
 	return &orderUsecase{
 		orderRepo,
 		productRepo,
 		categoryRepo,
 		userRepo,
 		paymentRepo,
+		customerRepo,
+		giftCardRepo,
+		loyaltyRepo,
 		cache,
+		loyalty,
+		metrics,
+		audit,
 	}
 }
 
 // CreateOrder creates a new order
 func (os *orderUsecase) CreateOrder(ctx context.Context, order *domainorder.Order) (*domainorder.Order, error) {
+	payment, err := getActivePayment(ctx, os.paymentRepo, order.PaymentID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = linkCustomer(ctx, os.customerRepo, order)
+	if err != nil {
+		return nil, err
+	}
+
+	if order.PointsRedeemed > 0 {
+		if order.CustomerID == nil {
+			return nil, domain.ErrCustomerRequired
+		}
+
+		if os.loyalty.PointValue == 0 {
+			return nil, domain.ErrRedemptionDisabled
+		}
+	}
+
+	// a gift card is a tender next to the payment, and a gift card payment cannot go without one
+	if order.GiftCard != nil {
+		giftCard, err := os.giftCardRepo.GetGiftCardByCode(ctx, order.GiftCard.Code)
+		if err != nil {
+			if errors.Is(err, domain.ErrDataNotFound) {
+				return nil, err
+			}
+			return nil, domain.ErrInternal.Wrap(err)
+		}
+
+		if giftCard.ExpiresAt != nil && giftCard.ExpiresAt.Before(time.Now()) {
+			return nil, domain.ErrGiftCardExpired
+		}
+
+		order.GiftCard = giftCard
+		order.GiftCardID = &giftCard.ID
+	} else if payment.Type == domainpayment.GiftCard {
+		return nil, domain.ErrGiftCardRequired
+	}
+
 	var totalPrice float64
+	var points float64
 	for i, orderProduct := range order.Products {
 		product, err := os.productRepo.GetProductByID(ctx, orderProduct.ProductID)
 		if err != nil {
-			if err == domain.ErrDataNotFound {
+			if errors.Is(err, domain.ErrDataNotFound) {
 				return nil, err
 			}
-			return nil, domain.ErrInternal
+			return nil, domain.ErrInternal.Wrap(err)
+		}
+
+		if product.DeletedAt != nil {
+			return nil, domain.ErrDataArchived
 		}
 
 		if product.Stock < orderProduct.Quantity {
+			os.metrics.OrderRejected(domainorder.RejectedInsufficientStock)
 			return nil, domain.ErrInsufficientStock
 		}
 
 		order.Products[i].TotalPrice = product.Price * float64(orderProduct.Quantity)
 		totalPrice += order.Products[i].TotalPrice
+		points += order.Products[i].TotalPrice * earnRate(os.loyalty, product.CategoryID)
 	}
 
-	if order.TotalPaid < totalPrice {
+	// redeemed points are a tender next to the payment
+	redeemedValue := float64(order.PointsRedeemed) * os.loyalty.PointValue
+	if redeemedValue > totalPrice {
+		return nil, domain.ErrRedemptionExceedsTotal
+	}
+
+	// the gift card covers what its balance allows and the payment covers the rest
+	if order.GiftCard != nil {
+		order.GiftCardAmount = math.Min(order.GiftCard.Balance, totalPrice-redeemedValue)
+		if order.GiftCardAmount <= 0 && totalPrice > redeemedValue {
+			return nil, domain.ErrInsufficientGiftCardBalance
+		}
+	}
+
+	if order.TotalPaid+redeemedValue+order.GiftCardAmount < totalPrice {
+		os.metrics.OrderRejected(domainorder.RejectedInsufficientPayment)
 		return nil, domain.ErrInsufficientPayment
 	}
 
 	order.TotalPrice = totalPrice
-	order.TotalReturn = order.TotalPaid - order.TotalPrice
+	order.TotalReturn = order.TotalPaid + redeemedValue + order.GiftCardAmount - order.TotalPrice
 
-	order, err := os.orderRepo.CreateOrder(ctx, order)
-	if err != nil {
-		return nil, domain.ErrInternal
+	// no points are earned on the share of the total paid with points
+	if order.CustomerID != nil && totalPrice > 0 {
+		order.PointsEarned = int64(math.Floor(points * (totalPrice - redeemedValue) / totalPrice))
 	}
 
-	user, err := os.userRepo.GetUserByID(ctx, order.UserID)
+	order, err = os.orderRepo.CreateOrder(ctx, order)
 	if err != nil {
-		if err == domain.ErrDataNotFound {
+		if errors.Is(err, domain.ErrInsufficientPoints) || errors.Is(err, domain.ErrInsufficientGiftCardBalance) {
 			return nil, err
 		}
-		return nil, domain.ErrInternal
+		return nil, domain.ErrInternal.Wrap(err)
 	}
 
-	payment, err := os.paymentRepo.GetPaymentByID(ctx, order.PaymentID)
+	// the order is audited as it was created, before its details are filled in below,
+	// and an audit failure fails the request only once the caches no longer hold the old stock
+	auditErr := os.audit.Record(ctx, domainaudit.Create, domainaudit.Order, order.ID, nil, order)
+	os.metrics.OrderCreated(order.TotalPrice)
+
+	user, err := os.userRepo.GetUserByID(ctx, order.UserID)
 	if err != nil {
-		if err == domain.ErrDataNotFound {
+		if errors.Is(err, domain.ErrDataNotFound) {
 			return nil, err
 		}
-		return nil, domain.ErrInternal
+		return nil, domain.ErrInternal.Wrap(err)
 	}
 
 	order.User = user
@@ -89,38 +176,53 @@ func (os *orderUsecase) CreateOrder(ctx context.Context, order *domainorder.Orde
 	for i, orderProduct := range order.Products {
 		product, err := os.productRepo.GetProductByID(ctx, orderProduct.ProductID)
 		if err != nil {
-			if err == domain.ErrDataNotFound {
+			if errors.Is(err, domain.ErrDataNotFound) {
 				return nil, err
 			}
-			return nil, domain.ErrInternal
+			return nil, domain.ErrInternal.Wrap(err)
 		}
 
 		category, err := os.categoryRepo.GetCategoryByID(ctx, product.CategoryID)
 		if err != nil {
-			if err == domain.ErrDataNotFound {
+			if errors.Is(err, domain.ErrDataNotFound) {
 				return nil, err
 			}
-			return nil, domain.ErrInternal
+			return nil, domain.ErrInternal.Wrap(err)
 		}
 
 		order.Products[i].Product = product
 		order.Products[i].Product.Category = category
+
+		// the stock decrement bumped the product version, so drop the stale copy
+		err = os.cache.Delete(ctx, util.GenerateCacheKey("product", product.ID))
+		if err != nil {
+			return nil, domain.ErrInternal.Wrap(err)
+		}
+	}
+
+	err = os.cache.DeleteByPrefix(ctx, "products:*")
+	if err != nil {
+		return nil, domain.ErrInternal.Wrap(err)
 	}
 
 	err = os.cache.DeleteByPrefix(ctx, "orders:*")
 	if err != nil {
-		return nil, domain.ErrInternal
+		return nil, domain.ErrInternal.Wrap(err)
 	}
 
 	cacheKey := util.GenerateCacheKey("order", order.ID)
 	orderSerialized, err := util.Serialize(order)
 	if err != nil {
-		return nil, domain.ErrInternal
+		return nil, domain.ErrInternal.Wrap(err)
 	}
 
 	err = os.cache.Set(ctx, cacheKey, orderSerialized, 0)
 	if err != nil {
-		return nil, domain.ErrInternal
+		return nil, domain.ErrInternal.Wrap(err)
+	}
+
+	if auditErr != nil {
+		return nil, auditErr
 	}
 
 	return order, nil
@@ -135,33 +237,33 @@ func (os *orderUsecase) GetOrder(ctx context.Context, id uint64) (*domainorder.O
 	if err == nil {
 		err := util.Deserialize(cachedOrder, &order)
 		if err != nil {
-			return nil, domain.ErrInternal
+			return nil, domain.ErrInternal.Wrap(err)
 		}
 		return order, nil
 	}
 
 	order, err = os.orderRepo.GetOrderByID(ctx, id)
 	if err != nil {
-		if err == domain.ErrDataNotFound {
+		if errors.Is(err, domain.ErrDataNotFound) {
 			return nil, err
 		}
-		return nil, domain.ErrInternal
+		return nil, domain.ErrInternal.Wrap(err)
 	}
 
 	user, err := os.userRepo.GetUserByID(ctx, order.UserID)
 	if err != nil {
-		if err == domain.ErrDataNotFound {
+		if errors.Is(err, domain.ErrDataNotFound) {
 			return nil, err
 		}
-		return nil, domain.ErrInternal
+		return nil, domain.ErrInternal.Wrap(err)
 	}
 
 	payment, err := os.paymentRepo.GetPaymentByID(ctx, order.PaymentID)
 	if err != nil {
-		if err == domain.ErrDataNotFound {
+		if errors.Is(err, domain.ErrDataNotFound) {
 			return nil, err
 		}
-		return nil, domain.ErrInternal
+		return nil, domain.ErrInternal.Wrap(err)
 	}
 
 	order.User = user
@@ -170,18 +272,18 @@ func (os *orderUsecase) GetOrder(ctx context.Context, id uint64) (*domainorder.O
 	for i, orderProduct := range order.Products {
 		product, err := os.productRepo.GetProductByID(ctx, orderProduct.ProductID)
 		if err != nil {
-			if err == domain.ErrDataNotFound {
+			if errors.Is(err, domain.ErrDataNotFound) {
 				return nil, err
 			}
-			return nil, domain.ErrInternal
+			return nil, domain.ErrInternal.Wrap(err)
 		}
 
 		category, err := os.categoryRepo.GetCategoryByID(ctx, product.CategoryID)
 		if err != nil {
-			if err == domain.ErrDataNotFound {
+			if errors.Is(err, domain.ErrDataNotFound) {
 				return nil, err
 			}
-			return nil, domain.ErrInternal
+			return nil, domain.ErrInternal.Wrap(err)
 		}
 
 		order.Products[i].Product = product
@@ -190,53 +292,53 @@ func (os *orderUsecase) GetOrder(ctx context.Context, id uint64) (*domainorder.O
 
 	orderSerialized, err := util.Serialize(order)
 	if err != nil {
-		return nil, domain.ErrInternal
+		return nil, domain.ErrInternal.Wrap(err)
 	}
 
 	err = os.cache.Set(ctx, cacheKey, orderSerialized, 0)
 	if err != nil {
-		return nil, domain.ErrInternal
+		return nil, domain.ErrInternal.Wrap(err)
 	}
 
 	return order, nil
 }
 
-// ListOrders lists all orders
-func (os *orderUsecase) ListOrders(ctx context.Context, skip, limit uint64) ([]domainorder.Order, error) {
+// ListOrders lists all orders, or only those of one customer
+func (os *orderUsecase) ListOrders(ctx context.Context, customerID, skip, limit uint64) ([]domainorder.Order, error) {
 	var orders []domainorder.Order
 
-	params := util.GenerateCacheKeyParams(skip, limit)
+	params := util.GenerateCacheKeyParams(skip, limit, customerID)
 	cacheKey := util.GenerateCacheKey("orders", params)
 
 	cachedOrders, err := os.cache.Get(ctx, cacheKey)
 	if err == nil {
 		err := util.Deserialize(cachedOrders, &orders)
 		if err != nil {
-			return nil, domain.ErrInternal
+			return nil, domain.ErrInternal.Wrap(err)
 		}
 		return orders, nil
 	}
 
-	orders, err = os.orderRepo.ListOrders(ctx, skip, limit)
+	orders, err = os.orderRepo.ListOrders(ctx, customerID, skip, limit)
 	if err != nil {
-		return nil, domain.ErrInternal
+		return nil, domain.ErrInternal.Wrap(err)
 	}
 
 	for i, order := range orders {
 		user, err := os.userRepo.GetUserByID(ctx, order.UserID)
 		if err != nil {
-			if err == domain.ErrDataNotFound {
+			if errors.Is(err, domain.ErrDataNotFound) {
 				return nil, err
 			}
-			return nil, domain.ErrInternal
+			return nil, domain.ErrInternal.Wrap(err)
 		}
 
 		payment, err := os.paymentRepo.GetPaymentByID(ctx, order.PaymentID)
 		if err != nil {
-			if err == domain.ErrDataNotFound {
+			if errors.Is(err, domain.ErrDataNotFound) {
 				return nil, err
 			}
-			return nil, domain.ErrInternal
+			return nil, domain.ErrInternal.Wrap(err)
 		}
 
 		orders[i].User = user
@@ -247,18 +349,18 @@ func (os *orderUsecase) ListOrders(ctx context.Context, skip, limit uint64) ([]d
 		for j, orderProduct := range order.Products {
 			product, err := os.productRepo.GetProductByID(ctx, orderProduct.ProductID)
 			if err != nil {
-				if err == domain.ErrDataNotFound {
+				if errors.Is(err, domain.ErrDataNotFound) {
 					return nil, err
 				}
-				return nil, domain.ErrInternal
+				return nil, domain.ErrInternal.Wrap(err)
 			}
 
 			category, err := os.categoryRepo.GetCategoryByID(ctx, product.CategoryID)
 			if err != nil {
-				if err == domain.ErrDataNotFound {
+				if errors.Is(err, domain.ErrDataNotFound) {
 					return nil, err
 				}
-				return nil, domain.ErrInternal
+				return nil, domain.ErrInternal.Wrap(err)
 			}
 
 			orders[i].Products[j].Product = product
@@ -268,13 +370,124 @@ func (os *orderUsecase) ListOrders(ctx context.Context, skip, limit uint64) ([]d
 
 	ordersSerialized, err := util.Serialize(orders)
 	if err != nil {
-		return nil, domain.ErrInternal
+		return nil, domain.ErrInternal.Wrap(err)
 	}
 
 	err = os.cache.Set(ctx, cacheKey, ordersSerialized, 0)
 	if err != nil {
-		return nil, domain.ErrInternal
+		return nil, domain.ErrInternal.Wrap(err)
 	}
 
 	return orders, nil
 }
+
+// RefundOrder refunds an order, restocking its products, reversing its gift card and loyalty points tenders
+// and taking back the amount of a gift card it sold
+func (os *orderUsecase) RefundOrder(ctx context.Context, id uint64) (*domainorder.Order, error) {
+	existingOrder, err := os.orderRepo.GetOrderByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, domain.ErrDataNotFound) {
+			return nil, err
+		}
+		return nil, domain.ErrInternal.Wrap(err)
+	}
+
+	if existingOrder.RefundedAt != nil {
+		return nil, domain.ErrOrderRefunded
+	}
+
+	// a single reversal gives back redeemed points and takes back earned ones,
+	// but no more than the customer has left once the earned points are spent
+	var points int64
+	if existingOrder.CustomerID != nil {
+		points = existingOrder.PointsRedeemed - existingOrder.PointsEarned
+		if points < 0 {
+			balance, err := os.loyaltyRepo.GetBalance(ctx, *existingOrder.CustomerID)
+			if err != nil {
+				return nil, domain.ErrInternal.Wrap(err)
+			}
+
+			points = max(points, -max(balance, 0))
+		}
+	}
+
+	refundedOrder, err := os.orderRepo.RefundOrder(ctx, id, points)
+	if err != nil {
+		if errors.Is(err, domain.ErrOrderRefunded) || errors.Is(err, domain.ErrInsufficientPoints) || errors.Is(err, domain.ErrInsufficientGiftCardBalance) {
+			return nil, err
+		}
+		return nil, domain.ErrInternal.Wrap(err)
+	}
+
+	for _, orderProduct := range existingOrder.Products {
+		err = os.cache.Delete(ctx, util.GenerateCacheKey("product", orderProduct.ProductID))
+		if err != nil {
+			return nil, domain.ErrInternal.Wrap(err)
+		}
+	}
+
+	err = os.cache.DeleteByPrefix(ctx, "products:*")
+	if err != nil {
+		return nil, domain.ErrInternal.Wrap(err)
+	}
+
+	err = os.cache.Delete(ctx, util.GenerateCacheKey("order", id))
+	if err != nil {
+		return nil, domain.ErrInternal.Wrap(err)
+	}
+
+	err = os.cache.DeleteByPrefix(ctx, "orders:*")
+	if err != nil {
+		return nil, domain.ErrInternal.Wrap(err)
+	}
+
+	err = os.audit.Record(ctx, domainaudit.Refund, domainaudit.Order, id, existingOrder, refundedOrder)
+	if err != nil {
+		return nil, err
+	}
+
+	return os.GetOrder(ctx, id)
+}
+
+// getActivePayment gets the payment a sale is paid with, archived payments cannot take new sales
+func getActivePayment(ctx context.Context, paymentRepo port.PaymentRepository, id uint64) (*domainpayment.Payment, error) {
+	payment, err := paymentRepo.GetPaymentByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, domain.ErrDataNotFound) {
+			return nil, err
+		}
+		return nil, domain.ErrInternal.Wrap(err)
+	}
+
+	if payment.DeletedAt != nil {
+		return nil, domain.ErrDataArchived
+	}
+
+	return payment, nil
+}
+
+// linkCustomer checks the registered customer of a sale and names the sale after it,
+// walk-in sales carry only a free-text name and are left as they are
+func linkCustomer(ctx context.Context, customerRepo port.CustomerRepository, order *domainorder.Order) error {
+	if order.CustomerID == nil {
+		return nil
+	}
+
+	customer, err := customerRepo.GetCustomerByID(ctx, *order.CustomerID)
+	if err != nil {
+		if errors.Is(err, domain.ErrDataNotFound) {
+			return err
+		}
+		return domain.ErrInternal.Wrap(err)
+	}
+
+	if customer.DeletedAt != nil {
+		return domain.ErrDataArchived
+	}
+
+	if order.CustomerName == "" {
+		order.CustomerName = customer.Name
+	}
+
+	return nil
+}
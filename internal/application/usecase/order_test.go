@@ -0,0 +1,815 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain"
+	domaincategory "github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain/category"
+	domaincustomer "github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain/customer"
+	domaingiftcard "github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain/giftcard"
+	domainloyalty "github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain/loyalty"
+	domainorder "github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain/order"
+	domainpayment "github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain/payment"
+	domainproduct "github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain/product"
+	domainuser "github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain/user"
+	"github.com/TienMinh25/go-hexagonal-architecture/internal/application/mock"
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+// orderMocks groups the repositories an order is created, refunded and loaded with
+type orderMocks struct {
+	orderRepo    *mock.MockOrderRepository
+	productRepo  *mock.MockProductRepository
+	categoryRepo *mock.MockCategoryRepository
+	userRepo     *mock.MockUserRepository
+	paymentRepo  *mock.MockPaymentRepository
+	customerRepo *mock.MockCustomerRepository
+	giftCardRepo *mock.MockGiftCardRepository
+	loyaltyRepo  *mock.MockLoyaltyRepository
+	cache        *mock.MockCacheRepository
+	metrics      *mock.MockMetrics
+}
+
+// newOrderMocks creates the order mocks, the cache and metrics accept any call
+// since the tests check the tenders and points rather than caching
+func newOrderMocks(ctrl *gomock.Controller) orderMocks {
+	m := orderMocks{
+		orderRepo:    mock.NewMockOrderRepository(ctrl),
+		productRepo:  mock.NewMockProductRepository(ctrl),
+		categoryRepo: mock.NewMockCategoryRepository(ctrl),
+		userRepo:     mock.NewMockUserRepository(ctrl),
+		paymentRepo:  mock.NewMockPaymentRepository(ctrl),
+		customerRepo: mock.NewMockCustomerRepository(ctrl),
+		giftCardRepo: mock.NewMockGiftCardRepository(ctrl),
+		loyaltyRepo:  mock.NewMockLoyaltyRepository(ctrl),
+		cache:        mock.NewMockCacheRepository(ctrl),
+		metrics:      mock.NewMockMetrics(ctrl),
+	}
+
+	m.cache.EXPECT().Get(gomock.Any(), gomock.Any()).Return(nil, domain.ErrDataNotFound).AnyTimes()
+	m.cache.EXPECT().Set(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	m.cache.EXPECT().Delete(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	m.cache.EXPECT().DeleteByPrefix(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	m.metrics.EXPECT().OrderCreated(gomock.Any()).AnyTimes()
+	m.metrics.EXPECT().OrderRejected(gomock.Any()).AnyTimes()
+
+	return m
+}
+
+// expectCatalog returns the products and their categories whenever they are looked up
+func (m orderMocks) expectCatalog(products ...*domainproduct.Product) {
+	for _, product := range products {
+		m.productRepo.EXPECT().
+			GetProductByID(gomock.Any(), gomock.Eq(product.ID)).
+			Return(product, nil).
+			AnyTimes()
+		m.categoryRepo.EXPECT().
+			GetCategoryByID(gomock.Any(), gomock.Eq(product.CategoryID)).
+			Return(&domaincategory.Category{ID: product.CategoryID, Name: gofakeit.ProductCategory()}, nil).
+			AnyTimes()
+	}
+}
+
+type createOrderTestedInput struct {
+	order *domainorder.Order
+}
+
+type createOrderExpectedOutput struct {
+	totalPrice     float64
+	totalReturn    float64
+	pointsEarned   int64
+	giftCardAmount float64
+	terminalID     *uint64
+	err            error
+}
+
+func TestOrderService_CreateOrder(t *testing.T) {
+	ctx := context.Background()
+	userID := gofakeit.Uint64()
+	customerID := gofakeit.Uint64()
+	terminalID := gofakeit.Uint64()
+	foodCategoryID := gofakeit.Uint64()
+	drinkCategoryID := gofakeit.Uint64()
+
+	policy := domainloyalty.Policy{
+		EarnRate: 0.01,
+		CategoryEarnRates: map[uint64]float64{
+			drinkCategoryID: 0.05,
+		},
+		PointValue: 100,
+	}
+
+	cash := &domainpayment.Payment{
+		ID:   gofakeit.Uint64(),
+		Name: "Cash",
+		Type: domainpayment.Cash,
+	}
+	customer := &domaincustomer.Customer{
+		ID:   customerID,
+		Name: gofakeit.Name(),
+	}
+	user := &domainuser.User{
+		ID:   userID,
+		Name: gofakeit.Name(),
+	}
+	food := &domainproduct.Product{
+		ID:         gofakeit.Uint64(),
+		CategoryID: foodCategoryID,
+		Name:       gofakeit.Dessert(),
+		Stock:      100,
+		Price:      20000,
+	}
+	drink := &domainproduct.Product{
+		ID:         gofakeit.Uint64(),
+		CategoryID: drinkCategoryID,
+		Name:       gofakeit.BeerName(),
+		Stock:      100,
+		Price:      10000,
+	}
+
+	giftCardPayment := &domainpayment.Payment{
+		ID:   gofakeit.Uint64(),
+		Name: "Gift card",
+		Type: domainpayment.GiftCard,
+	}
+	expiredAt := time.Now().Add(-time.Hour)
+	partialGiftCard := &domaingiftcard.GiftCard{
+		ID:      gofakeit.Uint64(),
+		Code:    gofakeit.Regex(`[A-Z]{4}-[A-Z]{4}-[A-Z]{4}-[A-Z]{4}`),
+		Balance: 25000,
+	}
+	expiredGiftCard := &domaingiftcard.GiftCard{
+		ID:        gofakeit.Uint64(),
+		Code:      gofakeit.Regex(`[A-Z]{4}-[A-Z]{4}-[A-Z]{4}-[A-Z]{4}`),
+		Balance:   100000,
+		ExpiresAt: &expiredAt,
+	}
+	emptyGiftCard := &domaingiftcard.GiftCard{
+		ID:      gofakeit.Uint64(),
+		Code:    gofakeit.Regex(`[A-Z]{4}-[A-Z]{4}-[A-Z]{4}-[A-Z]{4}`),
+		Balance: 0,
+	}
+
+	// one food and two drinks total 40000, earning 200 points at the default rate and 1000 at the drink rate
+	newOrder := func(customerID *uint64, pointsRedeemed int64, totalPaid float64) *domainorder.Order {
+		return &domainorder.Order{
+			UserID:         userID,
+			PaymentID:      cash.ID,
+			CustomerID:     customerID,
+			TotalPaid:      totalPaid,
+			PointsRedeemed: pointsRedeemed,
+			Products: []domainorder.OrderProduct{
+				{ProductID: food.ID, Quantity: 1},
+				{ProductID: drink.ID, Quantity: 2},
+			},
+		}
+	}
+
+	// newGiftCardOrder pays the order with a gift card first and the payment for the rest
+	newGiftCardOrder := func(payment *domainpayment.Payment, code string, totalPaid float64) *domainorder.Order {
+		order := newOrder(nil, 0, totalPaid)
+		order.PaymentID = payment.ID
+		if code != "" {
+			order.GiftCard = &domaingiftcard.GiftCard{Code: code}
+		}
+		return order
+	}
+
+	testCases := []struct {
+		desc     string
+		mocks    func(m orderMocks)
+		input    createOrderTestedInput
+		expected createOrderExpectedOutput
+	}{
+		{
+			desc: "Success_EarnByCategoryRate",
+			mocks: func(m orderMocks) {
+				m.paymentRepo.EXPECT().
+					GetPaymentByID(gomock.Any(), gomock.Eq(cash.ID)).
+					Return(cash, nil)
+				m.customerRepo.EXPECT().
+					GetCustomerByID(gomock.Any(), gomock.Eq(customerID)).
+					Return(customer, nil)
+				m.expectCatalog(food, drink)
+				m.orderRepo.EXPECT().
+					CreateOrder(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, order *domainorder.Order) (*domainorder.Order, error) {
+						order.ID = gofakeit.Uint64()
+						return order, nil
+					})
+				m.userRepo.EXPECT().
+					GetUserByID(gomock.Any(), gomock.Eq(userID)).
+					Return(user, nil)
+			},
+			input: createOrderTestedInput{
+				order: newOrder(&customerID, 0, 50000),
+			},
+			expected: createOrderExpectedOutput{
+				totalPrice:   40000,
+				totalReturn:  10000,
+				pointsEarned: 1200,
+				err:          nil,
+			},
+		},
+		{
+			desc: "Success_RedeemPointsAsTender",
+			mocks: func(m orderMocks) {
+				m.paymentRepo.EXPECT().
+					GetPaymentByID(gomock.Any(), gomock.Eq(cash.ID)).
+					Return(cash, nil)
+				m.customerRepo.EXPECT().
+					GetCustomerByID(gomock.Any(), gomock.Eq(customerID)).
+					Return(customer, nil)
+				m.expectCatalog(food, drink)
+				m.orderRepo.EXPECT().
+					CreateOrder(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, order *domainorder.Order) (*domainorder.Order, error) {
+						order.ID = gofakeit.Uint64()
+						return order, nil
+					})
+				m.userRepo.EXPECT().
+					GetUserByID(gomock.Any(), gomock.Eq(userID)).
+					Return(user, nil)
+			},
+			input: createOrderTestedInput{
+				// 100 points are worth 10000, no points are earned on that quarter of the total
+				order: newOrder(&customerID, 100, 30000),
+			},
+			expected: createOrderExpectedOutput{
+				totalPrice:   40000,
+				totalReturn:  0,
+				pointsEarned: 900,
+				err:          nil,
+			},
+		},
+		{
+			desc: "Success_WalkInEarnsNoPoints",
+			mocks: func(m orderMocks) {
+				m.paymentRepo.EXPECT().
+					GetPaymentByID(gomock.Any(), gomock.Eq(cash.ID)).
+					Return(cash, nil)
+				m.expectCatalog(food, drink)
+				m.orderRepo.EXPECT().
+					CreateOrder(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, order *domainorder.Order) (*domainorder.Order, error) {
+						order.ID = gofakeit.Uint64()
+						return order, nil
+					})
+				m.userRepo.EXPECT().
+					GetUserByID(gomock.Any(), gomock.Eq(userID)).
+					Return(user, nil)
+			},
+			input: createOrderTestedInput{
+				order: newOrder(nil, 0, 40000),
+			},
+			expected: createOrderExpectedOutput{
+				totalPrice:   40000,
+				totalReturn:  0,
+				pointsEarned: 0,
+				err:          nil,
+			},
+		},
+		{
+			desc: "Fail_RedemptionExceedsTotal",
+			mocks: func(m orderMocks) {
+				m.paymentRepo.EXPECT().
+					GetPaymentByID(gomock.Any(), gomock.Eq(cash.ID)).
+					Return(cash, nil)
+				m.customerRepo.EXPECT().
+					GetCustomerByID(gomock.Any(), gomock.Eq(customerID)).
+					Return(customer, nil)
+				m.expectCatalog(food, drink)
+			},
+			input: createOrderTestedInput{
+				order: newOrder(&customerID, 500, 0),
+			},
+			expected: createOrderExpectedOutput{
+				err: domain.ErrRedemptionExceedsTotal,
+			},
+		},
+		{
+			desc: "Fail_RedeemWithoutCustomer",
+			mocks: func(m orderMocks) {
+				m.paymentRepo.EXPECT().
+					GetPaymentByID(gomock.Any(), gomock.Eq(cash.ID)).
+					Return(cash, nil)
+			},
+			input: createOrderTestedInput{
+				order: newOrder(nil, 100, 30000),
+			},
+			expected: createOrderExpectedOutput{
+				err: domain.ErrCustomerRequired,
+			},
+		},
+		{
+			desc: "Fail_InsufficientPoints",
+			mocks: func(m orderMocks) {
+				m.paymentRepo.EXPECT().
+					GetPaymentByID(gomock.Any(), gomock.Eq(cash.ID)).
+					Return(cash, nil)
+				m.customerRepo.EXPECT().
+					GetCustomerByID(gomock.Any(), gomock.Eq(customerID)).
+					Return(customer, nil)
+				m.expectCatalog(food, drink)
+				m.orderRepo.EXPECT().
+					CreateOrder(gomock.Any(), gomock.Any()).
+					Return(nil, domain.ErrInsufficientPoints)
+			},
+			input: createOrderTestedInput{
+				order: newOrder(&customerID, 100, 30000),
+			},
+			expected: createOrderExpectedOutput{
+				err: domain.ErrInsufficientPoints,
+			},
+		},
+		{
+			desc: "Success_TerminalSession",
+			mocks: func(m orderMocks) {
+				m.paymentRepo.EXPECT().
+					GetPaymentByID(gomock.Any(), gomock.Eq(cash.ID)).
+					Return(cash, nil)
+				m.expectCatalog(food, drink)
+				m.orderRepo.EXPECT().
+					CreateOrder(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, order *domainorder.Order) (*domainorder.Order, error) {
+						order.ID = gofakeit.Uint64()
+						return order, nil
+					})
+				m.userRepo.EXPECT().
+					GetUserByID(gomock.Any(), gomock.Eq(userID)).
+					Return(user, nil)
+			},
+			input: createOrderTestedInput{
+				order: func() *domainorder.Order {
+					order := newOrder(nil, 0, 40000)
+					order.TerminalID = &terminalID
+					return order
+				}(),
+			},
+			expected: createOrderExpectedOutput{
+				totalPrice: 40000,
+				terminalID: &terminalID,
+				err:        nil,
+			},
+		},
+		{
+			desc: "Success_PartialGiftCardTender",
+			mocks: func(m orderMocks) {
+				m.paymentRepo.EXPECT().
+					GetPaymentByID(gomock.Any(), gomock.Eq(cash.ID)).
+					Return(cash, nil)
+				m.giftCardRepo.EXPECT().
+					GetGiftCardByCode(gomock.Any(), gomock.Eq(partialGiftCard.Code)).
+					Return(partialGiftCard, nil)
+				m.expectCatalog(food, drink)
+				m.orderRepo.EXPECT().
+					CreateOrder(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, order *domainorder.Order) (*domainorder.Order, error) {
+						order.ID = gofakeit.Uint64()
+						return order, nil
+					})
+				m.userRepo.EXPECT().
+					GetUserByID(gomock.Any(), gomock.Eq(userID)).
+					Return(user, nil)
+			},
+			input: createOrderTestedInput{
+				// the card covers 25000 of the 40000 and cash the remaining 15000
+				order: newGiftCardOrder(cash, partialGiftCard.Code, 20000),
+			},
+			expected: createOrderExpectedOutput{
+				totalPrice:     40000,
+				totalReturn:    5000,
+				giftCardAmount: 25000,
+				err:            nil,
+			},
+		},
+		{
+			desc: "Fail_PartialGiftCardTenderUnderpaid",
+			mocks: func(m orderMocks) {
+				m.paymentRepo.EXPECT().
+					GetPaymentByID(gomock.Any(), gomock.Eq(cash.ID)).
+					Return(cash, nil)
+				m.giftCardRepo.EXPECT().
+					GetGiftCardByCode(gomock.Any(), gomock.Eq(partialGiftCard.Code)).
+					Return(partialGiftCard, nil)
+				m.expectCatalog(food, drink)
+			},
+			input: createOrderTestedInput{
+				order: newGiftCardOrder(cash, partialGiftCard.Code, 10000),
+			},
+			expected: createOrderExpectedOutput{
+				err: domain.ErrInsufficientPayment,
+			},
+		},
+		{
+			desc: "Fail_GiftCardExpired",
+			mocks: func(m orderMocks) {
+				m.paymentRepo.EXPECT().
+					GetPaymentByID(gomock.Any(), gomock.Eq(giftCardPayment.ID)).
+					Return(giftCardPayment, nil)
+				m.giftCardRepo.EXPECT().
+					GetGiftCardByCode(gomock.Any(), gomock.Eq(expiredGiftCard.Code)).
+					Return(expiredGiftCard, nil)
+			},
+			input: createOrderTestedInput{
+				order: newGiftCardOrder(giftCardPayment, expiredGiftCard.Code, 0),
+			},
+			expected: createOrderExpectedOutput{
+				err: domain.ErrGiftCardExpired,
+			},
+		},
+		{
+			desc: "Fail_GiftCardEmpty",
+			mocks: func(m orderMocks) {
+				m.paymentRepo.EXPECT().
+					GetPaymentByID(gomock.Any(), gomock.Eq(giftCardPayment.ID)).
+					Return(giftCardPayment, nil)
+				m.giftCardRepo.EXPECT().
+					GetGiftCardByCode(gomock.Any(), gomock.Eq(emptyGiftCard.Code)).
+					Return(emptyGiftCard, nil)
+				m.expectCatalog(food, drink)
+			},
+			input: createOrderTestedInput{
+				order: newGiftCardOrder(giftCardPayment, emptyGiftCard.Code, 0),
+			},
+			expected: createOrderExpectedOutput{
+				err: domain.ErrInsufficientGiftCardBalance,
+			},
+		},
+		{
+			desc: "Fail_GiftCardSpentMeanwhile",
+			mocks: func(m orderMocks) {
+				m.paymentRepo.EXPECT().
+					GetPaymentByID(gomock.Any(), gomock.Eq(cash.ID)).
+					Return(cash, nil)
+				m.giftCardRepo.EXPECT().
+					GetGiftCardByCode(gomock.Any(), gomock.Eq(partialGiftCard.Code)).
+					Return(partialGiftCard, nil)
+				m.expectCatalog(food, drink)
+				m.orderRepo.EXPECT().
+					CreateOrder(gomock.Any(), gomock.Any()).
+					Return(nil, domain.ErrInsufficientGiftCardBalance)
+			},
+			input: createOrderTestedInput{
+				order: newGiftCardOrder(cash, partialGiftCard.Code, 15000),
+			},
+			expected: createOrderExpectedOutput{
+				err: domain.ErrInsufficientGiftCardBalance,
+			},
+		},
+		{
+			desc: "Fail_GiftCardRequired",
+			mocks: func(m orderMocks) {
+				m.paymentRepo.EXPECT().
+					GetPaymentByID(gomock.Any(), gomock.Eq(giftCardPayment.ID)).
+					Return(giftCardPayment, nil)
+			},
+			input: createOrderTestedInput{
+				order: newGiftCardOrder(giftCardPayment, "", 0),
+			},
+			expected: createOrderExpectedOutput{
+				err: domain.ErrGiftCardRequired,
+			},
+		},
+		{
+			desc: "Fail_InsufficientPayment",
+			mocks: func(m orderMocks) {
+				m.paymentRepo.EXPECT().
+					GetPaymentByID(gomock.Any(), gomock.Eq(cash.ID)).
+					Return(cash, nil)
+				m.customerRepo.EXPECT().
+					GetCustomerByID(gomock.Any(), gomock.Eq(customerID)).
+					Return(customer, nil)
+				m.expectCatalog(food, drink)
+			},
+			input: createOrderTestedInput{
+				order: newOrder(&customerID, 100, 20000),
+			},
+			expected: createOrderExpectedOutput{
+				err: domain.ErrInsufficientPayment,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.desc, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := newOrderMocks(ctrl)
+			auditService := newAuditStub(ctrl)
+
+			tc.mocks(m)
+
+			orderService := NewOrderUsecase(m.orderRepo, m.productRepo, m.categoryRepo, m.userRepo, m.paymentRepo,
+				m.customerRepo, m.giftCardRepo, m.loyaltyRepo, m.cache, policy, m.metrics, auditService)
+
+			order, err := orderService.CreateOrder(ctx, tc.input.order)
+			assert.ErrorIs(t, err, tc.expected.err, "Error mismatch")
+
+			if tc.expected.err == nil {
+				assert.Equal(t, tc.expected.totalPrice, order.TotalPrice, "Total price mismatch")
+				assert.Equal(t, tc.expected.totalReturn, order.TotalReturn, "Total return mismatch")
+				assert.Equal(t, tc.expected.pointsEarned, order.PointsEarned, "Points earned mismatch")
+				assert.Equal(t, tc.expected.giftCardAmount, order.GiftCardAmount, "Gift card amount mismatch")
+				assert.Equal(t, tc.expected.terminalID, order.TerminalID, "Terminal mismatch")
+			} else {
+				assert.Nil(t, order, "Order mismatch")
+			}
+		})
+	}
+}
+
+type refundOrderTestedInput struct {
+	id uint64
+}
+
+type refundOrderExpectedOutput struct {
+	refunded bool
+	err      error
+}
+
+func TestOrderService_RefundOrder(t *testing.T) {
+	ctx := context.Background()
+	userID := gofakeit.Uint64()
+	customerID := gofakeit.Uint64()
+	refundedAt := time.Now()
+	policy := domainloyalty.Policy{
+		EarnRate:   0.01,
+		PointValue: 100,
+	}
+
+	cash := &domainpayment.Payment{
+		ID:   gofakeit.Uint64(),
+		Name: "Cash",
+		Type: domainpayment.Cash,
+	}
+	user := &domainuser.User{
+		ID:   userID,
+		Name: gofakeit.Name(),
+	}
+	product := &domainproduct.Product{
+		ID:         gofakeit.Uint64(),
+		CategoryID: gofakeit.Uint64(),
+		Name:       gofakeit.Dessert(),
+		Stock:      100,
+		Price:      40000,
+	}
+
+	newOrder := func(customerID *uint64, pointsRedeemed, pointsEarned int64, refundedAt *time.Time) *domainorder.Order {
+		return &domainorder.Order{
+			ID:             gofakeit.Uint64(),
+			UserID:         userID,
+			PaymentID:      cash.ID,
+			CustomerID:     customerID,
+			TotalPrice:     40000,
+			TotalPaid:      40000,
+			PointsRedeemed: pointsRedeemed,
+			PointsEarned:   pointsEarned,
+			RefundedAt:     refundedAt,
+			Products: []domainorder.OrderProduct{
+				{ProductID: product.ID, Quantity: 1, TotalPrice: 40000},
+			},
+		}
+	}
+
+	// expectRefund refunds the order by the given points and loads it again afterwards
+	expectRefund := func(m orderMocks, order *domainorder.Order, points int64) {
+		m.orderRepo.EXPECT().
+			GetOrderByID(gomock.Any(), gomock.Eq(order.ID)).
+			Return(order, nil)
+		m.orderRepo.EXPECT().
+			RefundOrder(gomock.Any(), gomock.Eq(order.ID), gomock.Eq(points)).
+			Return(order, nil)
+		m.orderRepo.EXPECT().
+			GetOrderByID(gomock.Any(), gomock.Eq(order.ID)).
+			Return(order, nil)
+		m.userRepo.EXPECT().
+			GetUserByID(gomock.Any(), gomock.Eq(userID)).
+			Return(user, nil)
+		m.paymentRepo.EXPECT().
+			GetPaymentByID(gomock.Any(), gomock.Eq(cash.ID)).
+			Return(cash, nil)
+		m.expectCatalog(product)
+	}
+
+	giftCardID := gofakeit.Uint64()
+	giftCardTenderOrder := newOrder(nil, 0, 0, nil)
+	giftCardTenderOrder.GiftCardID = &giftCardID
+	giftCardTenderOrder.GiftCardAmount = 25000
+	giftCardSaleOrder := &domainorder.Order{
+		ID:             gofakeit.Uint64(),
+		UserID:         userID,
+		PaymentID:      cash.ID,
+		TotalPrice:     500000,
+		TotalPaid:      500000,
+		SoldGiftCardID: &giftCardID,
+	}
+	spentGiftCardSaleOrder := &domainorder.Order{
+		ID:             gofakeit.Uint64(),
+		UserID:         userID,
+		PaymentID:      cash.ID,
+		TotalPrice:     500000,
+		TotalPaid:      500000,
+		SoldGiftCardID: &giftCardID,
+	}
+
+	walkInOrder := newOrder(nil, 0, 0, nil)
+	earnedOrder := newOrder(&customerID, 0, 400, nil)
+	redeemedOrder := newOrder(&customerID, 100, 300, nil)
+	spentOrder := newOrder(&customerID, 0, 400, nil)
+	refundedOrder := newOrder(&customerID, 0, 400, &refundedAt)
+	racedOrder := newOrder(&customerID, 0, 400, nil)
+
+	testCases := []struct {
+		desc     string
+		mocks    func(m orderMocks)
+		input    refundOrderTestedInput
+		expected refundOrderExpectedOutput
+	}{
+		{
+			desc: "Success_WalkIn",
+			mocks: func(m orderMocks) {
+				expectRefund(m, walkInOrder, 0)
+			},
+			input: refundOrderTestedInput{
+				id: walkInOrder.ID,
+			},
+			expected: refundOrderExpectedOutput{
+				refunded: true,
+				err:      nil,
+			},
+		},
+		{
+			desc: "Success_TakeBackEarnedPoints",
+			mocks: func(m orderMocks) {
+				m.loyaltyRepo.EXPECT().
+					GetBalance(gomock.Any(), gomock.Eq(customerID)).
+					Return(int64(1000), nil)
+				expectRefund(m, earnedOrder, -400)
+			},
+			input: refundOrderTestedInput{
+				id: earnedOrder.ID,
+			},
+			expected: refundOrderExpectedOutput{
+				refunded: true,
+				err:      nil,
+			},
+		},
+		{
+			desc: "Success_GiveBackRedeemedPoints",
+			mocks: func(m orderMocks) {
+				m.loyaltyRepo.EXPECT().
+					GetBalance(gomock.Any(), gomock.Eq(customerID)).
+					Return(int64(1000), nil)
+				expectRefund(m, redeemedOrder, -200)
+			},
+			input: refundOrderTestedInput{
+				id: redeemedOrder.ID,
+			},
+			expected: refundOrderExpectedOutput{
+				refunded: true,
+				err:      nil,
+			},
+		},
+		{
+			desc: "Success_ClampSpentPoints",
+			mocks: func(m orderMocks) {
+				// 400 points were earned but only 150 are left, the balance must not go below zero
+				m.loyaltyRepo.EXPECT().
+					GetBalance(gomock.Any(), gomock.Eq(customerID)).
+					Return(int64(150), nil)
+				expectRefund(m, spentOrder, -150)
+			},
+			input: refundOrderTestedInput{
+				id: spentOrder.ID,
+			},
+			expected: refundOrderExpectedOutput{
+				refunded: true,
+				err:      nil,
+			},
+		},
+		{
+			desc: "Success_GiftCardTender",
+			mocks: func(m orderMocks) {
+				expectRefund(m, giftCardTenderOrder, 0)
+			},
+			input: refundOrderTestedInput{
+				id: giftCardTenderOrder.ID,
+			},
+			expected: refundOrderExpectedOutput{
+				refunded: true,
+				err:      nil,
+			},
+		},
+		{
+			desc: "Success_GiftCardSale",
+			mocks: func(m orderMocks) {
+				expectRefund(m, giftCardSaleOrder, 0)
+			},
+			input: refundOrderTestedInput{
+				id: giftCardSaleOrder.ID,
+			},
+			expected: refundOrderExpectedOutput{
+				refunded: true,
+				err:      nil,
+			},
+		},
+		{
+			desc: "Fail_GiftCardSaleSpent",
+			mocks: func(m orderMocks) {
+				m.orderRepo.EXPECT().
+					GetOrderByID(gomock.Any(), gomock.Eq(spentGiftCardSaleOrder.ID)).
+					Return(spentGiftCardSaleOrder, nil)
+				m.orderRepo.EXPECT().
+					RefundOrder(gomock.Any(), gomock.Eq(spentGiftCardSaleOrder.ID), gomock.Eq(int64(0))).
+					Return(nil, domain.ErrInsufficientGiftCardBalance)
+			},
+			input: refundOrderTestedInput{
+				id: spentGiftCardSaleOrder.ID,
+			},
+			expected: refundOrderExpectedOutput{
+				refunded: false,
+				err:      domain.ErrInsufficientGiftCardBalance,
+			},
+		},
+		{
+			desc: "Fail_AlreadyRefunded",
+			mocks: func(m orderMocks) {
+				m.orderRepo.EXPECT().
+					GetOrderByID(gomock.Any(), gomock.Eq(refundedOrder.ID)).
+					Return(refundedOrder, nil)
+			},
+			input: refundOrderTestedInput{
+				id: refundedOrder.ID,
+			},
+			expected: refundOrderExpectedOutput{
+				refunded: false,
+				err:      domain.ErrOrderRefunded,
+			},
+		},
+		{
+			desc: "Fail_PointsSpentMeanwhile",
+			mocks: func(m orderMocks) {
+				m.orderRepo.EXPECT().
+					GetOrderByID(gomock.Any(), gomock.Eq(racedOrder.ID)).
+					Return(racedOrder, nil)
+				m.loyaltyRepo.EXPECT().
+					GetBalance(gomock.Any(), gomock.Eq(customerID)).
+					Return(int64(1000), nil)
+				m.orderRepo.EXPECT().
+					RefundOrder(gomock.Any(), gomock.Eq(racedOrder.ID), gomock.Eq(int64(-400))).
+					Return(nil, domain.ErrInsufficientPoints)
+			},
+			input: refundOrderTestedInput{
+				id: racedOrder.ID,
+			},
+			expected: refundOrderExpectedOutput{
+				refunded: false,
+				err:      domain.ErrInsufficientPoints,
+			},
+		},
+		{
+			desc: "Fail_NotFound",
+			mocks: func(m orderMocks) {
+				m.orderRepo.EXPECT().
+					GetOrderByID(gomock.Any(), gomock.Eq(walkInOrder.ID)).
+					Return(nil, domain.ErrDataNotFound)
+			},
+			input: refundOrderTestedInput{
+				id: walkInOrder.ID,
+			},
+			expected: refundOrderExpectedOutput{
+				refunded: false,
+				err:      domain.ErrDataNotFound,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.desc, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := newOrderMocks(ctrl)
+			auditService := newAuditStub(ctrl)
+
+			tc.mocks(m)
+
+			orderService := NewOrderUsecase(m.orderRepo, m.productRepo, m.categoryRepo, m.userRepo, m.paymentRepo,
+				m.customerRepo, m.giftCardRepo, m.loyaltyRepo, m.cache, policy, m.metrics, auditService)
+
+			order, err := orderService.RefundOrder(ctx, tc.input.id)
+			assert.ErrorIs(t, err, tc.expected.err, "Error mismatch")
+			assert.Equal(t, tc.expected.refunded, order != nil, "Order mismatch")
+		})
+	}
+}
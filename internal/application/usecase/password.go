@@ -0,0 +1,255 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain"
+	domainaudit "github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain/audit"
+	domainauth "github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain/auth"
+	domainmail "github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain/mail"
+	"github.com/TienMinh25/go-hexagonal-architecture/internal/application/port"
+	"github.com/TienMinh25/go-hexagonal-architecture/internal/application/util"
+)
+
+/**
+ * passwordUsecase implements port.PasswordService interface
+ * and provides an access to the user, password reset and refresh token repositories,
+ * the mailer, cache service and audit service
+ */
+type passwordUsecase struct {
+	userRepo    port.UserRepository
+	resetRepo   port.PasswordResetRepository
+	refreshRepo port.RefreshTokenRepository
+	mailer      port.Mailer
+	cache       port.CacheRepository
+	policy      domainauth.PasswordResetPolicy
+	audit       port.AuditService
+	pending     sync.WaitGroup
+}
+
+// NewPasswordUsecase creates a new password service instance
+func NewPasswordUsecase(
+	userRepo port.UserRepository,
+	resetRepo port.PasswordResetRepository,
+	refreshRepo port.RefreshTokenRepository,
+	mailer port.Mailer,
+	cache port.CacheRepository,
+	policy domainauth.PasswordResetPolicy,
+	audit port.AuditService,
+) port.PasswordService {
+	return &passwordUsecase{
+		userRepo,
+		resetRepo,
+		refreshRepo,
+		mailer,
+		cache,
+		policy,
+		audit,
+		sync.WaitGroup{},
+	}
+}
+
+// ChangePassword checks the current password of a user, replaces it and revokes the refresh tokens of the user
+func (ps *passwordUsecase) ChangePassword(ctx context.Context, userID uint64, currentPassword, newPassword string) error {
+	user, err := ps.userRepo.GetUserByID(ctx, userID)
+	if err != nil {
+		if errors.Is(err, domain.ErrDataNotFound) {
+			return err
+		}
+		return domain.ErrInternal.Wrap(err)
+	}
+
+	if user.DeletedAt != nil {
+		return domain.ErrDataArchived
+	}
+
+	err = util.ComparePassword(currentPassword, user.Password)
+	if err != nil {
+		return domain.ErrIncorrectPassword
+	}
+
+	hashedPassword, err := util.HashPassword(newPassword)
+	if err != nil {
+		return domain.ErrInternal.Wrap(err)
+	}
+
+	err = ps.userRepo.UpdateUserPassword(ctx, userID, hashedPassword)
+	if err != nil {
+		if errors.Is(err, domain.ErrDataNotFound) {
+			return err
+		}
+		return domain.ErrInternal.Wrap(err)
+	}
+
+	err = ps.audit.Record(ctx, domainaudit.ChangePassword, domainaudit.User, userID, nil, nil)
+	if err != nil {
+		return err
+	}
+
+	return ps.signOut(ctx, userID)
+}
+
+// RequestPasswordReset mails a single-use reset link to the user with the email.
+// The user is looked up and mailed after returning, and failures are only logged,
+// so that neither the response nor its timing tells whether the account exists
+func (ps *passwordUsecase) RequestPasswordReset(ctx context.Context, email, ip string) error {
+	err := ps.checkResetThrottle(ctx, email, ip)
+	if err != nil {
+		return err
+	}
+
+	// the mail is sent even when the client has gone away after the response
+	ctx = context.WithoutCancel(ctx)
+
+	ps.pending.Add(1)
+	go func() {
+		defer ps.pending.Done()
+
+		err := ps.sendPasswordReset(ctx, email)
+		if err != nil {
+			slog.ErrorContext(ctx, "Error sending password reset", "error", err)
+		}
+	}()
+
+	return nil
+}
+
+// Close waits until the reset mails being sent in the background are done, or the context is done first
+func (ps *passwordUsecase) Close(ctx context.Context) error {
+	done := make(chan struct{})
+	go func() {
+		ps.pending.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// sendPasswordReset creates a reset token for the user with the email and mails its link.
+// Unknown and archived emails are skipped
+func (ps *passwordUsecase) sendPasswordReset(ctx context.Context, email string) error {
+	user, err := ps.userRepo.GetUserByEmail(ctx, email)
+	if err != nil {
+		if errors.Is(err, domain.ErrDataNotFound) {
+			return nil
+		}
+		return err
+	}
+
+	if user.DeletedAt != nil {
+		return nil
+	}
+
+	token, err := util.GenerateToken()
+	if err != nil {
+		return err
+	}
+
+	_, err = ps.resetRepo.CreatePasswordResetToken(ctx, &domainauth.PasswordResetToken{
+		UserID:    user.ID,
+		TokenHash: util.HashToken(token),
+		ExpiresAt: time.Now().Add(ps.policy.Duration),
+	})
+	if err != nil {
+		return err
+	}
+
+	err = ps.mailer.Send(ctx, &domainmail.Message{
+		To:      user.Email,
+		Subject: "Reset your password",
+		Body: fmt.Sprintf(
+			"Hi %s,\n\nUse the link below to reset your password, it expires in %s.\n\n%s\n\nIf you did not ask for a password reset, you can ignore this email.\n",
+			user.Name,
+			ps.policy.Duration,
+			ps.resetLink(token),
+		),
+	})
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// checkResetThrottle counts a reset request of the client ip and the email, failing once either
+// is over its limit within the window. An unreachable cache lets requests through like logins
+func (ps *passwordUsecase) checkResetThrottle(ctx context.Context, email, ip string) error {
+	if ip != "" {
+		requests, err := ps.cache.Increment(ctx, loginKey("password_reset_requests", "ip", ip), ps.policy.Window)
+		if err == nil && requests > ps.policy.IPMaxRequests {
+			return domain.ErrTooManyResetRequests
+		}
+	}
+
+	requests, err := ps.cache.Increment(ctx, loginKey("password_reset_requests", "email", email), ps.policy.Window)
+	if err == nil && requests > ps.policy.MaxRequests {
+		return domain.ErrTooManyResetRequests
+	}
+
+	return nil
+}
+
+// ResetPassword replaces the password of the user the reset token was sent to and revokes the refresh tokens of the user
+func (ps *passwordUsecase) ResetPassword(ctx context.Context, token, newPassword string) error {
+	hashedPassword, err := util.HashPassword(newPassword)
+	if err != nil {
+		return domain.ErrInternal.Wrap(err)
+	}
+
+	userID, err := ps.resetRepo.ResetPassword(ctx, util.HashToken(token), hashedPassword)
+	if err != nil {
+		if errors.Is(err, domain.ErrInvalidResetToken) {
+			return err
+		}
+		return domain.ErrInternal.Wrap(err)
+	}
+
+	err = ps.audit.Record(ctx, domainaudit.ResetPassword, domainaudit.User, userID, nil, nil)
+	if err != nil {
+		return err
+	}
+
+	return ps.signOut(ctx, userID)
+}
+
+// signOut revokes the refresh tokens of a user and drops the cached user holding the old password hash
+func (ps *passwordUsecase) signOut(ctx context.Context, userID uint64) error {
+	err := ps.refreshRepo.RevokeUserRefreshTokens(ctx, userID)
+	if err != nil {
+		return domain.ErrInternal.Wrap(err)
+	}
+
+	cacheKey := util.GenerateCacheKey("user", userID)
+
+	err = ps.cache.Delete(ctx, cacheKey)
+	if err != nil {
+		return domain.ErrInternal.Wrap(err)
+	}
+
+	return nil
+}
+
+// resetLink adds the token to the configured reset URL, or returns the bare token when none is configured
+func (ps *passwordUsecase) resetLink(token string) string {
+	link, err := url.Parse(ps.policy.URL)
+	if err != nil || ps.policy.URL == "" {
+		return token
+	}
+
+	query := link.Query()
+	query.Set("token", token)
+	link.RawQuery = query.Encode()
+
+	return link.String()
+}
@@ -0,0 +1,703 @@
+package usecase
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain"
+	domainauth "github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain/auth"
+	domainmail "github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain/mail"
+	domainuser "github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain/user"
+	"github.com/TienMinh25/go-hexagonal-architecture/internal/application/mock"
+	"github.com/TienMinh25/go-hexagonal-architecture/internal/application/util"
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+var passwordReset = domainauth.PasswordResetPolicy{
+	Duration:      time.Hour,
+	URL:           "http://127.0.0.1:5173/reset-password",
+	MaxRequests:   3,
+	IPMaxRequests: 20,
+	Window:        time.Hour,
+}
+
+type changePasswordTestedInput struct {
+	currentPassword string
+	newPassword     string
+}
+
+type changePasswordExpectedOutput struct {
+	err error
+}
+
+func TestPasswordService_ChangePassword(t *testing.T) {
+	ctx := context.Background()
+	password := gofakeit.Password(true, true, true, true, false, 8)
+	hashedPassword, _ := util.HashPassword(password)
+	newPassword := gofakeit.Password(true, true, true, true, false, 8)
+	deletedAt := gofakeit.Date()
+
+	user := &domainuser.User{
+		ID:       gofakeit.Uint64(),
+		Email:    gofakeit.Email(),
+		Password: hashedPassword,
+	}
+	archivedUser := &domainuser.User{
+		ID:        user.ID,
+		Email:     user.Email,
+		Password:  hashedPassword,
+		DeletedAt: &deletedAt,
+	}
+	cacheKey := util.GenerateCacheKey("user", user.ID)
+
+	testCases := []struct {
+		desc  string
+		mocks func(
+			userRepo *mock.MockUserRepository,
+			resetRepo *mock.MockPasswordResetRepository,
+			refreshRepo *mock.MockRefreshTokenRepository,
+			mailer *mock.MockMailer,
+			cache *mock.MockCacheRepository,
+		)
+		input    changePasswordTestedInput
+		expected changePasswordExpectedOutput
+	}{
+		{
+			desc: "Success",
+			mocks: func(
+				userRepo *mock.MockUserRepository,
+				resetRepo *mock.MockPasswordResetRepository,
+				refreshRepo *mock.MockRefreshTokenRepository,
+				mailer *mock.MockMailer,
+				cache *mock.MockCacheRepository,
+			) {
+				userRepo.EXPECT().
+					GetUserByID(gomock.Any(), gomock.Eq(user.ID)).
+					Return(user, nil)
+				userRepo.EXPECT().
+					UpdateUserPassword(gomock.Any(), gomock.Eq(user.ID), gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ uint64, passwordHash string) error {
+						assert.NoError(t, util.ComparePassword(newPassword, passwordHash))
+						return nil
+					})
+				refreshRepo.EXPECT().
+					RevokeUserRefreshTokens(gomock.Any(), gomock.Eq(user.ID)).
+					Return(nil)
+				cache.EXPECT().
+					Delete(gomock.Any(), gomock.Eq(cacheKey)).
+					Return(nil)
+			},
+			input: changePasswordTestedInput{
+				currentPassword: password,
+				newPassword:     newPassword,
+			},
+			expected: changePasswordExpectedOutput{
+				err: nil,
+			},
+		},
+		{
+			desc: "Fail_IncorrectPassword",
+			mocks: func(
+				userRepo *mock.MockUserRepository,
+				resetRepo *mock.MockPasswordResetRepository,
+				refreshRepo *mock.MockRefreshTokenRepository,
+				mailer *mock.MockMailer,
+				cache *mock.MockCacheRepository,
+			) {
+				userRepo.EXPECT().
+					GetUserByID(gomock.Any(), gomock.Eq(user.ID)).
+					Return(user, nil)
+			},
+			input: changePasswordTestedInput{
+				currentPassword: "wrong-password",
+				newPassword:     newPassword,
+			},
+			expected: changePasswordExpectedOutput{
+				err: domain.ErrIncorrectPassword,
+			},
+		},
+		{
+			desc: "Fail_ArchivedUser",
+			mocks: func(
+				userRepo *mock.MockUserRepository,
+				resetRepo *mock.MockPasswordResetRepository,
+				refreshRepo *mock.MockRefreshTokenRepository,
+				mailer *mock.MockMailer,
+				cache *mock.MockCacheRepository,
+			) {
+				userRepo.EXPECT().
+					GetUserByID(gomock.Any(), gomock.Eq(user.ID)).
+					Return(archivedUser, nil)
+			},
+			input: changePasswordTestedInput{
+				currentPassword: password,
+				newPassword:     newPassword,
+			},
+			expected: changePasswordExpectedOutput{
+				err: domain.ErrDataArchived,
+			},
+		},
+		{
+			desc: "Fail_InternalError",
+			mocks: func(
+				userRepo *mock.MockUserRepository,
+				resetRepo *mock.MockPasswordResetRepository,
+				refreshRepo *mock.MockRefreshTokenRepository,
+				mailer *mock.MockMailer,
+				cache *mock.MockCacheRepository,
+			) {
+				userRepo.EXPECT().
+					GetUserByID(gomock.Any(), gomock.Eq(user.ID)).
+					Return(user, nil)
+				userRepo.EXPECT().
+					UpdateUserPassword(gomock.Any(), gomock.Eq(user.ID), gomock.Any()).
+					Return(domain.ErrInternal)
+			},
+			input: changePasswordTestedInput{
+				currentPassword: password,
+				newPassword:     newPassword,
+			},
+			expected: changePasswordExpectedOutput{
+				err: domain.ErrInternal,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.desc, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			userRepo := mock.NewMockUserRepository(ctrl)
+			resetRepo := mock.NewMockPasswordResetRepository(ctrl)
+			refreshRepo := mock.NewMockRefreshTokenRepository(ctrl)
+			mailer := mock.NewMockMailer(ctrl)
+			cache := mock.NewMockCacheRepository(ctrl)
+			auditService := newAuditStub(ctrl)
+
+			tc.mocks(userRepo, resetRepo, refreshRepo, mailer, cache)
+
+			passwordService := NewPasswordUsecase(userRepo, resetRepo, refreshRepo, mailer, cache, passwordReset, auditService)
+
+			err := passwordService.ChangePassword(ctx, user.ID, tc.input.currentPassword, tc.input.newPassword)
+			assert.ErrorIs(t, err, tc.expected.err, "Error mismatch")
+		})
+	}
+}
+
+type requestPasswordResetTestedInput struct {
+	email string
+	ip    string
+}
+
+type requestPasswordResetExpectedOutput struct {
+	err error
+}
+
+func TestPasswordService_RequestPasswordReset(t *testing.T) {
+	ctx := context.Background()
+	deletedAt := gofakeit.Date()
+
+	user := &domainuser.User{
+		ID:    gofakeit.Uint64(),
+		Name:  gofakeit.Name(),
+		Email: gofakeit.Email(),
+	}
+	archivedUser := &domainuser.User{
+		ID:        user.ID,
+		Email:     user.Email,
+		DeletedAt: &deletedAt,
+	}
+	ip := gofakeit.IPv4Address()
+	ipRequestsKey := loginKey("password_reset_requests", "ip", ip)
+	emailRequestsKey := loginKey("password_reset_requests", "email", user.Email)
+
+	expectRequestsCounted := func(cache *mock.MockCacheRepository, ipRequests, emailRequests int64) {
+		cache.EXPECT().
+			Increment(gomock.Any(), gomock.Eq(ipRequestsKey), gomock.Eq(passwordReset.Window)).
+			Times(1).
+			Return(ipRequests, nil)
+		cache.EXPECT().
+			Increment(gomock.Any(), gomock.Eq(emailRequestsKey), gomock.Eq(passwordReset.Window)).
+			Times(1).
+			Return(emailRequests, nil)
+	}
+
+	testCases := []struct {
+		desc  string
+		mocks func(
+			userRepo *mock.MockUserRepository,
+			resetRepo *mock.MockPasswordResetRepository,
+			refreshRepo *mock.MockRefreshTokenRepository,
+			mailer *mock.MockMailer,
+			cache *mock.MockCacheRepository,
+		)
+		input    requestPasswordResetTestedInput
+		expected requestPasswordResetExpectedOutput
+	}{
+		{
+			desc: "Success",
+			mocks: func(
+				userRepo *mock.MockUserRepository,
+				resetRepo *mock.MockPasswordResetRepository,
+				refreshRepo *mock.MockRefreshTokenRepository,
+				mailer *mock.MockMailer,
+				cache *mock.MockCacheRepository,
+			) {
+				var tokenHash string
+
+				expectRequestsCounted(cache, 1, 1)
+
+				userRepo.EXPECT().
+					GetUserByEmail(gomock.Any(), gomock.Eq(user.Email)).
+					Return(user, nil)
+				resetRepo.EXPECT().
+					CreatePasswordResetToken(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, token *domainauth.PasswordResetToken) (*domainauth.PasswordResetToken, error) {
+						assert.Equal(t, user.ID, token.UserID)
+						assert.WithinDuration(t, time.Now().Add(passwordReset.Duration), token.ExpiresAt, time.Minute)
+						tokenHash = token.TokenHash
+						return token, nil
+					})
+				mailer.EXPECT().
+					Send(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, message *domainmail.Message) error {
+						assert.Equal(t, user.Email, message.To)
+
+						_, link, _ := strings.Cut(message.Body, passwordReset.URL+"?token=")
+						token, _, _ := strings.Cut(link, "\n")
+						assert.Equal(t, tokenHash, util.HashToken(token), "Mailed token does not match the stored hash")
+						return nil
+					})
+			},
+			input: requestPasswordResetTestedInput{
+				email: user.Email,
+				ip:    ip,
+			},
+			expected: requestPasswordResetExpectedOutput{
+				err: nil,
+			},
+		},
+		{
+			desc: "Success_UnknownEmail",
+			mocks: func(
+				userRepo *mock.MockUserRepository,
+				resetRepo *mock.MockPasswordResetRepository,
+				refreshRepo *mock.MockRefreshTokenRepository,
+				mailer *mock.MockMailer,
+				cache *mock.MockCacheRepository,
+			) {
+				cache.EXPECT().
+					Increment(gomock.Any(), gomock.Any(), gomock.Eq(passwordReset.Window)).
+					Times(2).
+					Return(int64(1), nil)
+				userRepo.EXPECT().
+					GetUserByEmail(gomock.Any(), gomock.Any()).
+					Return(nil, domain.ErrDataNotFound)
+			},
+			input: requestPasswordResetTestedInput{
+				email: gofakeit.Email(),
+				ip:    ip,
+			},
+			expected: requestPasswordResetExpectedOutput{
+				err: nil,
+			},
+		},
+		{
+			desc: "Success_ArchivedUser",
+			mocks: func(
+				userRepo *mock.MockUserRepository,
+				resetRepo *mock.MockPasswordResetRepository,
+				refreshRepo *mock.MockRefreshTokenRepository,
+				mailer *mock.MockMailer,
+				cache *mock.MockCacheRepository,
+			) {
+				expectRequestsCounted(cache, 1, 1)
+				userRepo.EXPECT().
+					GetUserByEmail(gomock.Any(), gomock.Eq(user.Email)).
+					Return(archivedUser, nil)
+			},
+			input: requestPasswordResetTestedInput{
+				email: user.Email,
+				ip:    ip,
+			},
+			expected: requestPasswordResetExpectedOutput{
+				err: nil,
+			},
+		},
+		{
+			desc: "Success_MailerError",
+			mocks: func(
+				userRepo *mock.MockUserRepository,
+				resetRepo *mock.MockPasswordResetRepository,
+				refreshRepo *mock.MockRefreshTokenRepository,
+				mailer *mock.MockMailer,
+				cache *mock.MockCacheRepository,
+			) {
+				expectRequestsCounted(cache, 1, 1)
+				userRepo.EXPECT().
+					GetUserByEmail(gomock.Any(), gomock.Eq(user.Email)).
+					Return(user, nil)
+				resetRepo.EXPECT().
+					CreatePasswordResetToken(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, token *domainauth.PasswordResetToken) (*domainauth.PasswordResetToken, error) {
+						return token, nil
+					})
+				mailer.EXPECT().
+					Send(gomock.Any(), gomock.Any()).
+					Return(domain.ErrInternal)
+			},
+			input: requestPasswordResetTestedInput{
+				email: user.Email,
+				ip:    ip,
+			},
+			expected: requestPasswordResetExpectedOutput{
+				err: nil,
+			},
+		},
+		{
+			desc: "Success_CacheUnavailable",
+			mocks: func(
+				userRepo *mock.MockUserRepository,
+				resetRepo *mock.MockPasswordResetRepository,
+				refreshRepo *mock.MockRefreshTokenRepository,
+				mailer *mock.MockMailer,
+				cache *mock.MockCacheRepository,
+			) {
+				cache.EXPECT().
+					Increment(gomock.Any(), gomock.Any(), gomock.Eq(passwordReset.Window)).
+					Times(2).
+					Return(int64(0), domain.ErrInternal)
+				userRepo.EXPECT().
+					GetUserByEmail(gomock.Any(), gomock.Eq(user.Email)).
+					Return(archivedUser, nil)
+			},
+			input: requestPasswordResetTestedInput{
+				email: user.Email,
+				ip:    ip,
+			},
+			expected: requestPasswordResetExpectedOutput{
+				err: nil,
+			},
+		},
+		{
+			desc: "Fail_TooManyRequestsFromIP",
+			mocks: func(
+				userRepo *mock.MockUserRepository,
+				resetRepo *mock.MockPasswordResetRepository,
+				refreshRepo *mock.MockRefreshTokenRepository,
+				mailer *mock.MockMailer,
+				cache *mock.MockCacheRepository,
+			) {
+				cache.EXPECT().
+					Increment(gomock.Any(), gomock.Eq(ipRequestsKey), gomock.Eq(passwordReset.Window)).
+					Times(1).
+					Return(passwordReset.IPMaxRequests+1, nil)
+			},
+			input: requestPasswordResetTestedInput{
+				email: user.Email,
+				ip:    ip,
+			},
+			expected: requestPasswordResetExpectedOutput{
+				err: domain.ErrTooManyResetRequests,
+			},
+		},
+		{
+			desc: "Fail_TooManyRequestsForEmail",
+			mocks: func(
+				userRepo *mock.MockUserRepository,
+				resetRepo *mock.MockPasswordResetRepository,
+				refreshRepo *mock.MockRefreshTokenRepository,
+				mailer *mock.MockMailer,
+				cache *mock.MockCacheRepository,
+			) {
+				expectRequestsCounted(cache, 1, passwordReset.MaxRequests+1)
+			},
+			input: requestPasswordResetTestedInput{
+				email: user.Email,
+				ip:    ip,
+			},
+			expected: requestPasswordResetExpectedOutput{
+				err: domain.ErrTooManyResetRequests,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.desc, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			userRepo := mock.NewMockUserRepository(ctrl)
+			resetRepo := mock.NewMockPasswordResetRepository(ctrl)
+			refreshRepo := mock.NewMockRefreshTokenRepository(ctrl)
+			mailer := mock.NewMockMailer(ctrl)
+			cache := mock.NewMockCacheRepository(ctrl)
+			auditService := newAuditStub(ctrl)
+
+			tc.mocks(userRepo, resetRepo, refreshRepo, mailer, cache)
+
+			passwordService := NewPasswordUsecase(userRepo, resetRepo, refreshRepo, mailer, cache, passwordReset, auditService)
+
+			err := passwordService.RequestPasswordReset(ctx, tc.input.email, tc.input.ip)
+			assert.ErrorIs(t, err, tc.expected.err, "Error mismatch")
+
+			// the mail is sent in the background
+			passwordService.(*passwordUsecase).pending.Wait()
+		})
+	}
+}
+
+type closeTestedInput struct {
+	timeout time.Duration
+}
+
+type closeExpectedOutput struct {
+	err error
+}
+
+func TestPasswordService_Close(t *testing.T) {
+	ctx := context.Background()
+
+	user := &domainuser.User{
+		ID:    gofakeit.Uint64(),
+		Name:  gofakeit.Name(),
+		Email: gofakeit.Email(),
+	}
+	ip := gofakeit.IPv4Address()
+
+	testCases := []struct {
+		desc     string
+		input    closeTestedInput
+		expected closeExpectedOutput
+	}{
+		{
+			desc: "Success_WaitsForPendingMail",
+			input: closeTestedInput{
+				timeout: time.Minute,
+			},
+			expected: closeExpectedOutput{
+				err: nil,
+			},
+		},
+		{
+			desc: "Fail_ContextDone",
+			input: closeTestedInput{
+				timeout: 10 * time.Millisecond,
+			},
+			expected: closeExpectedOutput{
+				err: context.DeadlineExceeded,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.desc, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			userRepo := mock.NewMockUserRepository(ctrl)
+			resetRepo := mock.NewMockPasswordResetRepository(ctrl)
+			mailer := mock.NewMockMailer(ctrl)
+			cache := mock.NewMockCacheRepository(ctrl)
+			auditService := newAuditStub(ctrl)
+
+			sending := make(chan struct{})
+			release := make(chan struct{})
+			sent := make(chan struct{})
+
+			cache.EXPECT().
+				Increment(gomock.Any(), gomock.Any(), gomock.Eq(passwordReset.Window)).
+				Times(2).
+				Return(int64(1), nil)
+			userRepo.EXPECT().
+				GetUserByEmail(gomock.Any(), gomock.Eq(user.Email)).
+				Return(user, nil)
+			resetRepo.EXPECT().
+				CreatePasswordResetToken(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(_ context.Context, token *domainauth.PasswordResetToken) (*domainauth.PasswordResetToken, error) {
+					return token, nil
+				})
+			mailer.EXPECT().
+				Send(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(_ context.Context, _ *domainmail.Message) error {
+					close(sending)
+					<-release
+					close(sent)
+					return nil
+				})
+
+			passwordService := NewPasswordUsecase(userRepo, resetRepo, nil, mailer, cache, passwordReset, auditService)
+
+			err := passwordService.RequestPasswordReset(ctx, user.Email, ip)
+			assert.NoError(t, err, "Request mismatch")
+			<-sending
+
+			closeCtx, cancel := context.WithTimeout(ctx, tc.input.timeout)
+			defer cancel()
+
+			closed := make(chan error, 1)
+			go func() {
+				closed <- passwordService.Close(closeCtx)
+			}()
+
+			if tc.expected.err == nil {
+				select {
+				case <-closed:
+					t.Fatal("Close returned before the pending mail was sent")
+				case <-time.After(20 * time.Millisecond):
+				}
+				close(release)
+			}
+
+			err = <-closed
+			assert.ErrorIs(t, err, tc.expected.err, "Error mismatch")
+
+			if tc.expected.err == nil {
+				select {
+				case <-sent:
+				default:
+					t.Fatal("Close returned before the pending mail was sent")
+				}
+			} else {
+				close(release)
+				<-sent
+			}
+		})
+	}
+}
+
+type resetPasswordTestedInput struct {
+	token string
+}
+
+type resetPasswordExpectedOutput struct {
+	err error
+}
+
+func TestPasswordService_ResetPassword(t *testing.T) {
+	ctx := context.Background()
+	token, _ := util.GenerateToken()
+	newPassword := gofakeit.Password(true, true, true, true, false, 8)
+	userID := gofakeit.Uint64()
+	cacheKey := util.GenerateCacheKey("user", userID)
+
+	testCases := []struct {
+		desc  string
+		mocks func(
+			userRepo *mock.MockUserRepository,
+			resetRepo *mock.MockPasswordResetRepository,
+			refreshRepo *mock.MockRefreshTokenRepository,
+			mailer *mock.MockMailer,
+			cache *mock.MockCacheRepository,
+		)
+		input    resetPasswordTestedInput
+		expected resetPasswordExpectedOutput
+	}{
+		{
+			desc: "Success",
+			mocks: func(
+				userRepo *mock.MockUserRepository,
+				resetRepo *mock.MockPasswordResetRepository,
+				refreshRepo *mock.MockRefreshTokenRepository,
+				mailer *mock.MockMailer,
+				cache *mock.MockCacheRepository,
+			) {
+				resetRepo.EXPECT().
+					ResetPassword(gomock.Any(), gomock.Eq(util.HashToken(token)), gomock.Any()).
+					DoAndReturn(func(_ context.Context, _, passwordHash string) (uint64, error) {
+						assert.NoError(t, util.ComparePassword(newPassword, passwordHash))
+						return userID, nil
+					})
+				refreshRepo.EXPECT().
+					RevokeUserRefreshTokens(gomock.Any(), gomock.Eq(userID)).
+					Return(nil)
+				cache.EXPECT().
+					Delete(gomock.Any(), gomock.Eq(cacheKey)).
+					Return(nil)
+			},
+			input: resetPasswordTestedInput{
+				token: token,
+			},
+			expected: resetPasswordExpectedOutput{
+				err: nil,
+			},
+		},
+		{
+			desc: "Fail_InvalidToken",
+			mocks: func(
+				userRepo *mock.MockUserRepository,
+				resetRepo *mock.MockPasswordResetRepository,
+				refreshRepo *mock.MockRefreshTokenRepository,
+				mailer *mock.MockMailer,
+				cache *mock.MockCacheRepository,
+			) {
+				resetRepo.EXPECT().
+					ResetPassword(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(uint64(0), domain.ErrInvalidResetToken)
+			},
+			input: resetPasswordTestedInput{
+				token: "used-or-expired",
+			},
+			expected: resetPasswordExpectedOutput{
+				err: domain.ErrInvalidResetToken,
+			},
+		},
+		{
+			desc: "Fail_InternalError",
+			mocks: func(
+				userRepo *mock.MockUserRepository,
+				resetRepo *mock.MockPasswordResetRepository,
+				refreshRepo *mock.MockRefreshTokenRepository,
+				mailer *mock.MockMailer,
+				cache *mock.MockCacheRepository,
+			) {
+				resetRepo.EXPECT().
+					ResetPassword(gomock.Any(), gomock.Eq(util.HashToken(token)), gomock.Any()).
+					Return(userID, nil)
+				refreshRepo.EXPECT().
+					RevokeUserRefreshTokens(gomock.Any(), gomock.Eq(userID)).
+					Return(domain.ErrInternal)
+			},
+			input: resetPasswordTestedInput{
+				token: token,
+			},
+			expected: resetPasswordExpectedOutput{
+				err: domain.ErrInternal,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.desc, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			userRepo := mock.NewMockUserRepository(ctrl)
+			resetRepo := mock.NewMockPasswordResetRepository(ctrl)
+			refreshRepo := mock.NewMockRefreshTokenRepository(ctrl)
+			mailer := mock.NewMockMailer(ctrl)
+			cache := mock.NewMockCacheRepository(ctrl)
+			auditService := newAuditStub(ctrl)
+
+			tc.mocks(userRepo, resetRepo, refreshRepo, mailer, cache)
+
+			passwordService := NewPasswordUsecase(userRepo, resetRepo, refreshRepo, mailer, cache, passwordReset, auditService)
+
+			err := passwordService.ResetPassword(ctx, tc.input.token, newPassword)
+			assert.ErrorIs(t, err, tc.expected.err, "Error mismatch")
+		})
+	}
+}
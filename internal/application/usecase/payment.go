@@ -2,8 +2,10 @@ package usecase
 
 import (
 	"context"
+	"errors"
 
 	"github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain"
+	domainaudit "github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain/audit"
 	domainpayment "github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain/payment"
 	"github.com/TienMinh25/go-hexagonal-architecture/internal/application/port"
 	"github.com/TienMinh25/go-hexagonal-architecture/internal/application/util"
@@ -11,19 +13,21 @@ import (
 
 /**
  * paymentUsecase implements port.PaymentService interface
- * and provides an access to the payment repository
- * and cache service
+ * and provides an access to the payment repository,
+ * cache service and audit service
  */
 type paymentUsecase struct {
 	repo  port.PaymentRepository
 	cache port.CacheRepository
+	audit port.AuditService
 }
 
 // NewPaymentUsecase creates a new payment service instance
-func NewPaymentUsecase(repo port.PaymentRepository, cache port.CacheRepository) port.PaymentService {
+func NewPaymentUsecase(repo port.PaymentRepository, cache port.CacheRepository, audit port.AuditService) port.PaymentService {
 	return &paymentUsecase{
 		repo,
 		cache,
+		audit,
 	}
 }
 
@@ -31,26 +35,31 @@ func NewPaymentUsecase(repo port.PaymentRepository, cache port.CacheRepository)
 func (ps *paymentUsecase) CreatePayment(ctx context.Context, payment *domainpayment.Payment) (*domainpayment.Payment, error) {
 	payment, err := ps.repo.CreatePayment(ctx, payment)
 	if err != nil {
-		if err == domain.ErrConflictingData {
+		if errors.Is(err, domain.ErrConflictingData) {
 			return nil, err
 		}
-		return nil, domain.ErrInternal
+		return nil, domain.ErrInternal.Wrap(err)
 	}
 
 	cacheKey := util.GenerateCacheKey("payment", payment.ID)
 	paymentSerialized, err := util.Serialize(payment)
 	if err != nil {
-		return nil, domain.ErrInternal
+		return nil, domain.ErrInternal.Wrap(err)
 	}
 
 	err = ps.cache.Set(ctx, cacheKey, paymentSerialized, 0)
 	if err != nil {
-		return nil, domain.ErrInternal
+		return nil, domain.ErrInternal.Wrap(err)
 	}
 
 	err = ps.cache.DeleteByPrefix(ctx, "payments:*")
 	if err != nil {
-		return nil, domain.ErrInternal
+		return nil, domain.ErrInternal.Wrap(err)
+	}
+
+	err = ps.audit.Record(ctx, domainaudit.Create, domainaudit.Payment, payment.ID, nil, payment)
+	if err != nil {
+		return nil, err
 	}
 
 	return payment, nil
@@ -65,7 +74,7 @@ func (ps *paymentUsecase) GetPayment(ctx context.Context, id uint64) (*domainpay
 	if err == nil {
 		err := util.Deserialize(cachedPayment, &payment)
 		if err != nil {
-			return nil, domain.ErrInternal
+			return nil, domain.ErrInternal.Wrap(err)
 		}
 
 		return payment, nil
@@ -73,55 +82,55 @@ func (ps *paymentUsecase) GetPayment(ctx context.Context, id uint64) (*domainpay
 
 	payment, err = ps.repo.GetPaymentByID(ctx, id)
 	if err != nil {
-		if err == domain.ErrDataNotFound {
+		if errors.Is(err, domain.ErrDataNotFound) {
 			return nil, err
 		}
-		return nil, domain.ErrInternal
+		return nil, domain.ErrInternal.Wrap(err)
 	}
 
 	paymentSerialized, err := util.Serialize(payment)
 	if err != nil {
-		return nil, domain.ErrInternal
+		return nil, domain.ErrInternal.Wrap(err)
 	}
 
 	err = ps.cache.Set(ctx, cacheKey, paymentSerialized, 0)
 	if err != nil {
-		return nil, domain.ErrInternal
+		return nil, domain.ErrInternal.Wrap(err)
 	}
 
 	return payment, nil
 }
 
 // ListPayments retrieves a list of payments
-func (ps *paymentUsecase) ListPayments(ctx context.Context, skip, limit uint64) ([]domainpayment.Payment, error) {
+func (ps *paymentUsecase) ListPayments(ctx context.Context, skip, limit uint64, includeArchived bool) ([]domainpayment.Payment, error) {
 	var payments []domainpayment.Payment
 
-	params := util.GenerateCacheKeyParams(skip, limit)
+	params := util.GenerateCacheKeyParams(skip, limit, includeArchived)
 	cacheKey := util.GenerateCacheKey("payments", params)
 
 	cachedPayments, err := ps.cache.Get(ctx, cacheKey)
 	if err == nil {
 		err := util.Deserialize(cachedPayments, &payments)
 		if err != nil {
-			return nil, domain.ErrInternal
+			return nil, domain.ErrInternal.Wrap(err)
 		}
 
 		return payments, nil
 	}
 
-	payments, err = ps.repo.ListPayments(ctx, skip, limit)
+	payments, err = ps.repo.ListPayments(ctx, skip, limit, includeArchived)
 	if err != nil {
-		return nil, domain.ErrInternal
+		return nil, domain.ErrInternal.Wrap(err)
 	}
 
 	paymentsSerialized, err := util.Serialize(payments)
 	if err != nil {
-		return nil, domain.ErrInternal
+		return nil, domain.ErrInternal.Wrap(err)
 	}
 
 	err = ps.cache.Set(ctx, cacheKey, paymentsSerialized, 0)
 	if err != nil {
-		return nil, domain.ErrInternal
+		return nil, domain.ErrInternal.Wrap(err)
 	}
 
 	return payments, nil
@@ -132,10 +141,18 @@ func (ps *paymentUsecase) ListPayments(ctx context.Context, skip, limit uint64)
 func (ps *paymentUsecase) UpdatePayment(ctx context.Context, payment *domainpayment.Payment) (*domainpayment.Payment, error) {
 	existingPayment, err := ps.repo.GetPaymentByID(ctx, payment.ID)
 	if err != nil {
-		if err == domain.ErrDataNotFound {
+		if errors.Is(err, domain.ErrDataNotFound) {
 			return nil, err
 		}
-		return nil, domain.ErrInternal
+		return nil, domain.ErrInternal.Wrap(err)
+	}
+
+	if existingPayment.DeletedAt != nil {
+		return nil, domain.ErrDataArchived
+	}
+
+	if existingPayment.Version != payment.Version {
+		return nil, domain.ErrVersionMismatch
 	}
 
 	emptyData := payment.Name == "" && payment.Type == "" && payment.Logo == ""
@@ -144,60 +161,129 @@ func (ps *paymentUsecase) UpdatePayment(ctx context.Context, payment *domainpaym
 		return nil, domain.ErrNoUpdatedData
 	}
 
-	_, err = ps.repo.UpdatePayment(ctx, payment)
+	updatedPayment, err := ps.repo.UpdatePayment(ctx, payment)
 	if err != nil {
-		if err == domain.ErrConflictingData {
+		if errors.Is(err, domain.ErrConflictingData) || errors.Is(err, domain.ErrVersionMismatch) {
 			return nil, err
 		}
-		return nil, domain.ErrInternal
+		return nil, domain.ErrInternal.Wrap(err)
 	}
 
 	cacheKey := util.GenerateCacheKey("payment", payment.ID)
 
 	err = ps.cache.Delete(ctx, cacheKey)
 	if err != nil {
-		return nil, domain.ErrInternal
+		return nil, domain.ErrInternal.Wrap(err)
 	}
 
 	paymentSerialized, err := util.Serialize(payment)
 	if err != nil {
-		return nil, domain.ErrInternal
+		return nil, domain.ErrInternal.Wrap(err)
 	}
 
 	err = ps.cache.Set(ctx, cacheKey, paymentSerialized, 0)
 	if err != nil {
-		return nil, domain.ErrInternal
+		return nil, domain.ErrInternal.Wrap(err)
 	}
 
 	err = ps.cache.DeleteByPrefix(ctx, "payments:*")
 	if err != nil {
-		return nil, domain.ErrInternal
+		return nil, domain.ErrInternal.Wrap(err)
+	}
+
+	err = ps.audit.Record(ctx, domainaudit.Update, domainaudit.Payment, payment.ID, existingPayment, updatedPayment)
+	if err != nil {
+		return nil, err
 	}
 
 	return payment, nil
 }
 
-// DeletePayment deletes a payment
-func (ps *paymentUsecase) DeletePayment(ctx context.Context, id uint64) error {
-	_, err := ps.repo.GetPaymentByID(ctx, id)
+// DeletePayment archives a payment
+func (ps *paymentUsecase) DeletePayment(ctx context.Context, id, version uint64) error {
+	payment, err := ps.repo.GetPaymentByID(ctx, id)
 	if err != nil {
-		if err == domain.ErrDataNotFound {
+		if errors.Is(err, domain.ErrDataNotFound) {
 			return err
 		}
-		return domain.ErrInternal
+		return domain.ErrInternal.Wrap(err)
+	}
+
+	if payment.DeletedAt != nil {
+		return domain.ErrDataArchived
+	}
+
+	if payment.Version != version {
+		return domain.ErrVersionMismatch
 	}
 
 	cacheKey := util.GenerateCacheKey("payment", id)
 
 	err = ps.cache.Delete(ctx, cacheKey)
 	if err != nil {
-		return domain.ErrInternal
+		return domain.ErrInternal.Wrap(err)
 	}
 
 	err = ps.cache.DeleteByPrefix(ctx, "payments:*")
 	if err != nil {
-		return domain.ErrInternal
+		return domain.ErrInternal.Wrap(err)
+	}
+
+	err = ps.repo.DeletePayment(ctx, id, version)
+	if err != nil {
+		return err
+	}
+
+	err = ps.audit.Record(ctx, domainaudit.Delete, domainaudit.Payment, id, payment, nil)
+	if err != nil {
+		return err
 	}
 
-	return ps.repo.DeletePayment(ctx, id)
+	return nil
+}
+
+// RestorePayment restores an archived payment
+func (ps *paymentUsecase) RestorePayment(ctx context.Context, id uint64) (*domainpayment.Payment, error) {
+	existingPayment, err := ps.repo.GetPaymentByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, domain.ErrDataNotFound) {
+			return nil, err
+		}
+		return nil, domain.ErrInternal.Wrap(err)
+	}
+
+	if existingPayment.DeletedAt == nil {
+		return nil, domain.ErrDataNotArchived
+	}
+
+	payment, err := ps.repo.RestorePayment(ctx, id)
+	if err != nil {
+		if errors.Is(err, domain.ErrDataNotFound) {
+			return nil, err
+		}
+		return nil, domain.ErrInternal.Wrap(err)
+	}
+
+	cacheKey := util.GenerateCacheKey("payment", payment.ID)
+	paymentSerialized, err := util.Serialize(payment)
+	if err != nil {
+		return nil, domain.ErrInternal.Wrap(err)
+	}
+
+	err = ps.cache.Set(ctx, cacheKey, paymentSerialized, 0)
+	if err != nil {
+		return nil, domain.ErrInternal.Wrap(err)
+	}
+
+	err = ps.cache.DeleteByPrefix(ctx, "payments:*")
+	if err != nil {
+		return nil, domain.ErrInternal.Wrap(err)
+	}
+
+	err = ps.audit.Record(ctx, domainaudit.Restore, domainaudit.Payment, id, existingPayment, payment)
+	if err != nil {
+		return nil, err
+	}
+
+	return payment, nil
 }
@@ -173,13 +173,14 @@ func TestPaymentService_CreatePayment(t *testing.T) {
 
 			paymentRepo := mock.NewMockPaymentRepository(ctrl)
 			cache := mock.NewMockCacheRepository(ctrl)
+			auditService := newAuditStub(ctrl)
 
 			tc.mocks(paymentRepo, cache)
 
-			paymentService := NewPaymentUsecase(paymentRepo, cache)
+			paymentService := NewPaymentUsecase(paymentRepo, cache, auditService)
 
 			payment, err := paymentService.CreatePayment(ctx, tc.input.payment)
-			assert.Equal(t, tc.expected.err, err, "Error mismatch")
+			assert.ErrorIs(t, err, tc.expected.err, "Error mismatch")
 			assert.Equal(t, tc.expected.payment, payment, "Payment mismatch")
 		})
 	}
@@ -363,21 +364,23 @@ func TestPaymentService_GetPayment(t *testing.T) {
 
 			paymentRepo := mock.NewMockPaymentRepository(ctrl)
 			cache := mock.NewMockCacheRepository(ctrl)
+			auditService := newAuditStub(ctrl)
 
 			tc.mocks(paymentRepo, cache)
 
-			paymentService := NewPaymentUsecase(paymentRepo, cache)
+			paymentService := NewPaymentUsecase(paymentRepo, cache, auditService)
 
 			payment, err := paymentService.GetPayment(ctx, tc.input.id)
-			assert.Equal(t, tc.expected.err, err, "Error mismatch")
+			assert.ErrorIs(t, err, tc.expected.err, "Error mismatch")
 			assert.Equal(t, tc.expected.payment, payment, "Payment mismatch")
 		})
 	}
 }
 
 type listPaymentsTestedInput struct {
-	skip  uint64
-	limit uint64
+	skip            uint64
+	limit           uint64
+	includeArchived bool
 }
 
 type listPaymentsExpectedOutput struct {
@@ -400,8 +403,9 @@ func TestPaymentService_ListPayments(t *testing.T) {
 	ctx := context.Background()
 	skip := gofakeit.Uint64()
 	limit := gofakeit.Uint64()
+	includeArchived := gofakeit.Bool()
 
-	params := util.GenerateCacheKeyParams(skip, limit)
+	params := util.GenerateCacheKeyParams(skip, limit, includeArchived)
 	cacheKey := util.GenerateCacheKey("payments", params)
 	paymentsSerialized, _ := util.Serialize(payments)
 	ttl := time.Duration(0)
@@ -426,8 +430,9 @@ func TestPaymentService_ListPayments(t *testing.T) {
 					Return(paymentsSerialized, nil)
 			},
 			input: listPaymentsTestedInput{
-				skip:  skip,
-				limit: limit,
+				skip:            skip,
+				limit:           limit,
+				includeArchived: includeArchived,
 			},
 			expected: listPaymentsExpectedOutput{
 				payments: payments,
@@ -444,7 +449,7 @@ func TestPaymentService_ListPayments(t *testing.T) {
 					Get(gomock.Any(), gomock.Eq(cacheKey)).
 					Return(nil, domain.ErrDataNotFound)
 				paymentRepo.EXPECT().
-					ListPayments(gomock.Any(), gomock.Eq(skip), gomock.Eq(limit)).
+					ListPayments(gomock.Any(), gomock.Eq(skip), gomock.Eq(limit), gomock.Eq(includeArchived)).
 					Return(payments, nil)
 				paymentsSerialized, _ := util.Serialize(payments)
 				cache.EXPECT().
@@ -452,8 +457,9 @@ func TestPaymentService_ListPayments(t *testing.T) {
 					Return(nil)
 			},
 			input: listPaymentsTestedInput{
-				skip:  skip,
-				limit: limit,
+				skip:            skip,
+				limit:           limit,
+				includeArchived: includeArchived,
 			},
 			expected: listPaymentsExpectedOutput{
 				payments: payments,
@@ -471,8 +477,9 @@ func TestPaymentService_ListPayments(t *testing.T) {
 					Return([]byte("invalid"), nil)
 			},
 			input: listPaymentsTestedInput{
-				skip:  skip,
-				limit: limit,
+				skip:            skip,
+				limit:           limit,
+				includeArchived: includeArchived,
 			},
 			expected: listPaymentsExpectedOutput{
 				payments: nil,
@@ -489,12 +496,13 @@ func TestPaymentService_ListPayments(t *testing.T) {
 					Get(gomock.Any(), gomock.Eq(cacheKey)).
 					Return(nil, domain.ErrDataNotFound)
 				paymentRepo.EXPECT().
-					ListPayments(gomock.Any(), gomock.Eq(skip), gomock.Eq(limit)).
+					ListPayments(gomock.Any(), gomock.Eq(skip), gomock.Eq(limit), gomock.Eq(includeArchived)).
 					Return(nil, domain.ErrInternal)
 			},
 			input: listPaymentsTestedInput{
-				skip:  skip,
-				limit: limit,
+				skip:            skip,
+				limit:           limit,
+				includeArchived: includeArchived,
 			},
 			expected: listPaymentsExpectedOutput{
 				payments: nil,
@@ -511,15 +519,16 @@ func TestPaymentService_ListPayments(t *testing.T) {
 					Get(gomock.Any(), gomock.Eq(cacheKey)).
 					Return(nil, domain.ErrDataNotFound)
 				paymentRepo.EXPECT().
-					ListPayments(gomock.Any(), gomock.Eq(skip), gomock.Eq(limit)).
+					ListPayments(gomock.Any(), gomock.Eq(skip), gomock.Eq(limit), gomock.Eq(includeArchived)).
 					Return(payments, nil)
 				cache.EXPECT().
 					Set(gomock.Any(), gomock.Eq(cacheKey), gomock.Eq(paymentsSerialized), gomock.Eq(ttl)).
 					Return(domain.ErrInternal)
 			},
 			input: listPaymentsTestedInput{
-				skip:  skip,
-				limit: limit,
+				skip:            skip,
+				limit:           limit,
+				includeArchived: includeArchived,
 			},
 			expected: listPaymentsExpectedOutput{
 				payments: nil,
@@ -536,13 +545,14 @@ func TestPaymentService_ListPayments(t *testing.T) {
 
 			paymentRepo := mock.NewMockPaymentRepository(ctrl)
 			cache := mock.NewMockCacheRepository(ctrl)
+			auditService := newAuditStub(ctrl)
 
 			tc.mocks(paymentRepo, cache)
 
-			paymentService := NewPaymentUsecase(paymentRepo, cache)
+			paymentService := NewPaymentUsecase(paymentRepo, cache, auditService)
 
-			payments, err := paymentService.ListPayments(ctx, tc.input.skip, tc.input.limit)
-			assert.Equal(t, tc.expected.err, err, "Error mismatch")
+			payments, err := paymentService.ListPayments(ctx, tc.input.skip, tc.input.limit, tc.input.includeArchived)
+			assert.ErrorIs(t, err, tc.expected.err, "Error mismatch")
 			assert.Equal(t, tc.expected.payments, payments, "Payments mismatch")
 		})
 	}
@@ -579,6 +589,10 @@ func TestPaymentService_UpdatePayment(t *testing.T) {
 		Type: domainpayment.Cash,
 		Logo: gofakeit.ImageURL(320, 320),
 	}
+	stalePayment := &domainpayment.Payment{
+		ID:      paymentInput.ID,
+		Version: paymentInput.Version + 1,
+	}
 
 	cacheKey := util.GenerateCacheKey("payment", paymentOutput.ID)
 	paymentSerialized, _ := util.Serialize(paymentOutput)
@@ -641,6 +655,24 @@ func TestPaymentService_UpdatePayment(t *testing.T) {
 				err:     domain.ErrDataNotFound,
 			},
 		},
+		{
+			desc: "Fail_VersionMismatch",
+			mocks: func(
+				paymentRepo *mock.MockPaymentRepository,
+				cache *mock.MockCacheRepository,
+			) {
+				paymentRepo.EXPECT().
+					GetPaymentByID(gomock.Any(), gomock.Eq(paymentID)).
+					Return(stalePayment, nil)
+			},
+			input: updatePaymentTestedInput{
+				payment: paymentInput,
+			},
+			expected: updatePaymentExpectedOutput{
+				payment: nil,
+				err:     domain.ErrVersionMismatch,
+			},
+		},
 		{
 			desc: "Fail_InternalErrorGetByID",
 			mocks: func(
@@ -716,6 +748,27 @@ func TestPaymentService_UpdatePayment(t *testing.T) {
 				err:     domain.ErrConflictingData,
 			},
 		},
+		{
+			desc: "Fail_ConcurrentUpdate",
+			mocks: func(
+				paymentRepo *mock.MockPaymentRepository,
+				cache *mock.MockCacheRepository,
+			) {
+				paymentRepo.EXPECT().
+					GetPaymentByID(gomock.Any(), gomock.Eq(paymentID)).
+					Return(existingPayment, nil)
+				paymentRepo.EXPECT().
+					UpdatePayment(gomock.Any(), gomock.Eq(paymentInput)).
+					Return(nil, domain.ErrVersionMismatch)
+			},
+			input: updatePaymentTestedInput{
+				payment: paymentInput,
+			},
+			expected: updatePaymentExpectedOutput{
+				payment: nil,
+				err:     domain.ErrVersionMismatch,
+			},
+		},
 		{
 			desc: "Fail_InternalErrorUpdate",
 			mocks: func(
@@ -829,20 +882,22 @@ func TestPaymentService_UpdatePayment(t *testing.T) {
 
 			paymentRepo := mock.NewMockPaymentRepository(ctrl)
 			cache := mock.NewMockCacheRepository(ctrl)
+			auditService := newAuditStub(ctrl)
 
 			tc.mocks(paymentRepo, cache)
 
-			paymentService := NewPaymentUsecase(paymentRepo, cache)
+			paymentService := NewPaymentUsecase(paymentRepo, cache, auditService)
 
 			payment, err := paymentService.UpdatePayment(ctx, tc.input.payment)
-			assert.Equal(t, tc.expected.err, err, "Error mismatch")
+			assert.ErrorIs(t, err, tc.expected.err, "Error mismatch")
 			assert.Equal(t, tc.expected.payment, payment, "Payment mismatch")
 		})
 	}
 }
 
 type deletePaymentTestedInput struct {
-	id uint64
+	id      uint64
+	version uint64
 }
 
 type deletePaymentExpectedOutput struct {
@@ -852,6 +907,7 @@ type deletePaymentExpectedOutput struct {
 func TestPaymentService_DeletePayment(t *testing.T) {
 	ctx := context.Background()
 	paymentID := gofakeit.Uint64()
+	version := gofakeit.Uint64()
 
 	cacheKey := util.GenerateCacheKey("payment", paymentID)
 
@@ -872,7 +928,7 @@ func TestPaymentService_DeletePayment(t *testing.T) {
 			) {
 				paymentRepo.EXPECT().
 					GetPaymentByID(gomock.Any(), gomock.Eq(paymentID)).
-					Return(&domainpayment.Payment{}, nil)
+					Return(&domainpayment.Payment{Version: version}, nil)
 				cache.EXPECT().
 					Delete(gomock.Any(), gomock.Eq(cacheKey)).
 					Return(nil)
@@ -880,11 +936,12 @@ func TestPaymentService_DeletePayment(t *testing.T) {
 					DeleteByPrefix(gomock.Any(), gomock.Eq("payments:*")).
 					Return(nil)
 				paymentRepo.EXPECT().
-					DeletePayment(gomock.Any(), gomock.Eq(paymentID)).
+					DeletePayment(gomock.Any(), gomock.Eq(paymentID), gomock.Eq(version)).
 					Return(nil)
 			},
 			input: deletePaymentTestedInput{
-				id: paymentID,
+				id:      paymentID,
+				version: version,
 			},
 			expected: deletePaymentExpectedOutput{
 				err: nil,
@@ -901,7 +958,8 @@ func TestPaymentService_DeletePayment(t *testing.T) {
 					Return(nil, domain.ErrDataNotFound)
 			},
 			input: deletePaymentTestedInput{
-				id: paymentID,
+				id:      paymentID,
+				version: version,
 			},
 			expected: deletePaymentExpectedOutput{
 				err: domain.ErrDataNotFound,
@@ -918,7 +976,8 @@ func TestPaymentService_DeletePayment(t *testing.T) {
 					Return(nil, domain.ErrInternal)
 			},
 			input: deletePaymentTestedInput{
-				id: paymentID,
+				id:      paymentID,
+				version: version,
 			},
 			expected: deletePaymentExpectedOutput{
 				err: domain.ErrInternal,
@@ -932,13 +991,14 @@ func TestPaymentService_DeletePayment(t *testing.T) {
 			) {
 				paymentRepo.EXPECT().
 					GetPaymentByID(gomock.Any(), gomock.Eq(paymentID)).
-					Return(&domainpayment.Payment{}, nil)
+					Return(&domainpayment.Payment{Version: version}, nil)
 				cache.EXPECT().
 					Delete(gomock.Any(), gomock.Eq(cacheKey)).
 					Return(domain.ErrInternal)
 			},
 			input: deletePaymentTestedInput{
-				id: paymentID,
+				id:      paymentID,
+				version: version,
 			},
 			expected: deletePaymentExpectedOutput{
 				err: domain.ErrInternal,
@@ -952,7 +1012,7 @@ func TestPaymentService_DeletePayment(t *testing.T) {
 			) {
 				paymentRepo.EXPECT().
 					GetPaymentByID(gomock.Any(), gomock.Eq(paymentID)).
-					Return(&domainpayment.Payment{}, nil)
+					Return(&domainpayment.Payment{Version: version}, nil)
 				cache.EXPECT().
 					Delete(gomock.Any(), gomock.Eq(cacheKey)).
 					Return(nil)
@@ -961,7 +1021,8 @@ func TestPaymentService_DeletePayment(t *testing.T) {
 					Return(domain.ErrInternal)
 			},
 			input: deletePaymentTestedInput{
-				id: paymentID,
+				id:      paymentID,
+				version: version,
 			},
 			expected: deletePaymentExpectedOutput{
 				err: domain.ErrInternal,
@@ -975,7 +1036,7 @@ func TestPaymentService_DeletePayment(t *testing.T) {
 			) {
 				paymentRepo.EXPECT().
 					GetPaymentByID(gomock.Any(), gomock.Eq(paymentID)).
-					Return(&domainpayment.Payment{}, nil)
+					Return(&domainpayment.Payment{Version: version}, nil)
 				cache.EXPECT().
 					Delete(gomock.Any(), gomock.Eq(cacheKey)).
 					Return(nil)
@@ -983,16 +1044,235 @@ func TestPaymentService_DeletePayment(t *testing.T) {
 					DeleteByPrefix(gomock.Any(), gomock.Eq("payments:*")).
 					Return(nil)
 				paymentRepo.EXPECT().
-					DeletePayment(gomock.Any(), gomock.Eq(paymentID)).
+					DeletePayment(gomock.Any(), gomock.Eq(paymentID), gomock.Eq(version)).
 					Return(domain.ErrInternal)
 			},
 			input: deletePaymentTestedInput{
-				id: paymentID,
+				id:      paymentID,
+				version: version,
 			},
 			expected: deletePaymentExpectedOutput{
 				err: domain.ErrInternal,
 			},
 		},
+		{
+			desc: "Fail_Archived",
+			mocks: func(
+				paymentRepo *mock.MockPaymentRepository,
+				cache *mock.MockCacheRepository,
+			) {
+				deletedAt := gofakeit.Date()
+				paymentRepo.EXPECT().
+					GetPaymentByID(gomock.Any(), gomock.Eq(paymentID)).
+					Times(1).
+					Return(&domainpayment.Payment{DeletedAt: &deletedAt}, nil)
+			},
+			input: deletePaymentTestedInput{
+				id:      paymentID,
+				version: version,
+			},
+			expected: deletePaymentExpectedOutput{
+				err: domain.ErrDataArchived,
+			},
+		},
+		{
+			desc: "Fail_VersionMismatch",
+			mocks: func(
+				paymentRepo *mock.MockPaymentRepository,
+				cache *mock.MockCacheRepository,
+			) {
+				paymentRepo.EXPECT().
+					GetPaymentByID(gomock.Any(), gomock.Eq(paymentID)).
+					Times(1).
+					Return(&domainpayment.Payment{Version: version + 1}, nil)
+			},
+			input: deletePaymentTestedInput{
+				id:      paymentID,
+				version: version,
+			},
+			expected: deletePaymentExpectedOutput{
+				err: domain.ErrVersionMismatch,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.desc, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			paymentRepo := mock.NewMockPaymentRepository(ctrl)
+			cache := mock.NewMockCacheRepository(ctrl)
+			auditService := newAuditStub(ctrl)
+
+			tc.mocks(paymentRepo, cache)
+
+			paymentService := NewPaymentUsecase(paymentRepo, cache, auditService)
+
+			err := paymentService.DeletePayment(ctx, tc.input.id, tc.input.version)
+			assert.ErrorIs(t, err, tc.expected.err, "Error mismatch")
+		})
+	}
+}
+
+type restorePaymentTestedInput struct {
+	id uint64
+}
+
+type restorePaymentExpectedOutput struct {
+	payment *domainpayment.Payment
+	err     error
+}
+
+func TestPaymentService_RestorePayment(t *testing.T) {
+	ctx := context.Background()
+	paymentID := gofakeit.Uint64()
+	paymentName := gofakeit.Company()
+	deletedAt := gofakeit.Date()
+	archivedPayment := &domainpayment.Payment{
+		ID:        paymentID,
+		Name:      paymentName,
+		DeletedAt: &deletedAt,
+	}
+	restoredPayment := &domainpayment.Payment{
+		ID:   paymentID,
+		Name: paymentName,
+	}
+
+	cacheKey := util.GenerateCacheKey("payment", paymentID)
+	paymentSerialized, _ := util.Serialize(restoredPayment)
+	ttl := time.Duration(0)
+
+	testCases := []struct {
+		desc  string
+		mocks func(
+			paymentRepo *mock.MockPaymentRepository,
+			cache *mock.MockCacheRepository,
+		)
+		input    restorePaymentTestedInput
+		expected restorePaymentExpectedOutput
+	}{
+		{
+			desc: "Success",
+			mocks: func(
+				paymentRepo *mock.MockPaymentRepository,
+				cache *mock.MockCacheRepository,
+			) {
+				paymentRepo.EXPECT().
+					GetPaymentByID(gomock.Any(), gomock.Eq(paymentID)).
+					Times(1).
+					Return(archivedPayment, nil)
+				paymentRepo.EXPECT().
+					RestorePayment(gomock.Any(), gomock.Eq(paymentID)).
+					Times(1).
+					Return(restoredPayment, nil)
+				cache.EXPECT().
+					Set(gomock.Any(), gomock.Eq(cacheKey), gomock.Eq(paymentSerialized), gomock.Eq(ttl)).
+					Times(1).
+					Return(nil)
+				cache.EXPECT().
+					DeleteByPrefix(gomock.Any(), gomock.Eq("payments:*")).
+					Times(1).
+					Return(nil)
+			},
+			input: restorePaymentTestedInput{
+				id: paymentID,
+			},
+			expected: restorePaymentExpectedOutput{
+				payment: restoredPayment,
+				err:     nil,
+			},
+		},
+		{
+			desc: "Fail_NotFound",
+			mocks: func(
+				paymentRepo *mock.MockPaymentRepository,
+				cache *mock.MockCacheRepository,
+			) {
+				paymentRepo.EXPECT().
+					GetPaymentByID(gomock.Any(), gomock.Eq(paymentID)).
+					Times(1).
+					Return(nil, domain.ErrDataNotFound)
+			},
+			input: restorePaymentTestedInput{
+				id: paymentID,
+			},
+			expected: restorePaymentExpectedOutput{
+				payment: nil,
+				err:     domain.ErrDataNotFound,
+			},
+		},
+		{
+			desc: "Fail_NotArchived",
+			mocks: func(
+				paymentRepo *mock.MockPaymentRepository,
+				cache *mock.MockCacheRepository,
+			) {
+				paymentRepo.EXPECT().
+					GetPaymentByID(gomock.Any(), gomock.Eq(paymentID)).
+					Times(1).
+					Return(restoredPayment, nil)
+			},
+			input: restorePaymentTestedInput{
+				id: paymentID,
+			},
+			expected: restorePaymentExpectedOutput{
+				payment: nil,
+				err:     domain.ErrDataNotArchived,
+			},
+		},
+		{
+			desc: "Fail_InternalErrorRestore",
+			mocks: func(
+				paymentRepo *mock.MockPaymentRepository,
+				cache *mock.MockCacheRepository,
+			) {
+				paymentRepo.EXPECT().
+					GetPaymentByID(gomock.Any(), gomock.Eq(paymentID)).
+					Times(1).
+					Return(archivedPayment, nil)
+				paymentRepo.EXPECT().
+					RestorePayment(gomock.Any(), gomock.Eq(paymentID)).
+					Times(1).
+					Return(nil, domain.ErrInternal)
+			},
+			input: restorePaymentTestedInput{
+				id: paymentID,
+			},
+			expected: restorePaymentExpectedOutput{
+				payment: nil,
+				err:     domain.ErrInternal,
+			},
+		},
+		{
+			desc: "Fail_SetCache",
+			mocks: func(
+				paymentRepo *mock.MockPaymentRepository,
+				cache *mock.MockCacheRepository,
+			) {
+				paymentRepo.EXPECT().
+					GetPaymentByID(gomock.Any(), gomock.Eq(paymentID)).
+					Times(1).
+					Return(archivedPayment, nil)
+				paymentRepo.EXPECT().
+					RestorePayment(gomock.Any(), gomock.Eq(paymentID)).
+					Times(1).
+					Return(restoredPayment, nil)
+				cache.EXPECT().
+					Set(gomock.Any(), gomock.Eq(cacheKey), gomock.Eq(paymentSerialized), gomock.Eq(ttl)).
+					Times(1).
+					Return(domain.ErrInternal)
+			},
+			input: restorePaymentTestedInput{
+				id: paymentID,
+			},
+			expected: restorePaymentExpectedOutput{
+				payment: nil,
+				err:     domain.ErrInternal,
+			},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -1004,13 +1284,15 @@ func TestPaymentService_DeletePayment(t *testing.T) {
 
 			paymentRepo := mock.NewMockPaymentRepository(ctrl)
 			cache := mock.NewMockCacheRepository(ctrl)
+			auditService := newAuditStub(ctrl)
 
 			tc.mocks(paymentRepo, cache)
 
-			paymentService := NewPaymentUsecase(paymentRepo, cache)
+			paymentService := NewPaymentUsecase(paymentRepo, cache, auditService)
 
-			err := paymentService.DeletePayment(ctx, tc.input.id)
-			assert.Equal(t, tc.expected.err, err, "Error mismatch")
+			payment, err := paymentService.RestorePayment(ctx, tc.input.id)
+			assert.ErrorIs(t, err, tc.expected.err, "Error mismatch")
+			assert.Equal(t, tc.expected.payment, payment, "Payment mismatch")
 		})
 	}
 }
@@ -2,8 +2,10 @@ package usecase
 
 import (
 	"context"
+	"errors"
 
 	"github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain"
+	domainaudit "github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain/audit"
 	domainproduct "github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain/product"
 	"github.com/TienMinh25/go-hexagonal-architecture/internal/application/port"
 	"github.com/TienMinh25/go-hexagonal-architecture/internal/application/util"
@@ -11,21 +13,23 @@ import (
 
 /**
  * productUsecase implements port.ProductService and port.CategoryService
- * interfaces and provides an access to the product and category repositories
- * and cache service
+ * interfaces and provides an access to the product and category repositories,
+ * cache service and audit service
  */
 type productUsecase struct {
 	productRepo  port.ProductRepository
 	categoryRepo port.CategoryRepository
 	cache        port.CacheRepository
+	audit        port.AuditService
 }
 
 // NewProductUsecase creates a new product service instance
-func NewProductUsecase(productRepo port.ProductRepository, categoryRepo port.CategoryRepository, cache port.CacheRepository) port.ProductService {
+func NewProductUsecase(productRepo port.ProductRepository, categoryRepo port.CategoryRepository, cache port.CacheRepository, audit port.AuditService) port.ProductService {
 	return &productUsecase{
 		productRepo,
 		categoryRepo,
 		cache,
+		audit,
 	}
 }
 
@@ -33,36 +37,45 @@ func NewProductUsecase(productRepo port.ProductRepository, categoryRepo port.Cat
 func (ps *productUsecase) CreateProduct(ctx context.Context, product *domainproduct.Product) (*domainproduct.Product, error) {
 	category, err := ps.categoryRepo.GetCategoryByID(ctx, product.CategoryID)
 	if err != nil {
-		if err == domain.ErrDataNotFound {
+		if errors.Is(err, domain.ErrDataNotFound) {
 			return nil, err
 		}
-		return nil, domain.ErrInternal
+		return nil, domain.ErrInternal.Wrap(err)
+	}
+
+	if category.DeletedAt != nil {
+		return nil, domain.ErrDataArchived
 	}
 
 	product.Category = category
 
 	product, err = ps.productRepo.CreateProduct(ctx, product)
 	if err != nil {
-		if err == domain.ErrConflictingData {
+		if errors.Is(err, domain.ErrConflictingData) {
 			return nil, err
 		}
-		return nil, domain.ErrInternal
+		return nil, domain.ErrInternal.Wrap(err)
 	}
 
 	cacheKey := util.GenerateCacheKey("product", product.ID)
 	productSerialized, err := util.Serialize(product)
 	if err != nil {
-		return nil, domain.ErrInternal
+		return nil, domain.ErrInternal.Wrap(err)
 	}
 
 	err = ps.cache.Set(ctx, cacheKey, productSerialized, 0)
 	if err != nil {
-		return nil, domain.ErrInternal
+		return nil, domain.ErrInternal.Wrap(err)
 	}
 
 	err = ps.cache.DeleteByPrefix(ctx, "products:*")
 	if err != nil {
-		return nil, domain.ErrInternal
+		return nil, domain.ErrInternal.Wrap(err)
+	}
+
+	err = ps.audit.Record(ctx, domainaudit.Create, domainaudit.Product, product.ID, nil, product)
+	if err != nil {
+		return nil, err
 	}
 
 	return product, nil
@@ -77,70 +90,70 @@ func (ps *productUsecase) GetProduct(ctx context.Context, id uint64) (*domainpro
 	if err == nil {
 		err := util.Deserialize(cachedProduct, &product)
 		if err != nil {
-			return nil, domain.ErrInternal
+			return nil, domain.ErrInternal.Wrap(err)
 		}
 		return product, nil
 	}
 
 	product, err = ps.productRepo.GetProductByID(ctx, id)
 	if err != nil {
-		if err == domain.ErrDataNotFound {
+		if errors.Is(err, domain.ErrDataNotFound) {
 			return nil, err
 		}
-		return nil, domain.ErrInternal
+		return nil, domain.ErrInternal.Wrap(err)
 	}
 
 	category, err := ps.categoryRepo.GetCategoryByID(ctx, product.CategoryID)
 	if err != nil {
-		if err == domain.ErrDataNotFound {
+		if errors.Is(err, domain.ErrDataNotFound) {
 			return nil, err
 		}
-		return nil, domain.ErrInternal
+		return nil, domain.ErrInternal.Wrap(err)
 	}
 
 	product.Category = category
 
 	productSerialized, err := util.Serialize(product)
 	if err != nil {
-		return nil, domain.ErrInternal
+		return nil, domain.ErrInternal.Wrap(err)
 	}
 
 	err = ps.cache.Set(ctx, cacheKey, productSerialized, 0)
 	if err != nil {
-		return nil, domain.ErrInternal
+		return nil, domain.ErrInternal.Wrap(err)
 	}
 
 	return product, nil
 }
 
 // ListProducts retrieves a list of products
-func (ps *productUsecase) ListProducts(ctx context.Context, search string, categoryID, skip, limit uint64) ([]domainproduct.Product, error) {
+func (ps *productUsecase) ListProducts(ctx context.Context, search string, categoryID, skip, limit uint64, includeArchived bool) ([]domainproduct.Product, error) {
 	var products []domainproduct.Product
 
-	params := util.GenerateCacheKeyParams(skip, limit, categoryID, search)
+	params := util.GenerateCacheKeyParams(skip, limit, categoryID, search, includeArchived)
 	cacheKey := util.GenerateCacheKey("products", params)
 
 	cachedProducts, err := ps.cache.Get(ctx, cacheKey)
 	if err == nil {
 		err := util.Deserialize(cachedProducts, &products)
 		if err != nil {
-			return nil, domain.ErrInternal
+			return nil, domain.ErrInternal.Wrap(err)
 		}
 		return products, nil
 	}
 
-	products, err = ps.productRepo.ListProducts(ctx, search, categoryID, skip, limit)
+	products, err = ps.productRepo.ListProducts(ctx, search, categoryID, skip, limit, includeArchived)
 	if err != nil {
-		return nil, domain.ErrInternal
+		return nil, domain.ErrInternal.Wrap(err)
 	}
 
 	for i, product := range products {
 		category, err := ps.categoryRepo.GetCategoryByID(ctx, product.CategoryID)
 		if err != nil {
-			if err == domain.ErrDataNotFound {
+			if errors.Is(err, domain.ErrDataNotFound) {
 				return nil, err
 			}
-			return nil, domain.ErrInternal
+			return nil, domain.ErrInternal.Wrap(err)
 		}
 
 		products[i].Category = category
@@ -148,12 +161,12 @@ func (ps *productUsecase) ListProducts(ctx context.Context, search string, categ
 
 	productsSerialized, err := util.Serialize(products)
 	if err != nil {
-		return nil, domain.ErrInternal
+		return nil, domain.ErrInternal.Wrap(err)
 	}
 
 	err = ps.cache.Set(ctx, cacheKey, productsSerialized, 0)
 	if err != nil {
-		return nil, domain.ErrInternal
+		return nil, domain.ErrInternal.Wrap(err)
 	}
 
 	return products, nil
@@ -163,10 +176,18 @@ func (ps *productUsecase) ListProducts(ctx context.Context, search string, categ
 func (ps *productUsecase) UpdateProduct(ctx context.Context, product *domainproduct.Product) (*domainproduct.Product, error) {
 	existingProduct, err := ps.productRepo.GetProductByID(ctx, product.ID)
 	if err != nil {
-		if err == domain.ErrDataNotFound {
+		if errors.Is(err, domain.ErrDataNotFound) {
 			return nil, err
 		}
-		return nil, domain.ErrInternal
+		return nil, domain.ErrInternal.Wrap(err)
+	}
+
+	if existingProduct.DeletedAt != nil {
+		return nil, domain.ErrDataArchived
+	}
+
+	if existingProduct.Version != product.Version {
+		return nil, domain.ErrVersionMismatch
 	}
 
 	emptyData := product.CategoryID == 0 &&
@@ -191,68 +212,151 @@ func (ps *productUsecase) UpdateProduct(ctx context.Context, product *domainprod
 
 	category, err := ps.categoryRepo.GetCategoryByID(ctx, product.CategoryID)
 	if err != nil {
-		if err == domain.ErrDataNotFound {
+		if errors.Is(err, domain.ErrDataNotFound) {
 			return nil, err
 		}
-		return nil, domain.ErrInternal
+		return nil, domain.ErrInternal.Wrap(err)
+	}
+
+	if category.DeletedAt != nil && category.ID != existingProduct.CategoryID {
+		return nil, domain.ErrDataArchived
 	}
 
 	product.Category = category
 
-	_, err = ps.productRepo.UpdateProduct(ctx, product)
+	updatedProduct, err := ps.productRepo.UpdateProduct(ctx, product)
 	if err != nil {
-		if err == domain.ErrConflictingData {
+		if errors.Is(err, domain.ErrConflictingData) || errors.Is(err, domain.ErrVersionMismatch) {
 			return nil, err
 		}
-		return nil, domain.ErrInternal
+		return nil, domain.ErrInternal.Wrap(err)
 	}
 
 	cacheKey := util.GenerateCacheKey("product", product.ID)
 
 	err = ps.cache.Delete(ctx, cacheKey)
 	if err != nil {
-		return nil, domain.ErrInternal
+		return nil, domain.ErrInternal.Wrap(err)
 	}
 
 	productSerialized, err := util.Serialize(product)
 	if err != nil {
-		return nil, domain.ErrInternal
+		return nil, domain.ErrInternal.Wrap(err)
 	}
 
 	err = ps.cache.Set(ctx, cacheKey, productSerialized, 0)
 	if err != nil {
-		return nil, domain.ErrInternal
+		return nil, domain.ErrInternal.Wrap(err)
 	}
 
 	err = ps.cache.DeleteByPrefix(ctx, "products:*")
 	if err != nil {
-		return nil, domain.ErrInternal
+		return nil, domain.ErrInternal.Wrap(err)
+	}
+
+	err = ps.audit.Record(ctx, domainaudit.Update, domainaudit.Product, product.ID, existingProduct, updatedProduct)
+	if err != nil {
+		return nil, err
 	}
 
 	return product, nil
 }
 
-// DeleteProduct deletes a product
-func (ps *productUsecase) DeleteProduct(ctx context.Context, id uint64) error {
-	_, err := ps.productRepo.GetProductByID(ctx, id)
+// DeleteProduct archives a product
+func (ps *productUsecase) DeleteProduct(ctx context.Context, id, version uint64) error {
+	product, err := ps.productRepo.GetProductByID(ctx, id)
 	if err != nil {
-		if err == domain.ErrDataNotFound {
+		if errors.Is(err, domain.ErrDataNotFound) {
 			return err
 		}
-		return domain.ErrInternal
+		return domain.ErrInternal.Wrap(err)
+	}
+
+	if product.DeletedAt != nil {
+		return domain.ErrDataArchived
+	}
+
+	if product.Version != version {
+		return domain.ErrVersionMismatch
 	}
 
 	cacheKey := util.GenerateCacheKey("product", id)
 
 	err = ps.cache.Delete(ctx, cacheKey)
 	if err != nil {
-		return domain.ErrInternal
+		return domain.ErrInternal.Wrap(err)
 	}
 
 	err = ps.cache.DeleteByPrefix(ctx, "products:*")
 	if err != nil {
-		return domain.ErrInternal
+		return domain.ErrInternal.Wrap(err)
+	}
+
+	err = ps.productRepo.DeleteProduct(ctx, id, version)
+	if err != nil {
+		return err
+	}
+
+	err = ps.audit.Record(ctx, domainaudit.Delete, domainaudit.Product, id, product, nil)
+	if err != nil {
+		return err
 	}
 
-	return ps.productRepo.DeleteProduct(ctx, id)
+	return nil
+}
+
+// RestoreProduct restores an archived product
+func (ps *productUsecase) RestoreProduct(ctx context.Context, id uint64) (*domainproduct.Product, error) {
+	existingProduct, err := ps.productRepo.GetProductByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, domain.ErrDataNotFound) {
+			return nil, err
+		}
+		return nil, domain.ErrInternal.Wrap(err)
+	}
+
+	if existingProduct.DeletedAt == nil {
+		return nil, domain.ErrDataNotArchived
+	}
+
+	product, err := ps.productRepo.RestoreProduct(ctx, id)
+	if err != nil {
+		if errors.Is(err, domain.ErrDataNotFound) {
+			return nil, err
+		}
+		return nil, domain.ErrInternal.Wrap(err)
+	}
+
+	category, err := ps.categoryRepo.GetCategoryByID(ctx, product.CategoryID)
+	if err != nil {
+		if errors.Is(err, domain.ErrDataNotFound) {
+			return nil, err
+		}
+		return nil, domain.ErrInternal.Wrap(err)
+	}
+
+	product.Category = category
+
+	cacheKey := util.GenerateCacheKey("product", product.ID)
+	productSerialized, err := util.Serialize(product)
+	if err != nil {
+		return nil, domain.ErrInternal.Wrap(err)
+	}
+
+	err = ps.cache.Set(ctx, cacheKey, productSerialized, 0)
+	if err != nil {
+		return nil, domain.ErrInternal.Wrap(err)
+	}
+
+	err = ps.cache.DeleteByPrefix(ctx, "products:*")
+	if err != nil {
+		return nil, domain.ErrInternal.Wrap(err)
+	}
+
+	err = ps.audit.Record(ctx, domainaudit.Restore, domainaudit.Product, id, existingProduct, product)
+	if err != nil {
+		return nil, err
+	}
+
+	return product, nil
 }
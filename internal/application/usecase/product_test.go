@@ -268,13 +268,14 @@ func TestProductService_CreateProduct(t *testing.T) {
 			productRepo := mock.NewMockProductRepository(ctrl)
 			categoryRepo := mock.NewMockCategoryRepository(ctrl)
 			cache := mock.NewMockCacheRepository(ctrl)
+			auditService := newAuditStub(ctrl)
 
 			tc.mocks(productRepo, categoryRepo, cache)
 
-			productService := NewProductUsecase(productRepo, categoryRepo, cache)
+			productService := NewProductUsecase(productRepo, categoryRepo, cache, auditService)
 
 			product, err := productService.CreateProduct(ctx, tc.input.product)
-			assert.Equal(t, tc.expected.err, err, "Error mismatch")
+			assert.ErrorIs(t, err, tc.expected.err, "Error mismatch")
 			assert.Equal(t, tc.expected.product, product, "Product mismatch")
 		})
 	}
@@ -548,23 +549,25 @@ func TestProductService_GetProduct(t *testing.T) {
 			productRepo := mock.NewMockProductRepository(ctrl)
 			categoryRepo := mock.NewMockCategoryRepository(ctrl)
 			cache := mock.NewMockCacheRepository(ctrl)
+			auditService := newAuditStub(ctrl)
 
 			tc.mocks(productRepo, categoryRepo, cache)
 
-			productService := NewProductUsecase(productRepo, categoryRepo, cache)
+			productService := NewProductUsecase(productRepo, categoryRepo, cache, auditService)
 
 			product, err := productService.GetProduct(ctx, tc.input.id)
-			assert.Equal(t, tc.expected.err, err, "Error mismatch")
+			assert.ErrorIs(t, err, tc.expected.err, "Error mismatch")
 			assert.Equal(t, tc.expected.product, product, "Product mismatch")
 		})
 	}
 }
 
 type listProductsTestedInput struct {
-	search     string
-	categoryID uint64
-	skip       uint64
-	limit      uint64
+	search          string
+	categoryID      uint64
+	skip            uint64
+	limit           uint64
+	includeArchived bool
 }
 
 type listProductsExpectedOutput struct {
@@ -602,8 +605,9 @@ func TestProductService_ListProducts(t *testing.T) {
 	skip := gofakeit.Uint64()
 	limit := gofakeit.Uint64()
 	search := ""
+	includeArchived := gofakeit.Bool()
 
-	params := util.GenerateCacheKeyParams(skip, limit, categoryID, search)
+	params := util.GenerateCacheKeyParams(skip, limit, categoryID, search, includeArchived)
 	cacheKey := util.GenerateCacheKey("products", params)
 	productsSerialized, _ := util.Serialize(products)
 	ttl := time.Duration(0)
@@ -631,10 +635,11 @@ func TestProductService_ListProducts(t *testing.T) {
 					Return(productsSerialized, nil)
 			},
 			input: listProductsTestedInput{
-				search:     search,
-				categoryID: categoryID,
-				skip:       skip,
-				limit:      limit,
+				search:          search,
+				categoryID:      categoryID,
+				skip:            skip,
+				limit:           limit,
+				includeArchived: includeArchived,
 			},
 			expected: listProductsExpectedOutput{
 				products: products,
@@ -653,7 +658,7 @@ func TestProductService_ListProducts(t *testing.T) {
 					Times(1).
 					Return(nil, domain.ErrDataNotFound)
 				productRepo.EXPECT().
-					ListProducts(gomock.Any(), gomock.Eq(search), gomock.Eq(categoryID), gomock.Eq(skip), gomock.Eq(limit)).
+					ListProducts(gomock.Any(), gomock.Eq(search), gomock.Eq(categoryID), gomock.Eq(skip), gomock.Eq(limit), gomock.Eq(includeArchived)).
 					Times(1).
 					Return(products, nil)
 				for i := range products {
@@ -669,10 +674,11 @@ func TestProductService_ListProducts(t *testing.T) {
 					Return(nil)
 			},
 			input: listProductsTestedInput{
-				search:     search,
-				categoryID: categoryID,
-				skip:       skip,
-				limit:      limit,
+				search:          search,
+				categoryID:      categoryID,
+				skip:            skip,
+				limit:           limit,
+				includeArchived: includeArchived,
 			},
 			expected: listProductsExpectedOutput{
 				products: products,
@@ -692,10 +698,11 @@ func TestProductService_ListProducts(t *testing.T) {
 					Return([]byte("invalid"), nil)
 			},
 			input: listProductsTestedInput{
-				search:     search,
-				categoryID: categoryID,
-				skip:       skip,
-				limit:      limit,
+				search:          search,
+				categoryID:      categoryID,
+				skip:            skip,
+				limit:           limit,
+				includeArchived: includeArchived,
 			},
 			expected: listProductsExpectedOutput{
 				products: nil,
@@ -714,15 +721,16 @@ func TestProductService_ListProducts(t *testing.T) {
 					Times(1).
 					Return(nil, domain.ErrDataNotFound)
 				productRepo.EXPECT().
-					ListProducts(gomock.Any(), gomock.Eq(search), gomock.Eq(categoryID), gomock.Eq(skip), gomock.Eq(limit)).
+					ListProducts(gomock.Any(), gomock.Eq(search), gomock.Eq(categoryID), gomock.Eq(skip), gomock.Eq(limit), gomock.Eq(includeArchived)).
 					Times(1).
 					Return(nil, domain.ErrInternal)
 			},
 			input: listProductsTestedInput{
-				search:     search,
-				categoryID: categoryID,
-				skip:       skip,
-				limit:      limit,
+				search:          search,
+				categoryID:      categoryID,
+				skip:            skip,
+				limit:           limit,
+				includeArchived: includeArchived,
 			},
 			expected: listProductsExpectedOutput{
 				products: nil,
@@ -742,7 +750,7 @@ func TestProductService_ListProducts(t *testing.T) {
 					Times(1).
 					Return(nil, domain.ErrDataNotFound)
 				productRepo.EXPECT().
-					ListProducts(gomock.Any(), gomock.Eq(search), gomock.Eq(categoryID), gomock.Eq(skip), gomock.Eq(limit)).
+					ListProducts(gomock.Any(), gomock.Eq(search), gomock.Eq(categoryID), gomock.Eq(skip), gomock.Eq(limit), gomock.Eq(includeArchived)).
 					Times(1).
 					Return(products, nil)
 				categoryRepo.EXPECT().
@@ -751,10 +759,11 @@ func TestProductService_ListProducts(t *testing.T) {
 					Return(nil, domain.ErrDataNotFound)
 			},
 			input: listProductsTestedInput{
-				search:     search,
-				categoryID: categoryID,
-				skip:       skip,
-				limit:      limit,
+				search:          search,
+				categoryID:      categoryID,
+				skip:            skip,
+				limit:           limit,
+				includeArchived: includeArchived,
 			},
 			expected: listProductsExpectedOutput{
 				products: nil,
@@ -774,7 +783,7 @@ func TestProductService_ListProducts(t *testing.T) {
 					Times(1).
 					Return(nil, domain.ErrDataNotFound)
 				productRepo.EXPECT().
-					ListProducts(gomock.Any(), gomock.Eq(search), gomock.Eq(categoryID), gomock.Eq(skip), gomock.Eq(limit)).
+					ListProducts(gomock.Any(), gomock.Eq(search), gomock.Eq(categoryID), gomock.Eq(skip), gomock.Eq(limit), gomock.Eq(includeArchived)).
 					Times(1).
 					Return(products, nil)
 				categoryRepo.EXPECT().
@@ -783,10 +792,11 @@ func TestProductService_ListProducts(t *testing.T) {
 					Return(nil, domain.ErrInternal)
 			},
 			input: listProductsTestedInput{
-				search:     search,
-				categoryID: categoryID,
-				skip:       skip,
-				limit:      limit,
+				search:          search,
+				categoryID:      categoryID,
+				skip:            skip,
+				limit:           limit,
+				includeArchived: includeArchived,
 			},
 			expected: listProductsExpectedOutput{
 				products: nil,
@@ -806,7 +816,7 @@ func TestProductService_ListProducts(t *testing.T) {
 					Times(1).
 					Return(nil, domain.ErrDataNotFound)
 				productRepo.EXPECT().
-					ListProducts(gomock.Any(), gomock.Eq(search), gomock.Eq(categoryID), gomock.Eq(skip), gomock.Eq(limit)).
+					ListProducts(gomock.Any(), gomock.Eq(search), gomock.Eq(categoryID), gomock.Eq(skip), gomock.Eq(limit), gomock.Eq(includeArchived)).
 					Times(1).
 					Return(products, nil)
 				for i := range products {
@@ -822,10 +832,11 @@ func TestProductService_ListProducts(t *testing.T) {
 					Return(domain.ErrInternal)
 			},
 			input: listProductsTestedInput{
-				search:     search,
-				categoryID: categoryID,
-				skip:       skip,
-				limit:      limit,
+				search:          search,
+				categoryID:      categoryID,
+				skip:            skip,
+				limit:           limit,
+				includeArchived: includeArchived,
 			},
 			expected: listProductsExpectedOutput{
 				products: nil,
@@ -845,13 +856,14 @@ func TestProductService_ListProducts(t *testing.T) {
 			productRepo := mock.NewMockProductRepository(ctrl)
 			categoryRepo := mock.NewMockCategoryRepository(ctrl)
 			cache := mock.NewMockCacheRepository(ctrl)
+			auditService := newAuditStub(ctrl)
 
 			tc.mocks(productRepo, categoryRepo, cache)
 
-			productService := NewProductUsecase(productRepo, categoryRepo, cache)
+			productService := NewProductUsecase(productRepo, categoryRepo, cache, auditService)
 
-			products, err := productService.ListProducts(ctx, tc.input.search, tc.input.categoryID, tc.input.skip, tc.input.limit)
-			assert.Equal(t, tc.expected.err, err, "Error mismatch")
+			products, err := productService.ListProducts(ctx, tc.input.search, tc.input.categoryID, tc.input.skip, tc.input.limit, tc.input.includeArchived)
+			assert.ErrorIs(t, err, tc.expected.err, "Error mismatch")
 			assert.Equal(t, tc.expected.products, products, "Products mismatch")
 		})
 	}
@@ -911,6 +923,10 @@ func TestProductService_UpdateProduct(t *testing.T) {
 		Price: gofakeit.Float64(),
 		Image: gofakeit.ImageURL(400, 400),
 	}
+	staleProduct := &domainproduct.Product{
+		ID:      productInput.ID,
+		Version: productInput.Version + 1,
+	}
 
 	cacheKey := util.GenerateCacheKey("product", productOutput.ID)
 	productSerialized, _ := util.Serialize(productOutput)
@@ -988,6 +1004,27 @@ func TestProductService_UpdateProduct(t *testing.T) {
 				err:     domain.ErrDataNotFound,
 			},
 		},
+		{
+			desc: "Fail_VersionMismatch",
+			mocks: func(
+				productRepo *mock.MockProductRepository,
+				categoryRepo *mock.MockCategoryRepository,
+				cache *mock.MockCacheRepository,
+			) {
+
+				productRepo.EXPECT().
+					GetProductByID(gomock.Any(), gomock.Eq(productID)).
+					Times(1).
+					Return(staleProduct, nil)
+			},
+			input: updateProductTestedInput{
+				product: productInput,
+			},
+			expected: updateProductExpectedOutput{
+				product: nil,
+				err:     domain.ErrVersionMismatch,
+			},
+		},
 		{
 			desc: "Fail_InternalError",
 			mocks: func(
@@ -1132,6 +1169,35 @@ func TestProductService_UpdateProduct(t *testing.T) {
 				err:     domain.ErrConflictingData,
 			},
 		},
+		{
+			desc: "Fail_ConcurrentUpdate",
+			mocks: func(
+				productRepo *mock.MockProductRepository,
+				categoryRepo *mock.MockCategoryRepository,
+				cache *mock.MockCacheRepository,
+			) {
+
+				productRepo.EXPECT().
+					GetProductByID(gomock.Any(), gomock.Eq(productID)).
+					Times(1).
+					Return(existingProduct, nil)
+				categoryRepo.EXPECT().
+					GetCategoryByID(gomock.Any(), gomock.Eq(categoryID)).
+					Times(1).
+					Return(category, nil)
+				productRepo.EXPECT().
+					UpdateProduct(gomock.Any(), gomock.Eq(productInput)).
+					Times(1).
+					Return(nil, domain.ErrVersionMismatch)
+			},
+			input: updateProductTestedInput{
+				product: productInput,
+			},
+			expected: updateProductExpectedOutput{
+				product: nil,
+				err:     domain.ErrVersionMismatch,
+			},
+		},
 		{
 			desc: "Fail_InternalError",
 			mocks: func(
@@ -1283,20 +1349,22 @@ func TestProductService_UpdateProduct(t *testing.T) {
 			productRepo := mock.NewMockProductRepository(ctrl)
 			categoryRepo := mock.NewMockCategoryRepository(ctrl)
 			cache := mock.NewMockCacheRepository(ctrl)
+			auditService := newAuditStub(ctrl)
 
 			tc.mocks(productRepo, categoryRepo, cache)
 
-			productService := NewProductUsecase(productRepo, categoryRepo, cache)
+			productService := NewProductUsecase(productRepo, categoryRepo, cache, auditService)
 
 			product, err := productService.UpdateProduct(ctx, tc.input.product)
-			assert.Equal(t, tc.expected.err, err, "Error mismatch")
+			assert.ErrorIs(t, err, tc.expected.err, "Error mismatch")
 			assert.Equal(t, tc.expected.product, product, "Product mismatch")
 		})
 	}
 }
 
 type deleteProductTestedInput struct {
-	id uint64
+	id      uint64
+	version uint64
 }
 
 type deleteProductExpectedOutput struct {
@@ -1306,6 +1374,7 @@ type deleteProductExpectedOutput struct {
 func TestProductService_DeleteProduct(t *testing.T) {
 	ctx := context.Background()
 	productID := gofakeit.Uint64()
+	version := gofakeit.Uint64()
 
 	cacheKey := util.GenerateCacheKey("product", productID)
 
@@ -1329,7 +1398,7 @@ func TestProductService_DeleteProduct(t *testing.T) {
 				productRepo.EXPECT().
 					GetProductByID(gomock.Any(), gomock.Eq(productID)).
 					Times(1).
-					Return(nil, nil)
+					Return(&domainproduct.Product{Version: version}, nil)
 				cache.EXPECT().
 					Delete(gomock.Any(), gomock.Eq(cacheKey)).
 					Times(1).
@@ -1339,12 +1408,13 @@ func TestProductService_DeleteProduct(t *testing.T) {
 					Times(1).
 					Return(nil)
 				productRepo.EXPECT().
-					DeleteProduct(gomock.Any(), gomock.Eq(productID)).
+					DeleteProduct(gomock.Any(), gomock.Eq(productID), gomock.Eq(version)).
 					Times(1).
 					Return(nil)
 			},
 			input: deleteProductTestedInput{
-				id: productID,
+				id:      productID,
+				version: version,
 			},
 			expected: deleteProductExpectedOutput{
 				err: nil,
@@ -1363,7 +1433,8 @@ func TestProductService_DeleteProduct(t *testing.T) {
 					Return(nil, domain.ErrDataNotFound)
 			},
 			input: deleteProductTestedInput{
-				id: productID,
+				id:      productID,
+				version: version,
 			},
 			expected: deleteProductExpectedOutput{
 				err: domain.ErrDataNotFound,
@@ -1382,7 +1453,8 @@ func TestProductService_DeleteProduct(t *testing.T) {
 					Return(nil, domain.ErrInternal)
 			},
 			input: deleteProductTestedInput{
-				id: productID,
+				id:      productID,
+				version: version,
 			},
 			expected: deleteProductExpectedOutput{
 				err: domain.ErrInternal,
@@ -1398,14 +1470,15 @@ func TestProductService_DeleteProduct(t *testing.T) {
 				productRepo.EXPECT().
 					GetProductByID(gomock.Any(), gomock.Eq(productID)).
 					Times(1).
-					Return(nil, nil)
+					Return(&domainproduct.Product{Version: version}, nil)
 				cache.EXPECT().
 					Delete(gomock.Any(), gomock.Eq(cacheKey)).
 					Times(1).
 					Return(domain.ErrInternal)
 			},
 			input: deleteProductTestedInput{
-				id: productID,
+				id:      productID,
+				version: version,
 			},
 			expected: deleteProductExpectedOutput{
 				err: domain.ErrInternal,
@@ -1421,7 +1494,7 @@ func TestProductService_DeleteProduct(t *testing.T) {
 				productRepo.EXPECT().
 					GetProductByID(gomock.Any(), gomock.Eq(productID)).
 					Times(1).
-					Return(nil, nil)
+					Return(&domainproduct.Product{Version: version}, nil)
 				cache.EXPECT().
 					Delete(gomock.Any(), gomock.Eq(cacheKey)).
 					Times(1).
@@ -1432,7 +1505,8 @@ func TestProductService_DeleteProduct(t *testing.T) {
 					Return(domain.ErrInternal)
 			},
 			input: deleteProductTestedInput{
-				id: productID,
+				id:      productID,
+				version: version,
 			},
 			expected: deleteProductExpectedOutput{
 				err: domain.ErrInternal,
@@ -1448,7 +1522,7 @@ func TestProductService_DeleteProduct(t *testing.T) {
 				productRepo.EXPECT().
 					GetProductByID(gomock.Any(), gomock.Eq(productID)).
 					Times(1).
-					Return(nil, nil)
+					Return(&domainproduct.Product{Version: version}, nil)
 				cache.EXPECT().
 					Delete(gomock.Any(), gomock.Eq(cacheKey)).
 					Times(1).
@@ -1458,17 +1532,59 @@ func TestProductService_DeleteProduct(t *testing.T) {
 					Times(1).
 					Return(nil)
 				productRepo.EXPECT().
-					DeleteProduct(gomock.Any(), gomock.Eq(productID)).
+					DeleteProduct(gomock.Any(), gomock.Eq(productID), gomock.Eq(version)).
 					Times(1).
 					Return(domain.ErrInternal)
 			},
 			input: deleteProductTestedInput{
-				id: productID,
+				id:      productID,
+				version: version,
 			},
 			expected: deleteProductExpectedOutput{
 				err: domain.ErrInternal,
 			},
 		},
+		{
+			desc: "Fail_Archived",
+			mocks: func(
+				productRepo *mock.MockProductRepository,
+				categoryRepo *mock.MockCategoryRepository,
+				cache *mock.MockCacheRepository,
+			) {
+				deletedAt := gofakeit.Date()
+				productRepo.EXPECT().
+					GetProductByID(gomock.Any(), gomock.Eq(productID)).
+					Times(1).
+					Return(&domainproduct.Product{DeletedAt: &deletedAt}, nil)
+			},
+			input: deleteProductTestedInput{
+				id:      productID,
+				version: version,
+			},
+			expected: deleteProductExpectedOutput{
+				err: domain.ErrDataArchived,
+			},
+		},
+		{
+			desc: "Fail_VersionMismatch",
+			mocks: func(
+				productRepo *mock.MockProductRepository,
+				categoryRepo *mock.MockCategoryRepository,
+				cache *mock.MockCacheRepository,
+			) {
+				productRepo.EXPECT().
+					GetProductByID(gomock.Any(), gomock.Eq(productID)).
+					Times(1).
+					Return(&domainproduct.Product{Version: version + 1}, nil)
+			},
+			input: deleteProductTestedInput{
+				id:      productID,
+				version: version,
+			},
+			expected: deleteProductExpectedOutput{
+				err: domain.ErrVersionMismatch,
+			},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -1481,13 +1597,190 @@ func TestProductService_DeleteProduct(t *testing.T) {
 			productRepo := mock.NewMockProductRepository(ctrl)
 			categoryRepo := mock.NewMockCategoryRepository(ctrl)
 			cache := mock.NewMockCacheRepository(ctrl)
+			auditService := newAuditStub(ctrl)
 
 			tc.mocks(productRepo, categoryRepo, cache)
 
-			productService := NewProductUsecase(productRepo, categoryRepo, cache)
+			productService := NewProductUsecase(productRepo, categoryRepo, cache, auditService)
+
+			err := productService.DeleteProduct(ctx, tc.input.id, tc.input.version)
+			assert.ErrorIs(t, err, tc.expected.err, "Error mismatch")
+		})
+	}
+}
 
-			err := productService.DeleteProduct(ctx, tc.input.id)
-			assert.Equal(t, tc.expected.err, err, "Error mismatch")
+type restoreProductTestedInput struct {
+	id uint64
+}
+
+type restoreProductExpectedOutput struct {
+	product *domainproduct.Product
+	err     error
+}
+
+func TestProductService_RestoreProduct(t *testing.T) {
+	ctx := context.Background()
+	productID := gofakeit.Uint64()
+	categoryID := gofakeit.Uint64()
+	productName := gofakeit.ProductName()
+	deletedAt := gofakeit.Date()
+	category := &domaincategory.Category{
+		ID:   categoryID,
+		Name: gofakeit.ProductCategory(),
+	}
+	archivedProduct := &domainproduct.Product{
+		ID:         productID,
+		CategoryID: categoryID,
+		Name:       productName,
+		DeletedAt:  &deletedAt,
+	}
+	restoredProduct := &domainproduct.Product{
+		ID:         productID,
+		CategoryID: categoryID,
+		Name:       productName,
+	}
+	productOutput := &domainproduct.Product{
+		ID:         productID,
+		CategoryID: categoryID,
+		Name:       productName,
+		Category:   category,
+	}
+
+	cacheKey := util.GenerateCacheKey("product", productID)
+	productSerialized, _ := util.Serialize(productOutput)
+	ttl := time.Duration(0)
+
+	testCases := []struct {
+		desc  string
+		mocks func(
+			productRepo *mock.MockProductRepository,
+			categoryRepo *mock.MockCategoryRepository,
+			cache *mock.MockCacheRepository,
+		)
+		input    restoreProductTestedInput
+		expected restoreProductExpectedOutput
+	}{
+		{
+			desc: "Success",
+			mocks: func(
+				productRepo *mock.MockProductRepository,
+				categoryRepo *mock.MockCategoryRepository,
+				cache *mock.MockCacheRepository,
+			) {
+				productRepo.EXPECT().
+					GetProductByID(gomock.Any(), gomock.Eq(productID)).
+					Times(1).
+					Return(archivedProduct, nil)
+				productRepo.EXPECT().
+					RestoreProduct(gomock.Any(), gomock.Eq(productID)).
+					Times(1).
+					Return(restoredProduct, nil)
+				categoryRepo.EXPECT().
+					GetCategoryByID(gomock.Any(), gomock.Eq(categoryID)).
+					Times(1).
+					Return(category, nil)
+				cache.EXPECT().
+					Set(gomock.Any(), gomock.Eq(cacheKey), gomock.Eq(productSerialized), gomock.Eq(ttl)).
+					Times(1).
+					Return(nil)
+				cache.EXPECT().
+					DeleteByPrefix(gomock.Any(), gomock.Eq("products:*")).
+					Times(1).
+					Return(nil)
+			},
+			input: restoreProductTestedInput{
+				id: productID,
+			},
+			expected: restoreProductExpectedOutput{
+				product: productOutput,
+				err:     nil,
+			},
+		},
+		{
+			desc: "Fail_NotFound",
+			mocks: func(
+				productRepo *mock.MockProductRepository,
+				categoryRepo *mock.MockCategoryRepository,
+				cache *mock.MockCacheRepository,
+			) {
+				productRepo.EXPECT().
+					GetProductByID(gomock.Any(), gomock.Eq(productID)).
+					Times(1).
+					Return(nil, domain.ErrDataNotFound)
+			},
+			input: restoreProductTestedInput{
+				id: productID,
+			},
+			expected: restoreProductExpectedOutput{
+				product: nil,
+				err:     domain.ErrDataNotFound,
+			},
+		},
+		{
+			desc: "Fail_NotArchived",
+			mocks: func(
+				productRepo *mock.MockProductRepository,
+				categoryRepo *mock.MockCategoryRepository,
+				cache *mock.MockCacheRepository,
+			) {
+				productRepo.EXPECT().
+					GetProductByID(gomock.Any(), gomock.Eq(productID)).
+					Times(1).
+					Return(&domainproduct.Product{ID: productID}, nil)
+			},
+			input: restoreProductTestedInput{
+				id: productID,
+			},
+			expected: restoreProductExpectedOutput{
+				product: nil,
+				err:     domain.ErrDataNotArchived,
+			},
+		},
+		{
+			desc: "Fail_InternalErrorRestore",
+			mocks: func(
+				productRepo *mock.MockProductRepository,
+				categoryRepo *mock.MockCategoryRepository,
+				cache *mock.MockCacheRepository,
+			) {
+				productRepo.EXPECT().
+					GetProductByID(gomock.Any(), gomock.Eq(productID)).
+					Times(1).
+					Return(archivedProduct, nil)
+				productRepo.EXPECT().
+					RestoreProduct(gomock.Any(), gomock.Eq(productID)).
+					Times(1).
+					Return(nil, domain.ErrInternal)
+			},
+			input: restoreProductTestedInput{
+				id: productID,
+			},
+			expected: restoreProductExpectedOutput{
+				product: nil,
+				err:     domain.ErrInternal,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.desc, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			productRepo := mock.NewMockProductRepository(ctrl)
+			categoryRepo := mock.NewMockCategoryRepository(ctrl)
+			cache := mock.NewMockCacheRepository(ctrl)
+			auditService := newAuditStub(ctrl)
+
+			tc.mocks(productRepo, categoryRepo, cache)
+
+			productService := NewProductUsecase(productRepo, categoryRepo, cache, auditService)
+
+			product, err := productService.RestoreProduct(ctx, tc.input.id)
+			assert.ErrorIs(t, err, tc.expected.err, "Error mismatch")
+			assert.Equal(t, tc.expected.product, product, "Product mismatch")
 		})
 	}
 }
@@ -0,0 +1,136 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+
+	"github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain"
+	domainaudit "github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain/audit"
+	domainrole "github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain/role"
+	domainuser "github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain/user"
+	"github.com/TienMinh25/go-hexagonal-architecture/internal/application/port"
+)
+
+/**
+ * roleUsecase implements port.RoleService interface
+ * and provides an access to the role repository
+ * and audit service
+ */
+type roleUsecase struct {
+	repo  port.RoleRepository
+	audit port.AuditService
+}
+
+// NewRoleUsecase creates a new role service instance
+func NewRoleUsecase(repo port.RoleRepository, audit port.AuditService) port.RoleService {
+	return &roleUsecase{
+		repo,
+		audit,
+	}
+}
+
+// CreateRole creates a new custom role with its permissions
+func (rs *roleUsecase) CreateRole(ctx context.Context, role *domainrole.Role) (*domainrole.Role, error) {
+	role, err := rs.repo.CreateRole(ctx, role)
+	if err != nil {
+		if errors.Is(err, domain.ErrConflictingData) || errors.Is(err, domain.ErrUnknownPermission) {
+			return nil, err
+		}
+		return nil, domain.ErrInternal.Wrap(err)
+	}
+
+	err = rs.audit.Record(ctx, domainaudit.Create, domainaudit.Role, role.ID, nil, role)
+	if err != nil {
+		return nil, err
+	}
+
+	return role, nil
+}
+
+// GetRole returns a role by id
+func (rs *roleUsecase) GetRole(ctx context.Context, id uint64) (*domainrole.Role, error) {
+	role, err := rs.repo.GetRoleByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, domain.ErrDataNotFound) {
+			return nil, err
+		}
+		return nil, domain.ErrInternal.Wrap(err)
+	}
+
+	return role, nil
+}
+
+// ListRoles returns all roles with their permissions
+func (rs *roleUsecase) ListRoles(ctx context.Context) ([]domainrole.Role, error) {
+	roles, err := rs.repo.ListRoles(ctx)
+	if err != nil {
+		return nil, domain.ErrInternal.Wrap(err)
+	}
+
+	return roles, nil
+}
+
+// ListPermissions returns all permissions that can be granted
+func (rs *roleUsecase) ListPermissions(ctx context.Context) ([]domainrole.Permission, error) {
+	permissions, err := rs.repo.ListPermissions(ctx)
+	if err != nil {
+		return nil, domain.ErrInternal.Wrap(err)
+	}
+
+	return permissions, nil
+}
+
+// SetRolePermissions replaces the permissions granted to a role.
+// The admin role always keeps every permission so that it cannot lock itself out
+func (rs *roleUsecase) SetRolePermissions(ctx context.Context, id uint64, permissions []string) (*domainrole.Role, error) {
+	existingRole, err := rs.GetRole(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if existingRole.Name == string(domainuser.Admin) {
+		return nil, domain.ErrSystemRole
+	}
+
+	role, err := rs.repo.SetRolePermissions(ctx, id, permissions)
+	if err != nil {
+		if errors.Is(err, domain.ErrDataNotFound) || errors.Is(err, domain.ErrUnknownPermission) {
+			return nil, err
+		}
+		return nil, domain.ErrInternal.Wrap(err)
+	}
+
+	err = rs.audit.Record(ctx, domainaudit.SetPermissions, domainaudit.Role, id, existingRole, role)
+	if err != nil {
+		return nil, err
+	}
+
+	return role, nil
+}
+
+// DeleteRole deletes a custom role that is not assigned to any user
+func (rs *roleUsecase) DeleteRole(ctx context.Context, id uint64) error {
+	role, err := rs.GetRole(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if role.IsSystem {
+		return domain.ErrSystemRole
+	}
+
+	err = rs.repo.DeleteRole(ctx, id)
+	if err != nil {
+		if errors.Is(err, domain.ErrDataNotFound) || errors.Is(err, domain.ErrRoleInUse) {
+			return err
+		}
+		return domain.ErrInternal.Wrap(err)
+	}
+
+	err = rs.audit.Record(ctx, domainaudit.Delete, domainaudit.Role, id, role, nil)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
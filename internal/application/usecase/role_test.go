@@ -0,0 +1,273 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain"
+	domainrole "github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain/role"
+	"github.com/TienMinh25/go-hexagonal-architecture/internal/application/mock"
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+type setRolePermissionsTestedInput struct {
+	id          uint64
+	permissions []string
+}
+
+type setRolePermissionsExpectedOutput struct {
+	role *domainrole.Role
+	err  error
+}
+
+func TestRoleService_SetRolePermissions(t *testing.T) {
+	ctx := context.Background()
+	permissions := []string{domainrole.OrdersRefund, domainrole.ReportsRead}
+
+	managerRole := &domainrole.Role{
+		ID:       gofakeit.Uint64(),
+		Name:     "manager",
+		IsSystem: true,
+	}
+	updatedRole := &domainrole.Role{
+		ID:          managerRole.ID,
+		Name:        managerRole.Name,
+		IsSystem:    true,
+		Permissions: permissions,
+	}
+	adminRole := &domainrole.Role{
+		ID:       gofakeit.Uint64(),
+		Name:     "admin",
+		IsSystem: true,
+	}
+
+	testCases := []struct {
+		desc     string
+		mocks    func(roleRepo *mock.MockRoleRepository)
+		input    setRolePermissionsTestedInput
+		expected setRolePermissionsExpectedOutput
+	}{
+		{
+			desc: "Success",
+			mocks: func(roleRepo *mock.MockRoleRepository) {
+				roleRepo.EXPECT().
+					GetRoleByID(gomock.Any(), gomock.Eq(managerRole.ID)).
+					Return(managerRole, nil)
+				roleRepo.EXPECT().
+					SetRolePermissions(gomock.Any(), gomock.Eq(managerRole.ID), gomock.Eq(permissions)).
+					Return(updatedRole, nil)
+			},
+			input: setRolePermissionsTestedInput{
+				id:          managerRole.ID,
+				permissions: permissions,
+			},
+			expected: setRolePermissionsExpectedOutput{
+				role: updatedRole,
+				err:  nil,
+			},
+		},
+		{
+			desc: "Fail_AdminRole",
+			mocks: func(roleRepo *mock.MockRoleRepository) {
+				roleRepo.EXPECT().
+					GetRoleByID(gomock.Any(), gomock.Eq(adminRole.ID)).
+					Return(adminRole, nil)
+			},
+			input: setRolePermissionsTestedInput{
+				id:          adminRole.ID,
+				permissions: permissions,
+			},
+			expected: setRolePermissionsExpectedOutput{
+				role: nil,
+				err:  domain.ErrSystemRole,
+			},
+		},
+		{
+			desc: "Fail_NotFound",
+			mocks: func(roleRepo *mock.MockRoleRepository) {
+				roleRepo.EXPECT().
+					GetRoleByID(gomock.Any(), gomock.Eq(managerRole.ID)).
+					Return(nil, domain.ErrDataNotFound)
+			},
+			input: setRolePermissionsTestedInput{
+				id:          managerRole.ID,
+				permissions: permissions,
+			},
+			expected: setRolePermissionsExpectedOutput{
+				role: nil,
+				err:  domain.ErrDataNotFound,
+			},
+		},
+		{
+			desc: "Fail_UnknownPermission",
+			mocks: func(roleRepo *mock.MockRoleRepository) {
+				roleRepo.EXPECT().
+					GetRoleByID(gomock.Any(), gomock.Eq(managerRole.ID)).
+					Return(managerRole, nil)
+				roleRepo.EXPECT().
+					SetRolePermissions(gomock.Any(), gomock.Eq(managerRole.ID), gomock.Any()).
+					Return(nil, domain.ErrUnknownPermission)
+			},
+			input: setRolePermissionsTestedInput{
+				id:          managerRole.ID,
+				permissions: []string{"orders:teleport"},
+			},
+			expected: setRolePermissionsExpectedOutput{
+				role: nil,
+				err:  domain.ErrUnknownPermission,
+			},
+		},
+		{
+			desc: "Fail_InternalError",
+			mocks: func(roleRepo *mock.MockRoleRepository) {
+				roleRepo.EXPECT().
+					GetRoleByID(gomock.Any(), gomock.Eq(managerRole.ID)).
+					Return(managerRole, nil)
+				roleRepo.EXPECT().
+					SetRolePermissions(gomock.Any(), gomock.Eq(managerRole.ID), gomock.Eq(permissions)).
+					Return(nil, domain.ErrInternal)
+			},
+			input: setRolePermissionsTestedInput{
+				id:          managerRole.ID,
+				permissions: permissions,
+			},
+			expected: setRolePermissionsExpectedOutput{
+				role: nil,
+				err:  domain.ErrInternal,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.desc, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			roleRepo := mock.NewMockRoleRepository(ctrl)
+			auditService := newAuditStub(ctrl)
+
+			tc.mocks(roleRepo)
+
+			roleService := NewRoleUsecase(roleRepo, auditService)
+
+			role, err := roleService.SetRolePermissions(ctx, tc.input.id, tc.input.permissions)
+			assert.ErrorIs(t, err, tc.expected.err, "Error mismatch")
+			assert.Equal(t, tc.expected.role, role, "Role mismatch")
+		})
+	}
+}
+
+type deleteRoleTestedInput struct {
+	id uint64
+}
+
+type deleteRoleExpectedOutput struct {
+	err error
+}
+
+func TestRoleService_DeleteRole(t *testing.T) {
+	ctx := context.Background()
+
+	customRole := &domainrole.Role{
+		ID:   gofakeit.Uint64(),
+		Name: "supervisor",
+	}
+	systemRole := &domainrole.Role{
+		ID:       gofakeit.Uint64(),
+		Name:     "cashier",
+		IsSystem: true,
+	}
+
+	testCases := []struct {
+		desc     string
+		mocks    func(roleRepo *mock.MockRoleRepository)
+		input    deleteRoleTestedInput
+		expected deleteRoleExpectedOutput
+	}{
+		{
+			desc: "Success",
+			mocks: func(roleRepo *mock.MockRoleRepository) {
+				roleRepo.EXPECT().
+					GetRoleByID(gomock.Any(), gomock.Eq(customRole.ID)).
+					Return(customRole, nil)
+				roleRepo.EXPECT().
+					DeleteRole(gomock.Any(), gomock.Eq(customRole.ID)).
+					Return(nil)
+			},
+			input: deleteRoleTestedInput{
+				id: customRole.ID,
+			},
+			expected: deleteRoleExpectedOutput{
+				err: nil,
+			},
+		},
+		{
+			desc: "Fail_SystemRole",
+			mocks: func(roleRepo *mock.MockRoleRepository) {
+				roleRepo.EXPECT().
+					GetRoleByID(gomock.Any(), gomock.Eq(systemRole.ID)).
+					Return(systemRole, nil)
+			},
+			input: deleteRoleTestedInput{
+				id: systemRole.ID,
+			},
+			expected: deleteRoleExpectedOutput{
+				err: domain.ErrSystemRole,
+			},
+		},
+		{
+			desc: "Fail_RoleInUse",
+			mocks: func(roleRepo *mock.MockRoleRepository) {
+				roleRepo.EXPECT().
+					GetRoleByID(gomock.Any(), gomock.Eq(customRole.ID)).
+					Return(customRole, nil)
+				roleRepo.EXPECT().
+					DeleteRole(gomock.Any(), gomock.Eq(customRole.ID)).
+					Return(domain.ErrRoleInUse)
+			},
+			input: deleteRoleTestedInput{
+				id: customRole.ID,
+			},
+			expected: deleteRoleExpectedOutput{
+				err: domain.ErrRoleInUse,
+			},
+		},
+		{
+			desc: "Fail_NotFound",
+			mocks: func(roleRepo *mock.MockRoleRepository) {
+				roleRepo.EXPECT().
+					GetRoleByID(gomock.Any(), gomock.Eq(customRole.ID)).
+					Return(nil, domain.ErrDataNotFound)
+			},
+			input: deleteRoleTestedInput{
+				id: customRole.ID,
+			},
+			expected: deleteRoleExpectedOutput{
+				err: domain.ErrDataNotFound,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.desc, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			roleRepo := mock.NewMockRoleRepository(ctrl)
+			auditService := newAuditStub(ctrl)
+
+			tc.mocks(roleRepo)
+
+			roleService := NewRoleUsecase(roleRepo, auditService)
+
+			err := roleService.DeleteRole(ctx, tc.input.id)
+			assert.ErrorIs(t, err, tc.expected.err, "Error mismatch")
+		})
+	}
+}
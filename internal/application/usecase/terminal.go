@@ -0,0 +1,102 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+
+	"github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain"
+	domainaudit "github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain/audit"
+	domainterminal "github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain/terminal"
+	"github.com/TienMinh25/go-hexagonal-architecture/internal/application/port"
+	"github.com/TienMinh25/go-hexagonal-architecture/internal/application/util"
+)
+
+/**
+ * terminalUsecase implements port.TerminalService interface
+ * and provides an access to the terminal repository
+ * and audit service
+ */
+type terminalUsecase struct {
+	repo  port.TerminalRepository
+	audit port.AuditService
+}
+
+// NewTerminalUsecase creates a new terminal service instance
+func NewTerminalUsecase(repo port.TerminalRepository, audit port.AuditService) port.TerminalService {
+	return &terminalUsecase{
+		repo,
+		audit,
+	}
+}
+
+// RegisterTerminal registers a new terminal with a generated secret, only the hash of the secret is stored
+func (ts *terminalUsecase) RegisterTerminal(ctx context.Context, terminal *domainterminal.Terminal) (*domainterminal.Terminal, string, error) {
+	secret, err := util.GenerateToken()
+	if err != nil {
+		return nil, "", domain.ErrInternal.Wrap(err)
+	}
+
+	terminal.SecretHash = util.HashToken(secret)
+
+	terminal, err = ts.repo.CreateTerminal(ctx, terminal)
+	if err != nil {
+		return nil, "", domain.ErrInternal.Wrap(err)
+	}
+
+	err = ts.audit.Record(ctx, domainaudit.Create, domainaudit.Terminal, terminal.ID, nil, terminal)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return terminal, secret, nil
+}
+
+// GetTerminal returns a terminal by id
+func (ts *terminalUsecase) GetTerminal(ctx context.Context, id uint64) (*domainterminal.Terminal, error) {
+	terminal, err := ts.repo.GetTerminalByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, domain.ErrDataNotFound) {
+			return nil, err
+		}
+		return nil, domain.ErrInternal.Wrap(err)
+	}
+
+	return terminal, nil
+}
+
+// ListTerminals returns a list of terminals with pagination
+func (ts *terminalUsecase) ListTerminals(ctx context.Context, skip, limit uint64) ([]domainterminal.Terminal, error) {
+	terminals, err := ts.repo.ListTerminals(ctx, skip, limit)
+	if err != nil {
+		return nil, domain.ErrInternal.Wrap(err)
+	}
+
+	return terminals, nil
+}
+
+// RevokeTerminal revokes a terminal, tokens already issued on it stay valid until they expire
+func (ts *terminalUsecase) RevokeTerminal(ctx context.Context, id uint64) (*domainterminal.Terminal, error) {
+	existingTerminal, err := ts.GetTerminal(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if existingTerminal.RevokedAt != nil {
+		return nil, domain.ErrDataArchived
+	}
+
+	terminal, err := ts.repo.RevokeTerminal(ctx, id)
+	if err != nil {
+		if errors.Is(err, domain.ErrDataNotFound) {
+			return nil, domain.ErrDataArchived
+		}
+		return nil, domain.ErrInternal.Wrap(err)
+	}
+
+	err = ts.audit.Record(ctx, domainaudit.Revoke, domainaudit.Terminal, id, existingTerminal, terminal)
+	if err != nil {
+		return nil, err
+	}
+
+	return terminal, nil
+}
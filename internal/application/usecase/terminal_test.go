@@ -0,0 +1,216 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain"
+	domainterminal "github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain/terminal"
+	"github.com/TienMinh25/go-hexagonal-architecture/internal/application/mock"
+	"github.com/TienMinh25/go-hexagonal-architecture/internal/application/util"
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+type registerTerminalTestedInput struct {
+	terminal *domainterminal.Terminal
+}
+
+type registerTerminalExpectedOutput struct {
+	err error
+}
+
+func TestTerminalService_RegisterTerminal(t *testing.T) {
+	ctx := context.Background()
+	name := gofakeit.Word()
+
+	testCases := []struct {
+		desc     string
+		mocks    func(terminalRepo *mock.MockTerminalRepository)
+		input    registerTerminalTestedInput
+		expected registerTerminalExpectedOutput
+	}{
+		{
+			desc: "Success",
+			mocks: func(terminalRepo *mock.MockTerminalRepository) {
+				terminalRepo.EXPECT().
+					CreateTerminal(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, terminal *domainterminal.Terminal) (*domainterminal.Terminal, error) {
+						terminal.ID = gofakeit.Uint64()
+						return terminal, nil
+					})
+			},
+			input: registerTerminalTestedInput{
+				terminal: &domainterminal.Terminal{
+					Name: name,
+				},
+			},
+			expected: registerTerminalExpectedOutput{
+				err: nil,
+			},
+		},
+		{
+			desc: "Fail_InternalError",
+			mocks: func(terminalRepo *mock.MockTerminalRepository) {
+				terminalRepo.EXPECT().
+					CreateTerminal(gomock.Any(), gomock.Any()).
+					Return(nil, domain.ErrInternal)
+			},
+			input: registerTerminalTestedInput{
+				terminal: &domainterminal.Terminal{
+					Name: name,
+				},
+			},
+			expected: registerTerminalExpectedOutput{
+				err: domain.ErrInternal,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.desc, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			terminalRepo := mock.NewMockTerminalRepository(ctrl)
+			auditService := newAuditStub(ctrl)
+
+			tc.mocks(terminalRepo)
+
+			terminalService := NewTerminalUsecase(terminalRepo, auditService)
+
+			terminal, secret, err := terminalService.RegisterTerminal(ctx, tc.input.terminal)
+			assert.ErrorIs(t, err, tc.expected.err, "Error mismatch")
+
+			if tc.expected.err != nil {
+				assert.Nil(t, terminal, "Terminal mismatch")
+				assert.Empty(t, secret, "Secret mismatch")
+				return
+			}
+
+			assert.NotEmpty(t, secret, "Secret mismatch")
+			assert.Equal(t, util.HashToken(secret), terminal.SecretHash, "Secret hash mismatch")
+		})
+	}
+}
+
+type revokeTerminalTestedInput struct {
+	id uint64
+}
+
+type revokeTerminalExpectedOutput struct {
+	terminal *domainterminal.Terminal
+	err      error
+}
+
+func TestTerminalService_RevokeTerminal(t *testing.T) {
+	ctx := context.Background()
+	revokedAt := gofakeit.Date()
+
+	terminal := &domainterminal.Terminal{
+		ID:   gofakeit.Uint64(),
+		Name: gofakeit.Word(),
+	}
+	revokedTerminal := &domainterminal.Terminal{
+		ID:        terminal.ID,
+		Name:      terminal.Name,
+		RevokedAt: &revokedAt,
+	}
+
+	testCases := []struct {
+		desc     string
+		mocks    func(terminalRepo *mock.MockTerminalRepository)
+		input    revokeTerminalTestedInput
+		expected revokeTerminalExpectedOutput
+	}{
+		{
+			desc: "Success",
+			mocks: func(terminalRepo *mock.MockTerminalRepository) {
+				terminalRepo.EXPECT().
+					GetTerminalByID(gomock.Any(), gomock.Eq(terminal.ID)).
+					Return(terminal, nil)
+				terminalRepo.EXPECT().
+					RevokeTerminal(gomock.Any(), gomock.Eq(terminal.ID)).
+					Return(revokedTerminal, nil)
+			},
+			input: revokeTerminalTestedInput{
+				id: terminal.ID,
+			},
+			expected: revokeTerminalExpectedOutput{
+				terminal: revokedTerminal,
+				err:      nil,
+			},
+		},
+		{
+			desc: "Fail_NotFound",
+			mocks: func(terminalRepo *mock.MockTerminalRepository) {
+				terminalRepo.EXPECT().
+					GetTerminalByID(gomock.Any(), gomock.Eq(terminal.ID)).
+					Return(nil, domain.ErrDataNotFound)
+			},
+			input: revokeTerminalTestedInput{
+				id: terminal.ID,
+			},
+			expected: revokeTerminalExpectedOutput{
+				terminal: nil,
+				err:      domain.ErrDataNotFound,
+			},
+		},
+		{
+			desc: "Fail_AlreadyRevoked",
+			mocks: func(terminalRepo *mock.MockTerminalRepository) {
+				terminalRepo.EXPECT().
+					GetTerminalByID(gomock.Any(), gomock.Eq(terminal.ID)).
+					Return(revokedTerminal, nil)
+			},
+			input: revokeTerminalTestedInput{
+				id: terminal.ID,
+			},
+			expected: revokeTerminalExpectedOutput{
+				terminal: nil,
+				err:      domain.ErrDataArchived,
+			},
+		},
+		{
+			desc: "Fail_InternalError",
+			mocks: func(terminalRepo *mock.MockTerminalRepository) {
+				terminalRepo.EXPECT().
+					GetTerminalByID(gomock.Any(), gomock.Eq(terminal.ID)).
+					Return(terminal, nil)
+				terminalRepo.EXPECT().
+					RevokeTerminal(gomock.Any(), gomock.Eq(terminal.ID)).
+					Return(nil, domain.ErrInternal)
+			},
+			input: revokeTerminalTestedInput{
+				id: terminal.ID,
+			},
+			expected: revokeTerminalExpectedOutput{
+				terminal: nil,
+				err:      domain.ErrInternal,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.desc, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			terminalRepo := mock.NewMockTerminalRepository(ctrl)
+			auditService := newAuditStub(ctrl)
+
+			tc.mocks(terminalRepo)
+
+			terminalService := NewTerminalUsecase(terminalRepo, auditService)
+
+			terminal, err := terminalService.RevokeTerminal(ctx, tc.input.id)
+			assert.ErrorIs(t, err, tc.expected.err, "Error mismatch")
+			assert.Equal(t, tc.expected.terminal, terminal, "Terminal mismatch")
+		})
+	}
+}
@@ -2,8 +2,10 @@ package usecase
 
 import (
 	"context"
+	"errors"
 
 	"github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain"
+	domainaudit "github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain/audit"
 	domainuser "github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain/user"
 	"github.com/TienMinh25/go-hexagonal-architecture/internal/application/port"
 	"github.com/TienMinh25/go-hexagonal-architecture/internal/application/util"
@@ -11,53 +13,84 @@ import (
 
 /**
  * userUsecase implements port.UserService interface
- * and provides an access to the user repository
- * and cache service
+ * and provides an access to the user and invitation repositories,
+ * cache service and audit service
  */
 type userUsecase struct {
-	repo  port.UserRepository
-	cache port.CacheRepository
+	repo           port.UserRepository
+	invitationRepo port.InvitationRepository
+	cache          port.CacheRepository
+	registration   domainuser.RegistrationPolicy
+	audit          port.AuditService
 }
 
 // NewUserUsecase creates a new user service instance
-func NewUserUsecase(repo port.UserRepository, cache port.CacheRepository) port.UserService {
+func NewUserUsecase(
+	repo port.UserRepository,
+	invitationRepo port.InvitationRepository,
+	cache port.CacheRepository,
+	registration domainuser.RegistrationPolicy,
+	audit port.AuditService,
+) port.UserService {
 	return &userUsecase{
 		repo,
+		invitationRepo,
 		cache,
+		registration,
+		audit,
 	}
 }
 
-// Register creates a new user
-func (us *userUsecase) Register(ctx context.Context, user *domainuser.User) (*domainuser.User, error) {
+// Register creates a new user, as a cashier when registration is open
+// or with the invited role when an invitation token for the user's email is given
+func (us *userUsecase) Register(ctx context.Context, user *domainuser.User, invitationToken string) (*domainuser.User, error) {
+	if us.registration.Mode == domainuser.RegistrationClosed {
+		return nil, domain.ErrRegistrationClosed
+	}
+
+	if invitationToken == "" && us.registration.Mode != domainuser.RegistrationOpen {
+		return nil, domain.ErrInvitationRequired
+	}
+
 	hashedPassword, err := util.HashPassword(user.Password)
 	if err != nil {
-		return nil, domain.ErrInternal
+		return nil, domain.ErrInternal.Wrap(err)
 	}
 
 	user.Password = hashedPassword
 
-	user, err = us.repo.CreateUser(ctx, user)
+	if invitationToken != "" {
+		user, err = us.invitationRepo.AcceptInvitation(ctx, util.HashToken(invitationToken), user)
+	} else {
+		user.Role = domainuser.Cashier
+		user, err = us.repo.CreateUser(ctx, user)
+	}
 	if err != nil {
-		if err == domain.ErrConflictingData {
+		if errors.Is(err, domain.ErrConflictingData) || errors.Is(err, domain.ErrInvalidInvitation) {
 			return nil, err
 		}
-		return nil, domain.ErrInternal
+		return nil, domain.ErrInternal.Wrap(err)
 	}
 
 	cacheKey := util.GenerateCacheKey("user", user.ID)
 	userSerialized, err := util.Serialize(user)
 	if err != nil {
-		return nil, domain.ErrInternal
+		return nil, domain.ErrInternal.Wrap(err)
 	}
 
 	err = us.cache.Set(ctx, cacheKey, userSerialized, 0)
 	if err != nil {
-		return nil, domain.ErrInternal
+		return nil, domain.ErrInternal.Wrap(err)
 	}
 
 	err = us.cache.DeleteByPrefix(ctx, "users:*")
 	if err != nil {
-		return nil, domain.ErrInternal
+		return nil, domain.ErrInternal.Wrap(err)
+	}
+
+	err = us.audit.Record(ctx, domainaudit.Create, domainaudit.User, user.ID, nil, user)
+	if err != nil {
+		return nil, err
 	}
 
 	return user, nil
@@ -72,61 +105,61 @@ func (us *userUsecase) GetUser(ctx context.Context, id uint64) (*domainuser.User
 	if err == nil {
 		err := util.Deserialize(cachedUser, &user)
 		if err != nil {
-			return nil, domain.ErrInternal
+			return nil, domain.ErrInternal.Wrap(err)
 		}
 		return user, nil
 	}
 
 	user, err = us.repo.GetUserByID(ctx, id)
 	if err != nil {
-		if err == domain.ErrDataNotFound {
+		if errors.Is(err, domain.ErrDataNotFound) {
 			return nil, err
 		}
-		return nil, domain.ErrInternal
+		return nil, domain.ErrInternal.Wrap(err)
 	}
 
 	userSerialized, err := util.Serialize(user)
 	if err != nil {
-		return nil, domain.ErrInternal
+		return nil, domain.ErrInternal.Wrap(err)
 	}
 
 	err = us.cache.Set(ctx, cacheKey, userSerialized, 0)
 	if err != nil {
-		return nil, domain.ErrInternal
+		return nil, domain.ErrInternal.Wrap(err)
 	}
 
 	return user, nil
 }
 
 // ListUsers lists all users
-func (us *userUsecase) ListUsers(ctx context.Context, skip, limit uint64) ([]domainuser.User, error) {
+func (us *userUsecase) ListUsers(ctx context.Context, skip, limit uint64, includeArchived bool) ([]domainuser.User, error) {
 	var users []domainuser.User
 
-	params := util.GenerateCacheKeyParams(skip, limit)
+	params := util.GenerateCacheKeyParams(skip, limit, includeArchived)
 	cacheKey := util.GenerateCacheKey("users", params)
 
 	cachedUsers, err := us.cache.Get(ctx, cacheKey)
 	if err == nil {
 		err := util.Deserialize(cachedUsers, &users)
 		if err != nil {
-			return nil, domain.ErrInternal
+			return nil, domain.ErrInternal.Wrap(err)
 		}
 		return users, nil
 	}
 
-	users, err = us.repo.ListUsers(ctx, skip, limit)
+	users, err = us.repo.ListUsers(ctx, skip, limit, includeArchived)
 	if err != nil {
-		return nil, domain.ErrInternal
+		return nil, domain.ErrInternal.Wrap(err)
 	}
 
 	usersSerialized, err := util.Serialize(users)
 	if err != nil {
-		return nil, domain.ErrInternal
+		return nil, domain.ErrInternal.Wrap(err)
 	}
 
 	err = us.cache.Set(ctx, cacheKey, usersSerialized, 0)
 	if err != nil {
-		return nil, domain.ErrInternal
+		return nil, domain.ErrInternal.Wrap(err)
 	}
 
 	return users, nil
@@ -136,88 +169,201 @@ func (us *userUsecase) ListUsers(ctx context.Context, skip, limit uint64) ([]dom
 func (us *userUsecase) UpdateUser(ctx context.Context, user *domainuser.User) (*domainuser.User, error) {
 	existingUser, err := us.repo.GetUserByID(ctx, user.ID)
 	if err != nil {
-		if err == domain.ErrDataNotFound {
+		if errors.Is(err, domain.ErrDataNotFound) {
+			return nil, err
+		}
+		return nil, domain.ErrInternal.Wrap(err)
+	}
+
+	if existingUser.DeletedAt != nil {
+		return nil, domain.ErrDataArchived
+	}
+
+	return us.updateUser(ctx, existingUser, user)
+}
+
+// UpdateMe updates the profile of the logged in user, checking its current password
+// first when the email changes since the email is what a password reset is sent to
+func (us *userUsecase) UpdateMe(ctx context.Context, user *domainuser.User, currentPassword string) (*domainuser.User, error) {
+	existingUser, err := us.repo.GetUserByID(ctx, user.ID)
+	if err != nil {
+		if errors.Is(err, domain.ErrDataNotFound) {
 			return nil, err
 		}
-		return nil, domain.ErrInternal
+		return nil, domain.ErrInternal.Wrap(err)
+	}
+
+	if existingUser.DeletedAt != nil {
+		return nil, domain.ErrDataArchived
+	}
+
+	if user.Email != "" && user.Email != existingUser.Email {
+		err = util.ComparePassword(currentPassword, existingUser.Password)
+		if err != nil {
+			return nil, domain.ErrIncorrectPassword
+		}
+	}
+
+	return us.updateUser(ctx, existingUser, user)
+}
+
+// updateUser applies the changes of user onto the existing user it was read as
+func (us *userUsecase) updateUser(ctx context.Context, existingUser, user *domainuser.User) (*domainuser.User, error) {
+	if existingUser.Version != user.Version {
+		return nil, domain.ErrVersionMismatch
 	}
 
 	emptyData := user.Name == "" &&
 		user.Email == "" &&
 		user.Password == "" &&
-		user.Role == ""
+		user.Role == "" &&
+		user.Locale == ""
+	// users updating themselves cannot change their role and leave it empty
 	sameData := existingUser.Name == user.Name &&
 		existingUser.Email == user.Email &&
-		existingUser.Role == user.Role
+		(user.Role == "" || existingUser.Role == user.Role) &&
+		existingUser.Locale == user.Locale
 	if emptyData || sameData {
 		return nil, domain.ErrNoUpdatedData
 	}
 
-	var hashedPassword string
+	var (
+		hashedPassword string
+		err            error
+	)
 
 	if user.Password != "" {
 		hashedPassword, err = util.HashPassword(user.Password)
 		if err != nil {
-			return nil, domain.ErrInternal
+			return nil, domain.ErrInternal.Wrap(err)
 		}
 	}
 
 	user.Password = hashedPassword
 
-	_, err = us.repo.UpdateUser(ctx, user)
+	updatedUser, err := us.repo.UpdateUser(ctx, user)
 	if err != nil {
-		if err == domain.ErrConflictingData {
+		if errors.Is(err, domain.ErrConflictingData) || errors.Is(err, domain.ErrVersionMismatch) || errors.Is(err, domain.ErrUnknownRole) {
 			return nil, err
 		}
-		return nil, domain.ErrInternal
+		return nil, domain.ErrInternal.Wrap(err)
 	}
 
 	cacheKey := util.GenerateCacheKey("user", user.ID)
 
 	err = us.cache.Delete(ctx, cacheKey)
 	if err != nil {
-		return nil, domain.ErrInternal
+		return nil, domain.ErrInternal.Wrap(err)
 	}
 
 	userSerialized, err := util.Serialize(user)
 	if err != nil {
-		return nil, domain.ErrInternal
+		return nil, domain.ErrInternal.Wrap(err)
 	}
 
 	err = us.cache.Set(ctx, cacheKey, userSerialized, 0)
 	if err != nil {
-		return nil, domain.ErrInternal
+		return nil, domain.ErrInternal.Wrap(err)
 	}
 
 	err = us.cache.DeleteByPrefix(ctx, "users:*")
 	if err != nil {
-		return nil, domain.ErrInternal
+		return nil, domain.ErrInternal.Wrap(err)
+	}
+
+	err = us.audit.Record(ctx, domainaudit.Update, domainaudit.User, user.ID, existingUser, updatedUser)
+	if err != nil {
+		return nil, err
 	}
 
 	return user, nil
 }
 
-// DeleteUser deletes a user by ID
-func (us *userUsecase) DeleteUser(ctx context.Context, id uint64) error {
-	_, err := us.repo.GetUserByID(ctx, id)
+// DeleteUser archives a user by ID
+func (us *userUsecase) DeleteUser(ctx context.Context, id, version uint64) error {
+	user, err := us.repo.GetUserByID(ctx, id)
 	if err != nil {
-		if err == domain.ErrDataNotFound {
+		if errors.Is(err, domain.ErrDataNotFound) {
 			return err
 		}
-		return domain.ErrInternal
+		return domain.ErrInternal.Wrap(err)
+	}
+
+	if user.DeletedAt != nil {
+		return domain.ErrDataArchived
+	}
+
+	if user.Version != version {
+		return domain.ErrVersionMismatch
 	}
 
 	cacheKey := util.GenerateCacheKey("user", id)
 
 	err = us.cache.Delete(ctx, cacheKey)
 	if err != nil {
-		return domain.ErrInternal
+		return domain.ErrInternal.Wrap(err)
+	}
+
+	err = us.cache.DeleteByPrefix(ctx, "users:*")
+	if err != nil {
+		return domain.ErrInternal.Wrap(err)
+	}
+
+	err = us.repo.DeleteUser(ctx, id, version)
+	if err != nil {
+		return err
+	}
+
+	err = us.audit.Record(ctx, domainaudit.Delete, domainaudit.User, id, user, nil)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// RestoreUser restores an archived user
+func (us *userUsecase) RestoreUser(ctx context.Context, id uint64) (*domainuser.User, error) {
+	existingUser, err := us.repo.GetUserByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, domain.ErrDataNotFound) {
+			return nil, err
+		}
+		return nil, domain.ErrInternal.Wrap(err)
+	}
+
+	if existingUser.DeletedAt == nil {
+		return nil, domain.ErrDataNotArchived
+	}
+
+	user, err := us.repo.RestoreUser(ctx, id)
+	if err != nil {
+		if errors.Is(err, domain.ErrDataNotFound) {
+			return nil, err
+		}
+		return nil, domain.ErrInternal.Wrap(err)
+	}
+
+	cacheKey := util.GenerateCacheKey("user", user.ID)
+	userSerialized, err := util.Serialize(user)
+	if err != nil {
+		return nil, domain.ErrInternal.Wrap(err)
+	}
+
+	err = us.cache.Set(ctx, cacheKey, userSerialized, 0)
+	if err != nil {
+		return nil, domain.ErrInternal.Wrap(err)
 	}
 
 	err = us.cache.DeleteByPrefix(ctx, "users:*")
 	if err != nil {
-		return domain.ErrInternal
+		return nil, domain.ErrInternal.Wrap(err)
 	}
 
-	return us.repo.DeleteUser(ctx, id)
+	err = us.audit.Record(ctx, domainaudit.Restore, domainaudit.User, id, existingUser, user)
+	if err != nil {
+		return nil, err
+	}
+
+	return user, nil
 }
@@ -14,6 +14,12 @@ import (
 	"go.uber.org/mock/gomock"
 )
 
+var registration = domainuser.RegistrationPolicy{
+	Mode:               domainuser.RegistrationOpen,
+	InvitationDuration: 72 * time.Hour,
+	InvitationURL:      "http://127.0.0.1:5173/register",
+}
+
 type registerTestedInput struct {
 	user *domainuser.User
 }
@@ -174,19 +180,188 @@ func TestUserService_Register(t *testing.T) {
 			defer ctrl.Finish()
 
 			userRepo := mock.NewMockUserRepository(ctrl)
+			invitationRepo := mock.NewMockInvitationRepository(ctrl)
 			cache := mock.NewMockCacheRepository(ctrl)
+			auditService := newAuditStub(ctrl)
 
 			tc.mocks(userRepo, cache)
 
-			userService := NewUserUsecase(userRepo, cache)
+			userService := NewUserUsecase(userRepo, invitationRepo, cache, registration, auditService)
 
-			user, err := userService.Register(ctx, tc.input.user)
-			assert.Equal(t, tc.expected.err, err, "Error mismatch")
+			user, err := userService.Register(ctx, tc.input.user, "")
+			assert.ErrorIs(t, err, tc.expected.err, "Error mismatch")
 			assert.Equal(t, tc.expected.user, user, "User mismatch")
 		})
 	}
 }
 
+type registerWithPolicyTestedInput struct {
+	mode            domainuser.RegistrationMode
+	invitationToken string
+}
+
+type registerWithPolicyExpectedOutput struct {
+	role domainuser.UserRole
+	err  error
+}
+
+func TestUserService_RegisterWithPolicy(t *testing.T) {
+	ctx := context.Background()
+	invitationToken, _ := util.GenerateToken()
+
+	testCases := []struct {
+		desc  string
+		mocks func(
+			userRepo *mock.MockUserRepository,
+			invitationRepo *mock.MockInvitationRepository,
+			cache *mock.MockCacheRepository,
+		)
+		input    registerWithPolicyTestedInput
+		expected registerWithPolicyExpectedOutput
+	}{
+		{
+			desc: "Success_OpenAsCashier",
+			mocks: func(
+				userRepo *mock.MockUserRepository,
+				invitationRepo *mock.MockInvitationRepository,
+				cache *mock.MockCacheRepository,
+			) {
+				userRepo.EXPECT().
+					CreateUser(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, user *domainuser.User) (*domainuser.User, error) {
+						user.ID = gofakeit.Uint64()
+						return user, nil
+					})
+				cache.EXPECT().
+					Set(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil)
+				cache.EXPECT().
+					DeleteByPrefix(gomock.Any(), gomock.Eq("users:*")).
+					Return(nil)
+			},
+			input: registerWithPolicyTestedInput{
+				mode: domainuser.RegistrationOpen,
+			},
+			expected: registerWithPolicyExpectedOutput{
+				role: domainuser.Cashier,
+				err:  nil,
+			},
+		},
+		{
+			desc: "Success_InvitedRole",
+			mocks: func(
+				userRepo *mock.MockUserRepository,
+				invitationRepo *mock.MockInvitationRepository,
+				cache *mock.MockCacheRepository,
+			) {
+				invitationRepo.EXPECT().
+					AcceptInvitation(gomock.Any(), gomock.Eq(util.HashToken(invitationToken)), gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ string, user *domainuser.User) (*domainuser.User, error) {
+						user.ID = gofakeit.Uint64()
+						user.Role = domainuser.Manager
+						return user, nil
+					})
+				cache.EXPECT().
+					Set(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil)
+				cache.EXPECT().
+					DeleteByPrefix(gomock.Any(), gomock.Eq("users:*")).
+					Return(nil)
+			},
+			input: registerWithPolicyTestedInput{
+				mode:            domainuser.RegistrationInvite,
+				invitationToken: invitationToken,
+			},
+			expected: registerWithPolicyExpectedOutput{
+				role: domainuser.Manager,
+				err:  nil,
+			},
+		},
+		{
+			desc: "Fail_InvalidInvitation",
+			mocks: func(
+				userRepo *mock.MockUserRepository,
+				invitationRepo *mock.MockInvitationRepository,
+				cache *mock.MockCacheRepository,
+			) {
+				invitationRepo.EXPECT().
+					AcceptInvitation(gomock.Any(), gomock.Eq(util.HashToken(invitationToken)), gomock.Any()).
+					Return(nil, domain.ErrInvalidInvitation)
+			},
+			input: registerWithPolicyTestedInput{
+				mode:            domainuser.RegistrationOpen,
+				invitationToken: invitationToken,
+			},
+			expected: registerWithPolicyExpectedOutput{
+				err: domain.ErrInvalidInvitation,
+			},
+		},
+		{
+			desc: "Fail_InvitationRequired",
+			mocks: func(
+				userRepo *mock.MockUserRepository,
+				invitationRepo *mock.MockInvitationRepository,
+				cache *mock.MockCacheRepository,
+			) {
+			},
+			input: registerWithPolicyTestedInput{
+				mode: domainuser.RegistrationInvite,
+			},
+			expected: registerWithPolicyExpectedOutput{
+				err: domain.ErrInvitationRequired,
+			},
+		},
+		{
+			desc: "Fail_RegistrationClosed",
+			mocks: func(
+				userRepo *mock.MockUserRepository,
+				invitationRepo *mock.MockInvitationRepository,
+				cache *mock.MockCacheRepository,
+			) {
+			},
+			input: registerWithPolicyTestedInput{
+				mode:            domainuser.RegistrationClosed,
+				invitationToken: invitationToken,
+			},
+			expected: registerWithPolicyExpectedOutput{
+				err: domain.ErrRegistrationClosed,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.desc, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			userRepo := mock.NewMockUserRepository(ctrl)
+			invitationRepo := mock.NewMockInvitationRepository(ctrl)
+			cache := mock.NewMockCacheRepository(ctrl)
+			auditService := newAuditStub(ctrl)
+
+			tc.mocks(userRepo, invitationRepo, cache)
+
+			policy := registration
+			policy.Mode = tc.input.mode
+			userService := NewUserUsecase(userRepo, invitationRepo, cache, policy, auditService)
+
+			user := &domainuser.User{
+				Name:     gofakeit.Name(),
+				Email:    gofakeit.Email(),
+				Password: gofakeit.Password(true, true, true, true, false, 8),
+			}
+
+			user, err := userService.Register(ctx, user, tc.input.invitationToken)
+			assert.ErrorIs(t, err, tc.expected.err, "Error mismatch")
+			if tc.expected.err == nil {
+				assert.Equal(t, tc.expected.role, user.Role, "Role mismatch")
+			}
+		})
+	}
+}
+
 type getUserTestedInput struct {
 	id uint64
 }
@@ -356,22 +531,25 @@ func TestUserService_GetUser(t *testing.T) {
 			defer ctrl.Finish()
 
 			userRepo := mock.NewMockUserRepository(ctrl)
+			invitationRepo := mock.NewMockInvitationRepository(ctrl)
 			cache := mock.NewMockCacheRepository(ctrl)
+			auditService := newAuditStub(ctrl)
 
 			tc.mocks(userRepo, cache)
 
-			userService := NewUserUsecase(userRepo, cache)
+			userService := NewUserUsecase(userRepo, invitationRepo, cache, registration, auditService)
 
 			user, err := userService.GetUser(ctx, tc.input.id)
-			assert.Equal(t, tc.expected.err, err, "Error mismatch")
+			assert.ErrorIs(t, err, tc.expected.err, "Error mismatch")
 			assert.Equal(t, tc.expected.user, user, "User mismatch")
 		})
 	}
 }
 
 type listUsersTestedInput struct {
-	skip  uint64
-	limit uint64
+	skip            uint64
+	limit           uint64
+	includeArchived bool
 }
 
 type listUsersExpectedOutput struct {
@@ -398,8 +576,9 @@ func TestUserService_ListUsers(t *testing.T) {
 	ctx := context.Background()
 	skip := gofakeit.Uint64()
 	limit := gofakeit.Uint64()
+	includeArchived := gofakeit.Bool()
 
-	params := util.GenerateCacheKeyParams(skip, limit)
+	params := util.GenerateCacheKeyParams(skip, limit, includeArchived)
 	cacheKey := util.GenerateCacheKey("users", params)
 	usersSerialized, _ := util.Serialize(users)
 	ttl := time.Duration(0)
@@ -424,8 +603,9 @@ func TestUserService_ListUsers(t *testing.T) {
 					Return(usersSerialized, nil)
 			},
 			input: listUsersTestedInput{
-				skip:  skip,
-				limit: limit,
+				skip:            skip,
+				limit:           limit,
+				includeArchived: includeArchived,
 			},
 			expected: listUsersExpectedOutput{
 				users: users,
@@ -442,15 +622,16 @@ func TestUserService_ListUsers(t *testing.T) {
 					Get(gomock.Any(), gomock.Eq(cacheKey)).
 					Return(nil, domain.ErrDataNotFound)
 				userRepo.EXPECT().
-					ListUsers(gomock.Any(), gomock.Eq(skip), gomock.Eq(limit)).
+					ListUsers(gomock.Any(), gomock.Eq(skip), gomock.Eq(limit), gomock.Eq(includeArchived)).
 					Return(users, nil)
 				cache.EXPECT().
 					Set(gomock.Any(), gomock.Eq(cacheKey), gomock.Eq(usersSerialized), gomock.Eq(ttl)).
 					Return(nil)
 			},
 			input: listUsersTestedInput{
-				skip:  skip,
-				limit: limit,
+				skip:            skip,
+				limit:           limit,
+				includeArchived: includeArchived,
 			},
 			expected: listUsersExpectedOutput{
 				users: users,
@@ -468,8 +649,9 @@ func TestUserService_ListUsers(t *testing.T) {
 					Return([]byte("invalid"), nil)
 			},
 			input: listUsersTestedInput{
-				skip:  skip,
-				limit: limit,
+				skip:            skip,
+				limit:           limit,
+				includeArchived: includeArchived,
 			},
 			expected: listUsersExpectedOutput{
 				users: nil,
@@ -486,12 +668,13 @@ func TestUserService_ListUsers(t *testing.T) {
 					Get(gomock.Any(), gomock.Eq(cacheKey)).
 					Return(nil, domain.ErrDataNotFound)
 				userRepo.EXPECT().
-					ListUsers(gomock.Any(), gomock.Eq(skip), gomock.Eq(limit)).
+					ListUsers(gomock.Any(), gomock.Eq(skip), gomock.Eq(limit), gomock.Eq(includeArchived)).
 					Return(nil, domain.ErrInternal)
 			},
 			input: listUsersTestedInput{
-				skip:  skip,
-				limit: limit,
+				skip:            skip,
+				limit:           limit,
+				includeArchived: includeArchived,
 			},
 			expected: listUsersExpectedOutput{
 				users: nil,
@@ -508,15 +691,16 @@ func TestUserService_ListUsers(t *testing.T) {
 					Get(gomock.Any(), gomock.Eq(cacheKey)).
 					Return(nil, domain.ErrDataNotFound)
 				userRepo.EXPECT().
-					ListUsers(gomock.Any(), gomock.Eq(skip), gomock.Eq(limit)).
+					ListUsers(gomock.Any(), gomock.Eq(skip), gomock.Eq(limit), gomock.Eq(includeArchived)).
 					Return(users, nil)
 				cache.EXPECT().
 					Set(gomock.Any(), gomock.Eq(cacheKey), gomock.Eq(usersSerialized), gomock.Eq(ttl)).
 					Return(domain.ErrInternal)
 			},
 			input: listUsersTestedInput{
-				skip:  skip,
-				limit: limit,
+				skip:            skip,
+				limit:           limit,
+				includeArchived: includeArchived,
 			},
 			expected: listUsersExpectedOutput{
 				users: nil,
@@ -533,14 +717,16 @@ func TestUserService_ListUsers(t *testing.T) {
 			defer ctrl.Finish()
 
 			userRepo := mock.NewMockUserRepository(ctrl)
+			invitationRepo := mock.NewMockInvitationRepository(ctrl)
 			cache := mock.NewMockCacheRepository(ctrl)
+			auditService := newAuditStub(ctrl)
 
 			tc.mocks(userRepo, cache)
 
-			userService := NewUserUsecase(userRepo, cache)
+			userService := NewUserUsecase(userRepo, invitationRepo, cache, registration, auditService)
 
-			users, err := userService.ListUsers(ctx, tc.input.skip, tc.input.limit)
-			assert.Equal(t, tc.expected.err, err, "Error mismatch")
+			users, err := userService.ListUsers(ctx, tc.input.skip, tc.input.limit, tc.input.includeArchived)
+			assert.ErrorIs(t, err, tc.expected.err, "Error mismatch")
 			assert.Equal(t, tc.expected.users, users, "Users mismatch")
 		})
 	}
@@ -579,9 +765,18 @@ func TestUserService_UpdateUser(t *testing.T) {
 		Email: gofakeit.Email(),
 		Role:  domainuser.Admin,
 	}
+	staleUser := &domainuser.User{
+		ID:      userInput.ID,
+		Version: userInput.Version + 1,
+	}
+	localeInput := &domainuser.User{
+		ID:     userID,
+		Locale: "vi",
+	}
 
 	cacheKey := util.GenerateCacheKey("user", userID)
 	userSerialized, _ := util.Serialize(userOutput)
+	localeSerialized, _ := util.Serialize(localeInput)
 	ttl := time.Duration(0)
 
 	testCases := []struct {
@@ -623,6 +818,36 @@ func TestUserService_UpdateUser(t *testing.T) {
 				err:  nil,
 			},
 		},
+		{
+			desc: "Success_LocaleOnly",
+			mocks: func(
+				userRepo *mock.MockUserRepository,
+				cache *mock.MockCacheRepository,
+			) {
+				userRepo.EXPECT().
+					GetUserByID(gomock.Any(), gomock.Eq(userID)).
+					Return(existingUser, nil)
+				userRepo.EXPECT().
+					UpdateUser(gomock.Any(), gomock.Eq(localeInput)).
+					Return(localeInput, nil)
+				cache.EXPECT().
+					Delete(gomock.Any(), gomock.Eq(cacheKey)).
+					Return(nil)
+				cache.EXPECT().
+					Set(gomock.Any(), gomock.Eq(cacheKey), gomock.Eq(localeSerialized), gomock.Eq(ttl)).
+					Return(nil)
+				cache.EXPECT().
+					DeleteByPrefix(gomock.Any(), gomock.Eq("users:*")).
+					Return(nil)
+			},
+			input: updateUserTestedInput{
+				user: localeInput,
+			},
+			expected: updateUserExpectedOutput{
+				user: localeInput,
+				err:  nil,
+			},
+		},
 		{
 			desc: "Fail_NotFound",
 			mocks: func(
@@ -641,6 +866,24 @@ func TestUserService_UpdateUser(t *testing.T) {
 				err:  domain.ErrDataNotFound,
 			},
 		},
+		{
+			desc: "Fail_VersionMismatch",
+			mocks: func(
+				userRepo *mock.MockUserRepository,
+				cache *mock.MockCacheRepository,
+			) {
+				userRepo.EXPECT().
+					GetUserByID(gomock.Any(), gomock.Eq(userID)).
+					Return(staleUser, nil)
+			},
+			input: updateUserTestedInput{
+				user: userInput,
+			},
+			expected: updateUserExpectedOutput{
+				user: nil,
+				err:  domain.ErrVersionMismatch,
+			},
+		},
 		{
 			desc: "Fail_InternalErrorGetByID",
 			mocks: func(
@@ -718,6 +961,27 @@ func TestUserService_UpdateUser(t *testing.T) {
 				err:  domain.ErrConflictingData,
 			},
 		},
+		{
+			desc: "Fail_ConcurrentUpdate",
+			mocks: func(
+				userRepo *mock.MockUserRepository,
+				cache *mock.MockCacheRepository,
+			) {
+				userRepo.EXPECT().
+					GetUserByID(gomock.Any(), gomock.Eq(userID)).
+					Return(existingUser, nil)
+				userRepo.EXPECT().
+					UpdateUser(gomock.Any(), gomock.Eq(userInput)).
+					Return(nil, domain.ErrVersionMismatch)
+			},
+			input: updateUserTestedInput{
+				user: userInput,
+			},
+			expected: updateUserExpectedOutput{
+				user: nil,
+				err:  domain.ErrVersionMismatch,
+			},
+		},
 		{
 			desc: "Fail_InternalErrorUpdate",
 			mocks: func(
@@ -831,21 +1095,262 @@ func TestUserService_UpdateUser(t *testing.T) {
 			defer ctrl.Finish()
 
 			userRepo := mock.NewMockUserRepository(ctrl)
+			invitationRepo := mock.NewMockInvitationRepository(ctrl)
 			cache := mock.NewMockCacheRepository(ctrl)
+			auditService := newAuditStub(ctrl)
 
 			tc.mocks(userRepo, cache)
 
-			userService := NewUserUsecase(userRepo, cache)
+			userService := NewUserUsecase(userRepo, invitationRepo, cache, registration, auditService)
 
 			user, err := userService.UpdateUser(ctx, tc.input.user)
-			assert.Equal(t, tc.expected.err, err, "Error mismatch")
+			assert.ErrorIs(t, err, tc.expected.err, "Error mismatch")
+			assert.Equal(t, tc.expected.user, user, "User mismatch")
+		})
+	}
+}
+
+type updateMeTestedInput struct {
+	user            *domainuser.User
+	currentPassword string
+}
+
+type updateMeExpectedOutput struct {
+	user *domainuser.User
+	err  error
+}
+
+func TestUserService_UpdateMe(t *testing.T) {
+	ctx := context.Background()
+	userID := gofakeit.Uint64()
+	password := gofakeit.Password(true, true, true, true, false, 8)
+	hashedPassword, _ := util.HashPassword(password)
+	deletedAt := gofakeit.Date()
+
+	existingUser := &domainuser.User{
+		ID:       userID,
+		Name:     gofakeit.Name(),
+		Email:    gofakeit.Email(),
+		Password: hashedPassword,
+		Role:     domainuser.Cashier,
+	}
+	archivedUser := &domainuser.User{
+		ID:        userID,
+		Email:     existingUser.Email,
+		Password:  hashedPassword,
+		DeletedAt: &deletedAt,
+	}
+	emailInput := &domainuser.User{
+		ID:    userID,
+		Email: gofakeit.Email(),
+	}
+	nameInput := &domainuser.User{
+		ID:    userID,
+		Name:  gofakeit.Name(),
+		Email: existingUser.Email,
+	}
+	sameInput := &domainuser.User{
+		ID:    userID,
+		Name:  existingUser.Name,
+		Email: existingUser.Email,
+	}
+
+	cacheKey := util.GenerateCacheKey("user", userID)
+	emailSerialized, _ := util.Serialize(emailInput)
+	nameSerialized, _ := util.Serialize(nameInput)
+	ttl := time.Duration(0)
+
+	testCases := []struct {
+		desc  string
+		mocks func(
+			userRepo *mock.MockUserRepository,
+			cache *mock.MockCacheRepository,
+		)
+		input    updateMeTestedInput
+		expected updateMeExpectedOutput
+	}{
+		{
+			desc: "Success_EmailChanged",
+			mocks: func(
+				userRepo *mock.MockUserRepository,
+				cache *mock.MockCacheRepository,
+			) {
+				userRepo.EXPECT().
+					GetUserByID(gomock.Any(), gomock.Eq(userID)).
+					Return(existingUser, nil)
+				userRepo.EXPECT().
+					UpdateUser(gomock.Any(), gomock.Eq(emailInput)).
+					Return(emailInput, nil)
+				cache.EXPECT().
+					Delete(gomock.Any(), gomock.Eq(cacheKey)).
+					Return(nil)
+				cache.EXPECT().
+					Set(gomock.Any(), gomock.Eq(cacheKey), gomock.Eq(emailSerialized), gomock.Eq(ttl)).
+					Return(nil)
+				cache.EXPECT().
+					DeleteByPrefix(gomock.Any(), gomock.Eq("users:*")).
+					Return(nil)
+			},
+			input: updateMeTestedInput{
+				user:            emailInput,
+				currentPassword: password,
+			},
+			expected: updateMeExpectedOutput{
+				user: emailInput,
+				err:  nil,
+			},
+		},
+		{
+			desc: "Success_SameEmailWithoutPassword",
+			mocks: func(
+				userRepo *mock.MockUserRepository,
+				cache *mock.MockCacheRepository,
+			) {
+				userRepo.EXPECT().
+					GetUserByID(gomock.Any(), gomock.Eq(userID)).
+					Return(existingUser, nil)
+				userRepo.EXPECT().
+					UpdateUser(gomock.Any(), gomock.Eq(nameInput)).
+					Return(nameInput, nil)
+				cache.EXPECT().
+					Delete(gomock.Any(), gomock.Eq(cacheKey)).
+					Return(nil)
+				cache.EXPECT().
+					Set(gomock.Any(), gomock.Eq(cacheKey), gomock.Eq(nameSerialized), gomock.Eq(ttl)).
+					Return(nil)
+				cache.EXPECT().
+					DeleteByPrefix(gomock.Any(), gomock.Eq("users:*")).
+					Return(nil)
+			},
+			input: updateMeTestedInput{
+				user: nameInput,
+			},
+			expected: updateMeExpectedOutput{
+				user: nameInput,
+				err:  nil,
+			},
+		},
+		{
+			desc: "Fail_EmailChangedWithoutPassword",
+			mocks: func(
+				userRepo *mock.MockUserRepository,
+				cache *mock.MockCacheRepository,
+			) {
+				userRepo.EXPECT().
+					GetUserByID(gomock.Any(), gomock.Eq(userID)).
+					Return(existingUser, nil)
+			},
+			input: updateMeTestedInput{
+				user: emailInput,
+			},
+			expected: updateMeExpectedOutput{
+				user: nil,
+				err:  domain.ErrIncorrectPassword,
+			},
+		},
+		{
+			desc: "Fail_NothingChanged",
+			mocks: func(
+				userRepo *mock.MockUserRepository,
+				cache *mock.MockCacheRepository,
+			) {
+				userRepo.EXPECT().
+					GetUserByID(gomock.Any(), gomock.Eq(userID)).
+					Return(existingUser, nil)
+			},
+			input: updateMeTestedInput{
+				user: sameInput,
+			},
+			expected: updateMeExpectedOutput{
+				user: nil,
+				err:  domain.ErrNoUpdatedData,
+			},
+		},
+		{
+			desc: "Fail_EmailChangedWithIncorrectPassword",
+			mocks: func(
+				userRepo *mock.MockUserRepository,
+				cache *mock.MockCacheRepository,
+			) {
+				userRepo.EXPECT().
+					GetUserByID(gomock.Any(), gomock.Eq(userID)).
+					Return(existingUser, nil)
+			},
+			input: updateMeTestedInput{
+				user:            emailInput,
+				currentPassword: password + "x",
+			},
+			expected: updateMeExpectedOutput{
+				user: nil,
+				err:  domain.ErrIncorrectPassword,
+			},
+		},
+		{
+			desc: "Fail_DataArchived",
+			mocks: func(
+				userRepo *mock.MockUserRepository,
+				cache *mock.MockCacheRepository,
+			) {
+				userRepo.EXPECT().
+					GetUserByID(gomock.Any(), gomock.Eq(userID)).
+					Return(archivedUser, nil)
+			},
+			input: updateMeTestedInput{
+				user:            emailInput,
+				currentPassword: password,
+			},
+			expected: updateMeExpectedOutput{
+				user: nil,
+				err:  domain.ErrDataArchived,
+			},
+		},
+		{
+			desc: "Fail_InternalError",
+			mocks: func(
+				userRepo *mock.MockUserRepository,
+				cache *mock.MockCacheRepository,
+			) {
+				userRepo.EXPECT().
+					GetUserByID(gomock.Any(), gomock.Eq(userID)).
+					Return(nil, domain.ErrInternal)
+			},
+			input: updateMeTestedInput{
+				user:            emailInput,
+				currentPassword: password,
+			},
+			expected: updateMeExpectedOutput{
+				user: nil,
+				err:  domain.ErrInternal,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.desc, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			userRepo := mock.NewMockUserRepository(ctrl)
+			invitationRepo := mock.NewMockInvitationRepository(ctrl)
+			cache := mock.NewMockCacheRepository(ctrl)
+			auditService := newAuditStub(ctrl)
+
+			tc.mocks(userRepo, cache)
+
+			userService := NewUserUsecase(userRepo, invitationRepo, cache, registration, auditService)
+
+			user, err := userService.UpdateMe(ctx, tc.input.user, tc.input.currentPassword)
+			assert.ErrorIs(t, err, tc.expected.err, "Error mismatch")
 			assert.Equal(t, tc.expected.user, user, "User mismatch")
 		})
 	}
 }
 
 type deleteUserTestedInput struct {
-	id uint64
+	id      uint64
+	version uint64
 }
 
 type deleteUserExpectedOutput struct {
@@ -855,6 +1360,7 @@ type deleteUserExpectedOutput struct {
 func TestUserService_DeleteUser(t *testing.T) {
 	ctx := context.Background()
 	userID := gofakeit.Uint64()
+	version := gofakeit.Uint64()
 
 	cacheKey := util.GenerateCacheKey("user", userID)
 
@@ -875,7 +1381,7 @@ func TestUserService_DeleteUser(t *testing.T) {
 			) {
 				userRepo.EXPECT().
 					GetUserByID(gomock.Any(), gomock.Eq(userID)).
-					Return(&domainuser.User{}, nil)
+					Return(&domainuser.User{Version: version}, nil)
 				cache.EXPECT().
 					Delete(gomock.Any(), gomock.Eq(cacheKey)).
 					Return(nil)
@@ -883,11 +1389,12 @@ func TestUserService_DeleteUser(t *testing.T) {
 					DeleteByPrefix(gomock.Any(), gomock.Eq("users:*")).
 					Return(nil)
 				userRepo.EXPECT().
-					DeleteUser(gomock.Any(), gomock.Eq(userID)).
+					DeleteUser(gomock.Any(), gomock.Eq(userID), gomock.Eq(version)).
 					Return(nil)
 			},
 			input: deleteUserTestedInput{
-				id: userID,
+				id:      userID,
+				version: version,
 			},
 			expected: deleteUserExpectedOutput{
 				err: nil,
@@ -904,7 +1411,8 @@ func TestUserService_DeleteUser(t *testing.T) {
 					Return(nil, domain.ErrDataNotFound)
 			},
 			input: deleteUserTestedInput{
-				id: userID,
+				id:      userID,
+				version: version,
 			},
 			expected: deleteUserExpectedOutput{
 				err: domain.ErrDataNotFound,
@@ -921,7 +1429,8 @@ func TestUserService_DeleteUser(t *testing.T) {
 					Return(nil, domain.ErrInternal)
 			},
 			input: deleteUserTestedInput{
-				id: userID,
+				id:      userID,
+				version: version,
 			},
 			expected: deleteUserExpectedOutput{
 				err: domain.ErrInternal,
@@ -935,13 +1444,14 @@ func TestUserService_DeleteUser(t *testing.T) {
 			) {
 				userRepo.EXPECT().
 					GetUserByID(gomock.Any(), gomock.Eq(userID)).
-					Return(&domainuser.User{}, nil)
+					Return(&domainuser.User{Version: version}, nil)
 				cache.EXPECT().
 					Delete(gomock.Any(), gomock.Eq(cacheKey)).
 					Return(domain.ErrInternal)
 			},
 			input: deleteUserTestedInput{
-				id: userID,
+				id:      userID,
+				version: version,
 			},
 			expected: deleteUserExpectedOutput{
 				err: domain.ErrInternal,
@@ -955,7 +1465,7 @@ func TestUserService_DeleteUser(t *testing.T) {
 			) {
 				userRepo.EXPECT().
 					GetUserByID(gomock.Any(), gomock.Eq(userID)).
-					Return(&domainuser.User{}, nil)
+					Return(&domainuser.User{Version: version}, nil)
 				cache.EXPECT().
 					Delete(gomock.Any(), gomock.Eq(cacheKey)).
 					Return(nil)
@@ -964,7 +1474,8 @@ func TestUserService_DeleteUser(t *testing.T) {
 					Return(domain.ErrInternal)
 			},
 			input: deleteUserTestedInput{
-				id: userID,
+				id:      userID,
+				version: version,
 			},
 			expected: deleteUserExpectedOutput{
 				err: domain.ErrInternal,
@@ -977,7 +1488,8 @@ func TestUserService_DeleteUser(t *testing.T) {
 				cache *mock.MockCacheRepository,
 			) {
 				user := &domainuser.User{
-					ID: userID,
+					ID:      userID,
+					Version: version,
 				}
 				userRepo.EXPECT().
 					GetUserByID(gomock.Any(), gomock.Eq(userID)).
@@ -989,16 +1501,56 @@ func TestUserService_DeleteUser(t *testing.T) {
 					DeleteByPrefix(gomock.Any(), gomock.Eq("users:*")).
 					Return(nil)
 				userRepo.EXPECT().
-					DeleteUser(gomock.Any(), gomock.Eq(userID)).
+					DeleteUser(gomock.Any(), gomock.Eq(userID), gomock.Eq(version)).
 					Return(domain.ErrInternal)
 			},
 			input: deleteUserTestedInput{
-				id: userID,
+				id:      userID,
+				version: version,
 			},
 			expected: deleteUserExpectedOutput{
 				err: domain.ErrInternal,
 			},
 		},
+		{
+			desc: "Fail_Archived",
+			mocks: func(
+				userRepo *mock.MockUserRepository,
+				cache *mock.MockCacheRepository,
+			) {
+				deletedAt := gofakeit.Date()
+				userRepo.EXPECT().
+					GetUserByID(gomock.Any(), gomock.Eq(userID)).
+					Times(1).
+					Return(&domainuser.User{DeletedAt: &deletedAt}, nil)
+			},
+			input: deleteUserTestedInput{
+				id:      userID,
+				version: version,
+			},
+			expected: deleteUserExpectedOutput{
+				err: domain.ErrDataArchived,
+			},
+		},
+		{
+			desc: "Fail_VersionMismatch",
+			mocks: func(
+				userRepo *mock.MockUserRepository,
+				cache *mock.MockCacheRepository,
+			) {
+				userRepo.EXPECT().
+					GetUserByID(gomock.Any(), gomock.Eq(userID)).
+					Times(1).
+					Return(&domainuser.User{Version: version + 1}, nil)
+			},
+			input: deleteUserTestedInput{
+				id:      userID,
+				version: version,
+			},
+			expected: deleteUserExpectedOutput{
+				err: domain.ErrVersionMismatch,
+			},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -1009,14 +1561,197 @@ func TestUserService_DeleteUser(t *testing.T) {
 			defer ctrl.Finish()
 
 			userRepo := mock.NewMockUserRepository(ctrl)
+			invitationRepo := mock.NewMockInvitationRepository(ctrl)
 			cache := mock.NewMockCacheRepository(ctrl)
+			auditService := newAuditStub(ctrl)
 
 			tc.mocks(userRepo, cache)
 
-			userService := NewUserUsecase(userRepo, cache)
+			userService := NewUserUsecase(userRepo, invitationRepo, cache, registration, auditService)
 
-			err := userService.DeleteUser(ctx, tc.input.id)
-			assert.Equal(t, tc.expected.err, err, "Error mismatch")
+			err := userService.DeleteUser(ctx, tc.input.id, tc.input.version)
+			assert.ErrorIs(t, err, tc.expected.err, "Error mismatch")
+		})
+	}
+}
+
+type restoreUserTestedInput struct {
+	id uint64
+}
+
+type restoreUserExpectedOutput struct {
+	user *domainuser.User
+	err  error
+}
+
+func TestUserService_RestoreUser(t *testing.T) {
+	ctx := context.Background()
+	userID := gofakeit.Uint64()
+	userName := gofakeit.Name()
+	deletedAt := gofakeit.Date()
+	archivedUser := &domainuser.User{
+		ID:        userID,
+		Name:      userName,
+		DeletedAt: &deletedAt,
+	}
+	restoredUser := &domainuser.User{
+		ID:   userID,
+		Name: userName,
+	}
+
+	cacheKey := util.GenerateCacheKey("user", userID)
+	userSerialized, _ := util.Serialize(restoredUser)
+	ttl := time.Duration(0)
+
+	testCases := []struct {
+		desc  string
+		mocks func(
+			userRepo *mock.MockUserRepository,
+			cache *mock.MockCacheRepository,
+		)
+		input    restoreUserTestedInput
+		expected restoreUserExpectedOutput
+	}{
+		{
+			desc: "Success",
+			mocks: func(
+				userRepo *mock.MockUserRepository,
+				cache *mock.MockCacheRepository,
+			) {
+				userRepo.EXPECT().
+					GetUserByID(gomock.Any(), gomock.Eq(userID)).
+					Times(1).
+					Return(archivedUser, nil)
+				userRepo.EXPECT().
+					RestoreUser(gomock.Any(), gomock.Eq(userID)).
+					Times(1).
+					Return(restoredUser, nil)
+				cache.EXPECT().
+					Set(gomock.Any(), gomock.Eq(cacheKey), gomock.Eq(userSerialized), gomock.Eq(ttl)).
+					Times(1).
+					Return(nil)
+				cache.EXPECT().
+					DeleteByPrefix(gomock.Any(), gomock.Eq("users:*")).
+					Times(1).
+					Return(nil)
+			},
+			input: restoreUserTestedInput{
+				id: userID,
+			},
+			expected: restoreUserExpectedOutput{
+				user: restoredUser,
+				err:  nil,
+			},
+		},
+		{
+			desc: "Fail_NotFound",
+			mocks: func(
+				userRepo *mock.MockUserRepository,
+				cache *mock.MockCacheRepository,
+			) {
+				userRepo.EXPECT().
+					GetUserByID(gomock.Any(), gomock.Eq(userID)).
+					Times(1).
+					Return(nil, domain.ErrDataNotFound)
+			},
+			input: restoreUserTestedInput{
+				id: userID,
+			},
+			expected: restoreUserExpectedOutput{
+				user: nil,
+				err:  domain.ErrDataNotFound,
+			},
+		},
+		{
+			desc: "Fail_NotArchived",
+			mocks: func(
+				userRepo *mock.MockUserRepository,
+				cache *mock.MockCacheRepository,
+			) {
+				userRepo.EXPECT().
+					GetUserByID(gomock.Any(), gomock.Eq(userID)).
+					Times(1).
+					Return(restoredUser, nil)
+			},
+			input: restoreUserTestedInput{
+				id: userID,
+			},
+			expected: restoreUserExpectedOutput{
+				user: nil,
+				err:  domain.ErrDataNotArchived,
+			},
+		},
+		{
+			desc: "Fail_InternalErrorRestore",
+			mocks: func(
+				userRepo *mock.MockUserRepository,
+				cache *mock.MockCacheRepository,
+			) {
+				userRepo.EXPECT().
+					GetUserByID(gomock.Any(), gomock.Eq(userID)).
+					Times(1).
+					Return(archivedUser, nil)
+				userRepo.EXPECT().
+					RestoreUser(gomock.Any(), gomock.Eq(userID)).
+					Times(1).
+					Return(nil, domain.ErrInternal)
+			},
+			input: restoreUserTestedInput{
+				id: userID,
+			},
+			expected: restoreUserExpectedOutput{
+				user: nil,
+				err:  domain.ErrInternal,
+			},
+		},
+		{
+			desc: "Fail_SetCache",
+			mocks: func(
+				userRepo *mock.MockUserRepository,
+				cache *mock.MockCacheRepository,
+			) {
+				userRepo.EXPECT().
+					GetUserByID(gomock.Any(), gomock.Eq(userID)).
+					Times(1).
+					Return(archivedUser, nil)
+				userRepo.EXPECT().
+					RestoreUser(gomock.Any(), gomock.Eq(userID)).
+					Times(1).
+					Return(restoredUser, nil)
+				cache.EXPECT().
+					Set(gomock.Any(), gomock.Eq(cacheKey), gomock.Eq(userSerialized), gomock.Eq(ttl)).
+					Times(1).
+					Return(domain.ErrInternal)
+			},
+			input: restoreUserTestedInput{
+				id: userID,
+			},
+			expected: restoreUserExpectedOutput{
+				user: nil,
+				err:  domain.ErrInternal,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.desc, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			userRepo := mock.NewMockUserRepository(ctrl)
+			invitationRepo := mock.NewMockInvitationRepository(ctrl)
+			cache := mock.NewMockCacheRepository(ctrl)
+			auditService := newAuditStub(ctrl)
+
+			tc.mocks(userRepo, cache)
+
+			userService := NewUserUsecase(userRepo, invitationRepo, cache, registration, auditService)
+
+			user, err := userService.RestoreUser(ctx, tc.input.id)
+			assert.ErrorIs(t, err, tc.expected.err, "Error mismatch")
+			assert.Equal(t, tc.expected.user, user, "User mismatch")
 		})
 	}
 }
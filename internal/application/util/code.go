@@ -0,0 +1,30 @@
+package util
+
+import (
+	"crypto/rand"
+	"math/big"
+	"strings"
+)
+
+// codeAlphabet leaves out characters that are easily misread, such as 0/O and 1/I
+const codeAlphabet = "ABCDEFGHJKLMNPQRSTUVWXYZ23456789"
+
+// GenerateCode generates a random code of dash separated groups, e.g. groups 4 and size 4 gives XXXX-XXXX-XXXX-XXXX
+func GenerateCode(groups, size int) (string, error) {
+	parts := make([]string, groups)
+	max := big.NewInt(int64(len(codeAlphabet)))
+
+	for i := range parts {
+		part := make([]byte, size)
+		for j := range part {
+			n, err := rand.Int(rand.Reader, max)
+			if err != nil {
+				return "", err
+			}
+			part[j] = codeAlphabet[n.Int64()]
+		}
+		parts[i] = string(part)
+	}
+
+	return strings.Join(parts, "-"), nil
+}
@@ -0,0 +1,40 @@
+package modelv1
+
+import "time"
+
+// APIKeyResponse represents an API key response body
+type APIKeyResponse struct {
+	ID         uint64     `json:"id" example:"1"`
+	Name       string     `json:"name" example:"Storefront sync"`
+	Prefix     string     `json:"prefix" example:"pk_q0GDCb0"`
+	Scopes     []string   `json:"scopes" example:"products:write"`
+	CreatedBy  uint64     `json:"created_by" example:"1"`
+	ExpiresAt  *time.Time `json:"expires_at" example:"1970-01-01T00:00:00Z"`
+	LastUsedAt *time.Time `json:"last_used_at" example:"1970-01-01T00:00:00Z"`
+	RevokedAt  *time.Time `json:"revoked_at" example:"1970-01-01T00:00:00Z"`
+	CreatedAt  time.Time  `json:"created_at" example:"1970-01-01T00:00:00Z"`
+}
+
+// CreateAPIKeyResponse represents the response body of a created API key, the key is only shown once
+type CreateAPIKeyResponse struct {
+	APIKeyResponse
+	Key string `json:"key" example:"pk_q0GDCb0wXJk5oK3Iu2p0n0v4Y0s8JqJ7Q6Xv3K2ZJ5A"`
+}
+
+// CreateAPIKeyRequest represents a request body for creating a new API key, it never expires without an expiry
+type CreateAPIKeyRequest struct {
+	Name      string     `json:"name" binding:"required" example:"Storefront sync"`
+	Scopes    []string   `json:"scopes" binding:"required,min=1,dive,required" example:"products:write"`
+	ExpiresAt *time.Time `json:"expires_at" example:"2030-01-01T00:00:00Z"`
+}
+
+// ListAPIKeysRequest represents a request body for listing API keys
+type ListAPIKeysRequest struct {
+	Skip  uint64 `form:"skip" binding:"required,min=0" example:"0"`
+	Limit uint64 `form:"limit" binding:"required,min=5" example:"5"`
+}
+
+// RevokeAPIKeyRequest represents a request body for revoking an API key
+type RevokeAPIKeyRequest struct {
+	ID uint64 `uri:"id" binding:"required,min=1" example:"1"`
+}
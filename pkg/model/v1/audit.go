@@ -0,0 +1,44 @@
+package modelv1
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// AuditLogResponse represents an audit log response body.
+// Changes maps every changed field to its value before and after the change
+type AuditLogResponse struct {
+	ID        uint64          `json:"id" example:"1"`
+	ActorID   uint64          `json:"actor_id" example:"1"`
+	APIKeyID  uint64          `json:"api_key_id" example:"0"`
+	Action    string          `json:"action" example:"update"`
+	Entity    string          `json:"entity" example:"product"`
+	EntityID  uint64          `json:"entity_id" example:"1"`
+	Before    json.RawMessage `json:"before" swaggertype:"object"`
+	After     json.RawMessage `json:"after" swaggertype:"object"`
+	Changes   json.RawMessage `json:"changes" swaggertype:"object"`
+	IP        string          `json:"ip" example:"127.0.0.1"`
+	RequestID string          `json:"request_id" example:"5f0c9a3e-7f55-4c5e-9a51-2d1f7bb3c8f4"`
+	PrevHash  string          `json:"prev_hash" example:"9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08"`
+	Hash      string          `json:"hash" example:"60303ae22b998861bce3b28f33eec1be758a213c86c93c076dbe9f558c11c752"`
+	CreatedAt time.Time       `json:"created_at" example:"1970-01-01T00:00:00Z"`
+}
+
+// AuditVerificationResponse represents the response body of a check of the audit log hash chain
+type AuditVerificationResponse struct {
+	Valid    bool   `json:"valid" example:"true"`
+	Checked  uint64 `json:"checked" example:"42"`
+	BrokenAt uint64 `json:"broken_at" example:"0"`
+}
+
+// ListAuditLogsRequest represents a request body for listing audit logs, from and to are RFC 3339 times
+type ListAuditLogsRequest struct {
+	ActorID  uint64     `form:"actor_id" binding:"omitempty,min=1" example:"1"`
+	Action   string     `form:"action" binding:"omitempty" example:"update"`
+	Entity   string     `form:"entity" binding:"omitempty" example:"product"`
+	EntityID uint64     `form:"entity_id" binding:"omitempty,min=1" example:"1"`
+	From     *time.Time `form:"from" time_format:"2006-01-02T15:04:05Z07:00" binding:"omitempty" example:"1970-01-01T00:00:00Z"`
+	To       *time.Time `form:"to" time_format:"2006-01-02T15:04:05Z07:00" binding:"omitempty" example:"1970-01-01T00:00:00Z"`
+	Skip     uint64     `form:"skip" binding:"required,min=0" example:"0"`
+	Limit    uint64     `form:"limit" binding:"required,min=5" example:"5"`
+}
@@ -8,5 +8,69 @@ type LoginRequest struct {
 
 // AuthResponse represents an authentication response body
 type AuthResponse struct {
-	AccessToken string `json:"token" example:"v2.local.Gdh5kiOTyyaQ3_bNykYDeYHO21Jg2..."`
+	AccessToken  string `json:"token" example:"v4.local.Gdh5kiOTyyaQ3_bNykYDeYHO21Jg2..."`
+	RefreshToken string `json:"refresh_token" example:"q0GDCb0wXJk5oK3Iu2p0n0v4Y0s8JqJ7Q6Xv3K2ZJ5A"`
+}
+
+// LoginResponse represents a login response body, either the tokens or a challenge to complete with a second factor
+type LoginResponse struct {
+	AccessToken       string `json:"token,omitempty" example:"v4.local.Gdh5kiOTyyaQ3_bNykYDeYHO21Jg2..."`
+	RefreshToken      string `json:"refresh_token,omitempty" example:"q0GDCb0wXJk5oK3Iu2p0n0v4Y0s8JqJ7Q6Xv3K2ZJ5A"`
+	TwoFactorRequired bool   `json:"two_factor_required" example:"false"`
+	Challenge         string `json:"challenge,omitempty" example:"x7Jm2c0k5dI9q3rV1sT8uW6yZ4aB0eF2gH5jK7lN9oP"`
+}
+
+// RefreshRequest represents the request body for refreshing an access token
+type RefreshRequest struct {
+	RefreshToken string `json:"refresh_token" binding:"required" example:"q0GDCb0wXJk5oK3Iu2p0n0v4Y0s8JqJ7Q6Xv3K2ZJ5A"`
+}
+
+// LogoutRequest represents the request body for logging out
+type LogoutRequest struct {
+	RefreshToken string `json:"refresh_token" binding:"omitempty" example:"q0GDCb0wXJk5oK3Iu2p0n0v4Y0s8JqJ7Q6Xv3K2ZJ5A"`
+}
+
+// UnlockUserRequest represents the request body for unlocking a locked out user
+type UnlockUserRequest struct {
+	ID uint64 `uri:"id" binding:"required,min=1" example:"1"`
+}
+
+// PINLoginRequest represents the request body for logging in a user by PIN on a registered terminal
+type PINLoginRequest struct {
+	TerminalID     uint64 `json:"terminal_id" binding:"required,min=1" example:"1"`
+	TerminalSecret string `json:"terminal_secret" binding:"required" example:"q0GDCb0wXJk5oK3Iu2p0n0v4Y0s8JqJ7Q6Xv3K2ZJ5A"`
+	UserID         uint64 `json:"user_id" binding:"required,min=1" example:"1"`
+	PIN            string `json:"pin" binding:"required,numeric,min=4,max=8" example:"1234" minLength:"4" maxLength:"8"`
+}
+
+// PINLoginResponse represents the response body of a PIN login, a short-lived access token without a refresh token
+type PINLoginResponse struct {
+	AccessToken string `json:"token" example:"v4.local.Gdh5kiOTyyaQ3_bNykYDeYHO21Jg2..."`
+}
+
+// SetPINRequest represents the request body for setting the PIN of a user
+type SetPINRequest struct {
+	PIN string `json:"pin" binding:"required,numeric,min=4,max=8" example:"1234" minLength:"4" maxLength:"8"`
+}
+
+// VerifyTwoFactorRequest represents the request body for completing a login challenge with a second factor
+type VerifyTwoFactorRequest struct {
+	Challenge string `json:"challenge" binding:"required" example:"x7Jm2c0k5dI9q3rV1sT8uW6yZ4aB0eF2gH5jK7lN9oP"`
+	Code      string `json:"code" binding:"required" example:"123456"`
+}
+
+// TwoFactorCodeRequest represents the request body for confirming or disabling two-factor authentication
+type TwoFactorCodeRequest struct {
+	Code string `json:"code" binding:"omitempty" example:"123456"`
+}
+
+// TOTPEnrollmentResponse represents a started TOTP enrollment response body
+type TOTPEnrollmentResponse struct {
+	Secret          string `json:"secret" example:"JBSWY3DPEHPK3PXPJBSWY3DPEHPK3PXP"`
+	ProvisioningURI string `json:"provisioning_uri" example:"otpauth://totp/hexagonal-demo:test@example.com?algorithm=SHA1&digits=6&issuer=hexagonal-demo&period=30&secret=JBSWY3DPEHPK3PXPJBSWY3DPEHPK3PXP"`
+}
+
+// RecoveryCodesResponse represents the response body of the recovery codes given when two-factor authentication is enabled
+type RecoveryCodesResponse struct {
+	RecoveryCodes []string `json:"recovery_codes" example:"ABCDE-FGHJK,LMNPQ-RSTUV"`
 }
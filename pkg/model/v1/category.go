@@ -1,5 +1,7 @@
 package modelv1
 
+import "time"
+
 // CreateCategoryRequest represents a request body for creating a new category
 type CreateCategoryRequest struct {
 	Name string `json:"name" binding:"required" example:"Foods"`
@@ -7,8 +9,10 @@ type CreateCategoryRequest struct {
 
 // categoryResponse represents a category response body
 type CategoryResponse struct {
-	ID   uint64 `json:"id" example:"1"`
-	Name string `json:"name" example:"Foods"`
+	ID        uint64     `json:"id" example:"1"`
+	Name      string     `json:"name" example:"Foods"`
+	DeletedAt *time.Time `json:"deleted_at,omitempty" example:"1970-01-01T00:00:00Z"`
+	Version   uint64     `json:"version" example:"1"`
 }
 
 // GetCategoryRequest represents a request body for retrieving a category
@@ -18,8 +22,9 @@ type GetCategoryRequest struct {
 
 // ListCategoriesRequest represents a request body for listing categories
 type ListCategoriesRequest struct {
-	Skip  uint64 `form:"skip" binding:"required,min=0" example:"0"`
-	Limit uint64 `form:"limit" binding:"required,min=5" example:"5"`
+	Skip            uint64 `form:"skip" binding:"required,min=0" example:"0"`
+	Limit           uint64 `form:"limit" binding:"required,min=5" example:"5"`
+	IncludeArchived bool   `form:"include_archived" binding:"omitempty" example:"false"`
 }
 
 // UpdateCategoryRequest represents a request body for updating a category
@@ -31,3 +36,8 @@ type UpdateCategoryRequest struct {
 type DeleteCategoryRequest struct {
 	ID uint64 `uri:"id" binding:"required,min=1" example:"1"`
 }
+
+// RestoreCategoryRequest represents a request body for restoring an archived category
+type RestoreCategoryRequest struct {
+	ID uint64 `uri:"id" binding:"required,min=1" example:"1"`
+}
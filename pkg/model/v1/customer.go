@@ -0,0 +1,77 @@
+package modelv1
+
+import "time"
+
+// CustomerResponse represents a customer response body
+type CustomerResponse struct {
+	ID        uint64     `json:"id" example:"1"`
+	Name      string     `json:"name" example:"John Doe"`
+	Phone     string     `json:"phone" example:"+6281234567890"`
+	Email     string     `json:"email" example:"john@example.com"`
+	Notes     string     `json:"notes" example:"Prefers paper receipts"`
+	CreatedAt time.Time  `json:"created_at" example:"1970-01-01T00:00:00Z"`
+	UpdatedAt time.Time  `json:"updated_at" example:"1970-01-01T00:00:00Z"`
+	DeletedAt *time.Time `json:"deleted_at,omitempty" example:"1970-01-01T00:00:00Z"`
+	Version   uint64     `json:"version" example:"1"`
+}
+
+// PurchaseSummaryResponse represents a customer's purchase summary response body
+type PurchaseSummaryResponse struct {
+	CustomerID    uint64     `json:"customer_id" example:"1"`
+	Visits        uint64     `json:"visits" example:"12"`
+	LifetimeSpend float64    `json:"lifetime_spend" example:"1250000"`
+	FirstVisitAt  *time.Time `json:"first_visit_at,omitempty" example:"1970-01-01T00:00:00Z"`
+	LastVisitAt   *time.Time `json:"last_visit_at,omitempty" example:"1970-01-01T00:00:00Z"`
+}
+
+// CustomerOrdersResponse represents a customer's purchase history response body
+type CustomerOrdersResponse struct {
+	Meta    Meta                    `json:"meta"`
+	Summary PurchaseSummaryResponse `json:"summary"`
+	Orders  []OrderResponse         `json:"orders"`
+}
+
+// CreateCustomerRequest represents a request body for creating a new customer
+type CreateCustomerRequest struct {
+	Name  string `json:"name" binding:"required" example:"John Doe"`
+	Phone string `json:"phone" binding:"omitempty,e164" example:"+6281234567890"`
+	Email string `json:"email" binding:"omitempty,email" example:"john@example.com"`
+	Notes string `json:"notes" binding:"omitempty" example:"Prefers paper receipts"`
+}
+
+// GetCustomerRequest represents a request body for retrieving a customer
+type GetCustomerRequest struct {
+	ID uint64 `uri:"id" binding:"required,min=1" example:"1"`
+}
+
+// ListCustomersRequest represents a request body for listing customers
+type ListCustomersRequest struct {
+	Query           string `form:"q" binding:"omitempty" example:"John"`
+	Skip            uint64 `form:"skip" binding:"required,min=0" example:"0"`
+	Limit           uint64 `form:"limit" binding:"required,min=5" example:"5"`
+	IncludeArchived bool   `form:"include_archived" binding:"omitempty" example:"false"`
+}
+
+// UpdateCustomerRequest represents a request body for updating a customer
+type UpdateCustomerRequest struct {
+	Name  string `json:"name" binding:"omitempty,required" example:"John Doe"`
+	Phone string `json:"phone" binding:"omitempty,required,e164" example:"+6281234567890"`
+	Email string `json:"email" binding:"omitempty,required,email" example:"john@example.com"`
+	Notes string `json:"notes" binding:"omitempty,required" example:"Prefers paper receipts"`
+}
+
+// DeleteCustomerRequest represents a request body for deleting a customer
+type DeleteCustomerRequest struct {
+	ID uint64 `uri:"id" binding:"required,min=1" example:"1"`
+}
+
+// RestoreCustomerRequest represents a request body for restoring an archived customer
+type RestoreCustomerRequest struct {
+	ID uint64 `uri:"id" binding:"required,min=1" example:"1"`
+}
+
+// ListCustomerOrdersRequest represents a request body for listing a customer's orders
+type ListCustomerOrdersRequest struct {
+	Skip  uint64 `form:"skip" binding:"required,min=0" example:"0"`
+	Limit uint64 `form:"limit" binding:"required,min=5" example:"5"`
+}
@@ -0,0 +1,48 @@
+package modelv1
+
+import "time"
+
+// GiftCardResponse represents a gift card response body
+type GiftCardResponse struct {
+	ID             uint64     `json:"id" example:"1"`
+	Code           string     `json:"code" example:"ABCD-EFGH-JKLM-NPQR"`
+	InitialBalance float64    `json:"initial_balance" example:"500000"`
+	Balance        float64    `json:"balance" example:"125000"`
+	ExpiresAt      *time.Time `json:"expires_at,omitempty" example:"1970-01-01T00:00:00Z"`
+	CreatedAt      time.Time  `json:"created_at" example:"1970-01-01T00:00:00Z"`
+	UpdatedAt      time.Time  `json:"updated_at" example:"1970-01-01T00:00:00Z"`
+}
+
+// GiftCardSaleResponse represents a response body for a gift card sold or topped up through an order
+type GiftCardSaleResponse struct {
+	GiftCard    GiftCardResponse `json:"gift_card"`
+	OrderID     uint64           `json:"order_id" example:"1"`
+	ReceiptCode string           `json:"receipt_id" example:"4979cf6e-d215-4ff8-9d0d-b3e99bcc7750"`
+	TotalPrice  float64          `json:"total_price" example:"500000"`
+	TotalPaid   float64          `json:"total_paid" example:"500000"`
+	TotalReturn float64          `json:"total_return" example:"0"`
+}
+
+// IssueGiftCardRequest represents a request body for selling a new gift card
+type IssueGiftCardRequest struct {
+	InitialBalance float64    `json:"initial_balance" binding:"required,gt=0" example:"500000"`
+	ExpiresAt      *time.Time `json:"expires_at" binding:"omitempty" example:"2030-01-01T00:00:00Z"`
+	PaymentID      uint64     `json:"payment_id" binding:"required" example:"1"`
+	CustomerID     uint64     `json:"customer_id" binding:"omitempty,min=1" example:"1"`
+	CustomerName   string     `json:"customer_name" binding:"required_without=CustomerID" example:"John Doe"`
+	TotalPaid      float64    `json:"total_paid" binding:"required,gt=0" example:"500000"`
+}
+
+// GetGiftCardRequest represents a request body for retrieving a gift card
+type GetGiftCardRequest struct {
+	Code string `uri:"code" binding:"required" example:"ABCD-EFGH-JKLM-NPQR"`
+}
+
+// TopUpGiftCardRequest represents a request body for topping up a gift card
+type TopUpGiftCardRequest struct {
+	Amount       float64 `json:"amount" binding:"required,gt=0" example:"100000"`
+	PaymentID    uint64  `json:"payment_id" binding:"required" example:"1"`
+	CustomerID   uint64  `json:"customer_id" binding:"omitempty,min=1" example:"1"`
+	CustomerName string  `json:"customer_name" binding:"required_without=CustomerID" example:"John Doe"`
+	TotalPaid    float64 `json:"total_paid" binding:"required,gt=0" example:"100000"`
+}
@@ -0,0 +1,21 @@
+package modelv1
+
+// LivenessResponse represents the response body of the liveness probe
+type LivenessResponse struct {
+	Status string `json:"status" example:"up"`
+}
+
+// ReadinessResponse represents the response body of the readiness probe
+type ReadinessResponse struct {
+	Status     string              `json:"status" example:"up"`
+	Draining   bool                `json:"draining" example:"false"`
+	Components []ComponentResponse `json:"components"`
+}
+
+// ComponentResponse represents the health of a dependency in the readiness probe
+type ComponentResponse struct {
+	Name      string  `json:"name" example:"database"`
+	Status    string  `json:"status" example:"up"`
+	LatencyMs float64 `json:"latency_ms" example:"1.25"`
+	Error     string  `json:"error,omitempty" example:"check timed out"`
+}
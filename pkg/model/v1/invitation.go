@@ -0,0 +1,42 @@
+package modelv1
+
+import (
+	"time"
+
+	domainuser "github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain/user"
+)
+
+// InvitationResponse represents an invitation response body
+type InvitationResponse struct {
+	ID         uint64              `json:"id" example:"1"`
+	Email      string              `json:"email" example:"test@example.com"`
+	Role       domainuser.UserRole `json:"role" example:"cashier"`
+	InvitedBy  uint64              `json:"invited_by" example:"1"`
+	ExpiresAt  time.Time           `json:"expires_at" example:"1970-01-01T00:00:00Z"`
+	AcceptedAt *time.Time          `json:"accepted_at" example:"1970-01-01T00:00:00Z"`
+	RevokedAt  *time.Time          `json:"revoked_at" example:"1970-01-01T00:00:00Z"`
+	CreatedAt  time.Time           `json:"created_at" example:"1970-01-01T00:00:00Z"`
+}
+
+// CreateInvitationResponse represents the response body of a created invitation, the token is only shown once
+type CreateInvitationResponse struct {
+	InvitationResponse
+	Token string `json:"token" example:"q0GDCb0wXJk5oK3Iu2p0n0v4Y0s8JqJ7Q6Xv3K2ZJ5A"`
+}
+
+// CreateInvitationRequest represents a request body for inviting an email to register
+type CreateInvitationRequest struct {
+	Email string              `json:"email" binding:"required,email" example:"test@example.com"`
+	Role  domainuser.UserRole `json:"role" binding:"required,user_role" example:"cashier"`
+}
+
+// ListInvitationsRequest represents a request body for listing invitations
+type ListInvitationsRequest struct {
+	Skip  uint64 `form:"skip" binding:"required,min=0" example:"0"`
+	Limit uint64 `form:"limit" binding:"required,min=5" example:"5"`
+}
+
+// RevokeInvitationRequest represents a request body for revoking an invitation
+type RevokeInvitationRequest struct {
+	ID uint64 `uri:"id" binding:"required,min=1" example:"1"`
+}
@@ -0,0 +1,16 @@
+package modelv1
+
+// KeySetResponse represents a JSON Web Key Set response body
+type KeySetResponse struct {
+	Keys []KeyResponse `json:"keys"`
+}
+
+// KeyResponse represents a public key in JSON Web Key format
+type KeyResponse struct {
+	KeyType   string `json:"kty" example:"OKP"`
+	Curve     string `json:"crv" example:"Ed25519"`
+	KeyID     string `json:"kid" example:"2024-01"`
+	Algorithm string `json:"alg" example:"v4.public"`
+	Use       string `json:"use" example:"sig"`
+	X         string `json:"x" example:"11qYAYKxCrfVS_7TyWQHOg7hcvPapiMlrwIaaPcHURo"`
+}
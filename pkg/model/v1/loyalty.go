@@ -0,0 +1,13 @@
+package modelv1
+
+// LoyaltyBalanceResponse represents a loyalty points balance response body
+type LoyaltyBalanceResponse struct {
+	CustomerID uint64  `json:"customer_id" example:"1"`
+	Points     int64   `json:"points" example:"250"`
+	Value      float64 `json:"value" example:"2500"`
+}
+
+// GetLoyaltyBalanceRequest represents a request body for retrieving the points balance of a customer
+type GetLoyaltyBalanceRequest struct {
+	ID uint64 `uri:"id" binding:"required,min=1" example:"1"`
+}
@@ -4,18 +4,26 @@ import "time"
 
 // OrderResponse represents an order response body
 type OrderResponse struct {
-	ID           uint64                 `json:"id" example:"1"`
-	UserID       uint64                 `json:"user_id" example:"1"`
-	PaymentID    uint64                 `json:"payment_type_id" example:"1"`
-	CustomerName string                 `json:"customer_name" example:"John Doe"`
-	TotalPrice   float64                `json:"total_price" example:"100000"`
-	TotalPaid    float64                `json:"total_paid" example:"100000"`
-	TotalReturn  float64                `json:"total_return" example:"0"`
-	ReceiptCode  string                 `json:"receipt_id" example:"4979cf6e-d215-4ff8-9d0d-b3e99bcc7750"`
-	Products     []OrderProductResponse `json:"products"`
-	PaymentType  PaymentResponse        `json:"payment_type"`
-	CreatedAt    time.Time              `json:"created_at" example:"1970-01-01T00:00:00Z"`
-	UpdatedAt    time.Time              `json:"updated_at" example:"1970-01-01T00:00:00Z"`
+	ID             uint64                 `json:"id" example:"1"`
+	UserID         uint64                 `json:"user_id" example:"1"`
+	PaymentID      uint64                 `json:"payment_type_id" example:"1"`
+	CustomerID     *uint64                `json:"customer_id,omitempty" example:"1"`
+	CustomerName   string                 `json:"customer_name" example:"John Doe"`
+	TotalPrice     float64                `json:"total_price" example:"100000"`
+	TotalPaid      float64                `json:"total_paid" example:"100000"`
+	TotalReturn    float64                `json:"total_return" example:"0"`
+	PointsRedeemed int64                  `json:"points_redeemed" example:"0"`
+	PointsEarned   int64                  `json:"points_earned" example:"100"`
+	GiftCardID     *uint64                `json:"gift_card_id,omitempty" example:"1"`
+	GiftCardAmount float64                `json:"gift_card_amount" example:"0"`
+	SoldGiftCardID *uint64                `json:"sold_gift_card_id,omitempty" example:"1"`
+	TerminalID     *uint64                `json:"terminal_id,omitempty" example:"1"`
+	ReceiptCode    string                 `json:"receipt_id" example:"4979cf6e-d215-4ff8-9d0d-b3e99bcc7750"`
+	Products       []OrderProductResponse `json:"products"`
+	PaymentType    PaymentResponse        `json:"payment_type"`
+	CreatedAt      time.Time              `json:"created_at" example:"1970-01-01T00:00:00Z"`
+	UpdatedAt      time.Time              `json:"updated_at" example:"1970-01-01T00:00:00Z"`
+	RefundedAt     *time.Time             `json:"refunded_at,omitempty" example:"1970-01-01T00:00:00Z"`
 }
 
 // orderProductResponse represents an order product response body
@@ -41,8 +49,11 @@ type OrderProductRequest struct {
 // CreateOrderRequest represents a request body for creating a new order
 type CreateOrderRequest struct {
 	PaymentID    uint64                `json:"payment_id" binding:"required" example:"1"`
-	CustomerName string                `json:"customer_name" binding:"required" example:"John Doe"`
-	TotalPaid    int64                 `json:"total_paid" binding:"required" example:"100000"`
+	CustomerID   uint64                `json:"customer_id" binding:"omitempty,min=1" example:"1"`
+	CustomerName string                `json:"customer_name" binding:"required_without=CustomerID" example:"John Doe"`
+	TotalPaid    int64                 `json:"total_paid" binding:"min=0" example:"100000"`
+	RedeemPoints int64                 `json:"redeem_points" binding:"omitempty,min=1" example:"0"`
+	GiftCardCode string                `json:"gift_card_code" binding:"omitempty" example:"ABCD-EFGH-JKLM-NPQR"`
 	Products     []OrderProductRequest `json:"products" binding:"required"`
 }
 
@@ -53,6 +64,12 @@ type GetOrderRequest struct {
 
 // ListOrdersRequest represents a request body for listing orders
 type ListOrdersRequest struct {
-	Skip  uint64 `form:"skip" binding:"required,min=0" example:"0"`
-	Limit uint64 `form:"limit" binding:"required,min=5" example:"5"`
+	CustomerID uint64 `form:"customer_id" binding:"omitempty,min=1" example:"1"`
+	Skip       uint64 `form:"skip" binding:"required,min=0" example:"0"`
+	Limit      uint64 `form:"limit" binding:"required,min=5" example:"5"`
+}
+
+// RefundOrderRequest represents a request body for refunding an order
+type RefundOrderRequest struct {
+	ID uint64 `uri:"id" binding:"required,min=1" example:"1"`
 }
@@ -0,0 +1,18 @@
+package modelv1
+
+// ChangePasswordRequest represents the request body for changing the password of the logged in user
+type ChangePasswordRequest struct {
+	CurrentPassword string `json:"current_password" binding:"required" example:"12345678"`
+	NewPassword     string `json:"new_password" binding:"required,min=8" example:"87654321"`
+}
+
+// ForgotPasswordRequest represents the request body for requesting a password reset link
+type ForgotPasswordRequest struct {
+	Email string `json:"email" binding:"required,email" example:"test@example.com"`
+}
+
+// ResetPasswordRequest represents the request body for resetting a password with a reset token
+type ResetPasswordRequest struct {
+	Token    string `json:"token" binding:"required" example:"q0GDCb0wXJk5oK3Iu2p0n0v4Y0s8JqJ7Q6Xv3K2ZJ5A"`
+	Password string `json:"password" binding:"required,min=8" example:"87654321"`
+}
@@ -1,13 +1,19 @@
 package modelv1
 
-import domainpayment "github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain/payment"
+import (
+	"time"
+
+	domainpayment "github.com/TienMinh25/go-hexagonal-architecture/internal/application/domain/payment"
+)
 
 // PaymentResponse represents a payment response body
 type PaymentResponse struct {
-	ID   uint64                    `json:"id" example:"1"`
-	Name string                    `json:"name" example:"Tunai"`
-	Type domainpayment.PaymentType `json:"type" example:"CASH"`
-	Logo string                    `json:"logo" example:"https://example.com/cash.png"`
+	ID        uint64                    `json:"id" example:"1"`
+	Name      string                    `json:"name" example:"Tunai"`
+	Type      domainpayment.PaymentType `json:"type" example:"CASH"`
+	Logo      string                    `json:"logo" example:"https://example.com/cash.png"`
+	DeletedAt *time.Time                `json:"deleted_at,omitempty" example:"1970-01-01T00:00:00Z"`
+	Version   uint64                    `json:"version" example:"1"`
 }
 
 // CreatePaymentRequest represents a request body for creating a new payment
@@ -24,8 +30,9 @@ type GetPaymentRequest struct {
 
 // ListPaymentsRequest represents a request body for listing payments
 type ListPaymentsRequest struct {
-	Skip  uint64 `form:"skip" binding:"required,min=0" example:"0"`
-	Limit uint64 `form:"limit" binding:"required,min=5" example:"5"`
+	Skip            uint64 `form:"skip" binding:"required,min=0" example:"0"`
+	Limit           uint64 `form:"limit" binding:"required,min=5" example:"5"`
+	IncludeArchived bool   `form:"include_archived" binding:"omitempty" example:"false"`
 }
 
 // UpdatePaymentRequest represents a request body for updating a payment
@@ -39,3 +46,8 @@ type UpdatePaymentRequest struct {
 type DeletePaymentRequest struct {
 	ID uint64 `uri:"id" binding:"required,min=1" example:"1"`
 }
+
+// RestorePaymentRequest represents a request body for restoring an archived payment
+type RestorePaymentRequest struct {
+	ID uint64 `uri:"id" binding:"required,min=1" example:"1"`
+}
@@ -13,6 +13,8 @@ type ProductResponse struct {
 	Category  CategoryResponse `json:"category"`
 	CreatedAt time.Time        `json:"created_at" example:"1970-01-01T00:00:00Z"`
 	UpdatedAt time.Time        `json:"updated_at" example:"1970-01-01T00:00:00Z"`
+	DeletedAt *time.Time       `json:"deleted_at,omitempty" example:"1970-01-01T00:00:00Z"`
+	Version   uint64           `json:"version" example:"1"`
 }
 
 // CreateProductRequest represents a request body for creating a new product
@@ -31,10 +33,11 @@ type GetProductRequest struct {
 
 // ListProductsRequest represents a request body for listing products
 type ListProductsRequest struct {
-	CategoryID uint64 `form:"category_id" binding:"omitempty,min=1" example:"1"`
-	Query      string `form:"q" binding:"omitempty" example:"Chiki"`
-	Skip       uint64 `form:"skip" binding:"required,min=0" example:"0"`
-	Limit      uint64 `form:"limit" binding:"required,min=5" example:"5"`
+	CategoryID      uint64 `form:"category_id" binding:"omitempty,min=1" example:"1"`
+	Query           string `form:"q" binding:"omitempty" example:"Chiki"`
+	Skip            uint64 `form:"skip" binding:"required,min=0" example:"0"`
+	Limit           uint64 `form:"limit" binding:"required,min=5" example:"5"`
+	IncludeArchived bool   `form:"include_archived" binding:"omitempty" example:"false"`
 }
 
 // UpdateProductRequest represents a request body for updating a product
@@ -50,3 +53,8 @@ type UpdateProductRequest struct {
 type DeleteProductRequest struct {
 	ID uint64 `uri:"id" binding:"required,min=1" example:"1"`
 }
+
+// RestoreProductRequest represents a request body for restoring an archived product
+type RestoreProductRequest struct {
+	ID uint64 `uri:"id" binding:"required,min=1" example:"1"`
+}
@@ -2,8 +2,10 @@ package modelv1
 
 // ErrorResponse represents an error response body format
 type ErrorResponse struct {
-	Success  bool     `json:"success" example:"false"`
-	Messages []string `json:"messages" example:"Error message 1, Error message 2"`
+	Success   bool     `json:"success" example:"false"`
+	Code      string   `json:"code" example:"data_not_found"`
+	Messages  []string `json:"messages" example:"Error message 1, Error message 2"`
+	RequestID string   `json:"request_id,omitempty" example:"5f1b7c1e-6d0a-4b5e-9a5e-0c8f1d2e3a4b"`
 }
 
 // Response represents a response body format
@@ -19,3 +21,24 @@ type Meta struct {
 	Limit uint64 `json:"limit" example:"10"`
 	Skip  uint64 `json:"skip" example:"0"`
 }
+
+// ProblemResponse represents an error response body in the problem details format of RFC 7807,
+// it is sent instead of ErrorResponse when the client accepts application/problem+json
+type ProblemResponse struct {
+	Type      string       `json:"type" example:"urn:problem:invalid_request"`
+	Title     string       `json:"title" example:"Bad Request"`
+	Status    int          `json:"status" example:"400"`
+	Detail    string       `json:"detail" example:"request is invalid"`
+	Instance  string       `json:"instance" example:"/v1/products"`
+	Code      string       `json:"code" example:"invalid_request"`
+	RequestID string       `json:"request_id,omitempty" example:"5f1b7c1e-6d0a-4b5e-9a5e-0c8f1d2e3a4b"`
+	Errors    []FieldError `json:"errors,omitempty"`
+}
+
+// FieldError represents a field of the request that failed validation
+type FieldError struct {
+	Field   string `json:"field" example:"products[0].quantity"`
+	Rule    string `json:"rule" example:"min"`
+	Param   string `json:"param,omitempty" example:"1"`
+	Message string `json:"message" example:"products[0].quantity must be at least 1"`
+}
@@ -0,0 +1,43 @@
+package modelv1
+
+import "time"
+
+// RoleResponse represents a role response body
+type RoleResponse struct {
+	ID          uint64    `json:"id" example:"1"`
+	Name        string    `json:"name" example:"supervisor"`
+	Description string    `json:"description" example:"Runs the floor during a shift"`
+	IsSystem    bool      `json:"is_system" example:"false"`
+	Permissions []string  `json:"permissions" example:"orders:refund,reports:read"`
+	CreatedAt   time.Time `json:"created_at" example:"1970-01-01T00:00:00Z"`
+	UpdatedAt   time.Time `json:"updated_at" example:"1970-01-01T00:00:00Z"`
+}
+
+// PermissionResponse represents a permission response body
+type PermissionResponse struct {
+	ID          uint64 `json:"id" example:"1"`
+	Name        string `json:"name" example:"orders:refund"`
+	Description string `json:"description" example:"Refund orders"`
+}
+
+// CreateRoleRequest represents a request body for creating a new role
+type CreateRoleRequest struct {
+	Name        string   `json:"name" binding:"required,user_role" example:"supervisor"`
+	Description string   `json:"description" binding:"omitempty" example:"Runs the floor during a shift"`
+	Permissions []string `json:"permissions" binding:"omitempty,dive,required" example:"orders:refund,reports:read"`
+}
+
+// GetRoleRequest represents a request body for retrieving a role
+type GetRoleRequest struct {
+	ID uint64 `uri:"id" binding:"required,min=1" example:"1"`
+}
+
+// SetRolePermissionsRequest represents a request body for replacing the permissions of a role
+type SetRolePermissionsRequest struct {
+	Permissions []string `json:"permissions" binding:"omitempty,dive,required" example:"orders:refund,reports:read"`
+}
+
+// DeleteRoleRequest represents a request body for deleting a role
+type DeleteRoleRequest struct {
+	ID uint64 `uri:"id" binding:"required,min=1" example:"1"`
+}
@@ -0,0 +1,38 @@
+package modelv1
+
+import "time"
+
+// TerminalResponse represents a terminal response body
+type TerminalResponse struct {
+	ID        uint64     `json:"id" example:"1"`
+	Name      string     `json:"name" example:"Front counter till"`
+	CreatedAt time.Time  `json:"created_at" example:"1970-01-01T00:00:00Z"`
+	RevokedAt *time.Time `json:"revoked_at" example:"1970-01-01T00:00:00Z"`
+}
+
+// RegisterTerminalResponse represents the response body of a registered terminal, the secret is only shown once
+type RegisterTerminalResponse struct {
+	TerminalResponse
+	Secret string `json:"secret" example:"q0GDCb0wXJk5oK3Iu2p0n0v4Y0s8JqJ7Q6Xv3K2ZJ5A"`
+}
+
+// RegisterTerminalRequest represents a request body for registering a new terminal
+type RegisterTerminalRequest struct {
+	Name string `json:"name" binding:"required" example:"Front counter till"`
+}
+
+// GetTerminalRequest represents a request body for retrieving a terminal
+type GetTerminalRequest struct {
+	ID uint64 `uri:"id" binding:"required,min=1" example:"1"`
+}
+
+// ListTerminalsRequest represents a request body for listing terminals
+type ListTerminalsRequest struct {
+	Skip  uint64 `form:"skip" binding:"required,min=0" example:"0"`
+	Limit uint64 `form:"limit" binding:"required,min=5" example:"5"`
+}
+
+// RevokeTerminalRequest represents a request body for revoking a terminal
+type RevokeTerminalRequest struct {
+	ID uint64 `uri:"id" binding:"required,min=1" example:"1"`
+}
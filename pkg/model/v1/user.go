@@ -8,24 +8,29 @@ import (
 
 // UserResponse represents a user response body
 type UserResponse struct {
-	ID        uint64    `json:"id" example:"1"`
-	Name      string    `json:"name" example:"John Doe"`
-	Email     string    `json:"email" example:"test@example.com"`
-	CreatedAt time.Time `json:"created_at" example:"1970-01-01T00:00:00Z"`
-	UpdatedAt time.Time `json:"updated_at" example:"1970-01-01T00:00:00Z"`
+	ID        uint64     `json:"id" example:"1"`
+	Name      string     `json:"name" example:"John Doe"`
+	Email     string     `json:"email" example:"test@example.com"`
+	Locale    string     `json:"locale,omitempty" example:"vi"`
+	CreatedAt time.Time  `json:"created_at" example:"1970-01-01T00:00:00Z"`
+	UpdatedAt time.Time  `json:"updated_at" example:"1970-01-01T00:00:00Z"`
+	DeletedAt *time.Time `json:"deleted_at,omitempty" example:"1970-01-01T00:00:00Z"`
+	Version   uint64     `json:"version" example:"1"`
 }
 
-// RegisterRequest represents the request body for creating a user
+// RegisterRequest represents the request body for creating a user, the invitation token is required when registration is invite-only
 type RegisterRequest struct {
-	Name     string `json:"name" binding:"required" example:"John Doe"`
-	Email    string `json:"email" binding:"required,email" example:"test@example.com"`
-	Password string `json:"password" binding:"required,min=8" example:"12345678"`
+	Name            string `json:"name" binding:"required" example:"John Doe"`
+	Email           string `json:"email" binding:"required,email" example:"test@example.com"`
+	Password        string `json:"password" binding:"required,min=8" example:"12345678"`
+	InvitationToken string `json:"invitation_token" binding:"omitempty" example:"q0GDCb0wXJk5oK3Iu2p0n0v4Y0s8JqJ7Q6Xv3K2ZJ5A"`
 }
 
 // ListUsersRequest represents the request body for listing users
 type ListUsersRequest struct {
-	Skip  uint64 `form:"skip" binding:"required,min=0" example:"0"`
-	Limit uint64 `form:"limit" binding:"required,min=5" example:"5"`
+	Skip            uint64 `form:"skip" binding:"required,min=0" example:"0"`
+	Limit           uint64 `form:"limit" binding:"required,min=5" example:"5"`
+	IncludeArchived bool   `form:"include_archived" binding:"omitempty" example:"false"`
 }
 
 // GetUserRequest represents the request body for getting a user
@@ -41,7 +46,21 @@ type UpdateUserRequest struct {
 	Role     domainuser.UserRole `json:"role" binding:"omitempty,required,user_role" example:"admin"`
 }
 
+// UpdateMeRequest represents the request body for updating the profile of the logged in user,
+// the locale messages are sent in applies from the next token on and the current password is required to change the email
+type UpdateMeRequest struct {
+	Name            string `json:"name" binding:"omitempty,required" example:"John Doe"`
+	Email           string `json:"email" binding:"omitempty,required,email" example:"test@example.com"`
+	Locale          string `json:"locale" binding:"omitempty,locale" example:"vi"`
+	CurrentPassword string `json:"current_password" binding:"omitempty,required" example:"12345678"`
+}
+
 // DeleteUserRequest represents the request body for deleting a user
 type DeleteUserRequest struct {
 	ID uint64 `uri:"id" binding:"required,min=1" example:"1"`
 }
+
+// RestoreUserRequest represents a request body for restoring an archived user
+type RestoreUserRequest struct {
+	ID uint64 `uri:"id" binding:"required,min=1" example:"1"`
+}